@@ -85,7 +85,8 @@ var (
 		{Account: nft.ModuleName},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: icatypes.ModuleName},
-		{Account: marketplacemoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}}}
+		{Account: marketplacemoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+		{Account: marketplacemoduletypes.EscrowAccountName}}
 
 	// blocked account addresses
 	blockAccAddrs = []string{
@@ -95,6 +96,7 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		marketplacemoduletypes.EscrowAccountName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -54,7 +54,7 @@ export function GigCard({ gig, onApply, showApplyButton = true }: GigCardProps)
       {/* Price and Action */}
       <div className="flex justify-between items-center pt-4 border-t">
         <div className="text-2xl font-bold text-green-600">
-          {formatAmount(gig.price)} SKILL
+          {formatAmount(gig.price.amount)} {gig.price.denom.toUpperCase()}
         </div>
         
         {showApplyButton && gig.status === 'open' && onApply && (
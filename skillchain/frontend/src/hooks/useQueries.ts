@@ -91,7 +91,7 @@ export function useMyBalance(denom: string = 'skill') {
 export function useEscrowBalance() {
   return useQuery({
     queryKey: ['marketplace', 'escrow'],
-    queryFn: api.getEscrowBalance,
+    queryFn: () => api.getEscrowBalance(),
     staleTime: 10000,
   });
 }
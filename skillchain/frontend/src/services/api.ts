@@ -2,12 +2,26 @@
 import axios from 'axios';
 import type {
   Profile,
+  Referral,
   Gig,
   Application,
   Contract,
+  ContractEscrow,
+  CancellationProposal,
+  Amendment,
+  DeadlineExtension,
+  Tip,
+  TimeLog,
+  StreamAccrual,
   Dispute,
+  Arbiter,
   Params,
+  FeeStats,
+  FeePreview,
+  CategoryRule,
+  EscrowStaking,
   Balance,
+  Coin,
 } from '@/types/skillchain';
 
 const API_BASE = 'http://localhost:1317';
@@ -52,8 +66,10 @@ export async function getGig(id: string): Promise<Gig | null> {
   }
 }
 
-export async function getAllGigs(): Promise<Gig[]> {
-  const response = await api.get('/skillchain/marketplace/v1/gig');
+export async function getAllGigs(fundedOnly = false): Promise<Gig[]> {
+  const response = await api.get('/skillchain/marketplace/v1/gig', {
+    params: fundedOnly ? { funded: true } : undefined,
+  });
   return response.data.gig || [];
 }
 
@@ -125,10 +141,160 @@ export async function getAllDisputes(): Promise<Dispute[]> {
   return response.data.dispute || [];
 }
 
+// Arbiters
+function toArbiter(arbiter: any): Arbiter {
+  return {
+    address: arbiter.address,
+    stake: arbiter.stake,
+    status: arbiter.status,
+    bondedAt: arbiter.bonded_at,
+    unbondingEndsAt: arbiter.unbonding_ends_at,
+    majorityVotes: arbiter.majority_votes || '0',
+    minorityVotes: arbiter.minority_votes || '0',
+    missedVotes: arbiter.missed_votes || '0',
+    earned: arbiter.earned || [],
+    slashed: arbiter.slashed || [],
+  };
+}
+
+export async function getArbiter(address: string): Promise<{ arbiter: Arbiter; accuracyBps: string } | null> {
+  try {
+    const response = await api.get(`/skillchain/marketplace/v1/arbiter/${address}`);
+    return { arbiter: toArbiter(response.data.arbiter), accuracyBps: response.data.accuracy_bps || '0' };
+  } catch (error: any) {
+    if (error.response?.status === 404) return null;
+    throw error;
+  }
+}
+
+export async function getActiveArbiters(): Promise<{ arbiters: Arbiter[]; totalStake: Coin }> {
+  const response = await api.get('/skillchain/marketplace/v1/active_arbiters');
+  return {
+    arbiters: (response.data.arbiters || []).map(toArbiter),
+    totalStake: response.data.total_stake,
+  };
+}
+
 // Escrow Balance
-export async function getEscrowBalance(): Promise<string> {
+export async function getEscrowBalance(denom: string = 'skill'): Promise<string> {
   const response = await api.get('/skillchain/marketplace/v1/escrow_balance');
-  return response.data.balance?.amount || '0';
+  const balances: Coin[] = response.data.balances || [];
+  return balances.find(coin => coin.denom === denom)?.amount || '0';
+}
+
+export async function getContractEscrow(contractId: string): Promise<{ escrow: ContractEscrow; held: Coin[] } | null> {
+  try {
+    const response = await api.get(`/skillchain/marketplace/v1/contract_escrow/${contractId}`);
+    return { escrow: response.data.escrow, held: response.data.held || [] };
+  } catch (error: any) {
+    if (error.response?.status === 404) return null;
+    throw error;
+  }
+}
+
+export async function getEscrowsByUser(address: string): Promise<ContractEscrow[]> {
+  const response = await api.get(`/skillchain/marketplace/v1/escrows_by_user/${address}`);
+  return response.data.escrows || [];
+}
+
+export async function getFeeStats(): Promise<{ stats: FeeStats; pending: Coin[] }> {
+  const response = await api.get('/skillchain/marketplace/v1/fee_stats');
+  return { stats: response.data.stats, pending: response.data.pending || [] };
+}
+
+export async function getFeePreview(address: string, price: Coin, category: string = ''): Promise<FeePreview> {
+  const response = await api.get(`/skillchain/marketplace/v1/fee_preview/${address}`, {
+    params: { 'price.denom': price.denom, 'price.amount': price.amount, category },
+  });
+  return {
+    tier: response.data.tier,
+    feeBps: response.data.fee_bps,
+    fee: response.data.fee,
+    net: response.data.net,
+  };
+}
+
+export async function getEscrowStaking(): Promise<EscrowStaking> {
+  const response = await api.get('/skillchain/marketplace/v1/escrow_staking');
+  const pool = response.data.pool;
+  return {
+    bonded: pool.bonded,
+    unbonding: pool.unbonding,
+    shortfall: pool.shortfall,
+    rewards: pool.rewards || [],
+    optedIn: response.data.opted_in,
+    liquid: response.data.liquid,
+  };
+}
+
+export async function getCategoryRule(category: string): Promise<{ rule: CategoryRule; overridden: boolean }> {
+  const response = await api.get(`/skillchain/marketplace/v1/category_rule/${category}`);
+  const rule = response.data.rule;
+  return {
+    rule: {
+      category: rule.category,
+      minPrice: rule.min_price,
+      feeBps: rule.fee_bps,
+      maxDeliveryDays: rule.max_delivery_days,
+      disputeDuration: rule.dispute_duration,
+    },
+    overridden: response.data.overridden || false,
+  };
+}
+
+export async function getReferralEarnings(referrer: string): Promise<{ earned: Coin[]; referredCount: string }> {
+  const response = await api.get(`/skillchain/marketplace/v1/referral_earnings/${referrer}`);
+  return { earned: response.data.earned || [], referredCount: response.data.referred_count };
+}
+
+export async function getReferredAccounts(referrer: string): Promise<Referral[]> {
+  const response = await api.get(`/skillchain/marketplace/v1/referred_accounts/${referrer}`);
+  return response.data.referrals || [];
+}
+
+export async function getCancellationProposal(contractId: string): Promise<CancellationProposal | null> {
+  try {
+    const response = await api.get(`/skillchain/marketplace/v1/cancellation_proposal/${contractId}`);
+    return response.data.proposal;
+  } catch (error: any) {
+    if (error.response?.status === 404) return null;
+    throw error;
+  }
+}
+
+export async function getAmendment(contractId: string): Promise<Amendment | null> {
+  try {
+    const response = await api.get(`/skillchain/marketplace/v1/amendment/${contractId}`);
+    return response.data.amendment;
+  } catch (error: any) {
+    if (error.response?.status === 404) return null;
+    throw error;
+  }
+}
+
+export async function getExtensionsByContract(contractId: string): Promise<DeadlineExtension[]> {
+  const response = await api.get(`/skillchain/marketplace/v1/extensions_by_contract/${contractId}`);
+  return response.data.extensions || [];
+}
+
+export async function getTipsByContract(contractId: string): Promise<{ tips: Tip[]; total: Coin[] }> {
+  const response = await api.get(`/skillchain/marketplace/v1/tips_by_contract/${contractId}`);
+  return { tips: response.data.tips || [], total: response.data.total || [] };
+}
+
+export async function getTimeLogsByContract(contractId: string): Promise<TimeLog[]> {
+  const response = await api.get(`/skillchain/marketplace/v1/time_logs_by_contract/${contractId}`);
+  return response.data.time_logs || [];
+}
+
+export async function getStreamAccrual(contractId: string): Promise<StreamAccrual | null> {
+  try {
+    const response = await api.get(`/skillchain/marketplace/v1/stream_accrual/${contractId}`);
+    return response.data;
+  } catch (error: any) {
+    if (error.response?.status === 404) return null;
+    throw error;
+  }
 }
 
 // ============ QUERIES BANK ============
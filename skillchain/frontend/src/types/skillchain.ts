@@ -7,9 +7,19 @@ export interface Profile {
   skills: string[];
   hourlyRate: string;  // uint64 to string to avoid precision issues
   totalJobs: string;
-  totalEarned: string;
+  totalEarned: Coin[];
   ratingSum: string;
   ratingCount: string;
+  referrer: string;
+}
+
+export interface Referral {
+  referred: string;
+  referrer: string;
+  rewardedContracts: string;
+  earned: Coin[];
+  createdAt: string;
+  contractIds: string[];
 }
 
 export interface Gig {
@@ -17,11 +27,12 @@ export interface Gig {
   title: string;
   description: string;
   owner: string;
-  price: string;
+  price: Coin;
   category: string;
   deliveryDays: string;
   status: GigStatus;
   createdAt: string;
+  funded: boolean;
 }
 
 export type GigStatus = 'open' | 'in_progress' | 'completed' | 'cancelled' | 'disputed';
@@ -31,13 +42,17 @@ export interface Application {
   gigId: string;
   freelancer: string;
   coverLetter: string;
-  proposedPrice: string;
+  proposedPrice: Coin;
   proposedDays: string;
   status: ApplicationStatus;
   createdAt: string;
+  hourlyRate: string;
+  weeklyHoursCap: string;
+  streaming: boolean;
+  bond: Coin;
 }
 
-export type ApplicationStatus = 'pending' | 'accepted' | 'rejected' | 'withdrawn';
+export type ApplicationStatus = 'pending' | 'accepted' | 'rejected' | 'withdrawn' | 'spam';
 
 export interface Contract {
   id: string;
@@ -45,14 +60,111 @@ export interface Contract {
   applicationId: string;
   client: string;
   freelancer: string;
-  price: string;
+  price: Coin;
   deliveryDeadline: string;
   status: ContractStatus;
   createdAt: string;
   completedAt: string;
+  deliveredAt: string;
+  hourlyRate: string;
+  weeklyHoursCap: string;
+  billed: string;
+  periodHours: string;
+  streamStart: string;
+  streamEnd: string;
+  descriptionHash: string;
+  priorTerms: ContractTerms[];
+  extensionsGranted: string;
+  escrowStaking: boolean;
+  freelancerPayoutBps: string;
+}
+
+export interface ContractTerms {
+  price: Coin;
+  deliveryDeadline: string;
+  descriptionHash: string;
+  amendedAt: string;
+}
+
+export interface Amendment {
+  contractId: string;
+  proposer: string;
+  price: Coin;
+  deliveryDeadline: string;
+  descriptionHash: string;
+  reason: string;
+  createdAt: string;
+}
+
+export type DeadlineExtensionStatus = 'pending' | 'approved' | 'declined';
+
+export interface DeadlineExtension {
+  id: string;
+  contractId: string;
+  freelancer: string;
+  previousDeadline: string;
+  requestedDeadline: string;
+  reason: string;
+  status: DeadlineExtensionStatus;
+  createdAt: string;
+  decidedAt: string;
+}
+
+export interface StreamAccrual {
+  accrued: Coin;
+  claimed: Coin;
+  claimable: Coin;
+}
+
+export interface ContractEscrow {
+  contractId: string;
+  client: string;
+  freelancer: string;
+  locked: Coin[];
+  released: Coin[];
+  refunded: Coin[];
+  fees: Coin[];
+  stakingRewards: Coin[];
+  disputeFees: Coin[];
+  stakingLosses: Coin[];
+}
+
+export interface CancellationProposal {
+  contractId: string;
+  proposer: string;
+  freelancerPayout: string;
+  reason: string;
+  createdAt: string;
+  expiresAt: string;
+}
+
+export interface Tip {
+  id: string;
+  contractId: string;
+  client: string;
+  freelancer: string;
+  amount: Coin;
+  fee: Coin;
+  note: string;
+  createdAt: string;
 }
 
-export type ContractStatus = 'active' | 'delivered' | 'completed' | 'disputed' | 'cancelled';
+export interface TimeLog {
+  id: string;
+  contractId: string;
+  freelancer: string;
+  hours: string;
+  descriptionHash: string;
+  loggedAt: string;
+  contestDeadline: string;
+  status: TimeLogStatus;
+  contestReason: string;
+  billedAt: string;
+}
+
+export type TimeLogStatus = 'pending' | 'contested' | 'billed';
+
+export type ContractStatus = 'active' | 'delivered' | 'overdue' | 'completed' | 'disputed' | 'cancelled' | 'resolved_split';
 
 export interface Dispute {
   id: string;
@@ -67,9 +179,53 @@ export interface Dispute {
   resolution: string;
   createdAt: string;
   deadline: string;
+  arbiters: string[];
+  votes: DisputeVote[];
+  alternates: string[];
+  jurorDeadline: string;
+  dismissed: string[];
+  revealDeadline: string;
+  freelancerPayoutBps: string;
+  appeals: string;
+  appealDeadline: string;
+  appellant: string;
+  appealBond: Coin;
+  appealReason: string;
+  appealedPayoutBps: string;
+  arbiterFees: Coin;
+  settled: boolean;
+  filingFee: Coin;
+  contested: boolean;
+  responseDeadline: string;
+}
+
+// The vote is empty until the juror revealed it.
+export interface DisputeVote {
+  arbiter: string;
+  disputeId: string;
+  vote: 'client' | 'freelancer' | 'split' | '';
+  votedAt: string;
+  commitment: string;
+  revealed: boolean;
+  freelancerPayoutBps: string;
 }
 
-export type DisputeStatus = 'open' | 'voting' | 'resolved_client' | 'resolved_freelancer' | 'expired';
+export interface Arbiter {
+  address: string;
+  stake: Coin;
+  status: ArbiterStatus;
+  bondedAt: string;
+  unbondingEndsAt: string;
+  majorityVotes: string;
+  minorityVotes: string;
+  missedVotes: string;
+  earned: Coin[];
+  slashed: Coin[];
+}
+
+export type ArbiterStatus = 'bonded' | 'unbonding';
+
+export type DisputeStatus = 'open' | 'voting' | 'resolved_client' | 'resolved_freelancer' | 'resolved_split' | 'expired';
 
 export interface Params {
   platformFeePercent: string;
@@ -78,6 +234,100 @@ export interface Params {
   disputeDuration: string;
   minArbitersRequired: string;
   arbiterStakeRequired: string;
+  allowedDenoms: string[];
+  stakeDenom: string;
+  feeDistribution: FeeDistribution;
+  feeSettlementEpoch: string;
+  reviewPeriod: string;
+  deadlineGracePeriod: string;
+  cancellationExpiry: string;
+  tipFeeEnabled: boolean;
+  hourlyBillingEpoch: string;
+  timeLogContestWindow: string;
+  applicationBond: Coin;
+  maxDeadlineExtensions: string;
+  feeTiers: FeeTier[];
+  referralFeeShareBps: string;
+  referralMaxContracts: string;
+  escrowStaking: EscrowStakingParams;
+  categoryRules: CategoryRule[];
+  arbiterUnbondingPeriod: string;
+  disputeFeeBps: string;
+  arbiterSlashBps: string;
+  juryAlternates: string;
+  jurorVotePeriod: string;
+  revealPeriod: string;
+  rulingToleranceBps: string;
+  appealPeriod: string;
+  appealBondBps: string;
+  maxAppealDepth: string;
+  appealJuryMultiplier: string;
+  appealDurationMultiplier: string;
+  disputeFilingFeeBps: string;
+  disputeResponsePeriod: string;
+  disputeFilingFees: Coin[];
+}
+
+export interface FeeDistribution {
+  treasury: string;
+  treasuryBps: string;
+  communityPoolBps: string;
+  burnBps: string;
+}
+
+export interface FeeTier {
+  name: string;
+  minCompletedJobs: string;
+  minRating: string;
+  minStake: string;
+  feeBps: string;
+}
+
+export interface CategoryRule {
+  category: string;
+  minPrice: string;
+  feeBps: string;
+  maxDeliveryDays: string;
+  disputeDuration: string;
+  minPriceSet: boolean;
+  feeBpsSet: boolean;
+  maxDeliveryDaysSet: boolean;
+  disputeDurationSet: boolean;
+}
+
+export interface FeePreview {
+  tier: string;
+  feeBps: string;
+  fee: Coin;
+  net: Coin;
+}
+
+export interface EscrowStakingParams {
+  enabled: boolean;
+  validators: string[];
+  maxStakedBps: string;
+  reserveBps: string;
+  clientRewardBps: string;
+  freelancerRewardBps: string;
+  treasuryRewardBps: string;
+  epoch: string;
+}
+
+export interface EscrowStaking {
+  bonded: string;
+  unbonding: string;
+  shortfall: string;
+  rewards: Coin[];
+  denom: string;
+  optedIn: Coin;
+  liquid: Coin;
+}
+
+export interface FeeStats {
+  collected: Coin[];
+  treasury: Coin[];
+  communityPool: Coin[];
+  burned: Coin[];
 }
 
 export interface QueryResponse<T> {
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// isAmendable reports whether the terms of a contract in status can still be
+// changed.
+func isAmendable(status string) bool {
+	return status == "active" || status == "overdue"
+}
+
+// validateAmendment checks that the amendment can apply to the contract as it
+// is now. It runs when the amendment is proposed and again when it is
+// accepted.
+func (k Keeper) validateAmendment(ctx sdk.Context, contract types.Contract, amendment types.Amendment) error {
+	if !isAmendable(contract.Status) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"cannot amend contract with status %s",
+			contract.Status,
+		)
+	}
+
+	changesPrice := amendmentChangesPrice(amendment)
+	if !changesPrice && amendment.DeliveryDeadline == 0 && amendment.DescriptionHash == "" {
+		return errorsmod.Wrap(types.ErrInvalidAmendment, "amendment changes nothing")
+	}
+
+	if changesPrice {
+		if len(contract.Milestones) > 0 || contract.IsHourly() || contract.IsStreaming() {
+			return errorsmod.Wrap(types.ErrInvalidAmendment, "only the price of single payment contracts can be amended")
+		}
+		if err := amendment.Price.Validate(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidPrice, err.Error())
+		}
+		if amendment.Price.Denom != contract.Price.Denom {
+			return errorsmod.Wrapf(types.ErrDenomNotAllowed, "contract is priced in %s", contract.Price.Denom)
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+		}
+		category, err := k.contractCategory(ctx, contract)
+		if err != nil {
+			return err
+		}
+		if rule, _ := params.CategoryRule(category); amendment.Price.Amount.LT(rule.MinPrice) {
+			return errorsmod.Wrap(types.ErrInvalidPrice, "amended price is below minimum")
+		}
+	}
+
+	if amendment.DeliveryDeadline != 0 {
+		if contract.IsStreaming() {
+			return errorsmod.Wrap(types.ErrInvalidAmendment, "the deadline of a streaming contract is its stream schedule")
+		}
+		if amendment.DeliveryDeadline <= ctx.BlockTime().Unix() {
+			return errorsmod.Wrap(types.ErrInvalidAmendment, "amended deadline must be in the future")
+		}
+	}
+
+	return nil
+}
+
+// amendmentChangesPrice reports whether the amendment sets a new price.
+func amendmentChangesPrice(amendment types.Amendment) bool {
+	return !amendment.Price.Amount.IsNil() && !amendment.Price.IsZero()
+}
+
+// applyAmendment records the current terms of the contract in its history and
+// replaces them with the amended ones. A higher price is topped up by the
+// client and a lower one refunded. An overdue contract given a new deadline
+// is active again. The caller saves the contract.
+func (k Keeper) applyAmendment(ctx sdk.Context, contract *types.Contract, amendment types.Amendment) error {
+	contract.PriorTerms = append(contract.PriorTerms, types.ContractTerms{
+		Price:            contract.Price,
+		DeliveryDeadline: contract.DeliveryDeadline,
+		DescriptionHash:  contract.DescriptionHash,
+		AmendedAt:        ctx.BlockTime().Unix(),
+	})
+
+	if amendmentChangesPrice(amendment) {
+		switch {
+		case contract.Price.IsLT(amendment.Price):
+			if err := k.addEscrow(ctx, *contract, sdk.NewCoins(amendment.Price.Sub(contract.Price))); err != nil {
+				return errorsmod.Wrap(types.ErrInsufficientFunds, err.Error())
+			}
+		case amendment.Price.IsLT(contract.Price):
+			if _, err := k.refundEscrow(ctx, *contract, contract.Price.Amount.Sub(amendment.Price.Amount)); err != nil {
+				return err
+			}
+		}
+		contract.Price = amendment.Price
+	}
+
+	if amendment.DeliveryDeadline != 0 {
+		contract.DeliveryDeadline = amendment.DeliveryDeadline
+		if contract.Status == "overdue" {
+			contract.Status = "active"
+		}
+	}
+
+	if amendment.DescriptionHash != "" {
+		contract.DescriptionHash = amendment.DescriptionHash
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// appealBond returns the bond held in escrow for the last appeal of a
+// dispute, none once the appeal was ruled on.
+func appealBond(dispute types.Dispute) sdk.Coins {
+	if !dispute.AppealBondHeld() || dispute.AppealBond.Amount.IsNil() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(dispute.AppealBond)
+}
+
+// settleAppealBond refunds the bond of an appeal to the appellant when the
+// appeal jury overturned the appealed ruling, moving it in favor of the
+// appellant by more than ruling_tolerance_bps. The bond is forfeited like a
+// platform fee otherwise. dispute is the appeal before it was ruled on.
+func (k Keeper) settleAppealBond(ctx sdk.Context, params types.Params, dispute types.Dispute, contract types.Contract, ruling uint64) error {
+	bond := appealBond(dispute)
+
+	var overturned bool
+	if dispute.Appellant == contract.Client {
+		overturned = ruling+params.RulingToleranceBps < dispute.AppealedPayoutBps
+	} else {
+		overturned = ruling > dispute.AppealedPayoutBps+params.RulingToleranceBps
+	}
+
+	if overturned && !bond.IsZero() {
+		appellantAddr, err := k.addressCodec.StringToBytes(dispute.Appellant)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid appellant address")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, appellantAddr, bond); err != nil {
+			return errorsmod.Wrap(err, "failed to refund appeal bond")
+		}
+	} else if !overturned {
+		for _, coin := range bond {
+			if err := k.collectFee(ctx, params, coin); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"appeal_decided",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("appellant", dispute.Appellant),
+			sdk.NewAttribute("appealed_payout_bps", fmt.Sprintf("%d", dispute.AppealedPayoutBps)),
+			sdk.NewAttribute("freelancer_payout_bps", fmt.Sprintf("%d", ruling)),
+			sdk.NewAttribute("overturned", fmt.Sprintf("%t", overturned)),
+			sdk.NewAttribute("bond", bond.String()),
+		),
+	)
+	return nil
+}
+
+// settleDisputePayout pays the escrow out according to the final ruling on a
+// dispute, less the dispute fees paid to the arbiters over every round.
+func (k Keeper) settleDisputePayout(ctx sdk.Context, dispute *types.Dispute) error {
+	contract, err := k.Contract.Get(ctx, dispute.ContractId)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", dispute.ContractId)
+	}
+
+	var payout, refund sdk.Coins
+	if dispute.FreelancerPayoutBps == types.BasisPoints {
+		payout, err = k.settleDisputeForFreelancer(ctx, &contract, dispute.ArbiterFees.Amount)
+	} else {
+		payout, refund, err = k.settleDisputeSplit(ctx, &contract, dispute.ArbiterFees.Amount, dispute.FreelancerPayoutBps)
+	}
+	if err != nil {
+		return err
+	}
+
+	dispute.Settled = true
+	if err := k.Dispute.Set(ctx, dispute.Id, *dispute); err != nil {
+		return errorsmod.Wrap(err, "failed to update dispute")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_settled",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer_payout_bps", fmt.Sprintf("%d", dispute.FreelancerPayoutBps)),
+			sdk.NewAttribute("amount", payout.String()),
+			sdk.NewAttribute("refund", refund.String()),
+		),
+	)
+	return nil
+}
+
+// ProcessAppealDeadlines pays out the escrow of the disputes whose ruling was
+// not appealed by the appeal deadline.
+func (k Keeper) ProcessAppealDeadlines(ctx sdk.Context) error {
+	now := ctx.BlockTime().Unix()
+	due, err := k.dueDisputes(ctx, k.AppealQueue, now, func(dispute types.Dispute) bool {
+		return dispute.IsResolved() && !dispute.Settled && dispute.AppealDeadline <= now
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing appeal deadlines")
+	}
+
+	for _, dispute := range due {
+		// a dispute whose escrow cannot be paid out must not halt the chain,
+		// its changes are discarded and it is retried on the next block
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleDisputePayout(cacheCtx, &dispute); err != nil {
+			ctx.Logger().Error("failed to settle dispute", "dispute_id", dispute.Id, "error", err)
+			if err := k.retryDispute(ctx, k.AppealQueue, now, dispute.Id); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestAppealOverturnsRuling(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderHash([]byte("header_hash"))
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinArbitersRequired = 1
+	params.JuryAlternates = 0
+	params.AppealJuryMultiplier = 3
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	for _, name := range []string{"arbiter1", "arbiter2", "arbiter3", "arbiter4"} {
+		registerArbiter(t, f, name+"____________", 1000)
+	}
+
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Len(t, dispute.Arbiters, 1)
+	require.NoError(t, commitVote(ctx, ms, dispute.Arbiters[0], dispute.Id, 0))
+	require.NoError(t, revealVote(ctx, ms, dispute.Arbiters[0], dispute.Id, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+
+	// the refund waits for the appeal window
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_client", dispute.Status)
+	require.Equal(t, ctx.BlockTime().Unix()+int64(types.DefaultAppealPeriod), dispute.AppealDeadline)
+	require.False(t, dispute.Settled)
+	require.True(t, f.bankKeeper.GetBalance(ctx, clientAddr, "skill").IsZero())
+	require.NoError(t, f.keeper.ProcessAppealDeadlines(ctx))
+	require.True(t, f.bankKeeper.GetBalance(ctx, clientAddr, "skill").IsZero())
+
+	// only the losing party can appeal, posting 10% of the disputed amount
+	appeal := &types.MsgAppealDispute{Creator: contract.Client, DisputeId: dispute.Id, Reason: "fine as is"}
+	_, err = ms.AppealDispute(ctx, appeal)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	appeal.Creator = dispute.Arbiters[0]
+	_, err = ms.AppealDispute(ctx, appeal)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	appeal.Creator, appeal.Reason = contract.Freelancer, "the work was delivered on time"
+	_, err = ms.AppealDispute(ctx, appeal)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	f.bankKeeper.mint(freelancerAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)))
+	appealed, err := ms.AppealDispute(ctx, appeal)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 100), appealed.Bond)
+
+	// the appeal draws a jury three times larger with twice the time
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "open", dispute.Status)
+	require.Equal(t, uint64(1), dispute.Appeals)
+	require.Equal(t, contract.Freelancer, dispute.Appellant)
+	require.Len(t, dispute.Arbiters, 3)
+	require.Empty(t, dispute.Votes)
+	require.Equal(t, ctx.BlockTime().Unix()+2*int64(types.DefaultDisputeDuration), dispute.Deadline)
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	payouts := []uint64{types.BasisPoints, types.BasisPoints, 8000}
+	for i, juror := range dispute.Arbiters {
+		require.NoError(t, commitVote(ctx, ms, juror, dispute.Id, payouts[i]))
+	}
+	for i, juror := range dispute.Arbiters {
+		require.NoError(t, revealVote(ctx, ms, juror, dispute.Id, payouts[i]))
+	}
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+
+	// the ruling was overturned: the bond is refunded and, the last appeal
+	// decided, the freelancer is paid right away less the fees of both juries
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_freelancer", dispute.Status)
+	require.True(t, dispute.Settled)
+	require.Equal(t, sdk.NewInt64Coin("skill", 40), dispute.ArbiterFees)
+	require.Equal(t, int64(1012), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+	require.True(t, f.bankKeeper.GetBalance(ctx, clientAddr, "skill").IsZero())
+	_, err = ms.AppealDispute(ctx, &types.MsgAppealDispute{Creator: contract.Client, DisputeId: dispute.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestAppealUpheldForfeitsBond(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	recordRuling(t, f, opened.DisputeId, 0)
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: opened.DisputeId})
+	require.NoError(t, err)
+
+	f.bankKeeper.mint(freelancerAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)))
+	_, err = ms.AppealDispute(ctx, &types.MsgAppealDispute{Creator: contract.Freelancer, DisputeId: opened.DisputeId})
+	require.NoError(t, err)
+
+	// without enough votes the appealed ruling stands and the bond is
+	// collected like a platform fee, with the stake slashed from the three
+	// jurors who missed the appeal
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_client", dispute.Status)
+	require.True(t, dispute.Settled)
+	require.True(t, f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").IsZero())
+	require.Equal(t, int64(982), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount.Int64())
+	stats, err := f.keeper.FeeStats.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 250)), stats.Collected)
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestDisputeFeesOverEveryAppeal(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderHash([]byte("header_hash"))
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinArbitersRequired = 1
+	params.JuryAlternates = 0
+	params.MaxAppealDepth = types.MaxAppealDepth
+	params.AppealJuryMultiplier = 1
+	params.AppealDurationMultiplier = 1
+	params.ArbiterUnbondingPeriod = params.RoundDuration(params.DisputeDuration, params.MaxAppealDepth) + params.RevealPeriod
+	params.DisputeFeeBps = types.BasisPoints/(types.MaxAppealDepth+1) + 1
+	require.Error(t, params.Validate())
+	params.DisputeFeeBps = types.BasisPoints / (types.MaxAppealDepth + 1)
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	for _, name := range []string{"arbiter1", "arbiter2", "arbiter3"} {
+		registerArbiter(t, f, name+"____________", 1000)
+	}
+
+	// every round goes against the previous one, up to the last appeal
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	for round := range params.MaxAppealDepth + 1 {
+		dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+		require.NoError(t, err)
+		require.Equal(t, round, dispute.Appeals)
+		ruling, appellant, appellantAddr := uint64(0), contract.Freelancer, freelancerAddr
+		if round%2 == 1 {
+			ruling, appellant, appellantAddr = types.BasisPoints, contract.Client, clientAddr
+		}
+		if round == params.MaxAppealDepth {
+			// a dispute fee raised since is capped by what the earlier
+			// rounds left of the disputed amount
+			raised := params
+			raised.DisputeFeeBps, raised.MaxAppealDepth = types.BasisPoints/2, 1
+			require.NoError(t, raised.Validate())
+			require.NoError(t, f.keeper.Params.Set(ctx, raised))
+		}
+		require.NoError(t, commitVote(ctx, ms, dispute.Arbiters[0], dispute.Id, ruling))
+		require.NoError(t, revealVote(ctx, ms, dispute.Arbiters[0], dispute.Id, ruling))
+		require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+
+		if round < params.MaxAppealDepth {
+			f.bankKeeper.mint(appellantAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)))
+			_, err = ms.AppealDispute(ctx, &types.MsgAppealDispute{Creator: appellant, DisputeId: dispute.Id, Reason: "wrong ruling"})
+			require.NoError(t, err)
+		}
+	}
+
+	// the freelancer won the last appeal, the escrow going to the six juries,
+	// and gets back its three bonds as every appeal overturned the ruling
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_freelancer", dispute.Status)
+	require.True(t, dispute.Settled)
+	require.Equal(t, sdk.NewInt64Coin("skill", 1000), dispute.ArbiterFees)
+	require.Equal(t, int64(300), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestDisputeProcessingRetried(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	escrowAddr := authtypes.NewModuleAddress(types.EscrowAccountName).String()
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	recordRuling(t, f, opened.DisputeId, 0)
+
+	// a dispute whose jurors cannot be paid does not halt the chain and is
+	// resolved on a later block
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	escrowBalance := f.bankKeeper.balances[escrowAddr]
+	f.bankKeeper.balances[escrowAddr] = sdk.NewCoins()
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "open", dispute.Status)
+
+	f.bankKeeper.balances[escrowAddr] = escrowBalance
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_client", dispute.Status)
+
+	// and so does a ruling whose escrow cannot be paid out
+	ctx = ctx.WithBlockTime(time.Unix(dispute.AppealDeadline, 0))
+	escrowBalance = f.bankKeeper.balances[escrowAddr]
+	f.bankKeeper.balances[escrowAddr] = sdk.NewCoins()
+	require.NoError(t, f.keeper.ProcessAppealDeadlines(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.False(t, dispute.Settled)
+
+	f.bankKeeper.balances[escrowAddr] = escrowBalance
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, f.keeper.ProcessAppealDeadlines(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.True(t, dispute.Settled)
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_client", contract.Status)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// applicationBond returns the bond held for a pending application, none for
+// applications made before bonds were introduced.
+func applicationBond(application types.Application) sdk.Coins {
+	if application.Bond.Amount.IsNil() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(application.Bond)
+}
+
+// lockApplicationBond moves the application bond from the freelancer into
+// the escrow account.
+func (k Keeper) lockApplicationBond(ctx sdk.Context, freelancer string, bond sdk.Coin) error {
+	if bond.IsZero() {
+		return nil
+	}
+
+	freelancerAddr, err := k.addressCodec.StringToBytes(freelancer)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid freelancer address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, freelancerAddr, types.EscrowAccountName, sdk.NewCoins(bond)); err != nil {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "failed to lock application bond of %s: %v", bond, err)
+	}
+	return nil
+}
+
+// refundApplicationBond returns the bond of an application leaving the
+// pending status to the freelancer.
+func (k Keeper) refundApplicationBond(ctx sdk.Context, application types.Application) error {
+	bond := applicationBond(application)
+	if bond.IsZero() {
+		return nil
+	}
+
+	freelancerAddr, err := k.addressCodec.StringToBytes(application.Freelancer)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid freelancer address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, freelancerAddr, bond); err != nil {
+		return errorsmod.Wrap(err, "failed to refund application bond")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"application_bond_refunded",
+			sdk.NewAttribute("application_id", fmt.Sprintf("%d", application.Id)),
+			sdk.NewAttribute("freelancer", application.Freelancer),
+			sdk.NewAttribute("amount", bond.String()),
+		),
+	)
+	return nil
+}
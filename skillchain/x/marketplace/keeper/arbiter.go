@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// eligibleArbiters returns the arbiters a dispute on contract can draw its
+// jury from: every active arbiter that is not a party to the contract.
+func (k Keeper) eligibleArbiters(ctx context.Context, params types.Params, contract types.Contract) ([]types.Arbiter, error) {
+	var arbiters []types.Arbiter
+	err := k.Arbiter.Walk(ctx, nil, func(address string, arbiter types.Arbiter) (bool, error) {
+		if arbiter.IsActive(params) && address != contract.Client && address != contract.Freelancer {
+			arbiters = append(arbiters, arbiter)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to walk arbiters: %v", err)
+	}
+	return arbiters, nil
+}
+
+// arbiterStakes returns the stake locked by the registered arbiters.
+func (k Keeper) arbiterStakes(ctx context.Context) (sdk.Coins, error) {
+	stakes := sdk.NewCoins()
+	err := k.Arbiter.Walk(ctx, nil, func(_ string, arbiter types.Arbiter) (bool, error) {
+		stakes = stakes.Add(arbiter.Stake)
+		return false, nil
+	})
+	return stakes, err
+}
+
+// ProcessUnbondedArbiters returns their stake to the arbiters whose unbonding
+// period has passed and removes them from the registry.
+func (k Keeper) ProcessUnbondedArbiters(ctx sdk.Context) error {
+	due, err := dueEntries(ctx, k.UnbondingQueue, ctx.BlockTime().Unix())
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing unbonded arbiters")
+	}
+
+	var unbonded []types.Arbiter
+	for _, entry := range due {
+		arbiter, err := k.Arbiter.Get(ctx, entry.K2())
+		if err == nil && arbiter.Status == "unbonding" && arbiter.UnbondingEndsAt == entry.K1() {
+			unbonded = append(unbonded, arbiter)
+			continue
+		}
+		// the arbiter was removed since it was queued
+		if err := k.UnbondingQueue.Remove(ctx, entry); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to dequeue arbiter %s: %v", entry.K2(), err)
+		}
+	}
+
+	for _, arbiter := range unbonded {
+		// an arbiter whose stake cannot be released must not halt the chain,
+		// its changes are discarded and it is retried on the next block
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseArbiter(cacheCtx, arbiter); err != nil {
+			ctx.Logger().Error("failed to release arbiter stake", "arbiter", arbiter.Address, "error", err)
+			continue
+		}
+		write()
+		if err := k.UnbondingQueue.Remove(ctx, collections.Join(arbiter.UnbondingEndsAt, arbiter.Address)); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to dequeue arbiter %s: %v", arbiter.Address, err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"arbiter_unbonded",
+				sdk.NewAttribute("arbiter", arbiter.Address),
+				sdk.NewAttribute("stake", arbiter.Stake.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// scheduleArbiter queues an unbonding arbiter for the release of its stake
+// once its unbonding period has passed.
+func (k Keeper) scheduleArbiter(ctx context.Context, arbiter types.Arbiter) error {
+	if arbiter.Status != "unbonding" {
+		return nil
+	}
+	if err := k.UnbondingQueue.Set(ctx, collections.Join(arbiter.UnbondingEndsAt, arbiter.Address)); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to queue arbiter %s: %v", arbiter.Address, err)
+	}
+	return nil
+}
+
+// releaseArbiter returns its stake to an unbonded arbiter and removes it from
+// the registry.
+func (k Keeper) releaseArbiter(ctx sdk.Context, arbiter types.Arbiter) error {
+	arbiterAddr, err := k.addressCodec.StringToBytes(arbiter.Address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid arbiter address")
+	}
+	if !arbiter.Stake.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, arbiterAddr, sdk.NewCoins(arbiter.Stake)); err != nil {
+			return errorsmod.Wrap(err, "failed to release arbiter stake")
+		}
+	}
+	if err := k.Arbiter.Remove(ctx, arbiter.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to remove arbiter: %v", err)
+	}
+	return nil
+}
+
+// settleArbiters pays the dispute fee to the arbiters whose revealed payout is
+// within ruling_tolerance_bps of the ruling and slashes those further off.
+// Jurors who did not vote or did not reveal their vote abstain and are
+// slashed, as are the jurors dismissed for missing the juror deadline. Without a quorum
+// the ruling is a default one, and the jurors who revealed are neither paid
+// nor slashed. The filing fee forfeited by the losing party is shared
+// by the majority too, or collected like a platform fee without one. It
+// returns the fee taken from the escrow.
+func (k Keeper) settleArbiters(ctx sdk.Context, params types.Params, dispute types.Dispute, contract types.Contract, ruling uint64, filingFee sdk.Coin) (math.Int, error) {
+	quorum := dispute.HasQuorum()
+	voted := make(map[string]bool, len(dispute.Votes))
+	var majority, minority, unrevealed []string
+	for _, vote := range dispute.Votes {
+		voted[vote.Arbiter] = true
+		switch {
+		case !vote.Revealed:
+			unrevealed = append(unrevealed, vote.Arbiter)
+		case !quorum:
+			// a default ruling is not held against the jurors who revealed
+		case max(vote.FreelancerPayoutBps, ruling)-min(vote.FreelancerPayoutBps, ruling) <= params.RulingToleranceBps:
+			majority = append(majority, vote.Arbiter)
+		default:
+			minority = append(minority, vote.Arbiter)
+		}
+	}
+	missed := append([]string(nil), dispute.Dismissed...)
+	for _, address := range dispute.Arbiters {
+		if !voted[address] {
+			missed = append(missed, address)
+		}
+	}
+
+	// rounding dust of the dispute fee split stays in escrow, the one of the
+	// filing fee is collected with the rest of it. The fees of all rounds
+	// cannot exceed the disputed amount, whatever the dispute fee was raised
+	// to since the earlier ones.
+	fee, filingShare := math.ZeroInt(), math.ZeroInt()
+	if len(majority) > 0 {
+		remaining := math.MaxInt(disputedAmount(contract).Sub(dispute.ArbiterFees.Amount), math.ZeroInt())
+		fee = math.MinInt(platformFee(disputedAmount(contract), params.DisputeFeeBps), remaining).QuoRaw(int64(len(majority)))
+		filingShare = filingFee.Amount.QuoRaw(int64(len(majority)))
+	}
+	reward := sdk.NewCoins(sdk.NewCoin(contract.Price.Denom, fee.Add(filingShare)))
+	for _, address := range majority {
+		if !reward.IsZero() {
+			arbiterAddr, err := k.addressCodec.StringToBytes(address)
+			if err != nil {
+				return math.Int{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid arbiter address")
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, arbiterAddr, reward); err != nil {
+				return math.Int{}, errorsmod.Wrap(err, "failed to pay dispute fee")
+			}
+		}
+		err := k.updateArbiter(ctx, address, func(arbiter *types.Arbiter) {
+			arbiter.MajorityVotes++
+			arbiter.Earned = arbiter.Earned.Add(reward...)
+		})
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+	fee = fee.MulRaw(int64(len(majority)))
+	if err := k.collectFee(ctx, params, filingFee.SubAmount(filingShare.MulRaw(int64(len(majority))))); err != nil {
+		return math.Int{}, err
+	}
+	if fee.IsPositive() {
+		err := k.updateEscrow(ctx, contract, func(escrow *types.ContractEscrow) {
+			escrow.DisputeFees = escrow.DisputeFees.Add(sdk.NewCoin(contract.Price.Denom, fee))
+		})
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	// stakes bonded before a change of stake denom are slashed in their own
+	slashed := sdk.NewCoins()
+	slash := func(address, reason string) error {
+		return k.updateArbiter(ctx, address, func(arbiter *types.Arbiter) {
+			amount := sdk.NewCoin(arbiter.Stake.Denom, platformFee(arbiter.Stake.Amount, params.ArbiterSlashBps))
+			arbiter.Stake = arbiter.Stake.Sub(amount)
+			arbiter.Slashed = arbiter.Slashed.Add(amount)
+			if reason != "minority" {
+				arbiter.MissedVotes++
+			} else {
+				arbiter.MinorityVotes++
+			}
+			slashed = slashed.Add(amount)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					"arbiter_slashed",
+					sdk.NewAttribute("arbiter", address),
+					sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+					sdk.NewAttribute("reason", reason),
+					sdk.NewAttribute("amount", amount.String()),
+				),
+			)
+		})
+	}
+	for _, address := range minority {
+		if err := slash(address, "minority"); err != nil {
+			return math.Int{}, err
+		}
+	}
+	for _, address := range unrevealed {
+		if err := slash(address, "unrevealed"); err != nil {
+			return math.Int{}, err
+		}
+	}
+	for _, address := range missed {
+		if err := slash(address, "missed"); err != nil {
+			return math.Int{}, err
+		}
+	}
+	// slashed stake is distributed with the platform fees
+	for _, coin := range slashed {
+		if err := k.collectFee(ctx, params, coin); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_arbiters_settled",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("majority", fmt.Sprintf("%d", len(majority))),
+			sdk.NewAttribute("minority", fmt.Sprintf("%d", len(minority))),
+			sdk.NewAttribute("unrevealed", fmt.Sprintf("%d", len(unrevealed))),
+			sdk.NewAttribute("missed", fmt.Sprintf("%d", len(missed))),
+			sdk.NewAttribute("dispute_fee", sdk.NewCoin(contract.Price.Denom, fee).String()),
+			sdk.NewAttribute("filing_fee", filingFee.String()),
+			sdk.NewAttribute("slashed", slashed.String()),
+		),
+	)
+
+	return fee, nil
+}
+
+// updateArbiter applies update to the arbiter registered at address. Arbiters
+// that left the registry since are skipped.
+func (k Keeper) updateArbiter(ctx context.Context, address string, update func(*types.Arbiter)) error {
+	arbiter, err := k.Arbiter.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get arbiter: %v", err)
+	}
+
+	update(&arbiter)
+	if err := k.Arbiter.Set(ctx, address, arbiter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update arbiter: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// isCancellable reports whether a contract in status can be ended by mutual
+// agreement. Disputed contracts are left to the arbiters.
+func isCancellable(status string) bool {
+	return status == "active" || status == "delivered" || status == "overdue"
+}
+
+// pendingCancellation returns the cancellation proposal of the contract if one
+// exists and has not expired yet.
+func (k Keeper) pendingCancellation(ctx sdk.Context, contractId uint64) (types.CancellationProposal, bool, error) {
+	proposal, err := k.CancellationProposal.Get(ctx, contractId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.CancellationProposal{}, false, nil
+	} else if err != nil {
+		return types.CancellationProposal{}, false, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get cancellation proposal: %v", err)
+	}
+	return proposal, proposal.ExpiresAt > ctx.BlockTime().Unix(), nil
+}
+
+// ProcessExpiredCancellations removes the cancellation proposals that were not
+// accepted in time. The contract carries on unchanged.
+func (k Keeper) ProcessExpiredCancellations(ctx sdk.Context) error {
+	var expired []types.CancellationProposal
+	err := k.CancellationProposal.Walk(ctx, nil, func(_ uint64, proposal types.CancellationProposal) (stop bool, err error) {
+		if proposal.ExpiresAt <= ctx.BlockTime().Unix() {
+			expired = append(expired, proposal)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing expired cancellations")
+	}
+
+	for _, proposal := range expired {
+		if err := k.CancellationProposal.Remove(ctx, proposal.ContractId); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to remove cancellation proposal: %v", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"cancellation_expired",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", proposal.ContractId)),
+				sdk.NewAttribute("proposer", proposal.Proposer),
+			),
+		)
+	}
+
+	return nil
+}
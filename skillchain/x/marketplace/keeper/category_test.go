@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestCategoryRules(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.CategoryRules = []types.CategoryRule{
+		{Category: "audit", MinPrice: math.NewInt(5000), MinPriceSet: true, MaxDeliveryDays: 30, MaxDeliveryDaysSet: true},
+		{Category: "development", FeeBps: 1000, FeeBpsSet: true, DisputeDuration: 10 * 86400, DisputeDurationSet: true},
+		{Category: "volunteer", FeeBpsSet: true},
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	client, err := f.addressCodec.BytesToString(sdk.AccAddress("client______________"))
+	require.NoError(t, err)
+	gig := &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Audit a module",
+		Description:  "Security review of a cosmos module.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "audit",
+		DeliveryDays: 10,
+	}
+	_, err = ms.CreateGig(ctx, gig)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	gig.Price = sdk.NewInt64Coin("skill", 5000)
+	gig.DeliveryDays = 60
+	_, err = ms.CreateGig(ctx, gig)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	gig.DeliveryDays = 20
+	created, err := ms.CreateGig(ctx, gig)
+	require.NoError(t, err)
+
+	freelancer, err := f.addressCodec.BytesToString(sdk.AccAddress("auditor_____________"))
+	require.NoError(t, err)
+	_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: freelancer, Name: "Auditor", Skills: []string{"go"}, HourlyRate: 50})
+	require.NoError(t, err)
+	_, err = ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: created.Id, ProposedPrice: sdk.NewInt64Coin("skill", 4000), ProposedDays: 20})
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+	_, err = ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: created.Id, ProposedPrice: sdk.NewInt64Coin("skill", 5000), ProposedDays: 45})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// unset fields fall back to the global params
+	rule, err := qs.CategoryRule(ctx, &types.QueryCategoryRuleRequest{Category: "audit"})
+	require.NoError(t, err)
+	require.True(t, rule.Overridden)
+	require.Equal(t, params.DisputeDuration, rule.Rule.DisputeDuration)
+	rule, err = qs.CategoryRule(ctx, &types.QueryCategoryRuleRequest{Category: "design"})
+	require.NoError(t, err)
+	require.False(t, rule.Overridden)
+	require.Equal(t, params.MinGigPrice, rule.Rule.MinPrice)
+
+	// a category can waive the platform fee
+	rule, err = qs.CategoryRule(ctx, &types.QueryCategoryRuleRequest{Category: "volunteer"})
+	require.NoError(t, err)
+	require.True(t, rule.Overridden)
+	require.Zero(t, rule.Rule.FeeBps)
+	require.Equal(t, params.MinGigPrice, rule.Rule.MinPrice)
+
+	// development contracts pay a 10% fee and get ten days of dispute
+	contractId, _, freelancerAddr := setupSinglePaymentContract(t, f)
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+
+	preview, err := qs.FeePreview(ctx, &types.QueryFeePreviewRequest{Address: contract.Freelancer, Price: contract.Price, Category: "development"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), preview.FeeBps)
+
+	_, err = ms.DeliverContract(ctx, &types.MsgDeliverContract{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "incomplete"})
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Unix()+10*86400, dispute.Deadline)
+
+	// without votes the freelancer is paid, minus the category fee
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: opened.DisputeId})
+	require.NoError(t, err)
+	ctx = closeAppealWindow(t, f, ctx, opened.DisputeId)
+	require.Equal(t, int64(900), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+}
+
+func TestCategoryRuleValidation(t *testing.T) {
+	params := types.DefaultParams()
+	params.CategoryRules = []types.CategoryRule{{Category: "audit", FeeBps: 1000, FeeBpsSet: true}, {Category: "audit"}}
+	require.Error(t, params.Validate())
+
+	// jurors must have the same time to vote in every category
+	params.CategoryRules = []types.CategoryRule{{Category: "audit", DisputeDuration: 86400, DisputeDurationSet: true}}
+	require.Error(t, params.Validate())
+	params.CategoryRules[0].DisputeDuration = params.JurorVotePeriod
+	require.NoError(t, params.Validate())
+
+	for _, rule := range []types.CategoryRule{
+		{},
+		{Category: "audit", MinPrice: math.NewInt(-1), MinPriceSet: true},
+		{Category: "audit", MinPriceSet: true},
+		{Category: "audit", FeeBps: types.BasisPoints + 1, FeeBpsSet: true},
+		{Category: "audit", FeeBps: 1000},
+		{Category: "audit", MaxDeliveryDays: types.MaxDeliveryDays + 1, MaxDeliveryDaysSet: true},
+		{Category: "audit", MaxDeliveryDaysSet: true},
+		{Category: "audit", DisputeDuration: 3600, DisputeDurationSet: true},
+	} {
+		require.Error(t, rule.Validate())
+	}
+}
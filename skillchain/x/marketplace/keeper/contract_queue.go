@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// scheduleContract queues a contract for the end block processor its status
+// is due for: the auto-completion of a delivered contract once its review
+// period expired, or the overdue flag of an active one once its delivery
+// deadline passed. Entries are not removed when the contract moves on, the
+// processor dropping those no longer due.
+func (k Keeper) scheduleContract(ctx context.Context, contract types.Contract) error {
+	switch {
+	case contract.Status == "delivered":
+		if err := k.ReviewQueue.Set(ctx, collections.Join(contract.DeliveredAt, contract.Id)); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to queue review of contract %d: %v", contract.Id, err)
+		}
+	case isDeliverable(contract):
+		if err := k.DeadlineQueue.Set(ctx, collections.Join(contract.DeliveryDeadline, contract.Id)); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to queue deadline of contract %d: %v", contract.Id, err)
+		}
+	}
+	return nil
+}
+
+// isDeliverable reports whether the contract is active and has something to
+// deliver by its deadline. Hourly and streaming contracts are paid as they go.
+func isDeliverable(contract types.Contract) bool {
+	return contract.Status == "active" && !contract.IsHourly() && !contract.IsStreaming()
+}
+
+// dueEntries returns the entries of a time-keyed queue up to cutoff.
+func dueEntries[K any](ctx context.Context, queue collections.KeySet[collections.Pair[int64, K]], cutoff int64) ([]collections.Pair[int64, K], error) {
+	iter, err := queue.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, K](cutoff))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
@@ -11,61 +11,41 @@ import (
 )
 
 func (k Keeper) ProcessExpiredDisputes(ctx sdk.Context) error {
-    currentTime := ctx.BlockTime().Unix()
-   	err := k.Dispute.Walk(ctx, nil, func(key uint64, dispute types.Dispute) (stop bool, err error) {
-		if dispute.Status != "open" && dispute.Status != "voting" {
-			return false, nil
-		}
-        
-		if dispute.Deadline > currentTime { 
-			return false, nil
-		}
-
-		params, err := k.Params.Get(ctx)
-		if err != nil {
-			return true, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
-		}
-
-		totalVotes := dispute.VotesClient + dispute.VotesFreelancer
-		if totalVotes >= params.MinArbitersRequired {
-			err := k.resolveDisputeInternal(ctx, dispute.Id)
-			if err != nil {
-				return true, errorsmod.Wrapf(err, "failed to resolve expired dispute %d", dispute.Id)
-			}
-		} else {
-			if dispute.VotesFreelancer == 0 {
-				dispute.VotesFreelancer = 1
-			}
-			dispute.Resolution = fmt.Sprintf(
-				"Expired with insufficient votes (%d/%d required). Defaulting to freelancer.",
-				totalVotes,
-				params.MinArbitersRequired,
-			)
-			err := k.Dispute.Set(ctx, dispute.Id, dispute)
-			if err != nil {
-				return true, errorsmod.Wrapf(err, "failed to update dispute %d", dispute.Id)
+	currentTime := ctx.BlockTime().Unix()
+	// uncontested disputes are resolved at their response deadline
+	due, err := k.dueDisputes(ctx, k.RevealQueue, currentTime, func(dispute types.Dispute) bool {
+		return (dispute.Status == "open" || dispute.Status == "voting") && dispute.Contested && dispute.RevealClosed(currentTime)
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing expired disputes")
+	}
+
+	for _, dispute := range due {
+		totalVotes := dispute.RevealedVotes()
+		// a dispute that cannot be resolved must not halt the chain, its
+		// changes are discarded and it is retried on the next block
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.resolveDisputeInternal(cacheCtx, dispute.Id); err != nil {
+			ctx.Logger().Error("failed to resolve expired dispute", "dispute_id", dispute.Id, "error", err)
+			if err := k.retryDispute(ctx, k.RevealQueue, currentTime, dispute.Id); err != nil {
+				return err
 			}
+			continue
+		}
+		write()
 
-			err = k.resolveDisputeInternal(ctx, dispute.Id)
-			if err != nil {
-				return true, errorsmod.Wrapf(err, "failed to resolve expired dispute %d", dispute.Id)
-			}
-			
+		required := dispute.Quorum()
+		if !dispute.HasQuorum() {
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					"dispute_expired",
 					sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
 					sdk.NewAttribute("total_votes", fmt.Sprintf("%d", totalVotes)),
-					sdk.NewAttribute("required_votes", fmt.Sprintf("%d", params.MinArbitersRequired)),
+					sdk.NewAttribute("required_votes", fmt.Sprintf("%d", required)),
 				),
 			)
-		} 
-		return false, nil
-	})
-	
-	if err != nil {
-		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing expired disputes")
-    }
+		}
+	}
 
-    return nil
-}
\ No newline at end of file
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// scheduleDispute queues a dispute for the end block processors of the
+// deadlines ahead of it: the response deadline of an uncontested dispute, the
+// juror deadline of a jury with alternates and the reveal deadline of an open
+// dispute, or the appeal deadline of an appealable ruling. Entries are not
+// removed when the dispute moves on, the processors dropping those no longer
+// due.
+func (k Keeper) scheduleDispute(ctx context.Context, dispute types.Dispute) error {
+	type entry struct {
+		queue collections.KeySet[collections.Pair[int64, uint64]]
+		at    int64
+	}
+	var entries []entry
+	switch {
+	case dispute.Status == "open" || dispute.Status == "voting":
+		if !dispute.Contested {
+			entries = append(entries, entry{k.ResponseQueue, dispute.ResponseDeadline})
+		}
+		if len(dispute.Alternates) > 0 {
+			entries = append(entries, entry{k.JurorQueue, dispute.JurorDeadline})
+		}
+		entries = append(entries, entry{k.RevealQueue, dispute.RevealDeadline})
+	case dispute.IsResolved() && !dispute.Settled && dispute.AppealDeadline > 0:
+		entries = append(entries, entry{k.AppealQueue, dispute.AppealDeadline})
+	}
+
+	for _, e := range entries {
+		if err := e.queue.Set(ctx, collections.Join(e.at, dispute.Id)); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to queue dispute %d: %v", dispute.Id, err)
+		}
+	}
+	return nil
+}
+
+// dueDisputes returns the disputes queued up to now that are still due, as
+// reported by isDue, and removes their entries, which are consumed whether the
+// dispute is still due or not. A dispute that fails to be processed is queued
+// again with retryDispute.
+func (k Keeper) dueDisputes(ctx context.Context, queue collections.KeySet[collections.Pair[int64, uint64]], now int64, isDue func(types.Dispute) bool) ([]types.Dispute, error) {
+	entries, err := dueEntries(ctx, queue, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var due []types.Dispute
+	seen := make(map[uint64]bool, len(entries))
+	for _, entry := range entries {
+		dispute, err := k.Dispute.Get(ctx, entry.K2())
+		if err == nil && !seen[dispute.Id] && isDue(dispute) {
+			seen[dispute.Id] = true
+			due = append(due, dispute)
+		}
+		if err := queue.Remove(ctx, entry); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to dequeue dispute %d: %v", entry.K2(), err)
+		}
+	}
+	return due, nil
+}
+
+// retryDispute queues again at now a dispute that failed to be processed, so
+// the next block retries it.
+func (k Keeper) retryDispute(ctx context.Context, queue collections.KeySet[collections.Pair[int64, uint64]], now int64, disputeId uint64) error {
+	if err := queue.Set(ctx, collections.Join(now, disputeId)); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to queue dispute %d: %v", disputeId, err)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// lockEscrow moves the contract price from the client into the escrow account
+// and opens the contract escrow ledger.
+func (k Keeper) lockEscrow(ctx sdk.Context, contract types.Contract) (sdk.Coins, error) {
+	locked := sdk.NewCoins(contract.Price)
+	return locked, k.addEscrow(ctx, contract, locked)
+}
+
+// addEscrow moves amount from the client into the escrow of the contract.
+func (k Keeper) addEscrow(ctx sdk.Context, contract types.Contract, amount sdk.Coins) error {
+	clientAddr, err := k.addressCodec.StringToBytes(contract.Client)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, clientAddr, types.EscrowAccountName, amount); err != nil {
+		return errorsmod.Wrap(err, "failed to lock funds in escrow")
+	}
+
+	return k.updateEscrow(ctx, contract, func(escrow *types.ContractEscrow) {
+		escrow.Locked = escrow.Locked.Add(amount...)
+	})
+}
+
+// releaseEscrow pays amount of the contract denom out of the escrow to the
+// freelancer. The platform fee of the freelancer fee tier in the category of
+// the gig is collected by the module account and the freelancer profile
+// earnings are updated. It returns the amount paid, the fee charged and the
+// fee tier applied.
+func (k Keeper) releaseEscrow(ctx sdk.Context, contract types.Contract, amount math.Int) (sdk.Coins, sdk.Coin, string, error) {
+	amount, err := k.payableEscrow(ctx, contract, amount)
+	if err != nil {
+		return nil, sdk.Coin{}, "", err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, sdk.Coin{}, "", errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+	denom := contract.Price.Denom
+
+	category, err := k.contractCategory(ctx, contract)
+	if err != nil {
+		return nil, sdk.Coin{}, "", err
+	}
+	tier, feeBps, err := k.feeTier(ctx, params, category, contract.Freelancer)
+	if err != nil {
+		return nil, sdk.Coin{}, "", err
+	}
+	feeAmount := platformFee(amount, feeBps)
+	freelancerAmount := amount.Sub(feeAmount)
+
+	freelancerAddr, err := k.addressCodec.StringToBytes(contract.Freelancer)
+	if err != nil {
+		return nil, sdk.Coin{}, "", errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid freelancer address")
+	}
+
+	freelancerCoins := sdk.NewCoins(sdk.NewCoin(denom, freelancerAmount))
+	if !freelancerCoins.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, freelancerAddr, freelancerCoins)
+		if err != nil {
+			return nil, sdk.Coin{}, "", errorsmod.Wrap(err, "failed to release funds to freelancer")
+		}
+	}
+
+	fee := sdk.NewCoin(denom, feeAmount)
+	platformShare, err := k.payReferralRewards(ctx, params, contract, fee)
+	if err != nil {
+		return nil, sdk.Coin{}, "", err
+	}
+	if err := k.collectFee(ctx, params, platformShare); err != nil {
+		return nil, sdk.Coin{}, "", err
+	}
+	err = k.updateEscrow(ctx, contract, func(escrow *types.ContractEscrow) {
+		escrow.Released = escrow.Released.Add(freelancerCoins...)
+		escrow.Fees = escrow.Fees.Add(fee)
+	})
+	if err != nil {
+		return nil, sdk.Coin{}, "", err
+	}
+
+	profile, err := k.Profile.Get(ctx, contract.Freelancer)
+	if err == nil {
+		profile.TotalEarned = profile.TotalEarned.Add(freelancerCoins...)
+		if err := k.Profile.Set(ctx, contract.Freelancer, profile); err != nil {
+			return nil, sdk.Coin{}, "", errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update profile: %v", err)
+		}
+	}
+
+	return freelancerCoins, fee, tier, nil
+}
+
+// refundEscrow returns amount of the contract denom from the escrow to the
+// client.
+func (k Keeper) refundEscrow(ctx sdk.Context, contract types.Contract, amount math.Int) (sdk.Coins, error) {
+	clientAddr, err := k.addressCodec.StringToBytes(contract.Client)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client address")
+	}
+
+	amount, err = k.payableEscrow(ctx, contract, amount)
+	if err != nil {
+		return nil, err
+	}
+	refund := sdk.NewCoins(sdk.NewCoin(contract.Price.Denom, amount))
+	if refund.IsZero() {
+		return refund, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, clientAddr, refund); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to refund client")
+	}
+
+	err = k.updateEscrow(ctx, contract, func(escrow *types.ContractEscrow) {
+		escrow.Refunded = escrow.Refunded.Add(refund...)
+	})
+	return refund, err
+}
+
+// payableEscrow returns the part of amount of the contract denom the escrow
+// ledger of the contract can pay out. Paying more than the contract holds
+// fails, so that a contract is never paid out of the escrow of others, unless
+// the contract lost escrow to slashing: its parties bear the loss and the
+// payout is cut to what it still holds.
+func (k Keeper) payableEscrow(ctx sdk.Context, contract types.Contract, amount math.Int) (math.Int, error) {
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+	escrow, err := k.ContractEscrow.Get(ctx, contract.Id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return math.Int{}, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get escrow of contract %d: %v", contract.Id, err)
+	}
+	denom := contract.Price.Denom
+	held := escrow.Held().AmountOf(denom)
+	if amount.LTE(held) {
+		return amount, nil
+	}
+	if escrow.StakingLosses.AmountOf(denom).IsPositive() {
+		return held, nil
+	}
+	return math.Int{}, errorsmod.Wrapf(types.ErrInsufficientFunds, "escrow of contract %d holds %s%s, cannot pay out %s%s", contract.Id, held, denom, amount, denom)
+}
+
+// updateEscrow applies update to the escrow ledger of the contract, creating
+// the ledger on first use.
+func (k Keeper) updateEscrow(ctx sdk.Context, contract types.Contract, update func(*types.ContractEscrow)) error {
+	escrow, err := k.ContractEscrow.Get(ctx, contract.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		escrow = types.ContractEscrow{
+			ContractId: contract.Id,
+			Client:     contract.Client,
+			Freelancer: contract.Freelancer,
+		}
+	} else if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get escrow of contract %d: %v", contract.Id, err)
+	}
+
+	update(&escrow)
+	if err := k.ContractEscrow.Set(ctx, contract.Id, escrow); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update escrow of contract %d: %v", contract.Id, err)
+	}
+	return nil
+}
+
+// unreleasedAmount returns the part of the contract price still held in
+// escrow: the full price for single payment contracts, the funds not billed
+// or claimed yet for hourly and streaming contracts, or the sum of the
+// milestones that were not approved, refunded or split by a dispute.
+func unreleasedAmount(contract types.Contract) math.Int {
+	if len(contract.Milestones) == 0 {
+		return contract.Price.Amount.Sub(contract.BilledAmount())
+	}
+
+	total := math.ZeroInt()
+	for _, milestone := range contract.Milestones {
+		if milestone.Status != "approved" && milestone.Status != "refunded" && milestone.Status != "split" {
+			total = total.Add(milestone.Amount)
+		}
+	}
+	return total
+}
+
+// disputedAmount returns the amount a dispute on contract is about: the
+// current milestone on milestone contracts, the unreleased escrow otherwise.
+func disputedAmount(contract types.Contract) math.Int {
+	if len(contract.Milestones) == 0 {
+		return unreleasedAmount(contract)
+	}
+	return contract.Milestones[contract.CurrentMilestone].Amount
+}
+
+// finishContract closes the contract with the given status and moves the gig
+// to gigStatus. A job is credited to the freelancer when credited is true.
+// A cancellation or amendment still pending on the contract is dropped.
+func (k Keeper) finishContract(ctx sdk.Context, contract *types.Contract, status, gigStatus string, credited bool) error {
+	contract.Status = status
+	contract.CompletedAt = ctx.BlockTime().Unix()
+	if err := k.Contract.Set(ctx, contract.Id, *contract); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract status: %v", err)
+	}
+	if err := k.CancellationProposal.Remove(ctx, contract.Id); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to remove cancellation proposal: %v", err)
+	}
+	if err := k.Amendment.Remove(ctx, contract.Id); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to remove amendment: %v", err)
+	}
+
+	gig, err := k.Gig.Get(ctx, contract.GigId)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "gig %d not found", contract.GigId)
+	}
+	gig.Status = gigStatus
+	if err := k.Gig.Set(ctx, gig.Id, gig); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update gig status: %v", err)
+	}
+
+	if credited {
+		profile, err := k.Profile.Get(ctx, contract.Freelancer)
+		if err == nil {
+			profile.TotalJobs++
+			if err := k.Profile.Set(ctx, contract.Freelancer, profile); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update profile: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,517 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"skillchain/x/marketplace/types"
+)
+
+// maxEscrowDelegations bounds the delegations and unbondings of the module
+// account read per epoch.
+const maxEscrowDelegations = 1000
+
+// stakedEscrow is the escrow of a contract opted into escrow staking.
+type stakedEscrow struct {
+	contract types.Contract
+	escrow   types.ContractEscrow
+	held     math.Int
+}
+
+// escrowDelegation is a delegation of the module account in tokens.
+type escrowDelegation struct {
+	validator sdk.ValAddress
+	tokens    math.Int
+}
+
+// getEscrowStakingPool returns the escrow staking pool, empty if nothing was
+// ever staked.
+func (k Keeper) getEscrowStakingPool(ctx context.Context) (types.EscrowStakingPool, error) {
+	pool, err := k.EscrowStakingPool.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewEscrowStakingPool(), nil
+	} else if err != nil {
+		return types.EscrowStakingPool{}, fmt.Errorf("failed to get escrow staking pool: %w", err)
+	}
+	return pool, nil
+}
+
+// stakedEscrows returns the contracts opted into escrow staking that still
+// hold escrow in the stake denom, along with the total they hold.
+func (k Keeper) stakedEscrows(ctx context.Context, denom string) ([]stakedEscrow, math.Int, error) {
+	var (
+		staked []stakedEscrow
+		total  = math.ZeroInt()
+	)
+	err := k.Contract.Walk(ctx, nil, func(id uint64, contract types.Contract) (bool, error) {
+		if !contract.EscrowStaking {
+			return false, nil
+		}
+		escrow, err := k.ContractEscrow.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+		held := escrow.Held().AmountOf(denom)
+		if held.IsPositive() {
+			staked = append(staked, stakedEscrow{contract: contract, escrow: escrow, held: held})
+			total = total.Add(held)
+		}
+		return false, nil
+	})
+	return staked, total, err
+}
+
+// escrowDelegations returns the delegations of the module account valued in
+// tokens, sorted by validator.
+func (k Keeper) escrowDelegations(ctx context.Context, delegator sdk.AccAddress) ([]escrowDelegation, error) {
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, maxEscrowDelegations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegations: %w", err)
+	}
+
+	result := make([]escrowDelegation, 0, len(delegations))
+	for _, delegation := range delegations {
+		_, bz, err := bech32.DecodeAndConvert(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator address %q: %w", delegation.ValidatorAddress, err)
+		}
+		validator, err := k.stakingKeeper.GetValidator(ctx, bz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validator %s: %w", delegation.ValidatorAddress, err)
+		}
+		result = append(result, escrowDelegation{
+			validator: bz,
+			tokens:    validator.TokensFromShares(delegation.Shares).TruncateInt(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].validator.String() < result[j].validator.String() })
+	return result, nil
+}
+
+// ProcessEscrowStaking runs at the end of every escrow staking epoch. It
+// returns matured unbondings to the escrow account, charges the escrow lost
+// to slashing to the contracts opted in and withdraws the staking rewards.
+// The rewards of each contract, pro rata to the escrow it holds, cover its
+// own losses first and the rest is split between its client, freelancer and
+// the treasury. It then delegates or undelegates so the stake matches the
+// target set by the params, keeping the reserve liquid. Disabling escrow staking undelegates everything, as does
+// a change of stake denom before staking in the new one.
+func (k Keeper) ProcessEscrowStaking(ctx sdk.Context, params types.Params) error {
+	pool, err := k.getEscrowStakingPool(ctx)
+	if err != nil {
+		return err
+	}
+	delegator := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	if err := k.settleUnbonding(ctx, &pool, delegator, pool.Denom); err != nil {
+		return err
+	}
+	if pool.Staked().IsZero() {
+		pool.Denom = params.StakeDenom
+	}
+
+	delegations, err := k.escrowDelegations(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	bonded := math.ZeroInt()
+	for _, delegation := range delegations {
+		if _, err := k.distrKeeper.WithdrawDelegationRewards(ctx, delegator, delegation.validator); err != nil {
+			return errorsmod.Wrapf(err, "failed to withdraw rewards from %s", delegation.validator)
+		}
+		bonded = bonded.Add(delegation.tokens)
+	}
+	if bonded.LT(pool.Bonded) {
+		// slashed
+		if err := k.chargeStakingLoss(ctx, &pool, pool.Bonded.Sub(bonded)); err != nil {
+			return err
+		}
+		pool.Bonded = bonded
+	}
+
+	if err := k.distributeStakingRewards(ctx, params, &pool, delegator); err != nil {
+		return err
+	}
+	if err := k.rebalanceEscrowStake(ctx, params, &pool, delegator, delegations); err != nil {
+		return err
+	}
+
+	return k.EscrowStakingPool.Set(ctx, pool)
+}
+
+// settleUnbonding returns the unbondings that matured since the last epoch
+// from the module account to the escrow account. The part that never arrived
+// was slashed and is charged to the contracts opted in.
+func (k Keeper) settleUnbonding(ctx sdk.Context, pool *types.EscrowStakingPool, delegator sdk.AccAddress, denom string) error {
+	if pool.Unbonding.IsZero() {
+		return nil
+	}
+
+	unbondings, err := k.stakingKeeper.GetUnbondingDelegations(ctx, delegator, maxEscrowDelegations)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get unbonding delegations: %v", err)
+	}
+	pending := math.ZeroInt()
+	for _, unbonding := range unbondings {
+		for _, entry := range unbonding.Entries {
+			pending = pending.Add(entry.Balance)
+		}
+	}
+	matured := pool.Unbonding.Sub(pending)
+	if !matured.IsPositive() {
+		return nil
+	}
+
+	surplus, err := k.moduleSurplus(ctx)
+	if err != nil {
+		return err
+	}
+	returned := math.MinInt(matured, surplus.AmountOf(denom))
+	if returned.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowAccountName, sdk.NewCoins(sdk.NewCoin(denom, returned))); err != nil {
+			return errorsmod.Wrap(err, "failed to return unbonded escrow")
+		}
+	}
+	pool.Unbonding = pool.Unbonding.Sub(matured)
+	return k.chargeStakingLoss(ctx, pool, matured.Sub(returned))
+}
+
+// chargeStakingLoss splits escrow lost to slashing between the contracts
+// opted in, pro rata to the escrow they hold, so that only the escrow that
+// was staked bears the loss. The rounding dust, or the whole loss when no
+// contract is opted in anymore, is added to the shortfall of the pool.
+func (k Keeper) chargeStakingLoss(ctx sdk.Context, pool *types.EscrowStakingPool, loss math.Int) error {
+	if !loss.IsPositive() {
+		return nil
+	}
+	staked, total, err := k.stakedEscrows(ctx, pool.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get staked escrows: %v", err)
+	}
+
+	charged := math.ZeroInt()
+	for _, s := range staked {
+		part := loss.Mul(s.held).Quo(total)
+		if part.IsZero() {
+			continue
+		}
+		s.escrow.StakingLosses = s.escrow.StakingLosses.Add(sdk.NewCoin(pool.Denom, part))
+		if err := k.ContractEscrow.Set(ctx, s.contract.Id, s.escrow); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update escrow: %v", err)
+		}
+		charged = charged.Add(part)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"escrow_staking_loss",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", s.contract.Id)),
+				sdk.NewAttribute("amount", sdk.NewCoin(pool.Denom, part).String()),
+			),
+		)
+	}
+	pool.Shortfall = pool.Shortfall.Add(loss.Sub(charged))
+	return nil
+}
+
+// moduleSurplus returns the balance of the module account beyond the retained
+// platform fees.
+func (k Keeper) moduleSurplus(ctx sdk.Context) (sdk.Coins, error) {
+	fees, err := k.retainedFees(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get retained fees: %v", err)
+	}
+	surplus := sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		if amount := coin.Amount.Sub(fees.AmountOf(coin.Denom)); amount.IsPositive() {
+			surplus = surplus.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return surplus, nil
+}
+
+// distributeStakingRewards pays out the staking rewards sitting in the module
+// account. Rounding dust stays there and is paid out with the next rewards.
+func (k Keeper) distributeStakingRewards(ctx sdk.Context, params types.Params, pool *types.EscrowStakingPool, delegator sdk.AccAddress) error {
+	rewards, err := k.moduleSurplus(ctx)
+	if err != nil {
+		return err
+	}
+	denom := pool.Denom
+
+	if cover := math.MinInt(pool.Shortfall, rewards.AmountOf(denom)); cover.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, cover))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowAccountName, coins); err != nil {
+			return errorsmod.Wrap(err, "failed to cover the escrow shortfall")
+		}
+		pool.Shortfall = pool.Shortfall.Sub(cover)
+		rewards = rewards.Sub(coins...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"escrow_shortfall_covered",
+				sdk.NewAttribute("amount", coins.String()),
+				sdk.NewAttribute("shortfall", pool.Shortfall.String()),
+			),
+		)
+	}
+	if rewards.IsZero() {
+		return nil
+	}
+
+	staked, total, err := k.stakedEscrows(ctx, denom)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get staked escrows: %v", err)
+	}
+
+	config := params.EscrowStaking
+	share := func(coins sdk.Coins, num, den math.Int) sdk.Coins {
+		result := sdk.NewCoins()
+		for _, coin := range coins {
+			result = result.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(num).Quo(den)))
+		}
+		return result
+	}
+	bps := func(coins sdk.Coins, bps uint64) sdk.Coins {
+		return share(coins, math.NewIntFromUint64(bps), math.NewInt(types.BasisPoints))
+	}
+
+	treasury := sdk.NewCoins()
+	if total.IsZero() {
+		// no contract is opted in anymore
+		treasury = rewards
+	}
+	for _, s := range staked {
+		earned := share(rewards, s.held, total)
+		if earned.IsZero() {
+			continue
+		}
+		// the rewards of the contract cover its own losses first
+		covered := sdk.NewCoins()
+		for _, loss := range s.escrow.StakingLosses {
+			if amount := math.MinInt(loss.Amount, earned.AmountOf(loss.Denom)); amount.IsPositive() {
+				covered = covered.Add(sdk.NewCoin(loss.Denom, amount))
+			}
+		}
+		if !covered.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowAccountName, covered); err != nil {
+				return errorsmod.Wrap(err, "failed to cover the escrow losses")
+			}
+			s.escrow.StakingLosses = s.escrow.StakingLosses.Sub(covered...)
+		}
+		split := earned.Sub(covered...)
+		clientReward, freelancerReward := bps(split, config.ClientRewardBps), bps(split, config.FreelancerRewardBps)
+		treasuryReward := split.Sub(clientReward...).Sub(freelancerReward...)
+
+		for _, payee := range []struct {
+			address string
+			amount  sdk.Coins
+		}{{s.contract.Client, clientReward}, {s.contract.Freelancer, freelancerReward}} {
+			if payee.amount.IsZero() {
+				continue
+			}
+			addr, err := k.addressCodec.StringToBytes(payee.address)
+			if err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", payee.address)
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, payee.amount); err != nil {
+				return errorsmod.Wrap(err, "failed to pay staking rewards")
+			}
+		}
+		treasury = treasury.Add(treasuryReward...)
+
+		s.escrow.StakingRewards = s.escrow.StakingRewards.Add(earned...)
+		if err := k.ContractEscrow.Set(ctx, s.contract.Id, s.escrow); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update escrow: %v", err)
+		}
+		pool.Rewards = pool.Rewards.Add(earned...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"escrow_staking_reward",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", s.contract.Id)),
+				sdk.NewAttribute("losses_covered", covered.String()),
+				sdk.NewAttribute("client_reward", clientReward.String()),
+				sdk.NewAttribute("freelancer_reward", freelancerReward.String()),
+				sdk.NewAttribute("treasury_reward", treasuryReward.String()),
+			),
+		)
+	}
+
+	if treasury.IsZero() {
+		return nil
+	}
+	if params.FeeDistribution.Treasury != "" {
+		treasuryAddr, err := k.addressCodec.StringToBytes(params.FeeDistribution.Treasury)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid treasury address")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasuryAddr, treasury); err != nil {
+			return errorsmod.Wrap(err, "failed to pay the treasury")
+		}
+		return nil
+	}
+	if err := k.distrKeeper.FundCommunityPool(ctx, treasury, delegator); err != nil {
+		return errorsmod.Wrap(err, "failed to fund the community pool")
+	}
+	return nil
+}
+
+// rebalanceEscrowStake delegates or undelegates escrow so the stake matches
+// max_staked_bps of the escrow opted in, as long as reserve_bps of it stays
+// liquid. Only escrow opted in is ever staked: the rest of the escrow
+// account, bonds, stakes, fees and the escrow of other contracts, stays
+// liquid. The escrow account also keeps at least the escrow of the largest
+// contract opted in, so that any single payout is made without waiting on an
+// unbonding, and stake is undelegated when it falls short. Stake on
+// validators that left the set is undelegated, and all of it when the pool
+// is not in the stake denom anymore.
+func (k Keeper) rebalanceEscrowStake(
+	ctx sdk.Context,
+	params types.Params,
+	pool *types.EscrowStakingPool,
+	delegator sdk.AccAddress,
+	delegations []escrowDelegation,
+) error {
+	denom := pool.Denom
+	config := params.EscrowStaking
+	enabled := config.Enabled && denom == params.StakeDenom
+
+	staked, optedIn, err := k.stakedEscrows(ctx, denom)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get staked escrows: %v", err)
+	}
+	largest := math.ZeroInt()
+	for _, s := range staked {
+		largest = math.MaxInt(largest, s.held)
+	}
+	balance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.EscrowAccountName), denom).Amount
+
+	target := math.ZeroInt()
+	if enabled {
+		target = optedIn.Mul(math.NewIntFromUint64(config.MaxStakedBps)).Quo(math.NewInt(types.BasisPoints))
+	}
+
+	validators := make(map[string]bool, len(config.Validators))
+	for _, validator := range config.Validators {
+		_, bz, err := bech32.DecodeAndConvert(validator)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s", validator)
+		}
+		validators[sdk.ValAddress(bz).String()] = true
+	}
+
+	// undelegate from validators that left the set, then evenly from the
+	// others while above the target or short of the largest payout
+	excess := pool.Bonded.Sub(target)
+	if deficit := largest.Sub(balance).Sub(pool.Unbonding); deficit.GT(excess) {
+		excess = math.MinInt(deficit, pool.Bonded)
+	}
+	var kept []escrowDelegation
+	for _, delegation := range delegations {
+		if validators[delegation.validator.String()] {
+			kept = append(kept, delegation)
+			continue
+		}
+		if err := k.undelegateEscrow(ctx, pool, delegator, delegation.validator, delegation.tokens); err != nil {
+			return err
+		}
+		excess = excess.Sub(delegation.tokens)
+	}
+	for i, delegation := range kept {
+		if !excess.IsPositive() {
+			break
+		}
+		amount := math.MinInt(delegation.tokens, excess.QuoRaw(int64(len(kept)-i)))
+		if i == len(kept)-1 {
+			amount = math.MinInt(delegation.tokens, excess)
+		}
+		if err := k.undelegateEscrow(ctx, pool, delegator, delegation.validator, amount); err != nil {
+			return err
+		}
+		excess = excess.Sub(amount)
+	}
+	if !excess.IsNegative() || !enabled {
+		return nil
+	}
+
+	// delegate evenly while the reserve of the escrow opted in and the
+	// largest payout stay liquid
+	liquid := optedIn.Sub(pool.Staked().AmountOf(denom))
+	liquid = math.MinInt(liquid, balance)
+	reserve := optedIn.Mul(math.NewIntFromUint64(config.ReserveBps)).Quo(math.NewInt(types.BasisPoints))
+	amount := math.MinInt(math.MinInt(excess.Neg(), liquid.Sub(reserve)), balance.Sub(largest))
+	count := int64(len(config.Validators))
+	if amount.LT(math.NewInt(count)) {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.EscrowAccountName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, amount))); err != nil {
+		return errorsmod.Wrap(err, "failed to move escrow to stake")
+	}
+	per := amount.QuoRaw(count)
+	for i, address := range config.Validators {
+		bondAmt := per
+		if i == 0 {
+			bondAmt = amount.Sub(per.MulRaw(count - 1))
+		}
+		_, bz, _ := bech32.DecodeAndConvert(address)
+		validator, err := k.stakingKeeper.GetValidator(ctx, bz)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "validator %s not found", address)
+		}
+		if _, err := k.stakingKeeper.Delegate(ctx, delegator, bondAmt, stakingtypes.Unbonded, validator, true); err != nil {
+			return errorsmod.Wrapf(err, "failed to delegate escrow to %s", address)
+		}
+	}
+	pool.Bonded = pool.Bonded.Add(amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"escrow_delegated",
+			sdk.NewAttribute("amount", sdk.NewCoin(denom, amount).String()),
+			sdk.NewAttribute("bonded", pool.Bonded.String()),
+		),
+	)
+
+	return nil
+}
+
+// undelegateEscrow starts unbonding amount tokens of escrow from a validator.
+func (k Keeper) undelegateEscrow(ctx sdk.Context, pool *types.EscrowStakingPool, delegator sdk.AccAddress, validator sdk.ValAddress, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, validator, amount)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid unbond amount for %s", validator)
+	}
+	_, unbonding, err := k.stakingKeeper.Undelegate(ctx, delegator, validator, shares)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to undelegate escrow from %s", validator)
+	}
+	pool.Bonded = pool.Bonded.Sub(unbonding)
+	pool.Unbonding = pool.Unbonding.Add(unbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"escrow_undelegated",
+			sdk.NewAttribute("validator", validator.String()),
+			sdk.NewAttribute("amount", unbonding.String()),
+			sdk.NewAttribute("bonded", pool.Bonded.String()),
+		),
+	)
+
+	return nil
+}
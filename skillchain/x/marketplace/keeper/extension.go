@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// validateExtension checks that the contract can still have its deadline moved
+// to requestedDeadline. It runs when the extension is requested and again when
+// the client approves it.
+func (k Keeper) validateExtension(ctx sdk.Context, contract types.Contract, requestedDeadline int64) error {
+	if contract.Status != "active" {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be active to extend its deadline (current: %s)",
+			contract.Status,
+		)
+	}
+	if contract.IsStreaming() {
+		return errorsmod.Wrap(types.ErrInvalidExtension, "the deadline of a streaming contract is its stream schedule")
+	}
+	if requestedDeadline <= contract.DeliveryDeadline {
+		return errorsmod.Wrap(types.ErrInvalidExtension, "requested deadline must be after the current deadline")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+	if contract.ExtensionsGranted >= params.MaxDeadlineExtensions {
+		return errorsmod.Wrapf(
+			types.ErrInvalidExtension,
+			"contract %d already got the maximum of %d deadline extensions",
+			contract.Id,
+			params.MaxDeadlineExtensions,
+		)
+	}
+
+	return nil
+}
+
+// contractExtensions returns the deadline extension requests of a contract,
+// oldest first.
+func (k Keeper) contractExtensions(ctx context.Context, contractId uint64) ([]types.DeadlineExtension, error) {
+	var extensions []types.DeadlineExtension
+	err := k.DeadlineExtension.Walk(ctx, nil, func(_ uint64, extension types.DeadlineExtension) (stop bool, err error) {
+		if extension.ContractId == contractId {
+			extensions = append(extensions, extension)
+		}
+		return false, nil
+	})
+	return extensions, err
+}
+
+// hasPendingExtension reports whether the contract has an extension request
+// the client has not answered yet.
+func (k Keeper) hasPendingExtension(ctx sdk.Context, contractId uint64) (bool, error) {
+	extensions, err := k.contractExtensions(ctx, contractId)
+	if err != nil {
+		return false, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get deadline extensions: %v", err)
+	}
+	for _, extension := range extensions {
+		if extension.Status == "pending" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// pendingExtensionForClient returns the pending extension request id and its
+// contract, provided creator is the client who has to answer it.
+func (k Keeper) pendingExtensionForClient(ctx sdk.Context, id uint64, creator string) (types.DeadlineExtension, types.Contract, error) {
+	extension, err := k.DeadlineExtension.Get(ctx, id)
+	if err != nil {
+		return types.DeadlineExtension{}, types.Contract{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "deadline extension %d not found", id)
+	}
+
+	contract, err := k.Contract.Get(ctx, extension.ContractId)
+	if err != nil {
+		return types.DeadlineExtension{}, types.Contract{}, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", extension.ContractId)
+	}
+
+	if contract.Client != creator {
+		return types.DeadlineExtension{}, types.Contract{}, errorsmod.Wrap(types.ErrUnauthorized, "only client can answer a deadline extension request")
+	}
+	if extension.Status != "pending" {
+		return types.DeadlineExtension{}, types.Contract{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"deadline extension is not pending (current: %s)",
+			extension.Status,
+		)
+	}
+
+	return extension, contract, nil
+}
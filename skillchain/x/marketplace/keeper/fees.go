@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// feeTier returns the name and the rate in basis points of the fee tier of a
+// freelancer working in a category. Among the tiers the freelancer qualifies
+// for the lowest fee applies. Freelancers qualifying for none pay the fee of
+// the category, platform_fee_percent unless governance set one.
+func (k Keeper) feeTier(ctx context.Context, params types.Params, category, freelancer string) (string, uint64, error) {
+	rule, _ := params.CategoryRule(category)
+	name, feeBps := types.BaseFeeTier, rule.FeeBps
+	if len(params.FeeTiers) == 0 {
+		return name, feeBps, nil
+	}
+
+	profile, err := k.Profile.Get(ctx, freelancer)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return "", 0, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get profile: %v", err)
+	}
+
+	// the bonded stake is only looked up when a tier requires one
+	var bonded math.Int
+	qualified := false
+	for _, tier := range params.FeeTiers {
+		if profile.TotalJobs < tier.MinCompletedJobs {
+			continue
+		}
+		if tier.MinRating > 0 && (profile.RatingCount == 0 || profile.RatingSum < tier.MinRating*profile.RatingCount) {
+			continue
+		}
+		if !tier.MinStake.IsNil() && tier.MinStake.IsPositive() {
+			if bonded.IsNil() {
+				addr, err := k.addressCodec.StringToBytes(freelancer)
+				if err != nil {
+					return "", 0, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid freelancer address")
+				}
+				bonded, err = k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+				if err != nil {
+					return "", 0, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get bonded stake: %v", err)
+				}
+			}
+			if bonded.LT(tier.MinStake) {
+				continue
+			}
+		}
+
+		if !qualified || tier.FeeBps < feeBps {
+			name, feeBps, qualified = tier.Name, tier.FeeBps, true
+		}
+	}
+
+	return name, feeBps, nil
+}
+
+// platformFee returns the fee charged on amount at a rate of feeBps.
+func platformFee(amount math.Int, feeBps uint64) math.Int {
+	return amount.Mul(math.NewIntFromUint64(feeBps)).Quo(math.NewInt(types.BasisPoints))
+}
+
+// collectFee moves a platform fee out of the escrow account into the module
+// account. Fees are distributed right away unless they are settled per epoch.
+func (k Keeper) collectFee(ctx sdk.Context, params types.Params, fee sdk.Coin) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.EscrowAccountName, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return errorsmod.Wrap(err, "failed to collect platform fee")
+	}
+	return k.retainFee(ctx, params, fee)
+}
+
+// retainFee records a fee that reached the module account so it is
+// distributed with the other platform fees.
+func (k Keeper) retainFee(ctx sdk.Context, params types.Params, fee sdk.Coin) error {
+	retained, err := k.RetainedFees.Get(ctx, fee.Denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		retained = math.ZeroInt()
+	} else if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get retained fees: %v", err)
+	}
+	if err := k.RetainedFees.Set(ctx, fee.Denom, retained.Add(fee.Amount)); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update retained fees: %v", err)
+	}
+
+	stats, err := k.getFeeStats(ctx)
+	if err != nil {
+		return err
+	}
+	stats.Collected = stats.Collected.Add(fee)
+	if err := k.FeeStats.Set(ctx, stats); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update fee stats: %v", err)
+	}
+
+	if params.FeeSettlementEpoch == "" {
+		return k.distributeFees(ctx, params)
+	}
+	return nil
+}
+
+// distributeFees splits the retained fees between the treasury, the community
+// pool and a burn according to the fee distribution params. Rounding dust stays
+// retained until the next distribution.
+func (k Keeper) distributeFees(ctx sdk.Context, params types.Params) error {
+	retained, err := k.retainedFees(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get retained fees: %v", err)
+	}
+	if retained.IsZero() {
+		return nil
+	}
+
+	split := params.FeeDistribution
+	share := func(bps uint64) sdk.Coins {
+		coins := sdk.NewCoins()
+		for _, coin := range retained {
+			amount := coin.Amount.Mul(math.NewIntFromUint64(bps)).Quo(math.NewInt(types.BasisPoints))
+			coins = coins.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		return coins
+	}
+	treasury, communityPool, burned := share(split.TreasuryBps), share(split.CommunityPoolBps), share(split.BurnBps)
+
+	if !treasury.IsZero() {
+		treasuryAddr, err := k.addressCodec.StringToBytes(split.Treasury)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid treasury address")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasuryAddr, treasury); err != nil {
+			return errorsmod.Wrap(err, "failed to pay the treasury")
+		}
+	}
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return errorsmod.Wrap(err, "failed to fund the community pool")
+		}
+	}
+	if !burned.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return errorsmod.Wrap(err, "failed to burn fees")
+		}
+	}
+
+	distributed := treasury.Add(communityPool...).Add(burned...)
+	for _, coin := range retained {
+		left := coin.Amount.Sub(distributed.AmountOf(coin.Denom))
+		if left.IsZero() {
+			err = k.RetainedFees.Remove(ctx, coin.Denom)
+		} else {
+			err = k.RetainedFees.Set(ctx, coin.Denom, left)
+		}
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update retained fees: %v", err)
+		}
+	}
+
+	stats, err := k.getFeeStats(ctx)
+	if err != nil {
+		return err
+	}
+	stats.Treasury = stats.Treasury.Add(treasury...)
+	stats.CommunityPool = stats.CommunityPool.Add(communityPool...)
+	stats.Burned = stats.Burned.Add(burned...)
+	if err := k.FeeStats.Set(ctx, stats); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update fee stats: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"fees_distributed",
+			sdk.NewAttribute("treasury", treasury.String()),
+			sdk.NewAttribute("community_pool", communityPool.String()),
+			sdk.NewAttribute("burned", burned.String()),
+		),
+	)
+
+	return nil
+}
+
+// retainedFees returns the platform fees held by the module account awaiting
+// distribution.
+func (k Keeper) retainedFees(ctx context.Context) (sdk.Coins, error) {
+	fees := sdk.NewCoins()
+	err := k.RetainedFees.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		fees = fees.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return fees, err
+}
+
+// getFeeStats returns the fee stats, empty if no fee was collected yet.
+func (k Keeper) getFeeStats(ctx context.Context) (types.FeeStats, error) {
+	stats, err := k.FeeStats.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FeeStats{}, nil
+	} else if err != nil {
+		return types.FeeStats{}, fmt.Errorf("failed to get fee stats: %w", err)
+	}
+	return stats, nil
+}
+
+// contractCategory returns the category of the gig a contract was created
+// from, empty when the gig no longer exists.
+func (k Keeper) contractCategory(ctx context.Context, contract types.Contract) (string, error) {
+	gig, err := k.Gig.Get(ctx, contract.GigId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get gig: %v", err)
+	}
+	return gig.Category, nil
+}
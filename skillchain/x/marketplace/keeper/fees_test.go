@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// approveFirstMilestone releases the first milestone of the contract, charging a
+// 20skill platform fee.
+func approveFirstMilestone(t *testing.T, f *fixture, contractId uint64) {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+}
+
+func TestFeesDistributedOnRelease(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	treasuryAddr := sdk.AccAddress([]byte("treasury____________"))
+	treasury, err := f.addressCodec.BytesToString(treasuryAddr)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FeeDistribution = types.FeeDistribution{
+		Treasury:         treasury,
+		TreasuryBps:      5000,
+		CommunityPoolBps: 3000,
+		BurnBps:          2000,
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	contractId, _, _ := setupMilestoneContract(t, f)
+	approveFirstMilestone(t, f, contractId)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 10)), f.bankKeeper.GetAllBalances(f.ctx, treasuryAddr))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 6)), f.distrKeeper.communityPool)
+	require.True(t, f.bankKeeper.GetAllBalances(f.ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+	res, err := qs.FeeStats(f.ctx, &types.QueryFeeStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), res.Stats.Collected)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 10)), res.Stats.Treasury)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 6)), res.Stats.CommunityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 4)), res.Stats.Burned)
+	require.True(t, res.Pending.IsZero())
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestFeesDistributedPerEpoch(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	hooks := f.keeper.Hooks()
+
+	params := types.DefaultParams()
+	params.FeeSettlementEpoch = "week"
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	contractId, _, _ := setupMilestoneContract(t, f)
+	approveFirstMilestone(t, f, contractId)
+
+	res, err := qs.FeeStats(f.ctx, &types.QueryFeeStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), res.Pending)
+	require.Equal(t, res.Pending, f.bankKeeper.GetAllBalances(f.ctx, authtypes.NewModuleAddress(types.ModuleName)))
+
+	// other epochs do not settle fees
+	require.NoError(t, hooks.AfterEpochEnd(f.ctx, "day", 1))
+	res, err = qs.FeeStats(f.ctx, &types.QueryFeeStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), res.Pending)
+
+	require.NoError(t, hooks.AfterEpochEnd(f.ctx, "week", 1))
+	res, err = qs.FeeStats(f.ctx, &types.QueryFeeStatsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Pending.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 10)), res.Stats.CommunityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 10)), res.Stats.Burned)
+}
+
+func TestFeeTiers(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, freelancerAddr := setupMilestoneContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	freelancer, err := f.addressCodec.BytesToString(freelancerAddr)
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.FeeTiers = []types.FeeTier{
+		{Name: "veteran", MinCompletedJobs: 1, MinStake: math.ZeroInt(), FeeBps: 250},
+		{Name: "staker", MinStake: math.NewInt(500), FeeBps: 100},
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	preview := func() *types.QueryFeePreviewResponse {
+		res, err := qs.FeePreview(ctx, &types.QueryFeePreviewRequest{Address: freelancer, Price: sdk.NewInt64Coin("skill", 1000)})
+		require.NoError(t, err)
+		return res
+	}
+	res := preview()
+	require.Equal(t, types.BaseFeeTier, res.Tier)
+	require.Equal(t, uint64(500), res.FeeBps)
+	require.Equal(t, sdk.NewInt64Coin("skill", 50), res.Fee)
+	require.Equal(t, sdk.NewInt64Coin("skill", 950), res.Net)
+
+	profile, err := f.keeper.Profile.Get(ctx, freelancer)
+	require.NoError(t, err)
+	profile.TotalJobs = 1
+	require.NoError(t, f.keeper.Profile.Set(ctx, freelancer, profile))
+	res = preview()
+	require.Equal(t, "veteran", res.Tier)
+	require.Equal(t, sdk.NewInt64Coin("skill", 25), res.Fee)
+
+	// the lowest fee of the qualifying tiers applies
+	f.stakingKeeper.bonded[freelancerAddr.String()] = math.NewInt(500)
+	res = preview()
+	require.Equal(t, "staker", res.Tier)
+	require.Equal(t, sdk.NewInt64Coin("skill", 10), res.Fee)
+
+	approveFirstMilestone(t, f, contractId)
+	escrow, err := f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 4)), escrow.Fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 396)), escrow.Released)
+
+	var tier string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "payment_released" {
+			continue
+		}
+		attr, ok := event.GetAttribute("fee_tier")
+		require.True(t, ok)
+		tier = attr.Value
+	}
+	require.Equal(t, "staker", tier)
+}
+
+func TestFeeTierValidation(t *testing.T) {
+	params := types.DefaultParams()
+	params.FeeTiers = []types.FeeTier{{Name: types.BaseFeeTier, MinStake: math.ZeroInt(), FeeBps: 100}}
+	require.Error(t, params.Validate())
+	params.FeeTiers = []types.FeeTier{{Name: "pro", MinStake: math.ZeroInt(), FeeBps: types.BasisPoints + 1}}
+	require.Error(t, params.Validate())
+	params.FeeTiers = []types.FeeTier{{Name: "pro", FeeBps: 100}, {Name: "pro", FeeBps: 50}}
+	require.Error(t, params.Validate())
+}
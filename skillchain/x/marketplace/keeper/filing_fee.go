@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// filingFees returns the filing fees held in escrow for a dispute: the one of
+// the initiator, matched by the counterparty once contested. They are held
+// until the first ruling, none for disputes opened before filing fees were
+// introduced.
+func filingFees(dispute types.Dispute) sdk.Coins {
+	if dispute.Appeals > 0 || (dispute.Status != "open" && dispute.Status != "voting") || dispute.FilingFee.Amount.IsNil() {
+		return sdk.NewCoins()
+	}
+	fees := sdk.NewCoins(dispute.FilingFee)
+	if dispute.Contested {
+		fees = fees.Add(dispute.FilingFee)
+	}
+	return fees
+}
+
+// lockFilingFee moves the filing fee of a dispute from a party into the
+// escrow account.
+func (k Keeper) lockFilingFee(ctx sdk.Context, party string, fee sdk.Coin) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	partyAddr, err := k.addressCodec.StringToBytes(party)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid party address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, partyAddr, types.EscrowAccountName, sdk.NewCoins(fee)); err != nil {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "failed to lock filing fee of %s: %v", fee, err)
+	}
+	return nil
+}
+
+// refundFilingFee returns a filing fee held in escrow to a party.
+func (k Keeper) refundFilingFee(ctx sdk.Context, party string, fee sdk.Coin) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	partyAddr, err := k.addressCodec.StringToBytes(party)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid party address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, partyAddr, sdk.NewCoins(fee)); err != nil {
+		return errorsmod.Wrap(err, "failed to refund filing fee")
+	}
+	return nil
+}
+
+// settleFilingFees refunds the filing fee of the party the first ruling on a
+// dispute went for: the freelancer when awarded at least half of the
+// disputed amount, the client otherwise. It returns the fee forfeited by the
+// other party, to be paid to the arbiters.
+func (k Keeper) settleFilingFees(ctx sdk.Context, dispute types.Dispute, contract types.Contract, ruling uint64) (sdk.Coin, error) {
+	forfeited := sdk.NewCoin(contract.Price.Denom, math.ZeroInt())
+	if filingFees(dispute).IsZero() {
+		return forfeited, nil
+	}
+
+	winner := contract.Freelancer
+	if ruling < types.BasisPoints/2 {
+		winner = contract.Client
+	}
+	if err := k.refundFilingFee(ctx, winner, dispute.FilingFee); err != nil {
+		return sdk.Coin{}, err
+	}
+	return dispute.FilingFee, nil
+}
+
+// resolveUncontested resolves a dispute the counterparty did not contest in
+// favor of its initiator. The filing fee is refunded, no arbiter is paid or
+// slashed and the ruling cannot be appealed, the escrow being paid out right
+// away.
+func (k Keeper) resolveUncontested(ctx sdk.Context, dispute types.Dispute) error {
+	contract, err := k.Contract.Get(ctx, dispute.ContractId)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", dispute.ContractId)
+	}
+
+	for _, fee := range filingFees(dispute) {
+		if err := k.refundFilingFee(ctx, dispute.Initiator, fee); err != nil {
+			return err
+		}
+	}
+
+	ruling := uint64(types.BasisPoints)
+	if dispute.Initiator == contract.Client {
+		ruling = 0
+	}
+	winner := types.RulingSide(ruling)
+	dispute.Status = "resolved_" + winner
+	dispute.FreelancerPayoutBps = ruling
+	dispute.Resolution = "Not contested by the response deadline, resolved in favor of the initiator"
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_defaulted",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("initiator", dispute.Initiator),
+			sdk.NewAttribute("winner", winner),
+			sdk.NewAttribute("filing_fee", dispute.FilingFee.String()),
+		),
+	)
+
+	return k.settleDisputePayout(ctx, &dispute)
+}
+
+// ProcessResponseDeadlines resolves the disputes not contested by their
+// response deadline in favor of their initiator.
+func (k Keeper) ProcessResponseDeadlines(ctx sdk.Context) error {
+	now := ctx.BlockTime().Unix()
+	due, err := k.dueDisputes(ctx, k.ResponseQueue, now, func(dispute types.Dispute) bool {
+		return (dispute.Status == "open" || dispute.Status == "voting") && !dispute.Contested && dispute.ResponseDeadline <= now
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing response deadlines")
+	}
+
+	for _, dispute := range due {
+		// a dispute that cannot be resolved must not halt the chain, its
+		// changes are discarded and it is retried on the next block
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.resolveUncontested(cacheCtx, dispute); err != nil {
+			ctx.Logger().Error("failed to resolve uncontested dispute", "dispute_id", dispute.Id, "error", err)
+			if err := k.retryDispute(ctx, k.ResponseQueue, now, dispute.Id); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
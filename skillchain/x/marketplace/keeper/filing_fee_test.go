@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestFilingFeeRefundedToWinner(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinArbitersRequired = 1
+	params.JuryAlternates = 0
+	params.DisputeFilingFeeBps = 1000
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	juror := registerArbiter(t, f, "arbiter1____________", 1000)
+
+	// the initiator pays 10% of the contract price to open the dispute
+	open := &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"}
+	_, err = ms.OpenDispute(ctx, open)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)))
+	opened, err := ms.OpenDispute(ctx, open)
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 100), dispute.FilingFee)
+	require.False(t, dispute.Contested)
+	require.Equal(t, ctx.BlockTime().Unix()+int64(types.DefaultDisputeResponsePeriod), dispute.ResponseDeadline)
+
+	// no ruling before the counterparty matched the fee
+	_, err = ms.ResolveDispute(ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0)), &types.MsgResolveDispute{Creator: contract.Client, DisputeId: dispute.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	contest := &types.MsgContestDispute{Creator: contract.Client, DisputeId: dispute.Id}
+	_, err = ms.ContestDispute(ctx, contest)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	contest.Creator = juror
+	_, err = ms.ContestDispute(ctx, contest)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	contest.Creator = contract.Freelancer
+	_, err = ms.ContestDispute(ctx, contest)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	f.bankKeeper.mint(freelancerAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)))
+	contested, err := ms.ContestDispute(ctx, contest)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 100), contested.Fee)
+	_, err = ms.ContestDispute(ctx, contest)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, commitVote(ctx, ms, juror, dispute.Id, 0))
+	require.NoError(t, revealVote(ctx, ms, juror, dispute.Id, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+
+	// the client won: its fee is refunded and the one of the freelancer goes
+	// to the juror with the dispute fee
+	require.Equal(t, int64(100), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount.Int64())
+	arbiter, err := f.keeper.Arbiter.Get(ctx, juror)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 120)), arbiter.Earned)
+
+	ctx = closeAppealWindow(t, f, ctx, dispute.Id)
+	require.Equal(t, int64(1080), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount.Int64())
+	require.True(t, f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").IsZero())
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestUncontestedDisputeResolvesForInitiator(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.DisputeFilingFees = sdk.NewCoins(sdk.NewInt64Coin("skill", 50))
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	f.bankKeeper.mint(freelancerAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)))
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Freelancer, ContractId: contractId, Reason: "unpaid"})
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").IsZero())
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ProcessResponseDeadlines(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, dispute.Id)
+	require.NoError(t, err)
+	require.Equal(t, "open", dispute.Status)
+
+	// the client let the response deadline pass: the freelancer is paid in
+	// full less the platform fee, gets the filing fee back, and the ruling
+	// cannot be appealed
+	ctx = ctx.WithBlockTime(time.Unix(dispute.ResponseDeadline, 0))
+	_, err = ms.ContestDispute(ctx, &types.MsgContestDispute{Creator: contract.Client, DisputeId: dispute.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// an escrow that cannot be paid out does not halt the chain, the
+	// dispute is resolved on a later block
+	escrowAddr := authtypes.NewModuleAddress(types.EscrowAccountName).String()
+	escrowBalance := f.bankKeeper.balances[escrowAddr]
+	f.bankKeeper.balances[escrowAddr] = sdk.NewCoins()
+	require.NoError(t, f.keeper.ProcessResponseDeadlines(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, dispute.Id)
+	require.NoError(t, err)
+	require.Equal(t, "open", dispute.Status)
+
+	f.bankKeeper.balances[escrowAddr] = escrowBalance
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, f.keeper.ProcessResponseDeadlines(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, dispute.Id)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_freelancer", dispute.Status)
+	require.True(t, dispute.Settled)
+	require.Equal(t, int64(1000), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+	_, err = ms.AppealDispute(ctx, &types.MsgAppealDispute{Creator: contract.Client, DisputeId: dispute.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestFilingFeeValidation(t *testing.T) {
+	// contracts in a denom with a fixed filing fee pay it, the others a share
+	// of their price
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{"skill", "uusdc"}
+	params.DisputeFilingFees = sdk.NewCoins(sdk.NewInt64Coin("skill", 50))
+	params.DisputeFilingFeeBps = 100
+	require.NoError(t, params.Validate())
+	require.Equal(t, sdk.NewInt64Coin("skill", 50), params.DisputeFilingFeeFor(sdk.NewInt64Coin("skill", 1000)))
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), params.DisputeFilingFeeFor(sdk.NewInt64Coin("uusdc", 1000)))
+	params.DisputeFilingFeeBps = 0
+	require.True(t, params.DisputeFilingFeeFor(sdk.NewInt64Coin("uusdc", 1000)).IsZero())
+
+	params.DisputeFilingFees = sdk.NewCoins(sdk.NewInt64Coin("uatom", 50))
+	require.Error(t, params.Validate())
+	params.DisputeFilingFees = sdk.Coins{sdk.NewInt64Coin("skill", 0)}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.DisputeResponsePeriod = params.DisputeDuration + 1
+	require.Error(t, params.Validate())
+}
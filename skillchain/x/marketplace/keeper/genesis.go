@@ -35,6 +35,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.Contract.Set(ctx, elem.Id, elem); err != nil {
 			return err
 		}
+		if err := k.scheduleContract(ctx, elem); err != nil {
+			return err
+		}
 	}
 
 	if err := k.ContractSeq.Set(ctx, genState.ContractCount); err != nil {
@@ -44,6 +47,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.Dispute.Set(ctx, elem.Id, elem); err != nil {
 			return err
 		}
+		if err := k.scheduleDispute(ctx, elem); err != nil {
+			return err
+		}
 	}
 
 	if err := k.DisputeSeq.Set(ctx, genState.DisputeCount); err != nil {
@@ -54,6 +60,72 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 	}
+	for _, elem := range genState.ContractEscrowList {
+		if err := k.ContractEscrow.Set(ctx, elem.ContractId, elem); err != nil {
+			return err
+		}
+	}
+	for _, fee := range genState.RetainedFees {
+		if err := k.RetainedFees.Set(ctx, fee.Denom, fee.Amount); err != nil {
+			return err
+		}
+	}
+	if err := k.FeeStats.Set(ctx, genState.FeeStats); err != nil {
+		return err
+	}
+	for _, elem := range genState.CancellationProposalList {
+		if err := k.CancellationProposal.Set(ctx, elem.ContractId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.TipList {
+		if err := k.Tip.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.TipSeq.Set(ctx, genState.TipCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.TimeLogList {
+		if err := k.setTimeLog(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.TimeLogSeq.Set(ctx, genState.TimeLogCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.AmendmentList {
+		if err := k.Amendment.Set(ctx, elem.ContractId, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.DeadlineExtensionList {
+		if err := k.DeadlineExtension.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DeadlineExtensionSeq.Set(ctx, genState.DeadlineExtensionCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.ReferralList {
+		if err := k.Referral.Set(ctx, elem.Referred, elem); err != nil {
+			return err
+		}
+	}
+	if err := k.EscrowStakingPool.Set(ctx, genState.EscrowStakingPool); err != nil {
+		return err
+	}
+	for _, elem := range genState.ArbiterList {
+		if err := k.Arbiter.Set(ctx, elem.Address, elem); err != nil {
+			return err
+		}
+		if err := k.scheduleArbiter(ctx, elem); err != nil {
+			return err
+		}
+	}
 
 	return k.Params.Set(ctx, genState.Params)
 }
@@ -127,6 +199,84 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.ContractEscrow.Walk(ctx, nil, func(_ uint64, val types.ContractEscrow) (stop bool, err error) {
+		genesis.ContractEscrowList = append(genesis.ContractEscrowList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.RetainedFees, err = k.retainedFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.FeeStats, err = k.getFeeStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CancellationProposal.Walk(ctx, nil, func(_ uint64, val types.CancellationProposal) (stop bool, err error) {
+		genesis.CancellationProposalList = append(genesis.CancellationProposalList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	err = k.Tip.Walk(ctx, nil, func(key uint64, elem types.Tip) (bool, error) {
+		genesis.TipList = append(genesis.TipList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.TipCount, err = k.TipSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = k.TimeLog.Walk(ctx, nil, func(key uint64, elem types.TimeLog) (bool, error) {
+		genesis.TimeLogList = append(genesis.TimeLogList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.TimeLogCount, err = k.TimeLogSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Amendment.Walk(ctx, nil, func(_ uint64, val types.Amendment) (stop bool, err error) {
+		genesis.AmendmentList = append(genesis.AmendmentList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	err = k.DeadlineExtension.Walk(ctx, nil, func(key uint64, elem types.DeadlineExtension) (bool, error) {
+		genesis.DeadlineExtensionList = append(genesis.DeadlineExtensionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.DeadlineExtensionCount, err = k.DeadlineExtensionSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Referral.Walk(ctx, nil, func(_ string, val types.Referral) (stop bool, err error) {
+		genesis.ReferralList = append(genesis.ReferralList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.EscrowStakingPool, err = k.getEscrowStakingPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Arbiter.Walk(ctx, nil, func(_ string, val types.Arbiter) (stop bool, err error) {
+		genesis.ArbiterList = append(genesis.ArbiterList, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
 }
@@ -5,6 +5,8 @@ import (
 
 	"skillchain/x/marketplace/types"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +21,32 @@ func TestGenesis(t *testing.T) {
 		ContractCount:    2,
 		DisputeList:      []types.Dispute{{Id: 0}, {Id: 1}},
 		DisputeCount:     2,
-		DisputeVoteMap:   []types.DisputeVote{{Arbiter: "0"}, {Arbiter: "1"}}}
+		DisputeVoteMap:   []types.DisputeVote{{Arbiter: "0"}, {Arbiter: "1"}},
+		ContractEscrowList: []types.ContractEscrow{
+			{ContractId: 0, Locked: sdk.NewCoins(sdk.NewInt64Coin("skill", 100))},
+			{ContractId: 1, Locked: sdk.NewCoins(sdk.NewInt64Coin("skill", 200))},
+		},
+		RetainedFees: sdk.NewCoins(sdk.NewInt64Coin("skill", 5)),
+		CancellationProposalList: []types.CancellationProposal{
+			{ContractId: 0, FreelancerPayout: math.NewInt(50)},
+			{ContractId: 1, FreelancerPayout: math.NewInt(0)},
+		},
+		TipList:                []types.Tip{{Id: 0}, {Id: 1}},
+		TipCount:               2,
+		TimeLogList:            []types.TimeLog{{Id: 0}, {Id: 1}},
+		TimeLogCount:           2,
+		AmendmentList:          []types.Amendment{{ContractId: 0}, {ContractId: 1}},
+		DeadlineExtensionList:  []types.DeadlineExtension{{Id: 0}, {Id: 1}},
+		DeadlineExtensionCount: 2,
+		ReferralList:           []types.Referral{{Referred: "0"}, {Referred: "1"}},
+		EscrowStakingPool: types.EscrowStakingPool{
+			Bonded:    math.NewInt(500),
+			Unbonding: math.NewInt(100),
+			Shortfall: math.NewInt(20),
+			Rewards:   sdk.NewCoins(sdk.NewInt64Coin("skill", 40)),
+		},
+		ArbiterList: []types.Arbiter{{Address: "0", Stake: sdk.NewInt64Coin("skill", 1000)}, {Address: "1", Stake: sdk.NewInt64Coin("skill", 1000)}},
+	}
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
 	require.NoError(t, err)
@@ -38,5 +65,18 @@ func TestGenesis(t *testing.T) {
 	require.EqualExportedValues(t, genesisState.DisputeList, got.DisputeList)
 	require.Equal(t, genesisState.DisputeCount, got.DisputeCount)
 	require.EqualExportedValues(t, genesisState.DisputeVoteMap, got.DisputeVoteMap)
+	require.EqualExportedValues(t, genesisState.ContractEscrowList, got.ContractEscrowList)
+	require.Equal(t, genesisState.RetainedFees, got.RetainedFees)
+	require.EqualExportedValues(t, genesisState.CancellationProposalList, got.CancellationProposalList)
+	require.EqualExportedValues(t, genesisState.TipList, got.TipList)
+	require.Equal(t, genesisState.TipCount, got.TipCount)
+	require.EqualExportedValues(t, genesisState.TimeLogList, got.TimeLogList)
+	require.Equal(t, genesisState.TimeLogCount, got.TimeLogCount)
+	require.EqualExportedValues(t, genesisState.AmendmentList, got.AmendmentList)
+	require.EqualExportedValues(t, genesisState.DeadlineExtensionList, got.DeadlineExtensionList)
+	require.Equal(t, genesisState.DeadlineExtensionCount, got.DeadlineExtensionCount)
+	require.EqualExportedValues(t, genesisState.ReferralList, got.ReferralList)
+	require.EqualExportedValues(t, genesisState.EscrowStakingPool, got.EscrowStakingPool)
+	require.EqualExportedValues(t, genesisState.ArbiterList, got.ArbiterList)
 
 }
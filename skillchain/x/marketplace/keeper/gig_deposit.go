@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// lockGigDeposit moves the price of a funded gig from its owner into the
+// escrow account.
+func (k Keeper) lockGigDeposit(ctx sdk.Context, gig types.Gig) error {
+	ownerAddr, err := k.addressCodec.StringToBytes(gig.Owner)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid gig owner address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, ownerAddr, types.EscrowAccountName, sdk.NewCoins(gig.Price)); err != nil {
+		return errorsmod.Wrap(types.ErrInsufficientFunds, err.Error())
+	}
+	return nil
+}
+
+// refundGigDeposit returns amount of the deposit of a funded gig to its owner.
+func (k Keeper) refundGigDeposit(ctx sdk.Context, gig types.Gig, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	ownerAddr, err := k.addressCodec.StringToBytes(gig.Owner)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid gig owner address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, ownerAddr, amount); err != nil {
+		return errorsmod.Wrap(err, "failed to refund gig deposit")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"gig_deposit_refunded",
+			sdk.NewAttribute("gig_id", fmt.Sprintf("%d", gig.Id)),
+			sdk.NewAttribute("owner", gig.Owner),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+	return nil
+}
+
+// lockEscrowFromDeposit opens the escrow of a contract hired on a funded gig.
+// The gig deposit already held by the escrow account covers the contract
+// price, the client tops up a higher price and gets the difference back on a
+// lower one.
+func (k Keeper) lockEscrowFromDeposit(ctx sdk.Context, gig types.Gig, contract types.Contract) (sdk.Coins, error) {
+	deposit := gig.Price
+	price := contract.Price
+
+	covered := deposit
+	if price.IsLT(deposit) {
+		covered = price
+	}
+	err := k.updateEscrow(ctx, contract, func(escrow *types.ContractEscrow) {
+		escrow.Locked = escrow.Locked.Add(covered)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if deposit.IsLT(price) {
+		if err := k.addEscrow(ctx, contract, sdk.NewCoins(price.Sub(deposit))); err != nil {
+			return nil, err
+		}
+	} else if err := k.refundGigDeposit(ctx, gig, sdk.NewCoins(deposit.Sub(price))); err != nil {
+		return nil, err
+	}
+
+	return sdk.NewCoins(price), nil
+}
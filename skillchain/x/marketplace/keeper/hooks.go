@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wraps the keeper to implement the x/epochs hooks.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the x/epochs hooks of the marketplace module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd bills the hourly contracts at the end of the billing epoch,
+// rebalances the staked escrow at the end of the escrow staking epoch and
+// distributes the retained fees when fees are settled per epoch and the
+// settlement epoch ended. A failed rebalance or distribution is logged and
+// retried at the end of the next epoch rather than halting the chain.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := h.k.Params.Get(sdkCtx)
+	if err != nil {
+		return err
+	}
+	if params.HourlyBillingEpoch == epochIdentifier {
+		if err := h.k.BillHourlyContracts(sdkCtx); err != nil {
+			return err
+		}
+	}
+	if params.EscrowStaking.Epoch == epochIdentifier {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := h.k.ProcessEscrowStaking(cacheCtx, params); err != nil {
+			sdkCtx.Logger().Error("failed to process escrow staking", "epoch", epochIdentifier, "error", err)
+		} else {
+			write()
+		}
+	}
+	if params.FeeSettlementEpoch == "" || params.FeeSettlementEpoch != epochIdentifier {
+		return nil
+	}
+
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := h.k.distributeFees(cacheCtx, params); err != nil {
+		sdkCtx.Logger().Error("failed to distribute marketplace fees", "epoch", epochIdentifier, "error", err)
+		return nil
+	}
+	write()
+	return nil
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
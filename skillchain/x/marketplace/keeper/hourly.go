@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// setTimeLog saves a time log and indexes it by contract.
+func (k Keeper) setTimeLog(ctx context.Context, log types.TimeLog) error {
+	if err := k.TimeLog.Set(ctx, log.Id, log); err != nil {
+		return err
+	}
+	return k.TimeLogByContract.Set(ctx, collections.Join(log.ContractId, log.Id))
+}
+
+// contractTimeLogs returns the time logs of the contract, in the order they
+// were logged.
+func (k Keeper) contractTimeLogs(ctx context.Context, contractId uint64) ([]types.TimeLog, error) {
+	iter, err := k.TimeLogByContract.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](contractId))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]types.TimeLog, 0, len(keys))
+	for _, key := range keys {
+		log, err := k.TimeLog.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// pendingTimeLogs returns the time logs of the contract that were neither
+// billed nor contested.
+func (k Keeper) pendingTimeLogs(ctx sdk.Context, contractId uint64) ([]types.TimeLog, error) {
+	logs, err := k.contractTimeLogs(ctx, contractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get time logs: %v", err)
+	}
+
+	var pending []types.TimeLog
+	for _, log := range logs {
+		if log.Status == "pending" {
+			pending = append(pending, log)
+		}
+	}
+	return pending, nil
+}
+
+// billTimeLogs releases the cost of logs from the escrow of an hourly contract
+// and marks them billed. The caller saves the contract.
+func (k Keeper) billTimeLogs(ctx sdk.Context, contract *types.Contract, logs []types.TimeLog) error {
+	var hours uint64
+	for _, log := range logs {
+		hours += log.Hours
+	}
+	if hours == 0 {
+		return nil
+	}
+
+	amount := contract.HoursCost(hours)
+	freelancerCoins, platformFee, feeTier, err := k.releaseEscrow(ctx, *contract, amount)
+	if err != nil {
+		return err
+	}
+	contract.Billed = contract.BilledAmount().Add(amount)
+
+	for _, log := range logs {
+		log.Status = "billed"
+		log.BilledAt = ctx.BlockTime().Unix()
+		if err := k.setTimeLog(ctx, log); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update time log %d: %v", log.Id, err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"hours_billed",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("hours", fmt.Sprintf("%d", hours)),
+			sdk.NewAttribute("amount", freelancerCoins.String()),
+			sdk.NewAttribute("platform_fee", platformFee.String()),
+			sdk.NewAttribute("fee_tier", feeTier),
+		),
+	)
+
+	return nil
+}
+
+// BillHourlyContracts bills the pending hours of the active hourly contracts
+// whose contest window has passed and opens a new billing period. A contract
+// that cannot be billed is logged and retried at the next billing epoch.
+func (k Keeper) BillHourlyContracts(ctx sdk.Context) error {
+	var contracts []types.Contract
+	err := k.Contract.Walk(ctx, nil, func(_ uint64, contract types.Contract) (stop bool, err error) {
+		if contract.Status == "active" && contract.IsHourly() {
+			contracts = append(contracts, contract)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error billing hourly contracts")
+	}
+
+	for _, contract := range contracts {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.billHourlyContract(cacheCtx, contract); err != nil {
+			ctx.Logger().Error("failed to bill hourly contract", "contract_id", contract.Id, "error", err)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+func (k Keeper) billHourlyContract(ctx sdk.Context, contract types.Contract) error {
+	logs, err := k.pendingTimeLogs(ctx, contract.Id)
+	if err != nil {
+		return err
+	}
+
+	var billable []types.TimeLog
+	for _, log := range logs {
+		if log.ContestDeadline <= ctx.BlockTime().Unix() {
+			billable = append(billable, log)
+		}
+	}
+	if err := k.billTimeLogs(ctx, &contract, billable); err != nil {
+		return err
+	}
+
+	contract.PeriodHours = 0
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+	return nil
+}
+
+// completeHourlyContract ends an hourly contract on the client request. The
+// pending hours are billed right away, completing is accepting them, and the
+// unbilled funds are refunded to the client.
+func (k Keeper) completeHourlyContract(ctx sdk.Context, contract types.Contract) error {
+	logs, err := k.pendingTimeLogs(ctx, contract.Id)
+	if err != nil {
+		return err
+	}
+	if err := k.billTimeLogs(ctx, &contract, logs); err != nil {
+		return err
+	}
+
+	refund, err := k.refundEscrow(ctx, contract, unreleasedAmount(contract))
+	if err != nil {
+		return err
+	}
+	if err := k.finishContract(ctx, &contract, "completed", "completed", true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"contract_completed",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+		),
+		sdk.NewEvent(
+			"funds_refunded",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("amount", refund.String()),
+		),
+	})
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skillchain/x/marketplace/types"
+)
+
+// RegisterInvariants registers the marketplace module invariants. The app
+// does not wire x/crisis, so they are not checked on chain: they catch
+// accounting bugs in tests, while payouts are bounded by the escrow ledger of
+// their contract.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-balance", EscrowBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the marketplace module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return EscrowBalanceInvariant(k)(ctx)
+	}
+}
+
+// EscrowBalanceInvariant checks that the funds held for open contracts,
+// funded gigs, pending application bonds, dispute filing fees, appeal bonds
+// and arbiter stakes equal the balance of the escrow account plus the escrow
+// staked, unbonding or lost to slashing, that the balance of the module
+// account covers the retained platform fees, and that no contract paid out
+// more than it locked.
+func EscrowBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+			held   = sdk.NewCoins()
+		)
+
+		err := k.ContractEscrow.Walk(ctx, nil, func(id uint64, escrow types.ContractEscrow) (bool, error) {
+			if !escrow.Outflows().IsAllLTE(escrow.Locked) {
+				broken = true
+				msg += fmt.Sprintf("\tcontract %d locked %s but paid out %s\n", id, escrow.Locked, escrow.Outflows())
+				return false, nil
+			}
+			held = held.Add(escrow.Held()...)
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", fmt.Sprintf("failed to walk escrows: %v", err)), true
+		}
+
+		err = k.Gig.Walk(ctx, nil, func(_ uint64, gig types.Gig) (bool, error) {
+			if gig.Funded {
+				held = held.Add(gig.Price)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", fmt.Sprintf("failed to walk gigs: %v", err)), true
+		}
+
+		err = k.Application.Walk(ctx, nil, func(_ uint64, application types.Application) (bool, error) {
+			if application.Status == "pending" {
+				held = held.Add(applicationBond(application)...)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", fmt.Sprintf("failed to walk applications: %v", err)), true
+		}
+
+		err = k.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+			held = held.Add(appealBond(dispute)...)
+			held = held.Add(filingFees(dispute)...)
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", fmt.Sprintf("failed to walk disputes: %v", err)), true
+		}
+
+		stakes, err := k.arbiterStakes(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", fmt.Sprintf("failed to walk arbiters: %v", err)), true
+		}
+		held = held.Add(stakes...)
+
+		fees, err := k.retainedFees(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", fmt.Sprintf("failed to get retained fees: %v", err)), true
+		}
+
+		pool, err := k.getEscrowStakingPool(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-balance", err.Error()), true
+		}
+
+		// staking rewards waiting to be paid out and matured unbondings waiting
+		// to be returned sit in the module account next to the fees
+		escrowBalance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.EscrowAccountName))
+		staked := pool.Staked()
+		if !escrowBalance.Add(staked...).Equal(held) {
+			broken = true
+			msg += fmt.Sprintf("\tescrow account balance %s and staked escrow %s do not match held escrow %s\n", escrowBalance, staked, held)
+		}
+
+		feeBalance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		if !feeBalance.IsAllGTE(fees) {
+			broken = true
+			msg += fmt.Sprintf("\tmodule account balance %s does not cover retained fees %s\n", feeBalance, fees)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-balance", msg), broken
+	}
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestEscrowBalanceInvariant(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contractId, _, _ := setupMilestoneContract(t, f)
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	escrow, err := qs.ContractEscrow(f.ctx, &types.QueryContractEscrowRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)), escrow.Escrow.Locked)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 380)), escrow.Escrow.Released)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), escrow.Escrow.Fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 600)), escrow.Held)
+
+	// the dispute refunds the remaining milestone to the client, less the
+	// dispute fee paid to the jurors, leaving the stake of the arbiters
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(f.ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	recordRuling(t, f, opened.DisputeId, 0)
+	_, err = f.keeper.ResolveDispute(f.ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: opened.DisputeId})
+	require.NoError(t, err)
+	ctx = closeAppealWindow(t, f, ctx, opened.DisputeId)
+
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	byUser, err := qs.EscrowsByUser(f.ctx, &types.QueryEscrowsByUserRequest{User: contract.Client})
+	require.NoError(t, err)
+	require.Len(t, byUser.Escrows, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 588)), byUser.Escrows[0].Refunded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 12)), byUser.Escrows[0].DisputeFees)
+	require.True(t, byUser.Held.IsZero())
+
+	balance, err := qs.EscrowBalance(f.ctx, &types.QueryEscrowBalanceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 3000)), balance.Balances)
+	require.True(t, balance.RetainedFees.IsZero())
+
+	// funds the escrow ledger does not account for break the invariant
+	f.bankKeeper.mint(authtypes.NewModuleAddress(types.EscrowAccountName), sdk.NewCoins(sdk.NewInt64Coin("skill", 1)))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+	require.NoError(t, f.bankKeeper.BurnCoins(ctx, types.EscrowAccountName, sdk.NewCoins(sdk.NewInt64Coin("skill", 1))))
+
+	// staking rewards awaiting distribution sit next to the fees
+	f.bankKeeper.mint(authtypes.NewModuleAddress(types.ModuleName), sdk.NewCoins(sdk.NewInt64Coin("skill", 1)))
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestEscrowLedgerEnforced(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	escrowAddr := authtypes.NewModuleAddress(types.EscrowAccountName)
+
+	// a contract without escrow of its own cannot be paid out of the escrow
+	// of the others
+	forged, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	attacker, err := f.addressCodec.BytesToString(sdk.AccAddress("attacker____________"))
+	require.NoError(t, err)
+	forged.Id, forged.Client, forged.Freelancer, forged.Status = contractId+1, attacker, attacker, "delivered"
+	require.NoError(t, f.keeper.Contract.Set(ctx, forged.Id, forged))
+	_, err = ms.CompleteContract(ctx, &types.MsgCompleteContract{Creator: attacker, ContractId: forged.Id})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	require.Equal(t, int64(1000), f.bankKeeper.GetBalance(ctx, escrowAddr, "skill").Amount.Int64())
+	require.True(t, f.bankKeeper.GetBalance(ctx, sdk.AccAddress("attacker____________"), "skill").IsZero())
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// drawJury draws size jurors and the alternates of a dispute on contract from
+// the eligible arbiters, without replacement and weighted by stake. The draw
+// is seeded from the block header hash, the height and the dispute id so every
+// node draws the same jury. When fewer arbiters are eligible than size, all
+// of them are jurors, but no jury is drawn below min_arbiters_required.
+func (k Keeper) drawJury(ctx sdk.Context, params types.Params, contract types.Contract, disputeId, size uint64) ([]string, []string, error) {
+	candidates, err := k.eligibleArbiters(ctx, params, contract)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(candidates)) < params.MinArbitersRequired {
+		return nil, nil, errorsmod.Wrapf(
+			types.ErrNotEnoughArbiters,
+			"%d arbiters eligible, %d required",
+			len(candidates),
+			params.MinArbitersRequired,
+		)
+	}
+
+	total := math.ZeroInt()
+	for _, candidate := range candidates {
+		total = total.Add(candidate.Stake.Amount)
+	}
+
+	seed := sha256.New()
+	seed.Write(ctx.HeaderHash())
+	seed.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight())))
+	seed.Write(binary.BigEndian.AppendUint64(nil, disputeId))
+	digest := seed.Sum(nil)
+
+	draws := min(size+params.JuryAlternates, uint64(len(candidates)))
+	drawn := make([]string, 0, draws)
+	for round := uint64(0); round < draws; round++ {
+		hash := sha256.Sum256(binary.BigEndian.AppendUint64(slices.Clone(digest), round))
+		point := math.NewIntFromBigInt(new(big.Int).SetBytes(hash[:])).Mod(total)
+
+		for i, candidate := range candidates {
+			if point.LT(candidate.Stake.Amount) {
+				drawn = append(drawn, candidate.Address)
+				total = total.Sub(candidate.Stake.Amount)
+				candidates = slices.Delete(candidates, i, i+1)
+				break
+			}
+			point = point.Sub(candidate.Stake.Amount)
+		}
+	}
+
+	jurors := min(size, uint64(len(drawn)))
+	return drawn[:jurors], drawn[jurors:], nil
+}
+
+// ProcessJurorDeadlines replaces the jurors who did not vote by the juror
+// deadline of their dispute with its alternates, in the order they were
+// drawn. The replaced jurors are dismissed and the alternates not promoted
+// are released, so each dispute is processed once.
+func (k Keeper) ProcessJurorDeadlines(ctx sdk.Context) error {
+	now := ctx.BlockTime().Unix()
+	due, err := k.dueDisputes(ctx, k.JurorQueue, now, func(dispute types.Dispute) bool {
+		return (dispute.Status == "open" || dispute.Status == "voting") && len(dispute.Alternates) > 0 && dispute.JurorDeadline <= now
+	})
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing juror deadlines")
+	}
+
+	for _, dispute := range due {
+		voted := make(map[string]bool, len(dispute.Votes))
+		for _, vote := range dispute.Votes {
+			voted[vote.Arbiter] = true
+		}
+
+		var promoted []string
+		for i, juror := range dispute.Arbiters {
+			if voted[juror] || len(dispute.Alternates) == 0 {
+				continue
+			}
+			dispute.Dismissed = append(dispute.Dismissed, juror)
+			dispute.Arbiters[i] = dispute.Alternates[0]
+			promoted = append(promoted, dispute.Alternates[0])
+			dispute.Alternates = dispute.Alternates[1:]
+		}
+		dispute.Alternates = nil
+
+		if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update dispute %d: %v", dispute.Id, err)
+		}
+		if len(promoted) == 0 {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"jurors_replaced",
+				sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+				sdk.NewAttribute("dismissed", strings.Join(dispute.Dismissed, ",")),
+				sdk.NewAttribute("promoted", strings.Join(promoted, ",")),
+			),
+		)
+	}
+
+	return nil
+}
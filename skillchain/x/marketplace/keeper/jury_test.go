@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestJurySelection(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderHash([]byte("header_hash"))
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	arbiters := make([]string, 0, 6)
+	for i, name := range []string{"arbiter1", "arbiter2", "arbiter3", "arbiter4", "arbiter5", "arbiter6"} {
+		arbiters = append(arbiters, registerArbiter(t, f, name+"____________", int64(1000*(i+1))))
+	}
+	// parties are never drawn, even when they are arbiters
+	f.bankKeeper.mint(freelancerAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 10000)))
+	_, err = ms.RegisterArbiter(ctx, &types.MsgRegisterArbiter{Creator: contract.Freelancer, Stake: sdk.NewInt64Coin("skill", 10000)})
+	require.NoError(t, err)
+
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Len(t, dispute.Arbiters, 3)
+	require.Len(t, dispute.Alternates, 2)
+	require.Subset(t, arbiters, append(dispute.Arbiters, dispute.Alternates...))
+	require.NotContains(t, append(dispute.Arbiters, dispute.Alternates...), contract.Freelancer)
+	require.Equal(t, dispute.CreatedAt+int64(types.DefaultJurorVotePeriod), dispute.JurorDeadline)
+
+	// only jurors vote, alternates wait for a juror to miss the deadline
+	drawn := dispute
+	require.ErrorIs(t, commitVote(ctx, ms, drawn.Alternates[0], drawn.Id, 0), types.ErrInvalidArbiter)
+	require.NoError(t, commitVote(ctx, ms, drawn.Arbiters[0], drawn.Id, 0))
+
+	require.NoError(t, f.keeper.ProcessJurorDeadlines(ctx))
+	unchanged, err := f.keeper.Dispute.Get(ctx, drawn.Id)
+	require.NoError(t, err)
+	require.Equal(t, drawn.Arbiters, unchanged.Arbiters)
+
+	ctx = ctx.WithBlockTime(time.Unix(drawn.JurorDeadline, 0))
+	require.NoError(t, f.keeper.ProcessJurorDeadlines(ctx))
+	replaced, err := f.keeper.Dispute.Get(ctx, drawn.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{drawn.Arbiters[0], drawn.Alternates[0], drawn.Alternates[1]}, replaced.Arbiters)
+	require.Equal(t, drawn.Arbiters[1:], replaced.Dismissed)
+	require.Empty(t, replaced.Alternates)
+
+	require.ErrorIs(t, commitVote(ctx, ms, drawn.Arbiters[1], drawn.Id, 0), types.ErrInvalidArbiter)
+	require.NoError(t, commitVote(ctx, ms, drawn.Alternates[0], drawn.Id, 0))
+
+	// dismissed jurors are slashed for the vote they missed
+	ctx = ctx.WithBlockTime(time.Unix(drawn.RevealDeadline, 0))
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: drawn.Id})
+	require.NoError(t, err)
+	for _, address := range drawn.Arbiters[1:] {
+		arbiter, err := f.keeper.Arbiter.Get(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), arbiter.MissedVotes)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"skillchain/x/marketplace/types"
 )
@@ -24,6 +26,8 @@ type Keeper struct {
 
 	bankKeeper     types.BankKeeper
 	accountKeeper  types.AccountKeeper
+	distrKeeper    types.DistributionKeeper
+	stakingKeeper  types.StakingKeeper
 	Profile        collections.Map[string, types.Profile]
 	GigSeq         collections.Sequence
 	Gig            collections.Map[uint64, types.Gig]
@@ -34,6 +38,40 @@ type Keeper struct {
 	DisputeSeq     collections.Sequence
 	Dispute        collections.Map[uint64, types.Dispute]
 	DisputeVote    collections.Map[string, types.DisputeVote]
+	ContractEscrow collections.Map[uint64, types.ContractEscrow]
+	// RetainedFees holds the platform fees kept by the module account, by denom.
+	RetainedFees collections.Map[string, math.Int]
+	FeeStats     collections.Item[types.FeeStats]
+	// CancellationProposal holds the pending cancellation proposal of a contract.
+	CancellationProposal collections.Map[uint64, types.CancellationProposal]
+	TipSeq               collections.Sequence
+	Tip                  collections.Map[uint64, types.Tip]
+	TimeLogSeq           collections.Sequence
+	TimeLog              collections.Map[uint64, types.TimeLog]
+	// TimeLogByContract indexes the time logs by contract.
+	TimeLogByContract collections.KeySet[collections.Pair[uint64, uint64]]
+	// Amendment holds the pending amendment of a contract.
+	Amendment            collections.Map[uint64, types.Amendment]
+	DeadlineExtensionSeq collections.Sequence
+	DeadlineExtension    collections.Map[uint64, types.DeadlineExtension]
+	// Referral holds the referrer of each referred account.
+	Referral collections.Map[string, types.Referral]
+	// EscrowStakingPool tracks the escrow delegated to validators.
+	EscrowStakingPool collections.Item[types.EscrowStakingPool]
+	// Arbiter holds the registered arbiters by address.
+	Arbiter collections.Map[string, types.Arbiter]
+	// ReviewQueue holds the delivered contracts by delivery time.
+	ReviewQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// DeadlineQueue holds the active contracts by delivery deadline.
+	DeadlineQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// ResponseQueue, JurorQueue, RevealQueue and AppealQueue hold the
+	// disputes by the deadline each of them is due at.
+	ResponseQueue collections.KeySet[collections.Pair[int64, uint64]]
+	JurorQueue    collections.KeySet[collections.Pair[int64, uint64]]
+	RevealQueue   collections.KeySet[collections.Pair[int64, uint64]]
+	AppealQueue   collections.KeySet[collections.Pair[int64, uint64]]
+	// UnbondingQueue holds the unbonding arbiters by end of unbonding.
+	UnbondingQueue collections.KeySet[collections.Pair[int64, string]]
 }
 
 func NewKeeper(
@@ -44,6 +82,8 @@ func NewKeeper(
 
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -59,16 +99,41 @@ func NewKeeper(
 
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		stakingKeeper: stakingKeeper,
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Profile:       collections.NewMap(sb, types.ProfileKey, "profile", collections.StringKey, codec.CollValue[types.Profile](cdc)), Gig: collections.NewMap(sb, types.GigKey, "gig", collections.Uint64Key, codec.CollValue[types.Gig](cdc)),
-		GigSeq:         collections.NewSequence(sb, types.GigCountKey, "gigSequence"),
-		Application:    collections.NewMap(sb, types.ApplicationKey, "application", collections.Uint64Key, codec.CollValue[types.Application](cdc)),
-		ApplicationSeq: collections.NewSequence(sb, types.ApplicationCountKey, "applicationSequence"),
-		Contract:       collections.NewMap(sb, types.ContractKey, "contract", collections.Uint64Key, codec.CollValue[types.Contract](cdc)),
-		ContractSeq:    collections.NewSequence(sb, types.ContractCountKey, "contractSequence"),
-		Dispute:        collections.NewMap(sb, types.DisputeKey, "dispute", collections.Uint64Key, codec.CollValue[types.Dispute](cdc)),
-		DisputeSeq:     collections.NewSequence(sb, types.DisputeCountKey, "disputeSequence"),
-		DisputeVote:    collections.NewMap(sb, types.DisputeVoteKey, "disputeVote", collections.StringKey, codec.CollValue[types.DisputeVote](cdc))}
+		GigSeq:               collections.NewSequence(sb, types.GigCountKey, "gigSequence"),
+		Application:          collections.NewMap(sb, types.ApplicationKey, "application", collections.Uint64Key, codec.CollValue[types.Application](cdc)),
+		ApplicationSeq:       collections.NewSequence(sb, types.ApplicationCountKey, "applicationSequence"),
+		Contract:             collections.NewMap(sb, types.ContractKey, "contract", collections.Uint64Key, codec.CollValue[types.Contract](cdc)),
+		ContractSeq:          collections.NewSequence(sb, types.ContractCountKey, "contractSequence"),
+		Dispute:              collections.NewMap(sb, types.DisputeKey, "dispute", collections.Uint64Key, codec.CollValue[types.Dispute](cdc)),
+		DisputeSeq:           collections.NewSequence(sb, types.DisputeCountKey, "disputeSequence"),
+		DisputeVote:          collections.NewMap(sb, types.DisputeVoteKey, "disputeVote", collections.StringKey, codec.CollValue[types.DisputeVote](cdc)),
+		ContractEscrow:       collections.NewMap(sb, types.ContractEscrowKey, "contractEscrow", collections.Uint64Key, codec.CollValue[types.ContractEscrow](cdc)),
+		RetainedFees:         collections.NewMap(sb, types.RetainedFeesKey, "retainedFees", collections.StringKey, sdk.IntValue),
+		FeeStats:             collections.NewItem(sb, types.FeeStatsKey, "feeStats", codec.CollValue[types.FeeStats](cdc)),
+		CancellationProposal: collections.NewMap(sb, types.CancellationProposalKey, "cancellationProposal", collections.Uint64Key, codec.CollValue[types.CancellationProposal](cdc)),
+		Tip:                  collections.NewMap(sb, types.TipKey, "tip", collections.Uint64Key, codec.CollValue[types.Tip](cdc)),
+		TipSeq:               collections.NewSequence(sb, types.TipCountKey, "tipSequence"),
+		TimeLog:              collections.NewMap(sb, types.TimeLogKey, "timeLog", collections.Uint64Key, codec.CollValue[types.TimeLog](cdc)),
+		TimeLogSeq:           collections.NewSequence(sb, types.TimeLogCountKey, "timeLogSequence"),
+		TimeLogByContract:    collections.NewKeySet(sb, types.TimeLogByContractKey, "timeLogByContract", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		Amendment:            collections.NewMap(sb, types.AmendmentKey, "amendment", collections.Uint64Key, codec.CollValue[types.Amendment](cdc)),
+		DeadlineExtension:    collections.NewMap(sb, types.DeadlineExtensionKey, "deadlineExtension", collections.Uint64Key, codec.CollValue[types.DeadlineExtension](cdc)),
+		DeadlineExtensionSeq: collections.NewSequence(sb, types.DeadlineExtensionCountKey, "deadlineExtensionSequence"),
+		Referral:             collections.NewMap(sb, types.ReferralKey, "referral", collections.StringKey, codec.CollValue[types.Referral](cdc)),
+		EscrowStakingPool:    collections.NewItem(sb, types.EscrowStakingPoolKey, "escrowStakingPool", codec.CollValue[types.EscrowStakingPool](cdc)),
+		Arbiter:              collections.NewMap(sb, types.ArbiterKey, "arbiter", collections.StringKey, codec.CollValue[types.Arbiter](cdc)),
+		ReviewQueue:          collections.NewKeySet(sb, types.ReviewQueueKey, "reviewQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		DeadlineQueue:        collections.NewKeySet(sb, types.DeadlineQueueKey, "deadlineQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		ResponseQueue:        collections.NewKeySet(sb, types.ResponseQueueKey, "responseQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		JurorQueue:           collections.NewKeySet(sb, types.JurorQueueKey, "jurorQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		RevealQueue:          collections.NewKeySet(sb, types.RevealQueueKey, "revealQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		AppealQueue:          collections.NewKeySet(sb, types.AppealQueueKey, "appealQueue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		UnbondingQueue:       collections.NewKeySet(sb, types.UnbondingQueueKey, "unbondingQueue", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+	}
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
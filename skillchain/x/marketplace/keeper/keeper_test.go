@@ -3,14 +3,19 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"skillchain/x/marketplace/keeper"
 	module "skillchain/x/marketplace/module"
@@ -18,9 +23,12 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	bankKeeper    *mockBankKeeper
+	distrKeeper   *mockDistributionKeeper
+	stakingKeeper *mockStakingKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -34,14 +42,19 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	distrKeeper := &mockDistributionKeeper{bankKeeper: bankKeeper, rewards: make(map[string]sdk.Coins)}
+	stakingKeeper := &mockStakingKeeper{bankKeeper: bankKeeper, bonded: make(map[string]math.Int), delegations: make(map[string]math.Int)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
-		nil,
-		nil,
+		bankKeeper,
+		mockAccountKeeper{},
+		distrKeeper,
+		stakingKeeper,
 	)
 
 	// Initialize params
@@ -50,8 +63,193 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           ctx,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 }
+
+// mockAccountKeeper derives module addresses the same way x/auth does.
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(moduleName)
+}
+
+// mockBankKeeper is an in-memory bank used to follow escrow movements in tests.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (b *mockBankKeeper) mint(addr sdk.AccAddress, coins sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(coins...)
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, ok := b.balances[from.String()].SafeSub(amt...)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, from, authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(module), to, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, from, to string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(from), authtypes.NewModuleAddress(to), amt)
+}
+
+func (b *mockBankKeeper) MintCoins(_ context.Context, module string, amt sdk.Coins) error {
+	b.mint(authtypes.NewModuleAddress(module), amt)
+	return nil
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(module).String()
+	balance, ok := b.balances[addr].SafeSub(amt...)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", b.balances[addr], amt)
+	}
+	b.balances[addr] = balance
+	return nil
+}
+
+func (b *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+// mockDistributionKeeper funds an in-memory community pool through the mock
+// bank and pays out the rewards set per validator.
+type mockDistributionKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+	rewards       map[string]sdk.Coins
+}
+
+func (d *mockDistributionKeeper) WithdrawDelegationRewards(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	rewards := d.rewards[valAddr.String()]
+	d.bankKeeper.mint(delAddr, rewards)
+	delete(d.rewards, valAddr.String())
+	return rewards, nil
+}
+
+func (d *mockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := d.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, "distribution", amount); err != nil {
+		return err
+	}
+	d.communityPool = d.communityPool.Add(amount...)
+	return nil
+}
+
+// mockStakingKeeper reports the stake bonded by each delegator and follows
+// the delegations of the module account one token per share.
+type mockStakingKeeper struct {
+	bankKeeper  *mockBankKeeper
+	bonded      map[string]math.Int
+	delegations map[string]math.Int
+	unbonding   []stakingtypes.UnbondingDelegation
+}
+
+func (s *mockStakingKeeper) GetDelegatorBonded(_ context.Context, delegator sdk.AccAddress) (math.Int, error) {
+	if bonded, ok := s.bonded[delegator.String()]; ok {
+		return bonded, nil
+	}
+	return math.ZeroInt(), nil
+}
+
+func (s *mockStakingKeeper) GetDelegatorDelegations(_ context.Context, delegator sdk.AccAddress, _ uint16) ([]stakingtypes.Delegation, error) {
+	var delegations []stakingtypes.Delegation
+	for validator, tokens := range s.delegations {
+		delegations = append(delegations, stakingtypes.Delegation{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator,
+			Shares:           math.LegacyNewDecFromInt(tokens),
+		})
+	}
+	return delegations, nil
+}
+
+func (s *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	return stakingtypes.Validator{OperatorAddress: addr.String(), Tokens: math.OneInt(), DelegatorShares: math.LegacyOneDec()}, nil
+}
+
+func (s *mockStakingKeeper) Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, _ stakingtypes.BondStatus, validator stakingtypes.Validator, _ bool) (math.LegacyDec, error) {
+	if err := s.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(types.DefaultStakeDenom, bondAmt))); err != nil {
+		return math.LegacyDec{}, err
+	}
+	tokens, ok := s.delegations[validator.OperatorAddress]
+	if !ok {
+		tokens = math.ZeroInt()
+	}
+	s.delegations[validator.OperatorAddress] = tokens.Add(bondAmt)
+	return math.LegacyNewDecFromInt(bondAmt), nil
+}
+
+func (s *mockStakingKeeper) ValidateUnbondAmount(_ context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) (math.LegacyDec, error) {
+	tokens, ok := s.delegations[valAddr.String()]
+	if !ok || tokens.LT(amt) {
+		return math.LegacyDec{}, stakingtypes.ErrNotEnoughDelegationShares
+	}
+	return math.LegacyNewDecFromInt(amt), nil
+}
+
+func (s *mockStakingKeeper) Undelegate(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec) (time.Time, math.Int, error) {
+	amount := sharesAmount.TruncateInt()
+	tokens := s.delegations[valAddr.String()].Sub(amount)
+	if tokens.IsZero() {
+		delete(s.delegations, valAddr.String())
+	} else {
+		s.delegations[valAddr.String()] = tokens
+	}
+	s.unbonding = append(s.unbonding, stakingtypes.UnbondingDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Entries:          []stakingtypes.UnbondingDelegationEntry{{Balance: amount}},
+	})
+	return time.Time{}, amount, nil
+}
+
+func (s *mockStakingKeeper) GetUnbondingDelegations(_ context.Context, _ sdk.AccAddress, _ uint16) ([]stakingtypes.UnbondingDelegation, error) {
+	return s.unbonding, nil
+}
+
+// completeUnbonding pays out all unbondings to their delegators.
+func (s *mockStakingKeeper) completeUnbonding(ctx context.Context) error {
+	for _, unbonding := range s.unbonding {
+		delegator := sdk.MustAccAddressFromBech32(unbonding.DelegatorAddress)
+		for _, entry := range unbonding.Entries {
+			if err := s.bankKeeper.SendCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, delegator, sdk.NewCoins(sdk.NewCoin(types.DefaultStakeDenom, entry.Balance))); err != nil {
+				return err
+			}
+		}
+	}
+	s.unbonding = nil
+	return nil
+}
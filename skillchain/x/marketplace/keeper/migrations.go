@@ -0,0 +1,707 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skillchain/x/marketplace/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. Prices, milestone amounts and
+// profile earnings were plain amounts of the native denom and become coins,
+// and the denom allowlist is initialized with the native denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.AllowedDenoms = types.DefaultAllowedDenoms
+	params.StakeDenom = types.DefaultStakeDenom
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	// collections cannot be written while being walked, so records are
+	// collected first and saved afterwards
+	var gigs []types.Gig
+	if err := m.keeper.Gig.Walk(ctx, nil, func(_ uint64, gig types.Gig) (bool, error) {
+		gig.Price = sdk.NewCoin(types.DefaultDenom, math.NewIntFromUint64(gig.LegacyPrice))
+		gig.LegacyPrice = 0
+		gigs = append(gigs, gig)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, gig := range gigs {
+		if err := m.keeper.Gig.Set(ctx, gig.Id, gig); err != nil {
+			return err
+		}
+	}
+
+	var applications []types.Application
+	if err := m.keeper.Application.Walk(ctx, nil, func(_ uint64, application types.Application) (bool, error) {
+		application.ProposedPrice = sdk.NewCoin(types.DefaultDenom, math.NewIntFromUint64(application.LegacyProposedPrice))
+		application.LegacyProposedPrice = 0
+		migrateMilestones(application.Milestones)
+		applications = append(applications, application)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, application := range applications {
+		if err := m.keeper.Application.Set(ctx, application.Id, application); err != nil {
+			return err
+		}
+	}
+
+	var contracts []types.Contract
+	if err := m.keeper.Contract.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
+		contract.Price = sdk.NewCoin(types.DefaultDenom, math.NewIntFromUint64(contract.LegacyPrice))
+		contract.LegacyPrice = 0
+		migrateMilestones(contract.Milestones)
+		contracts = append(contracts, contract)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, contract := range contracts {
+		if err := m.keeper.Contract.Set(ctx, contract.Id, contract); err != nil {
+			return err
+		}
+	}
+
+	var profiles []types.Profile
+	if err := m.keeper.Profile.Walk(ctx, nil, func(_ string, profile types.Profile) (bool, error) {
+		profile.TotalEarned = sdk.NewCoins(sdk.NewCoin(types.DefaultDenom, math.NewIntFromUint64(profile.LegacyTotalEarned)))
+		profile.LegacyTotalEarned = 0
+		profiles = append(profiles, profile)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		if err := m.keeper.Profile.Set(ctx, profile.Owner, profile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateMilestones converts the legacy milestone amounts in place.
+func migrateMilestones(milestones []types.Milestone) {
+	for i := range milestones {
+		milestones[i].Amount = math.NewIntFromUint64(milestones[i].LegacyAmount)
+		milestones[i].LegacyAmount = 0
+	}
+}
+
+// Migrate2to3 migrates from version 2 to 3. It opens an escrow ledger for every
+// contract still holding funds and records the rest of the module balance as
+// retained platform fees.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var escrows []types.ContractEscrow
+	held := sdk.NewCoins()
+	if err := m.keeper.Contract.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
+		switch contract.Status {
+		case "completed", "resolved_client", "resolved_freelancer":
+			return false, nil
+		}
+
+		// amounts released before the ledger existed are not known, so the
+		// ledger starts from what is still held
+		locked := sdk.NewCoins(sdk.NewCoin(contract.Price.Denom, unreleasedAmount(contract)))
+		escrows = append(escrows, types.ContractEscrow{
+			ContractId: contract.Id,
+			Client:     contract.Client,
+			Freelancer: contract.Freelancer,
+			Locked:     locked,
+		})
+		held = held.Add(locked...)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, escrow := range escrows {
+		if err := m.keeper.ContractEscrow.Set(ctx, escrow.ContractId, escrow); err != nil {
+			return err
+		}
+	}
+
+	balance := m.keeper.bankKeeper.GetAllBalances(ctx, m.keeper.accountKeeper.GetModuleAddress(types.ModuleName))
+	for _, coin := range balance {
+		retained := coin.Amount.Sub(held.AmountOf(coin.Denom))
+		if !retained.IsPositive() {
+			continue
+		}
+		if err := m.keeper.RetainedFees.Set(ctx, coin.Denom, retained); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4. Escrowed funds move from the module
+// account to the dedicated escrow account, leaving only the retained fees in
+// the module account, and the fee distribution params are initialized.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.FeeDistribution = types.DefaultFeeDistribution
+	params.FeeSettlementEpoch = types.DefaultFeeSettlementEpoch
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	held := sdk.NewCoins()
+	if err := m.keeper.ContractEscrow.Walk(ctx, nil, func(_ uint64, escrow types.ContractEscrow) (bool, error) {
+		held = held.Add(escrow.Held()...)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if !held.IsZero() {
+		if err := m.keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowAccountName, held); err != nil {
+			return fmt.Errorf("failed to move escrowed funds: %w", err)
+		}
+	}
+
+	retained, err := m.keeper.retainedFees(ctx)
+	if err != nil {
+		return err
+	}
+	return m.keeper.FeeStats.Set(ctx, types.FeeStats{Collected: retained})
+}
+
+// Migrate4to5 migrates from version 4 to 5. It sets the review period and
+// starts the review window of the deliveries already awaiting a review.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.ReviewPeriod = types.DefaultReviewPeriod
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	var delivered []types.Contract
+	if err := m.keeper.Contract.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
+		if contract.Status == "delivered" {
+			contract.DeliveredAt = ctx.BlockTime().Unix()
+			delivered = append(delivered, contract)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, contract := range delivered {
+		if err := m.keeper.Contract.Set(ctx, contract.Id, contract); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6. It sets the cancellation expiry.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.CancellationExpiry = types.DefaultCancellationExpiry
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7. It sets the hourly billing params.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.HourlyBillingEpoch = types.DefaultHourlyBillingEpoch
+	params.TimeLogContestWindow = types.DefaultTimeLogContestWindow
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8. It sets the application bond
+// param, disabled by default.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.ApplicationBond = types.DefaultApplicationBond
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate8to9 migrates from version 8 to 9. It sets the maximum number of
+// deadline extensions of a contract.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.MaxDeadlineExtensions = types.DefaultMaxDeadlineExtensions
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate9to10 migrates from version 9 to 10. It sets the referral params.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.ReferralFeeShareBps = types.DefaultReferralFeeShareBps
+	params.ReferralMaxContracts = types.DefaultReferralMaxContracts
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate10to11 migrates from version 10 to 11. It sets the escrow staking
+// params, disabled by default.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.EscrowStaking = types.DefaultEscrowStaking
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate11to12 migrates from version 11 to 12. It sets the arbiter unbonding
+// period. Arbiters register again under the bonded registry.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.ArbiterUnbondingPeriod = types.DefaultArbiterUnbondingPeriod
+	if params.ArbiterUnbondingPeriod < params.DisputeDuration {
+		params.ArbiterUnbondingPeriod = params.DisputeDuration
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate12to13 migrates from version 12 to 13. It sets the dispute fee paid
+// to the arbiters and the slash of arbiters voting against the majority, and
+// assigns the bonded arbiters to the disputes still open along with the votes
+// already cast on them.
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.DisputeFeeBps = types.DefaultDisputeFeeBps
+	params.ArbiterSlashBps = types.DefaultArbiterSlashBps
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	var open []types.Dispute
+	err = m.keeper.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		if dispute.Status == "open" || dispute.Status == "voting" {
+			open = append(open, dispute)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk disputes: %w", err)
+	}
+	votes := make(map[uint64][]types.DisputeVote)
+	err = m.keeper.DisputeVote.Walk(ctx, nil, func(_ string, vote types.DisputeVote) (bool, error) {
+		votes[vote.DisputeId] = append(votes[vote.DisputeId], vote)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk dispute votes: %w", err)
+	}
+	for _, dispute := range open {
+		contract, err := m.keeper.Contract.Get(ctx, dispute.ContractId)
+		if err != nil {
+			return fmt.Errorf("failed to get contract %d: %w", dispute.ContractId, err)
+		}
+		arbiters, err := m.keeper.eligibleArbiters(ctx, params, contract)
+		if err != nil {
+			return err
+		}
+		dispute.Arbiters = nil
+		for _, arbiter := range arbiters {
+			dispute.Arbiters = append(dispute.Arbiters, arbiter.Address)
+		}
+		dispute.Votes = votes[dispute.Id]
+		if err := m.keeper.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+			return fmt.Errorf("failed to set dispute %d: %w", dispute.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate13to14 migrates from version 13 to 14. It sets the jury alternates
+// and the juror vote period. Disputes already open keep their arbiters.
+func (m Migrator) Migrate13to14(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.JuryAlternates = types.DefaultJuryAlternates
+	params.JurorVotePeriod = types.DefaultJurorVotePeriod
+	if params.JurorVotePeriod > params.DisputeDuration {
+		params.JurorVotePeriod = params.DisputeDuration
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate14to15 migrates from version 14 to 15. It sets the reveal period,
+// lengthening the arbiter unbonding period so arbiters stay slashable until
+// the reveal closes. Votes already cast on open disputes count as revealed.
+func (m Migrator) Migrate14to15(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.RevealPeriod = types.DefaultRevealPeriod
+	longest := params.DisputeDuration
+	for _, rule := range params.CategoryRules {
+		longest = max(longest, rule.DisputeDuration)
+	}
+	params.ArbiterUnbondingPeriod = max(params.ArbiterUnbondingPeriod, longest+params.RevealPeriod)
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	var open []types.Dispute
+	err = m.keeper.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		if dispute.Status == "open" || dispute.Status == "voting" {
+			open = append(open, dispute)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk disputes: %w", err)
+	}
+	for _, dispute := range open {
+		dispute.RevealDeadline = dispute.Deadline + int64(params.RevealPeriod)
+		for i := range dispute.Votes {
+			dispute.Votes[i].Revealed = true
+		}
+		if err := m.keeper.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+			return fmt.Errorf("failed to set dispute %d: %w", dispute.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate15to16 migrates from version 15 to 16. It sets the ruling tolerance
+// and turns the revealed votes of open disputes into full payouts to the side
+// voted for. Commitments not revealed yet were made to a side rather than a
+// payout and cannot be revealed anymore, so they are dropped and their jurors
+// may commit again.
+func (m Migrator) Migrate15to16(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.RulingToleranceBps = types.DefaultRulingToleranceBps
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	var open []types.Dispute
+	err = m.keeper.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		if dispute.Status == "open" || dispute.Status == "voting" {
+			open = append(open, dispute)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk disputes: %w", err)
+	}
+	for _, dispute := range open {
+		votes := dispute.Votes[:0]
+		for _, vote := range dispute.Votes {
+			if !vote.Revealed {
+				continue
+			}
+			if vote.Vote == "freelancer" {
+				vote.FreelancerPayoutBps = types.BasisPoints
+			}
+			votes = append(votes, vote)
+		}
+		dispute.Votes = votes
+		if err := m.keeper.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+			return fmt.Errorf("failed to set dispute %d: %w", dispute.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate16to17 migrates from version 16 to 17. It sets the appeal params,
+// lengthening the arbiter unbonding period so arbiters stay slashable until
+// the last appeal round closes. Disputes already resolved were paid out, and
+// the open ones start with no dispute fee paid.
+func (m Migrator) Migrate16to17(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.AppealPeriod = types.DefaultAppealPeriod
+	params.AppealBondBps = types.DefaultAppealBondBps
+	params.MaxAppealDepth = types.DefaultMaxAppealDepth
+	params.AppealJuryMultiplier = types.DefaultAppealJuryMultiplier
+	params.AppealDurationMultiplier = types.DefaultAppealDurationMultiplier
+	longest := params.DisputeDuration
+	for _, rule := range params.CategoryRules {
+		longest = max(longest, rule.DisputeDuration)
+	}
+	params.ArbiterUnbondingPeriod = max(params.ArbiterUnbondingPeriod, params.RoundDuration(longest, params.MaxAppealDepth)+params.RevealPeriod)
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	var disputes []types.Dispute
+	err = m.keeper.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		disputes = append(disputes, dispute)
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk disputes: %w", err)
+	}
+	for _, dispute := range disputes {
+		switch {
+		case dispute.IsResolved():
+			dispute.Settled = true
+		case dispute.Status == "open" || dispute.Status == "voting":
+			contract, err := m.keeper.Contract.Get(ctx, dispute.ContractId)
+			if err != nil {
+				return fmt.Errorf("failed to get contract %d: %w", dispute.ContractId, err)
+			}
+			dispute.ArbiterFees = sdk.NewCoin(contract.Price.Denom, math.ZeroInt())
+		default:
+			continue
+		}
+		if err := m.keeper.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+			return fmt.Errorf("failed to set dispute %d: %w", dispute.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate17to18 migrates from version 17 to 18. It sets the dispute filing
+// fee params, no fee being charged until governance sets one, and the
+// disputes open before are contested without any filing fee.
+func (m Migrator) Migrate17to18(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.DisputeFilingFees = types.DefaultDisputeFilingFees
+	params.DisputeFilingFeeBps = types.DefaultDisputeFilingFeeBps
+	shortest := params.DisputeDuration
+	for _, rule := range params.CategoryRules {
+		if rule.DisputeDuration > 0 {
+			shortest = min(shortest, rule.DisputeDuration)
+		}
+	}
+	params.DisputeResponsePeriod = min(types.DefaultDisputeResponsePeriod, shortest)
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	var disputes []types.Dispute
+	err = m.keeper.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		if dispute.Status == "open" || dispute.Status == "voting" {
+			disputes = append(disputes, dispute)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk disputes: %w", err)
+	}
+	for _, dispute := range disputes {
+		dispute.Contested = true
+		dispute.FilingFee = sdk.NewCoin(dispute.ArbiterFees.Denom, math.ZeroInt())
+		if err := m.keeper.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+			return fmt.Errorf("failed to set dispute %d: %w", dispute.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate18to19 migrates from version 18 to 19. The escrow staking pool
+// records the denom it staked, the stake denom until then.
+func (m Migrator) Migrate18to19(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+
+	pool, err := m.keeper.getEscrowStakingPool(ctx)
+	if err != nil {
+		return err
+	}
+	pool.Denom = params.StakeDenom
+	if err := m.keeper.EscrowStakingPool.Set(ctx, pool); err != nil {
+		return fmt.Errorf("failed to set escrow staking pool: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate19to20 migrates from version 19 to 20. The fields of the category
+// rules are flagged as set when they were not zero, zero meaning unset until
+// then.
+func (m Migrator) Migrate19to20(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	for i := range params.CategoryRules {
+		rule := &params.CategoryRules[i]
+		rule.MinPriceSet = !rule.MinPrice.IsNil() && rule.MinPrice.IsPositive()
+		rule.FeeBpsSet = rule.FeeBps > 0
+		rule.MaxDeliveryDaysSet = rule.MaxDeliveryDays > 0
+		rule.DisputeDurationSet = rule.DisputeDuration > 0
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate20to21 migrates from version 20 to 21. The fixed dispute filing fee,
+// which had no denom, is dropped for one per denom, none being set until
+// governance does.
+func (m Migrator) Migrate20to21(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	params.DisputeFilingFees = types.DefaultDisputeFilingFees
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("failed to set params: %w", err)
+	}
+
+	return nil
+}
+
+// Migrate21to22 migrates from version 21 to 22. The delivered contracts are
+// queued by delivery time, the review period no longer being checked by
+// walking every contract.
+func (m Migrator) Migrate21to22(ctx sdk.Context) error {
+	return m.keeper.Contract.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
+		return false, m.keeper.scheduleContract(ctx, contract)
+	})
+}
+
+// Migrate22to23 migrates from version 22 to 23. The active contracts are
+// queued by delivery deadline, overdue contracts no longer being found by
+// walking every contract.
+func (m Migrator) Migrate22to23(ctx sdk.Context) error {
+	return m.keeper.Contract.Walk(ctx, nil, func(_ uint64, contract types.Contract) (bool, error) {
+		if !isDeliverable(contract) {
+			return false, nil
+		}
+		return false, m.keeper.scheduleContract(ctx, contract)
+	})
+}
+
+// Migrate23to24 migrates from version 23 to 24. The disputes and the
+// unbonding arbiters are queued by their deadlines, the end block processors
+// no longer walking every dispute and arbiter.
+func (m Migrator) Migrate23to24(ctx sdk.Context) error {
+	if err := m.keeper.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		return false, m.keeper.scheduleDispute(ctx, dispute)
+	}); err != nil {
+		return err
+	}
+	return m.keeper.Arbiter.Walk(ctx, nil, func(_ string, arbiter types.Arbiter) (bool, error) {
+		return false, m.keeper.scheduleArbiter(ctx, arbiter)
+	})
+}
+
+// Migrate24to25 migrates from version 24 to 25. The time logs are indexed by
+// contract, the logs of a contract no longer being found by walking every
+// time log.
+func (m Migrator) Migrate24to25(ctx sdk.Context) error {
+	return m.keeper.TimeLog.Walk(ctx, nil, func(_ uint64, log types.TimeLog) (bool, error) {
+		return false, m.keeper.TimeLogByContract.Set(ctx, collections.Join(log.ContractId, log.Id))
+	})
+}
+
+// Migrate25to26 migrates from version 25 to 26. The shortfall of the escrow
+// staking pool is charged to the contracts opted in, escrow lost to slashing
+// no longer being borne by the pool as a whole.
+func (m Migrator) Migrate25to26(ctx sdk.Context) error {
+	pool, err := m.keeper.getEscrowStakingPool(ctx)
+	if err != nil {
+		return err
+	}
+	shortfall := pool.Shortfall
+	if !shortfall.IsPositive() {
+		return nil
+	}
+	pool.Shortfall = math.ZeroInt()
+	if err := m.keeper.chargeStakingLoss(ctx, &pool, shortfall); err != nil {
+		return err
+	}
+	if err := m.keeper.EscrowStakingPool.Set(ctx, pool); err != nil {
+		return fmt.Errorf("failed to set escrow staking pool: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.AllowedDenoms = nil
+	params.StakeDenom = ""
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, f.keeper.Gig.Set(f.ctx, 0, types.Gig{Id: 0, LegacyPrice: 1000}))
+	require.NoError(t, f.keeper.Application.Set(f.ctx, 0, types.Application{Id: 0, LegacyProposedPrice: 900}))
+	require.NoError(t, f.keeper.Contract.Set(f.ctx, 0, types.Contract{
+		Id:          0,
+		LegacyPrice: 900,
+		Milestones: []types.Milestone{
+			{Title: "design", LegacyAmount: 300},
+			{Title: "build", LegacyAmount: 600},
+		},
+	}))
+	require.NoError(t, f.keeper.Profile.Set(f.ctx, "alice", types.Profile{Owner: "alice", LegacyTotalEarned: 855}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	require.Equal(t, []string{"skill"}, params.AllowedDenoms)
+
+	gig, err := f.keeper.Gig.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 1000), gig.Price)
+	require.Zero(t, gig.LegacyPrice)
+
+	application, err := f.keeper.Application.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 900), application.ProposedPrice)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 900), contract.Price)
+	require.True(t, contract.Milestones[0].Amount.Equal(math.NewInt(300)))
+	require.True(t, contract.Milestones[1].Amount.Equal(math.NewInt(600)))
+
+	profile, err := f.keeper.Profile.Get(f.ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 855)), profile.TotalEarned)
+}
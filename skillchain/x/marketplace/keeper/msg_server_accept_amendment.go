@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptAmendment(goCtx context.Context, msg *types.MsgAcceptAmendment) (*types.MsgAcceptAmendmentResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator && contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can accept an amendment")
+	}
+
+	amendment, err := k.Amendment.Get(ctx, contract.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmendment, "contract %d has no pending amendment", contract.Id)
+	} else if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get amendment: %v", err)
+	}
+
+	if amendment.Proposer == msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "the proposer cannot accept their own amendment")
+	}
+
+	// the contract may have changed since the proposal
+	if err := k.validateAmendment(ctx, contract, amendment); err != nil {
+		return nil, err
+	}
+
+	previous := contract.Price
+	if err := k.applyAmendment(ctx, &contract, amendment); err != nil {
+		return nil, err
+	}
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+	if err := k.scheduleContract(ctx, contract); err != nil {
+		return nil, err
+	}
+	if err := k.Amendment.Remove(ctx, contract.Id); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to remove amendment: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"amendment_accepted",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("accepted_by", msg.Creator),
+			sdk.NewAttribute("previous_price", previous.String()),
+			sdk.NewAttribute("price", contract.Price.String()),
+			sdk.NewAttribute("delivery_deadline", fmt.Sprintf("%d", contract.DeliveryDeadline)),
+			sdk.NewAttribute("description_hash", contract.DescriptionHash),
+		),
+	)
+
+	return &types.MsgAcceptAmendmentResponse{}, nil
+}
@@ -6,9 +6,8 @@ import (
 
 	"skillchain/x/marketplace/types"
 
-	math "cosmossdk.io/math"
-
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -52,31 +51,38 @@ func (k msgServer) AcceptApplication(goCtx context.Context, msg *types.MsgAccept
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gig is no longer open")
 	}
 
-	clientAddr, err := sdk.AccAddressFromBech32(gig.Owner)
+	clientAddr, err := k.addressCodec.StringToBytes(gig.Owner)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid client address")
 	}
 
-	escrowAmount := sdk.NewCoins(sdk.NewCoin("skill", math.NewIntFromUint64(application.ProposedPrice)))
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
+	}
+
+	// the allowlist may have changed since the gig was posted
+	if !params.IsAllowedDenom(application.ProposedPrice.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s can no longer be escrowed", application.ProposedPrice.Denom)
+	}
 
-	clientBalance := k.bankKeeper.GetBalance(ctx, clientAddr, "skill")
-	if clientBalance.Amount.LT(math.NewIntFromUint64(application.ProposedPrice)) {
+	// the deposit of a funded gig covers the price up to the gig price
+	required := application.ProposedPrice
+	if gig.Funded {
+		required = required.SubAmount(math.MinInt(required.Amount, gig.Price.Amount))
+	}
+	clientBalance := k.bankKeeper.GetBalance(ctx, clientAddr, application.ProposedPrice.Denom)
+	if clientBalance.IsLT(required) {
 		return nil, errorsmod.Wrapf(
 			types.ErrInsufficientFunds,
 			"client has %s but needs %s",
 			clientBalance.String(),
-			escrowAmount.String(),
+			required.String(),
 		)
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(
-		ctx,
-		clientAddr,
-		types.ModuleName,
-		escrowAmount,
-	)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to lock funds in escrow")
+	if err := k.refundApplicationBond(ctx, application); err != nil {
+		return nil, err
 	}
 
 	application.Status = "accepted"
@@ -85,7 +91,9 @@ func (k msgServer) AcceptApplication(goCtx context.Context, msg *types.MsgAccept
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update application status: %v", err)
 	}
 
+	funded := gig.Funded
 	gig.Status = "in_progress"
+	gig.Funded = false
 	err = k.Gig.Set(ctx, gig.Id, gig)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update gig status: %v", err)
@@ -93,6 +101,9 @@ func (k msgServer) AcceptApplication(goCtx context.Context, msg *types.MsgAccept
 
 	err = k.Application.Walk(ctx, nil, func(key uint64, application types.Application) (stop bool, err error) {
 		if application.GigId == gig.Id && application.Id != msg.ApplicationId && application.Status == "pending" {
+			if err := k.refundApplicationBond(ctx, application); err != nil {
+				return false, err
+			}
 			application.Status = "rejected"
 			err = k.Application.Set(ctx, application.Id, application)
 			if err != nil {
@@ -107,6 +118,17 @@ func (k msgServer) AcceptApplication(goCtx context.Context, msg *types.MsgAccept
 
 	deliveryDeadline := ctx.BlockTime().Unix() + int64(application.ProposedDays*86400)
 
+	var milestones []types.Milestone
+	for _, milestone := range application.Milestones {
+		milestones = append(milestones, types.Milestone{
+			Title:        milestone.Title,
+			Amount:       milestone.Amount,
+			DeliveryDays: milestone.DeliveryDays,
+			Deadline:     ctx.BlockTime().Unix() + int64(milestone.DeliveryDays*86400),
+			Status:       "pending",
+		})
+	}
+
 	contractId, err := k.ContractSeq.Next(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next contract id")
@@ -123,12 +145,33 @@ func (k msgServer) AcceptApplication(goCtx context.Context, msg *types.MsgAccept
 		Status:           "active",
 		CreatedAt:        ctx.BlockTime().Unix(),
 		CompletedAt:      0,
+		Milestones:       milestones,
+		HourlyRate:       application.HourlyRate,
+		WeeklyHoursCap:   application.WeeklyHoursCap,
+		Billed:           math.ZeroInt(),
+	}
+	if application.Streaming {
+		contract.StreamStart = contract.CreatedAt
+		contract.StreamEnd = deliveryDeadline
 	}
 
 	err = k.Contract.Set(ctx, contract.Id, contract)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to create contract: %v", err)
 	}
+	if err := k.scheduleContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	var escrowAmount sdk.Coins
+	if funded {
+		escrowAmount, err = k.lockEscrowFromDeposit(ctx, gig, contract)
+	} else {
+		escrowAmount, err = k.lockEscrow(ctx, contract)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -148,8 +191,11 @@ func (k msgServer) AcceptApplication(goCtx context.Context, msg *types.MsgAccept
 			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
 			sdk.NewAttribute("client", contract.Client),
 			sdk.NewAttribute("freelancer", contract.Freelancer),
-			sdk.NewAttribute("price", fmt.Sprintf("%d", contract.Price)),
+			sdk.NewAttribute("price", contract.Price.String()),
 			sdk.NewAttribute("delivery_deadline", fmt.Sprintf("%d", contract.DeliveryDeadline)),
+			sdk.NewAttribute("milestones", fmt.Sprintf("%d", len(contract.Milestones))),
+			sdk.NewAttribute("weekly_hours_cap", fmt.Sprintf("%d", contract.WeeklyHoursCap)),
+			sdk.NewAttribute("streaming", fmt.Sprintf("%t", contract.IsStreaming())),
 		),
 	})
 
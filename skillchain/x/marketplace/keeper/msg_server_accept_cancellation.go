@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptCancellation(goCtx context.Context, msg *types.MsgAcceptCancellation) (*types.MsgAcceptCancellationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	proposal, pending, err := k.pendingCancellation(ctx, contract.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !pending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCancellation, "contract %d has no pending cancellation proposal", contract.Id)
+	}
+
+	if contract.Client != msg.Creator && contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can accept a cancellation")
+	}
+	if proposal.Proposer == msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "a cancellation must be accepted by the counterparty")
+	}
+
+	if !isCancellable(contract.Status) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"cannot cancel contract with status %s",
+			contract.Status,
+		)
+	}
+
+	// milestones may have been approved since the proposal was made
+	held := unreleasedAmount(contract)
+	if proposal.FreelancerPayout.GT(held) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidCancellation,
+			"freelancer payout exceeds the %s%s held in escrow",
+			held,
+			contract.Price.Denom,
+		)
+	}
+
+	payout := sdk.NewCoins()
+	if proposal.FreelancerPayout.IsPositive() {
+		payout, _, _, err = k.releaseEscrow(ctx, contract, proposal.FreelancerPayout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	refund, err := k.refundEscrow(ctx, contract, held.Sub(proposal.FreelancerPayout))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range contract.Milestones {
+		if contract.Milestones[i].Status != "approved" {
+			contract.Milestones[i].Status = "refunded"
+		}
+	}
+	if err := k.finishContract(ctx, &contract, "cancelled", "closed", false); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"cancellation_accepted",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("proposer", proposal.Proposer),
+			sdk.NewAttribute("accepter", msg.Creator),
+		),
+		sdk.NewEvent(
+			"contract_cancelled",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("gig_status", "closed"),
+		),
+		sdk.NewEvent(
+			"payment_released",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("amount", payout.String()),
+		),
+		sdk.NewEvent(
+			"funds_refunded",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("amount", refund.String()),
+		),
+	})
+
+	return &types.MsgAcceptCancellationResponse{Payout: payout, Refund: refund}, nil
+}
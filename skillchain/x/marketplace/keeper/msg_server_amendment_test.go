@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// setupSinglePaymentContract starts a 1000skill contract paid at once on
+// completion.
+func setupSinglePaymentContract(t *testing.T, f *fixture) (uint64, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	clientAddr := sdk.AccAddress([]byte("client______________"))
+	freelancerAddr := sdk.AccAddress([]byte("freelancer__________"))
+	client, err := f.addressCodec.BytesToString(clientAddr)
+	require.NoError(t, err)
+	freelancer, err := f.addressCodec.BytesToString(freelancerAddr)
+	require.NoError(t, err)
+
+	_, err = ms.CreateProfile(f.ctx, &types.MsgCreateProfile{Creator: freelancer, Name: "Freelancer", Skills: []string{"go"}, HourlyRate: 50})
+	require.NoError(t, err)
+	gig, err := ms.CreateGig(f.ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Build a dApp",
+		Description:  "A decentralized application on cosmos.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "development",
+		DeliveryDays: 10,
+	})
+	require.NoError(t, err)
+	application, err := ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 1000), ProposedDays: 10})
+	require.NoError(t, err)
+
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)))
+	accepted, err := ms.AcceptApplication(f.ctx, &types.MsgAcceptApplication{Creator: client, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+
+	return accepted.ContractId, clientAddr, freelancerAddr
+}
+
+func TestAmendContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, clientAddr, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	deadline := contract.DeliveryDeadline + 5*86400
+
+	_, err = ms.ProposeAmendment(ctx, &types.MsgProposeAmendment{Creator: contract.Freelancer, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrInvalidAmendment)
+	_, err = ms.ProposeAmendment(ctx, &types.MsgProposeAmendment{
+		Creator:          contract.Freelancer,
+		ContractId:       contractId,
+		Price:            sdk.NewInt64Coin("skill", 1200),
+		DeliveryDeadline: deadline,
+		DescriptionHash:  "scope-v2",
+		Reason:           "extra screens",
+	})
+	require.NoError(t, err)
+	pending, err := qs.Amendment(ctx, &types.QueryAmendmentRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, contract.Freelancer, pending.Amendment.Proposer)
+
+	_, err = ms.AcceptAmendment(ctx, &types.MsgAcceptAmendment{Creator: contract.Freelancer, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the client tops up the price difference
+	_, err = ms.AcceptAmendment(ctx, &types.MsgAcceptAmendment{Creator: contract.Client, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 200)))
+	_, err = ms.AcceptAmendment(ctx, &types.MsgAcceptAmendment{Creator: contract.Client, ContractId: contractId})
+	require.NoError(t, err)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 1200), contract.Price)
+	require.Equal(t, deadline, contract.DeliveryDeadline)
+	require.Equal(t, "scope-v2", contract.DescriptionHash)
+	require.Len(t, contract.PriorTerms, 1)
+	require.Equal(t, sdk.NewInt64Coin("skill", 1000), contract.PriorTerms[0].Price)
+	require.True(t, f.bankKeeper.GetBalance(ctx, clientAddr, "skill").IsZero())
+
+	_, err = qs.Amendment(ctx, &types.QueryAmendmentRequest{ContractId: contractId})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// a lower price is refunded
+	_, err = ms.ProposeAmendment(ctx, &types.MsgProposeAmendment{Creator: contract.Client, ContractId: contractId, Price: sdk.NewInt64Coin("skill", 900)})
+	require.NoError(t, err)
+	_, err = ms.AcceptAmendment(ctx, &types.MsgAcceptAmendment{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 900), contract.Price)
+	require.Equal(t, deadline, contract.DeliveryDeadline)
+	require.Len(t, contract.PriorTerms, 2)
+
+	escrow, err := f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 900)), escrow.Held())
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestAmendOverdueContractDeadline(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupMilestoneContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(contract.DeliveryDeadline+1, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "overdue", contract.Status)
+
+	// milestone amounts make up the price
+	_, err = ms.ProposeAmendment(ctx, &types.MsgProposeAmendment{Creator: contract.Client, ContractId: contractId, Price: sdk.NewInt64Coin("skill", 2000)})
+	require.ErrorIs(t, err, types.ErrInvalidAmendment)
+	_, err = ms.ProposeAmendment(ctx, &types.MsgProposeAmendment{Creator: contract.Client, ContractId: contractId, DeliveryDeadline: ctx.BlockTime().Unix()})
+	require.ErrorIs(t, err, types.ErrInvalidAmendment)
+
+	_, err = ms.ProposeAmendment(ctx, &types.MsgProposeAmendment{Creator: contract.Client, ContractId: contractId, DeliveryDeadline: ctx.BlockTime().Unix() + 86400})
+	require.NoError(t, err)
+	_, err = ms.AcceptAmendment(ctx, &types.MsgAcceptAmendment{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+	require.Equal(t, ctx.BlockTime().Unix()+86400, contract.DeliveryDeadline)
+}
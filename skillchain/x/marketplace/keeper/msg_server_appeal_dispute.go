@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+func (k msgServer) AppealDispute(goCtx context.Context, msg *types.MsgAppealDispute) (*types.MsgAppealDisputeResponse, error) {
+	appellantAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dispute, err := k.Dispute.Get(ctx, msg.DisputeId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "dispute %d not found", msg.DisputeId)
+	}
+	if !dispute.IsResolved() || dispute.Settled || ctx.BlockTime().Unix() >= dispute.AppealDeadline {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "dispute %d cannot be appealed anymore", dispute.Id)
+	}
+
+	contract, err := k.Contract.Get(ctx, dispute.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", dispute.ContractId)
+	}
+
+	// only a party the ruling went against, even partly, can appeal it
+	switch msg.Creator {
+	case contract.Client:
+		if dispute.FreelancerPayoutBps == 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the ruling fully refunds the client")
+		}
+	case contract.Freelancer:
+		if dispute.FreelancerPayoutBps == types.BasisPoints {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the ruling fully pays the freelancer")
+		}
+	default:
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can appeal the dispute")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get marketplace params")
+	}
+	if dispute.Appeals >= params.MaxAppealDepth {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "dispute %d was already appealed %d times", dispute.Id, dispute.Appeals)
+	}
+
+	bond := sdk.NewCoin(contract.Price.Denom, platformFee(disputedAmount(contract), params.AppealBondBps))
+	if bond.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, appellantAddr, types.EscrowAccountName, sdk.NewCoins(bond)); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "failed to lock appeal bond of %s: %v", bond, err)
+		}
+	}
+
+	// the appeal reopens the dispute before a larger jury with more time
+	category, err := k.contractCategory(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	rule, _ := params.CategoryRule(category)
+	dispute.Appeals++
+	dispute.Appellant = msg.Creator
+	dispute.AppealBond = bond
+	dispute.AppealReason = msg.Reason
+	dispute.AppealedPayoutBps = dispute.FreelancerPayoutBps
+	dispute.AppealDeadline = 0
+	dispute.Status = "open"
+	dispute.Resolution = ""
+	dispute.FreelancerPayoutBps = 0
+	dispute.VotesClient = 0
+	dispute.VotesFreelancer = 0
+	dispute.Votes = nil
+	dispute.Dismissed = nil
+
+	now := ctx.BlockTime().Unix()
+	dispute.Deadline = now + int64(params.RoundDuration(rule.DisputeDuration, dispute.Appeals))
+	dispute.Arbiters, dispute.Alternates, err = k.drawJury(ctx, params, contract, dispute.Id, params.JurySize(dispute.Appeals))
+	if err != nil {
+		return nil, err
+	}
+	dispute.JurorDeadline = min(now+int64(params.JurorVotePeriod), dispute.Deadline)
+	dispute.RevealDeadline = dispute.Deadline + int64(params.RevealPeriod)
+	if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update dispute")
+	}
+	if err := k.scheduleDispute(ctx, dispute); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_appealed",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("appellant", msg.Creator),
+			sdk.NewAttribute("appeals", fmt.Sprintf("%d", dispute.Appeals)),
+			sdk.NewAttribute("appealed_payout_bps", fmt.Sprintf("%d", dispute.AppealedPayoutBps)),
+			sdk.NewAttribute("bond", bond.String()),
+			sdk.NewAttribute("deadline", fmt.Sprintf("%d", dispute.Deadline)),
+			sdk.NewAttribute("reveal_deadline", fmt.Sprintf("%d", dispute.RevealDeadline)),
+			sdk.NewAttribute("jurors", strings.Join(dispute.Arbiters, ",")),
+			sdk.NewAttribute("alternates", strings.Join(dispute.Alternates, ",")),
+		),
+	)
+
+	return &types.MsgAppealDisputeResponse{Bond: bond, Deadline: dispute.Deadline}, nil
+}
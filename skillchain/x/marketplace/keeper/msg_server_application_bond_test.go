@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestApplicationBond(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ApplicationBond = sdk.NewInt64Coin("skill", 50)
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	client, err := f.addressCodec.BytesToString(sdk.AccAddress([]byte("client______________")))
+	require.NoError(t, err)
+	gig, err := ms.CreateGig(ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Build a dApp",
+		Description:  "A decentralized application on cosmos.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "development",
+		DeliveryDays: 10,
+	})
+	require.NoError(t, err)
+	f.bankKeeper.mint(sdk.AccAddress([]byte("client______________")), sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)))
+
+	apply := func(name string, funded bool) (sdk.AccAddress, uint64, error) {
+		addr := sdk.AccAddress([]byte(name))
+		freelancer, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: freelancer, Name: name, Skills: []string{"go"}, HourlyRate: 50})
+		require.NoError(t, err)
+		if funded {
+			f.bankKeeper.mint(addr, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)))
+		}
+		res, err := ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 1000), ProposedDays: 10})
+		if err != nil {
+			return addr, 0, err
+		}
+		require.True(t, f.bankKeeper.GetBalance(ctx, addr, "skill").IsZero())
+		return addr, res.ApplicationId, nil
+	}
+
+	_, _, err = apply("broke_______________", false)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	withdrawnAddr, withdrawnId, err := apply("withdrawn___________", true)
+	require.NoError(t, err)
+	spamAddr, spamId, err := apply("spammer_____________", true)
+	require.NoError(t, err)
+	hiredAddr, hiredId, err := apply("hired_______________", true)
+	require.NoError(t, err)
+
+	// withdrawing refunds the bond
+	withdrawn, err := f.addressCodec.BytesToString(withdrawnAddr)
+	require.NoError(t, err)
+	_, err = ms.WithdrawApplication(ctx, &types.MsgWithdrawApplication{Creator: withdrawn, ApplicationId: withdrawnId})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), f.bankKeeper.GetBalance(ctx, withdrawnAddr, "skill").Amount)
+
+	// only the gig owner flags spam, the bond goes to the fee destination
+	_, err = ms.FlagApplicationSpam(ctx, &types.MsgFlagApplicationSpam{Creator: withdrawn, ApplicationId: spamId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	flagged, err := ms.FlagApplicationSpam(ctx, &types.MsgFlagApplicationSpam{Creator: client, ApplicationId: spamId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)), flagged.Forfeited)
+	require.True(t, f.bankKeeper.GetBalance(ctx, spamAddr, "skill").IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 25)), f.distrKeeper.communityPool)
+
+	application, err := f.keeper.Application.Get(ctx, spamId)
+	require.NoError(t, err)
+	require.Equal(t, "spam", application.Status)
+	_, err = ms.FlagApplicationSpam(ctx, &types.MsgFlagApplicationSpam{Creator: client, ApplicationId: spamId})
+	require.Error(t, err)
+
+	// accepting refunds the bond of the hired freelancer
+	_, err = ms.AcceptApplication(ctx, &types.MsgAcceptApplication{Creator: client, ApplicationId: hiredId})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), f.bankKeeper.GetBalance(ctx, hiredAddr, "skill").Amount)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestApplicationBondRefundedOnRejection(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	id, _, freelancerAddr := setupMilestoneContract(t, f)
+	contract, err := f.keeper.Contract.Get(ctx, id)
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ApplicationBond = sdk.NewInt64Coin("skill", 50)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	gig, err := ms.CreateGig(ctx, &types.MsgCreateGig{
+		Creator:      contract.Client,
+		Title:        "Another gig",
+		Description:  "Another gig for the same freelancer.",
+		Price:        sdk.NewInt64Coin("skill", 500),
+		Category:     "development",
+		DeliveryDays: 10,
+	})
+	require.NoError(t, err)
+	before := f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount
+	f.bankKeeper.mint(freelancerAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)))
+	application, err := ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: contract.Freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 500), ProposedDays: 10})
+	require.NoError(t, err)
+	require.Equal(t, before, f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+
+	_, err = ms.RejectApplication(ctx, &types.MsgRejectApplication{Creator: contract.Client, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+	require.Equal(t, before.AddRaw(50), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
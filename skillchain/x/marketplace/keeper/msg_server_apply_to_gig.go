@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"skillchain/x/marketplace/types"
 
@@ -33,7 +34,7 @@ func (k msgServer) ApplyToGig(goCtx context.Context, msg *types.MsgApplyToGig) (
 		)
 	}
 
-	_, err = k.Profile.Get(ctx, msg.Creator)
+	profile, err := k.Profile.Get(ctx, msg.Creator)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "freelancer must have a profile to apply")
 	}
@@ -59,22 +60,85 @@ func (k msgServer) ApplyToGig(goCtx context.Context, msg *types.MsgApplyToGig) (
 		return nil, errorsmod.Wrap(err, "failed to get marketplace parameters")
 	}
 
-	if math.NewInt(int64(msg.ProposedPrice)).LT(params.MinGigPrice) {
+	if err := msg.ProposedPrice.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPrice, err.Error())
+	}
+
+	if msg.ProposedPrice.Denom != gig.Price.Denom {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "proposed price must be in %s, the gig denom", gig.Price.Denom)
+	}
+
+	rule, _ := params.CategoryRule(gig.Category)
+	if msg.ProposedPrice.Amount.LT(rule.MinPrice) {
 		return nil, errorsmod.Wrap(types.ErrInvalidPrice, "proposed price is below minimum")
 	}
 
+	if msg.ProposedDays > rule.MaxDeliveryDays {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "proposed days cannot exceed %d", rule.MaxDeliveryDays)
+	}
+
+	if err := types.ValidateMilestones(msg.Milestones, msg.ProposedPrice.Amount, msg.ProposedDays); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMilestone, err.Error())
+	}
+
+	hourlyRate := math.ZeroInt()
+	if msg.WeeklyHoursCap > 0 {
+		hourlyRate = msg.HourlyRate
+		if hourlyRate.IsNil() || hourlyRate.IsZero() {
+			hourlyRate = math.NewIntFromUint64(profile.HourlyRate)
+		}
+		if !hourlyRate.IsPositive() {
+			return nil, errorsmod.Wrap(types.ErrInvalidPrice, "hourly rate must be greater than zero")
+		}
+		if len(msg.Milestones) > 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidMilestone, "hourly applications cannot have milestones")
+		}
+		// the client funds one full week upfront
+		weeklyCap := hourlyRate.Mul(math.NewIntFromUint64(msg.WeeklyHoursCap))
+		if !msg.ProposedPrice.Amount.Equal(weeklyCap) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidPrice,
+				"proposed price of an hourly application must be the weekly cap of %s%s",
+				weeklyCap,
+				msg.ProposedPrice.Denom,
+			)
+		}
+	}
+
+	if msg.Streaming {
+		if msg.WeeklyHoursCap > 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "an application cannot be both hourly and streaming")
+		}
+		if len(msg.Milestones) > 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidMilestone, "streaming applications cannot have milestones")
+		}
+		if msg.ProposedDays == 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "streaming applications must stream over at least one day")
+		}
+	}
+
+	if err := k.lockApplicationBond(ctx, msg.Creator, params.ApplicationBond); err != nil {
+		return nil, err
+	}
+
 	id, err := k.ApplicationSeq.Next(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to get next application id")
 	}
 
 	application := types.Application{
-		Id:            id,
-		GigId:         msg.GigId,
-		Freelancer:    msg.Creator,
-		ProposedPrice: msg.ProposedPrice,
-		CoverLetter:   msg.CoverLetter,
-		Status:        "pending",
+		Id:             id,
+		GigId:          msg.GigId,
+		Freelancer:     msg.Creator,
+		ProposedPrice:  msg.ProposedPrice,
+		ProposedDays:   msg.ProposedDays,
+		CoverLetter:    msg.CoverLetter,
+		Status:         "pending",
+		Milestones:     msg.Milestones,
+		HourlyRate:     hourlyRate,
+		WeeklyHoursCap: msg.WeeklyHoursCap,
+		Streaming:      msg.Streaming,
+		Bond:           params.ApplicationBond,
 	}
 
 	err = k.Application.Set(ctx, application.Id, application)
@@ -85,10 +149,10 @@ func (k msgServer) ApplyToGig(goCtx context.Context, msg *types.MsgApplyToGig) (
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"application_submitted",
-			sdk.NewAttribute("application_id", string(application.Id)),
-			sdk.NewAttribute("gig_id", string(application.GigId)),
+			sdk.NewAttribute("application_id", fmt.Sprintf("%d", application.Id)),
+			sdk.NewAttribute("gig_id", fmt.Sprintf("%d", application.GigId)),
 			sdk.NewAttribute("freelancer", application.Freelancer),
-			sdk.NewAttribute("proposed_price", string(application.ProposedPrice)),
+			sdk.NewAttribute("proposed_price", application.ProposedPrice.String()),
 			sdk.NewAttribute("status", application.Status),
 		),
 	)
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ApproveDeadlineExtension(goCtx context.Context, msg *types.MsgApproveDeadlineExtension) (*types.MsgApproveDeadlineExtensionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	extension, contract, err := k.pendingExtensionForClient(ctx, msg.ExtensionId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the contract may have changed since the request
+	if err := k.validateExtension(ctx, contract, extension.RequestedDeadline); err != nil {
+		return nil, err
+	}
+
+	contract.DeliveryDeadline = extension.RequestedDeadline
+	contract.ExtensionsGranted++
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+	if err := k.scheduleContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	extension.Status = "approved"
+	extension.DecidedAt = ctx.BlockTime().Unix()
+	if err := k.DeadlineExtension.Set(ctx, extension.Id, extension); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update deadline extension: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"deadline_extension_approved",
+			sdk.NewAttribute("extension_id", fmt.Sprintf("%d", extension.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", msg.Creator),
+			sdk.NewAttribute("delivery_deadline", fmt.Sprintf("%d", contract.DeliveryDeadline)),
+			sdk.NewAttribute("extensions_granted", fmt.Sprintf("%d", contract.ExtensionsGranted)),
+		),
+	)
+
+	return &types.MsgApproveDeadlineExtensionResponse{}, nil
+}
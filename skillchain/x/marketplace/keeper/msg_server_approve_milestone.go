@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ApproveMilestone(goCtx context.Context, msg *types.MsgApproveMilestone) (*types.MsgApproveMilestoneResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can approve a milestone")
+	}
+
+	if contract.Status != "delivered" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"milestone must be delivered to be approved (contract status: %s)",
+			contract.Status,
+		)
+	}
+
+	if len(contract.Milestones) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidMilestone, "contract has no milestones, use complete-contract instead")
+	}
+
+	if msg.MilestoneIndex != contract.CurrentMilestone {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidMilestone,
+			"milestone %d is not the current milestone (current: %d)",
+			msg.MilestoneIndex,
+			contract.CurrentMilestone,
+		)
+	}
+
+	if status := contract.Milestones[contract.CurrentMilestone].Status; status != "delivered" {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone is not delivered (status: %s)", status)
+	}
+
+	if err := k.approveMilestone(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMilestoneResponse{}, nil
+}
+
+// approveMilestone releases the amount of the delivered current milestone to
+// the freelancer, then moves on to the next milestone or closes the contract
+// after the last one.
+func (k Keeper) approveMilestone(ctx sdk.Context, contract types.Contract) error {
+	milestone := &contract.Milestones[contract.CurrentMilestone]
+	freelancerCoins, platformFee, feeTier, err := k.releaseEscrow(ctx, contract, milestone.Amount)
+	if err != nil {
+		return err
+	}
+
+	milestone.Status = "approved"
+	milestone.ApprovedAt = ctx.BlockTime().Unix()
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			"milestone_approved",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("milestone_index", fmt.Sprintf("%d", contract.CurrentMilestone)),
+			sdk.NewAttribute("client", contract.Client),
+		),
+		sdk.NewEvent(
+			"payment_released",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("amount", freelancerCoins.String()),
+			sdk.NewAttribute("platform_fee", platformFee.String()),
+			sdk.NewAttribute("fee_tier", feeTier),
+		),
+	}
+
+	if int(contract.CurrentMilestone) == len(contract.Milestones)-1 {
+		if err := k.finishContract(ctx, &contract, "completed", "completed", true); err != nil {
+			return err
+		}
+		events = append(events, sdk.NewEvent(
+			"contract_completed",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+		))
+	} else {
+		contract.CurrentMilestone++
+		contract.Status = "active"
+		contract.DeliveredAt = 0
+		if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract status: %v", err)
+		}
+		if err := k.scheduleContract(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
@@ -0,0 +1,535 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// registerArbiter funds an account and registers it as an arbiter bonding
+// the stake.
+func registerArbiter(t *testing.T, f *fixture, name string, stake int64) string {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	addr := sdk.AccAddress([]byte(name))
+	arbiter, err := f.addressCodec.BytesToString(addr)
+	require.NoError(t, err)
+	f.bankKeeper.mint(addr, sdk.NewCoins(sdk.NewInt64Coin("skill", stake)))
+	_, err = ms.RegisterArbiter(f.ctx, &types.MsgRegisterArbiter{Creator: arbiter, Stake: sdk.NewInt64Coin("skill", stake)})
+	require.NoError(t, err)
+	return arbiter
+}
+
+// registerJury registers min_arbiters_required arbiters, enough for a dispute
+// to draw its jury, and returns their addresses.
+func registerJury(t *testing.T, f *fixture) []string {
+	t.Helper()
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+
+	var arbiters []string
+	for i := range params.MinArbitersRequired {
+		arbiters = append(arbiters, registerArbiter(t, f, fmt.Sprintf("panel%d_____________", i), int64(params.ArbiterStakeRequired)))
+	}
+	return arbiters
+}
+
+// commitVote commits juror to award the freelancer payoutBps of a dispute,
+// salted with its address.
+func commitVote(ctx context.Context, ms types.MsgServer, juror string, disputeId uint64, payoutBps uint64) error {
+	commitment := types.VoteCommitment(disputeId, juror, payoutBps, "salt-"+juror)
+	_, err := ms.VoteDispute(ctx, &types.MsgVoteDispute{Creator: juror, DisputeId: disputeId, Commitment: commitment})
+	return err
+}
+
+// revealVote reveals a vote committed with commitVote.
+func revealVote(ctx context.Context, ms types.MsgServer, juror string, disputeId uint64, payoutBps uint64) error {
+	_, err := ms.RevealDisputeVote(ctx, &types.MsgRevealDisputeVote{Creator: juror, DisputeId: disputeId, FreelancerPayoutBps: payoutBps, Salt: "salt-" + juror})
+	return err
+}
+
+// recordRuling replaces the jury of a dispute with min_arbiters_required
+// jurors who all revealed a vote awarding the freelancer payoutBps and closes
+// its reveal, so the dispute resolves to that ruling.
+func recordRuling(t *testing.T, f *fixture, disputeId uint64, payoutBps uint64) {
+	t.Helper()
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(f.ctx, disputeId)
+	require.NoError(t, err)
+
+	dispute.Arbiters, dispute.Alternates, dispute.Votes = nil, nil, nil
+	for i := range params.MinArbitersRequired {
+		juror, err := f.addressCodec.BytesToString(sdk.AccAddress(fmt.Sprintf("juror%d______________", i)))
+		require.NoError(t, err)
+		dispute.Arbiters = append(dispute.Arbiters, juror)
+		dispute.Votes = append(dispute.Votes, types.DisputeVote{Arbiter: juror, DisputeId: disputeId, FreelancerPayoutBps: payoutBps, Revealed: true})
+	}
+	dispute.RevealDeadline = dispute.CreatedAt
+	require.NoError(t, f.keeper.Dispute.Set(f.ctx, disputeId, dispute))
+}
+
+// closeAppealWindow moves past the appeal deadline of a resolved dispute and
+// pays its escrow out, returning the context it moved to.
+func closeAppealWindow(t *testing.T, f *fixture, ctx sdk.Context, disputeId uint64) sdk.Context {
+	t.Helper()
+	dispute, err := f.keeper.Dispute.Get(ctx, disputeId)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(max(dispute.AppealDeadline, ctx.BlockTime().Unix()), 0))
+	require.NoError(t, f.keeper.ProcessAppealDeadlines(ctx))
+	return ctx
+}
+
+func TestArbiterRegistry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+
+	voterAddr := sdk.AccAddress([]byte("voter_______________"))
+	voter, err := f.addressCodec.BytesToString(voterAddr)
+	require.NoError(t, err)
+	f.bankKeeper.mint(voterAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 5000)))
+	_, err = ms.RegisterArbiter(ctx, &types.MsgRegisterArbiter{Creator: voter, Stake: sdk.NewInt64Coin("skill", 999)})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	_, err = ms.RegisterArbiter(ctx, &types.MsgRegisterArbiter{Creator: voter, Stake: sdk.NewInt64Coin("usdc", 1000)})
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	arbiter := registerArbiter(t, f, "arbiter_____________", 1000)
+	_, err = ms.RegisterArbiter(ctx, &types.MsgRegisterArbiter{Creator: voter, Stake: sdk.NewInt64Coin("skill", 1500)})
+	require.NoError(t, err)
+	require.Equal(t, int64(3500), f.bankKeeper.GetBalance(ctx, voterAddr, "skill").Amount.Int64())
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	active, err := qs.ActiveArbiters(ctx, &types.QueryActiveArbitersRequest{})
+	require.NoError(t, err)
+	require.Len(t, active.Arbiters, 2)
+	require.Equal(t, sdk.NewInt64Coin("skill", 2500), active.TotalStake)
+
+	// no jury is drawn while fewer arbiters are eligible than required
+	_, err = ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.ErrorIs(t, err, types.ErrNotEnoughArbiters)
+	registerArbiter(t, f, "third_arbiter_______", 1000)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+
+	// arbiters registered after the dispute opened are not assigned to it
+	late := registerArbiter(t, f, "late_arbiter________", 1000)
+	require.ErrorIs(t, commitVote(ctx, ms, late, opened.DisputeId, 0), types.ErrInvalidArbiter)
+
+	require.NoError(t, commitVote(ctx, ms, arbiter, opened.DisputeId, 0))
+	require.ErrorIs(t, commitVote(ctx, ms, arbiter, opened.DisputeId, 0), sdkerrors.ErrInvalidRequest)
+
+	// unbonding arbiters leave the active set but keep the votes they were
+	// assigned, and get their stake back after the unbonding period
+	unbonding, err := ms.UnbondArbiter(ctx, &types.MsgUnbondArbiter{Creator: voter})
+	require.NoError(t, err)
+	_, err = ms.UnbondArbiter(ctx, &types.MsgUnbondArbiter{Creator: voter})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.RegisterArbiter(ctx, &types.MsgRegisterArbiter{Creator: voter, Stake: sdk.NewInt64Coin("skill", 1000)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.NoError(t, commitVote(ctx, ms, voter, opened.DisputeId, 0))
+
+	active, err = qs.ActiveArbiters(ctx, &types.QueryActiveArbitersRequest{})
+	require.NoError(t, err)
+	require.Len(t, active.Arbiters, 3)
+
+	require.NoError(t, f.keeper.ProcessUnbondedArbiters(ctx))
+	require.Equal(t, int64(3500), f.bankKeeper.GetBalance(ctx, voterAddr, "skill").Amount.Int64())
+
+	ctx = ctx.WithBlockTime(time.Unix(unbonding.UnbondingEndsAt, 0))
+	require.NoError(t, f.keeper.ProcessUnbondedArbiters(ctx))
+	require.Equal(t, int64(5000), f.bankKeeper.GetBalance(ctx, voterAddr, "skill").Amount.Int64())
+	_, err = qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: voter})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestUnbondedArbiterReleaseRetried(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	arbiter := registerArbiter(t, f, "arbiter1____________", 1000)
+	unbonding, err := ms.UnbondArbiter(ctx, &types.MsgUnbondArbiter{Creator: arbiter})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(unbonding.UnbondingEndsAt, 0))
+
+	// a stake that cannot be released does not halt the chain and is retried
+	escrowAddr := authtypes.NewModuleAddress(types.EscrowAccountName).String()
+	escrowBalance := f.bankKeeper.balances[escrowAddr]
+	f.bankKeeper.balances[escrowAddr] = sdk.NewCoins()
+	require.NoError(t, f.keeper.ProcessUnbondedArbiters(ctx))
+	_, err = f.keeper.Arbiter.Get(ctx, arbiter)
+	require.NoError(t, err)
+
+	f.bankKeeper.balances[escrowAddr] = escrowBalance
+	require.NoError(t, f.keeper.ProcessUnbondedArbiters(ctx))
+	_, err = f.keeper.Arbiter.Get(ctx, arbiter)
+	require.Error(t, err)
+	require.Equal(t, int64(1000), f.bankKeeper.GetBalance(ctx, sdk.AccAddress("arbiter1____________"), "skill").Amount.Int64())
+}
+
+func TestSlashingAfterStakeDenomChange(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinArbitersRequired = 1
+	params.JuryAlternates = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	juror := registerArbiter(t, f, "arbiter1____________", 1000)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+
+	// the juror bonded in the former stake denom is slashed in it
+	params.StakeDenom = "uatom"
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	arbiter, err := f.keeper.Arbiter.Get(ctx, juror)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)), arbiter.Slashed)
+}
+
+func TestDisputeResolvedOnceJuryRevealed(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	arbiters := []string{
+		registerArbiter(t, f, "arbiter1____________", 1000),
+		registerArbiter(t, f, "arbiter2____________", 1000),
+		registerArbiter(t, f, "arbiter3____________", 1000),
+	}
+
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	for _, arbiter := range arbiters {
+		require.NoError(t, commitVote(ctx, ms, arbiter, opened.DisputeId, types.BasisPoints))
+	}
+	for _, arbiter := range arbiters {
+		require.NoError(t, revealVote(ctx, ms, arbiter, opened.DisputeId, types.BasisPoints))
+	}
+
+	// the dispute does not wait for its reveal deadline
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_freelancer", dispute.Status)
+	require.Less(t, ctx.BlockTime().Unix(), dispute.RevealDeadline)
+}
+
+func TestArbiterRewardsAndSlashing(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	arbiters := []string{
+		registerArbiter(t, f, "arbiter1____________", 1000),
+		registerArbiter(t, f, "arbiter2____________", 1000),
+		registerArbiter(t, f, "arbiter3____________", 1000),
+	}
+
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	payouts := []uint64{types.BasisPoints, types.BasisPoints, 0}
+	for i, payout := range payouts[:2] {
+		require.NoError(t, commitVote(ctx, ms, arbiters[i], opened.DisputeId, payout))
+	}
+
+	// votes stay secret and are not counted until revealed
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.ElementsMatch(t, arbiters, dispute.Arbiters)
+	require.Zero(t, dispute.VotesClient+dispute.VotesFreelancer)
+	require.ErrorIs(t, revealVote(ctx, ms, arbiters[0], opened.DisputeId, types.BasisPoints), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, commitVote(ctx, ms, arbiters[2], opened.DisputeId, payouts[2]))
+
+	ctx = ctx.WithBlockTime(time.Unix(dispute.Deadline+1, 0))
+	require.ErrorIs(t, revealVote(ctx, ms, arbiters[0], opened.DisputeId, 0), sdkerrors.ErrInvalidRequest)
+	for i, payout := range payouts {
+		require.NoError(t, revealVote(ctx, ms, arbiters[i], opened.DisputeId, payout))
+	}
+
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_freelancer", dispute.Status)
+	ctx = closeAppealWindow(t, f, ctx, opened.DisputeId)
+
+	// the 2% dispute fee is split by the majority, paid out of the escrow
+	for _, address := range arbiters[:2] {
+		addr, err := f.addressCodec.StringToBytes(address)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), f.bankKeeper.GetBalance(ctx, addr, "skill").Amount.Int64())
+	}
+	require.Equal(t, int64(931), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+	escrow, err := f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), escrow.DisputeFees)
+
+	majority, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: arbiters[0]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), majority.Arbiter.MajorityVotes)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 10)), majority.Arbiter.Earned)
+	require.Equal(t, uint64(types.BasisPoints), majority.AccuracyBps)
+
+	// the minority loses 5% of its stake
+	minority, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: arbiters[2]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), minority.Arbiter.MinorityVotes)
+	require.Equal(t, sdk.NewInt64Coin("skill", 950), minority.Arbiter.Stake)
+	require.Zero(t, minority.AccuracyBps)
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	// slashed below the required stake, it leaves the active set
+	active, err := qs.ActiveArbiters(ctx, &types.QueryActiveArbitersRequest{})
+	require.NoError(t, err)
+	require.Len(t, active.Arbiters, 2)
+	require.Equal(t, sdk.NewInt64Coin("skill", 2000), active.TotalStake)
+}
+
+func TestDefaultRulingSlashesOnlyAbsentJurors(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinArbitersRequired = 4
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	arbiters := []string{
+		registerArbiter(t, f, "arbiter1____________", 1000),
+		registerArbiter(t, f, "arbiter2____________", 1000),
+		registerArbiter(t, f, "arbiter3____________", 1000),
+		registerArbiter(t, f, "arbiter4____________", 1000),
+	}
+
+	// every eligible arbiter is drawn into the jury of four
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.ElementsMatch(t, arbiters, dispute.Arbiters)
+	payouts := []uint64{0, 0}
+	for i, payout := range payouts {
+		require.NoError(t, commitVote(ctx, ms, arbiters[i], opened.DisputeId, payout))
+	}
+	require.NoError(t, commitVote(ctx, ms, arbiters[3], opened.DisputeId, 0))
+	ctx = ctx.WithBlockTime(time.Unix(dispute.Deadline+1, 0))
+	for i, payout := range payouts {
+		require.NoError(t, revealVote(ctx, ms, arbiters[i], opened.DisputeId, payout))
+	}
+
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_freelancer", dispute.Status)
+	require.Contains(t, dispute.Resolution, "2/3 required")
+	ctx = closeAppealWindow(t, f, ctx, opened.DisputeId)
+
+	// the jurors who revealed are neither paid nor slashed against the
+	// default ruling
+	for _, address := range arbiters[:2] {
+		revealed, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: address})
+		require.NoError(t, err)
+		require.Zero(t, revealed.Arbiter.MajorityVotes+revealed.Arbiter.MinorityVotes)
+		require.True(t, revealed.Arbiter.Earned.IsZero())
+		require.True(t, revealed.Arbiter.Slashed.IsZero())
+		require.Equal(t, sdk.NewInt64Coin("skill", 1000), revealed.Arbiter.Stake)
+	}
+
+	// the juror who missed the vote and the one who did not reveal it lose
+	// 5% of their stake
+	for _, address := range arbiters[2:] {
+		missed, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: address})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), missed.Arbiter.MissedVotes)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)), missed.Arbiter.Slashed)
+		require.Zero(t, missed.AccuracyBps)
+	}
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMajorityOfJuryRules(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	jurors := registerJury(t, f)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.ElementsMatch(t, jurors, dispute.Arbiters)
+
+	// two of the three jurors reveal, the third one abstains
+	for _, juror := range jurors {
+		require.NoError(t, commitVote(ctx, ms, juror, opened.DisputeId, 3000))
+	}
+	for _, juror := range jurors[:2] {
+		require.NoError(t, revealVote(ctx, ms, juror, opened.DisputeId, 3000))
+	}
+	ctx = ctx.WithBlockTime(time.Unix(dispute.RevealDeadline, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	dispute, err = f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_split", dispute.Status)
+	require.Equal(t, uint64(3000), dispute.FreelancerPayoutBps)
+
+	// only the juror who did not reveal is slashed
+	for _, address := range jurors[:2] {
+		revealed, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: address})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), revealed.Arbiter.MajorityVotes)
+		require.True(t, revealed.Arbiter.Slashed.IsZero())
+	}
+	abstained, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: jurors[2]})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), abstained.Arbiter.MissedVotes)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 50)), abstained.Arbiter.Slashed)
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestDisputeResolvesOnceAllVotesRevealed(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinArbitersRequired = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	arbiter := registerArbiter(t, f, "arbiter_____________", 1000)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+
+	_, err = ms.ResolveDispute(ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: opened.DisputeId})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// every juror committed, so the reveal opens before the deadline
+	require.NoError(t, commitVote(ctx, ms, arbiter, opened.DisputeId, 0))
+	require.NoError(t, revealVote(ctx, ms, arbiter, opened.DisputeId, 0))
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_client", dispute.Status)
+	res, err := qs.Arbiter(ctx, &types.QueryArbiterRequest{Address: arbiter})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Arbiter.MajorityVotes)
+	require.Equal(t, sdk.NewInt64Coin("skill", 1000), res.Arbiter.Stake)
+}
+
+func TestSplitRuling(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	arbiters := []string{
+		registerArbiter(t, f, "arbiter1____________", 1000),
+		registerArbiter(t, f, "arbiter2____________", 1000),
+		registerArbiter(t, f, "arbiter3____________", 1000),
+	}
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "half done"})
+	require.NoError(t, err)
+
+	payouts := []uint64{9000, 7000, 6000}
+	for i, payout := range payouts {
+		require.NoError(t, commitVote(ctx, ms, arbiters[i], opened.DisputeId, payout))
+	}
+	require.ErrorIs(t, revealVote(ctx, ms, arbiters[0], opened.DisputeId, types.BasisPoints+1), sdkerrors.ErrInvalidRequest)
+	for i, payout := range payouts {
+		require.NoError(t, revealVote(ctx, ms, arbiters[i], opened.DisputeId, payout))
+	}
+	require.NoError(t, f.keeper.ProcessExpiredDisputes(ctx))
+	ctx = closeAppealWindow(t, f, ctx, opened.DisputeId)
+
+	// the median awards the freelancer 70% of the escrow left after the 2%
+	// dispute fee, paid net of the 5% platform fee; the rest is refunded
+	dispute, err := f.keeper.Dispute.Get(ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_split", dispute.Status)
+	require.Equal(t, uint64(7000), dispute.FreelancerPayoutBps)
+	require.Equal(t, int64(652), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+	require.Equal(t, int64(294), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount.Int64())
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_split", contract.Status)
+	require.Equal(t, uint64(7000), contract.FreelancerPayoutBps)
+
+	// payouts within the 10% tolerance of the ruling share the dispute fee,
+	// the one further off is slashed
+	for _, address := range arbiters[1:] {
+		arbiter, err := f.keeper.Arbiter.Get(ctx, address)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), arbiter.MajorityVotes)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 10)), arbiter.Earned)
+	}
+	outlier, err := f.keeper.Arbiter.Get(ctx, arbiters[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), outlier.MinorityVotes)
+	require.Equal(t, sdk.NewInt64Coin("skill", 950), outlier.Stake)
+
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
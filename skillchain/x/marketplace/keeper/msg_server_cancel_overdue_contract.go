@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CancelOverdueContract(goCtx context.Context, msg *types.MsgCancelOverdueContract) (*types.MsgCancelOverdueContractResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can cancel an overdue contract")
+	}
+
+	if contract.Status != "overdue" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be overdue to be cancelled (current: %s)",
+			contract.Status,
+		)
+	}
+
+	// milestones approved before the deadline stay paid
+	refund, err := k.refundEscrow(ctx, contract, unreleasedAmount(contract))
+	if err != nil {
+		return nil, err
+	}
+	for i := range contract.Milestones {
+		if contract.Milestones[i].Status != "approved" {
+			contract.Milestones[i].Status = "refunded"
+		}
+	}
+
+	gigStatus := "closed"
+	if msg.ReopenGig {
+		gigStatus = "open"
+	}
+	if err := k.finishContract(ctx, &contract, "cancelled", gigStatus, false); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"contract_cancelled",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("gig_status", gigStatus),
+		),
+		sdk.NewEvent(
+			"funds_refunded",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("amount", refund.String()),
+		),
+	})
+
+	return &types.MsgCancelOverdueContractResponse{Refund: refund}, nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestAcceptCancellation(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupMilestoneContract(t, f)
+	approveFirstMilestone(t, f, contractId)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	// only the 600 of the second milestone are still in escrow
+	_, err = ms.ProposeCancellation(f.ctx, &types.MsgProposeCancellation{Creator: contract.Freelancer, ContractId: contractId, FreelancerPayout: math.NewInt(601)})
+	require.ErrorIs(t, err, types.ErrInvalidCancellation)
+
+	_, err = ms.ProposeCancellation(f.ctx, &types.MsgProposeCancellation{Creator: contract.Freelancer, ContractId: contractId, FreelancerPayout: math.NewInt(200), Reason: "scope changed"})
+	require.NoError(t, err)
+	_, err = ms.ProposeCancellation(f.ctx, &types.MsgProposeCancellation{Creator: contract.Client, ContractId: contractId, FreelancerPayout: math.NewInt(0)})
+	require.ErrorIs(t, err, types.ErrInvalidCancellation)
+	_, err = ms.AcceptCancellation(f.ctx, &types.MsgAcceptCancellation{Creator: contract.Freelancer, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := qs.CancellationProposal(f.ctx, &types.QueryCancellationProposalRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, contract.Freelancer, res.Proposal.Proposer)
+	require.True(t, res.Proposal.FreelancerPayout.Equal(math.NewInt(200)))
+
+	accepted, err := ms.AcceptCancellation(f.ctx, &types.MsgAcceptCancellation{Creator: contract.Client, ContractId: contractId})
+	require.NoError(t, err)
+
+	// 200 minus the 5% platform fee, the other 400 go back to the client
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 190)), accepted.Payout)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 400)), accepted.Refund)
+	require.Equal(t, math.NewInt(570), f.bankKeeper.GetBalance(f.ctx, freelancerAddr, "skill").Amount)
+	require.Equal(t, math.NewInt(400), f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "cancelled", contract.Status)
+	require.Equal(t, "refunded", contract.Milestones[1].Status)
+
+	gig, err := f.keeper.Gig.Get(f.ctx, contract.GigId)
+	require.NoError(t, err)
+	require.Equal(t, "closed", gig.Status)
+
+	_, err = qs.CancellationProposal(f.ctx, &types.QueryCancellationProposalRequest{ContractId: contractId})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestRejectAndExpireCancellation(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupMilestoneContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	_, err = ms.ProposeCancellation(f.ctx, &types.MsgProposeCancellation{Creator: contract.Client, ContractId: contractId, FreelancerPayout: math.NewInt(100)})
+	require.NoError(t, err)
+	_, err = ms.RejectCancellation(f.ctx, &types.MsgRejectCancellation{Creator: contract.Client, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RejectCancellation(f.ctx, &types.MsgRejectCancellation{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+	_, err = ms.AcceptCancellation(f.ctx, &types.MsgAcceptCancellation{Creator: contract.Freelancer, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrInvalidCancellation)
+
+	proposed, err := ms.ProposeCancellation(f.ctx, &types.MsgProposeCancellation{Creator: contract.Client, ContractId: contractId, FreelancerPayout: math.NewInt(100)})
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(proposed.ExpiresAt, 0))
+	_, err = ms.AcceptCancellation(ctx, &types.MsgAcceptCancellation{Creator: contract.Freelancer, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrInvalidCancellation)
+
+	require.NoError(t, f.keeper.ProcessExpiredCancellations(ctx))
+	has, err := f.keeper.CancellationProposal.Has(ctx, contractId)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+}
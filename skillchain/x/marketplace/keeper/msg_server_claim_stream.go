@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ClaimStream(goCtx context.Context, msg *types.MsgClaimStream) (*types.MsgClaimStreamResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only freelancer can claim the stream")
+	}
+
+	if !contract.IsStreaming() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contract is not streaming")
+	}
+
+	if contract.Status != "active" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be active to claim (current: %s)",
+			contract.Status,
+		)
+	}
+
+	amount, err := k.claimStream(ctx, &contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// the stream ended and everything was claimed
+	if isFullyStreamed(contract) {
+		if err := k.finishContract(ctx, &contract, "completed", "completed", true); err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_completed",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+				sdk.NewAttribute("client", contract.Client),
+				sdk.NewAttribute("freelancer", contract.Freelancer),
+			),
+		)
+		return &types.MsgClaimStreamResponse{Amount: amount}, nil
+	}
+
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+
+	return &types.MsgClaimStreamResponse{Amount: amount}, nil
+}
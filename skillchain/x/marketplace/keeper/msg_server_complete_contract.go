@@ -6,7 +6,6 @@ import (
 	"skillchain/x/marketplace/types"
 
 	errorsmod "cosmossdk.io/errors"
-	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -28,6 +27,20 @@ func (k msgServer) CompleteContract(goCtx context.Context, msg *types.MsgComplet
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can complete the contract")
 	}
 
+	if contract.IsHourly() {
+		if contract.Status != "active" {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"hourly contract must be active to complete (current: %s)",
+				contract.Status,
+			)
+		}
+		if err := k.completeHourlyContract(ctx, contract); err != nil {
+			return nil, err
+		}
+		return &types.MsgCompleteContractResponse{}, nil
+	}
+
 	if contract.Status != "delivered" {
 		return nil, errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
@@ -36,74 +49,48 @@ func (k msgServer) CompleteContract(goCtx context.Context, msg *types.MsgComplet
 		)
 	}
 
-	params, err := k.Params.Get(ctx)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
-	}
-	totalAmount := math.NewIntFromUint64(contract.Price)
-
-	// Platform Fee = price * feePercent / 100
-	platformFee := totalAmount.Mul(math.NewIntFromUint64(params.PlatformFeePercent)).Quo(math.NewInt(100))
-	freelancerAmount := totalAmount.Sub(platformFee)
-
-	freelancerAddr, err := sdk.AccAddressFromBech32(contract.Freelancer)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid freelancer address")
+	if len(contract.Milestones) > 0 {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"contract is paid per milestone, use approve-milestone instead",
+		)
 	}
 
-	freelancerCoins := sdk.NewCoins(sdk.NewCoin("skill", freelancerAmount))
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		types.ModuleName,
-		freelancerAddr,
-		freelancerCoins,
-	)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to release funds to freelancer")
+	if err := k.completeContract(ctx, contract); err != nil {
+		return nil, err
 	}
 
-	contract.Status = "completed"
-	contract.CompletedAt = ctx.BlockTime().Unix()
-	err = k.Contract.Set(ctx, contract.Id, contract)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract status: %v", err)
-	}
+	return &types.MsgCompleteContractResponse{}, nil
+}
 
-	gig, err := k.Gig.Get(ctx, contract.GigId)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "gig %d not found", contract.GigId)
-	}
-	gig.Status = "completed"
-	err = k.Gig.Set(ctx, gig.Id, gig)
+// completeContract releases the price of a delivered single payment contract
+// to the freelancer and closes it.
+func (k Keeper) completeContract(ctx sdk.Context, contract types.Contract) error {
+	freelancerCoins, platformFee, feeTier, err := k.releaseEscrow(ctx, contract, contract.Price.Amount)
 	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update gig status: %v", err)
+		return err
 	}
 
-	profile, err := k.Profile.Get(ctx, contract.Freelancer)
-	if err == nil {
-		profile.TotalJobs++
-		profile.TotalEarned += freelancerAmount.Uint64()
-		err = k.Profile.Set(ctx, contract.Freelancer, profile)
-		if err != nil {
-			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update profile: %v", err)
-		}
+	if err := k.finishContract(ctx, &contract, "completed", "completed", true); err != nil {
+		return err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			"contract_completed",
-			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", msg.ContractId)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
 			sdk.NewAttribute("client", contract.Client),
 			sdk.NewAttribute("freelancer", contract.Freelancer),
 		),
 		sdk.NewEvent(
 			"payment_released",
-			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", msg.ContractId)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
 			sdk.NewAttribute("freelancer", contract.Freelancer),
 			sdk.NewAttribute("amount", freelancerCoins.String()),
 			sdk.NewAttribute("platform_fee", platformFee.String()),
+			sdk.NewAttribute("fee_tier", feeTier),
 		),
 	})
 
-	return &types.MsgCompleteContractResponse{}, nil
+	return nil
 }
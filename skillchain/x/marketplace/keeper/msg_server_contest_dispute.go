@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+func (k msgServer) ContestDispute(goCtx context.Context, msg *types.MsgContestDispute) (*types.MsgContestDisputeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dispute, err := k.Dispute.Get(ctx, msg.DisputeId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "dispute %d not found", msg.DisputeId)
+	}
+	if dispute.Status != "open" && dispute.Status != "voting" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot contest dispute with status %s", dispute.Status)
+	}
+	if dispute.Contested {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "dispute %d is already contested", dispute.Id)
+	}
+	if ctx.BlockTime().Unix() >= dispute.ResponseDeadline {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "response deadline of dispute %d has passed", dispute.Id)
+	}
+
+	contract, err := k.Contract.Get(ctx, dispute.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", dispute.ContractId)
+	}
+	if (msg.Creator != contract.Client && msg.Creator != contract.Freelancer) || msg.Creator == dispute.Initiator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the counterparty of the initiator can contest the dispute")
+	}
+
+	if err := k.lockFilingFee(ctx, msg.Creator, dispute.FilingFee); err != nil {
+		return nil, err
+	}
+	dispute.Contested = true
+	if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update dispute")
+	}
+	if err := k.scheduleDispute(ctx, dispute); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_contested",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("respondent", msg.Creator),
+			sdk.NewAttribute("filing_fee", dispute.FilingFee.String()),
+		),
+	)
+
+	return &types.MsgContestDisputeResponse{Fee: dispute.FilingFee}, nil
+}
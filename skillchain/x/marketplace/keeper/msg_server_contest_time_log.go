@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ContestTimeLog(goCtx context.Context, msg *types.MsgContestTimeLog) (*types.MsgContestTimeLogResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timeLog, err := k.TimeLog.Get(ctx, msg.TimeLogId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "time log %d not found", msg.TimeLogId)
+	}
+
+	contract, err := k.Contract.Get(ctx, timeLog.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", timeLog.ContractId)
+	}
+
+	if contract.Client != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can contest a time log")
+	}
+
+	if timeLog.Status != "pending" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "time log is not pending (status: %s)", timeLog.Status)
+	}
+
+	if ctx.BlockTime().Unix() >= timeLog.ContestDeadline {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contest window of the time log has passed")
+	}
+
+	timeLog.Status = "contested"
+	timeLog.ContestReason = msg.Reason
+	if err := k.setTimeLog(ctx, timeLog); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update time log: %v", err)
+	}
+
+	// contested hours no longer count against the weekly cap
+	if contract.PeriodHours >= timeLog.Hours {
+		contract.PeriodHours -= timeLog.Hours
+	} else {
+		contract.PeriodHours = 0
+	}
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"time_log_contested",
+			sdk.NewAttribute("time_log_id", fmt.Sprintf("%d", timeLog.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("hours", fmt.Sprintf("%d", timeLog.Hours)),
+			sdk.NewAttribute("reason", msg.Reason),
+		),
+	)
+
+	return &types.MsgContestTimeLogResponse{}, nil
+}
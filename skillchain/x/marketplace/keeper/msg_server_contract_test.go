@@ -1,125 +0,0 @@
-package keeper_test
-
-import (
-	"testing"
-
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/stretchr/testify/require"
-
-	"skillchain/x/marketplace/keeper"
-	"skillchain/x/marketplace/types"
-)
-
-func TestContractMsgServerCreate(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
-
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
-
-	for i := 0; i < 5; i++ {
-		resp, err := srv.CreateContract(f.ctx, &types.MsgCreateContract{Creator: creator})
-		require.NoError(t, err)
-		require.Equal(t, i, int(resp.Id))
-	}
-}
-
-func TestContractMsgServerUpdate(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
-
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
-
-	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
-	require.NoError(t, err)
-
-	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Creator: creator})
-	require.NoError(t, err)
-
-	tests := []struct {
-		desc    string
-		request *types.MsgUpdateContract
-		err     error
-	}{
-		{
-			desc:    "invalid address",
-			request: &types.MsgUpdateContract{Creator: "invalid"},
-			err:     sdkerrors.ErrInvalidAddress,
-		},
-		{
-			desc:    "unauthorized",
-			request: &types.MsgUpdateContract{Creator: unauthorizedAddr},
-			err:     sdkerrors.ErrUnauthorized,
-		},
-		{
-			desc:    "key not found",
-			request: &types.MsgUpdateContract{Creator: creator, Id: 10},
-			err:     sdkerrors.ErrKeyNotFound,
-		},
-		{
-			desc:    "completed",
-			request: &types.MsgUpdateContract{Creator: creator},
-		},
-	}
-	for _, tc := range tests {
-		t.Run(tc.desc, func(t *testing.T) {
-			_, err = srv.UpdateContract(f.ctx, tc.request)
-			if tc.err != nil {
-				require.ErrorIs(t, err, tc.err)
-			} else {
-				require.NoError(t, err)
-			}
-		})
-	}
-}
-
-func TestContractMsgServerDelete(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
-
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
-
-	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
-	require.NoError(t, err)
-
-	_, err = srv.CreateContract(f.ctx, &types.MsgCreateContract{Creator: creator})
-	require.NoError(t, err)
-
-	tests := []struct {
-		desc    string
-		request *types.MsgDeleteContract
-		err     error
-	}{
-		{
-			desc:    "invalid address",
-			request: &types.MsgDeleteContract{Creator: "invalid"},
-			err:     sdkerrors.ErrInvalidAddress,
-		},
-		{
-			desc:    "unauthorized",
-			request: &types.MsgDeleteContract{Creator: unauthorizedAddr},
-			err:     sdkerrors.ErrUnauthorized,
-		},
-		{
-			desc:    "key not found",
-			request: &types.MsgDeleteContract{Creator: creator, Id: 10},
-			err:     sdkerrors.ErrKeyNotFound,
-		},
-		{
-			desc:    "completed",
-			request: &types.MsgDeleteContract{Creator: creator},
-		},
-	}
-	for _, tc := range tests {
-		t.Run(tc.desc, func(t *testing.T) {
-			_, err = srv.DeleteContract(f.ctx, tc.request)
-			if tc.err != nil {
-				require.ErrorIs(t, err, tc.err)
-			} else {
-				require.NoError(t, err)
-			}
-		})
-	}
-}
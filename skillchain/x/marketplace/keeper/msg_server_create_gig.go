@@ -2,8 +2,7 @@ package keeper
 
 import (
 	"context"
-
-	sdkmath "cosmossdk.io/math"
+	"fmt"
 
 	"skillchain/x/marketplace/types"
 
@@ -26,13 +25,21 @@ func (k msgServer) CreateGig(goCtx context.Context, msg *types.MsgCreateGig) (*t
 		return nil, errorsmod.Wrap(err, "failed to get params")
 	}
 
-	priceInt := sdkmath.NewIntFromUint64(msg.Price)
-	if priceInt.LT(params.MinGigPrice) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gig price must be at least %s skill, got %d", params.MinGigPrice, msg.Price)
+	if err := msg.Price.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPrice, err.Error())
+	}
+
+	if !params.IsAllowedDenom(msg.Price.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "gigs cannot be priced in %s", msg.Price.Denom)
 	}
 
-	if msg.DeliveryDays < 1 || msg.DeliveryDays > 365 {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "delivery days must be between 1 and 365")
+	rule, _ := params.CategoryRule(msg.Category)
+	if msg.Price.Amount.LT(rule.MinPrice) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gig price must be at least %s%s, got %s", rule.MinPrice, msg.Price.Denom, msg.Price)
+	}
+
+	if msg.DeliveryDays < 1 || msg.DeliveryDays > rule.MaxDeliveryDays {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "delivery days must be between 1 and %d", rule.MaxDeliveryDays)
 	}
 
 	if len(msg.Title) < 5 || len(msg.Title) > 100 {
@@ -58,6 +65,13 @@ func (k msgServer) CreateGig(goCtx context.Context, msg *types.MsgCreateGig) (*t
 		DeliveryDays: msg.DeliveryDays,
 		Status:       "open",
 		CreatedAt:    ctx.BlockTime().Unix(),
+		Funded:       msg.Funded,
+	}
+
+	if gig.Funded {
+		if err := k.lockGigDeposit(ctx, gig); err != nil {
+			return nil, err
+		}
 	}
 
 	err = k.Gig.Set(ctx, gig.Id, gig)
@@ -71,10 +85,11 @@ func (k msgServer) CreateGig(goCtx context.Context, msg *types.MsgCreateGig) (*t
 			sdk.NewAttribute("owner", msg.Creator),
 			sdk.NewAttribute("title", msg.Title),
 			sdk.NewAttribute("description", msg.Description),
-			sdk.NewAttribute("price", string(msg.Price)),
+			sdk.NewAttribute("price", msg.Price.String()),
 			sdk.NewAttribute("category", msg.Category),
 			sdk.NewAttribute("status", gig.Status),
-			sdk.NewAttribute("delivery_days", string(msg.DeliveryDays)),
+			sdk.NewAttribute("delivery_days", fmt.Sprintf("%d", msg.DeliveryDays)),
+			sdk.NewAttribute("funded", fmt.Sprintf("%t", gig.Funded)),
 		),
 	)
 
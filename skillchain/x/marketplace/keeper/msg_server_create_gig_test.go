@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"skillchain/x/marketplace/keeper"
@@ -18,7 +19,7 @@ func TestMsgCreateGig(t *testing.T) {
 		Creator:      "skill13axcg4tlh0e6efuytpd52n5w5f2sv3xv6esu4x",
 		Title:        "First Gig Title",
 		Description:  "This is the first gig description which is long enough.",
-		Price:        100,
+		Price:        sdk.NewInt64Coin("skill", 100),
 		Category:     "development",
 		DeliveryDays: 10,
 	}
@@ -32,7 +33,7 @@ func TestMsgCreateGig(t *testing.T) {
 		Creator:      "skill13axcg4tlh0e6efuytpd52n5w5f2sv3xv6esu4x",
 		Title:        "Second Gig Title",
 		Description:  "This is the second gig description which is long enough.",
-		Price:        200,
+		Price:        sdk.NewInt64Coin("skill", 200),
 		Category:     "design",
 		DeliveryDays: 5,
 	}
@@ -49,4 +50,17 @@ func TestMsgCreateGig(t *testing.T) {
 	gig2, err := f.keeper.Gig.Get(f.ctx, res2.Id)
 	require.NoError(t, err)
 	require.Equal(t, msg2.Title, gig2.Title)
+	require.Equal(t, msg2.Price, gig2.Price)
+
+	// Denoms outside of the allowlist are rejected
+	msg3 := &types.MsgCreateGig{
+		Creator:      "skill13axcg4tlh0e6efuytpd52n5w5f2sv3xv6esu4x",
+		Title:        "Third Gig Title",
+		Description:  "This is the third gig description which is long enough.",
+		Price:        sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 500),
+		Category:     "design",
+		DeliveryDays: 5,
+	}
+	_, err = ms.CreateGig(f.ctx, msg3)
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
 }
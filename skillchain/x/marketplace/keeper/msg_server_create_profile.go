@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"skillchain/x/marketplace/types"
@@ -38,6 +39,12 @@ func (k msgServer) CreateProfile(goCtx context.Context, msg *types.MsgCreateProf
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at least one skill is required, got %d", len(msg.Skills))
 	}
 
+	if msg.Referrer != "" {
+		if err := k.validateReferrer(ctx, msg.Creator, msg.Referrer); err != nil {
+			return nil, err
+		}
+	}
+
 	profile := types.Profile{
 		Owner:       msg.Creator,
 		Name:        msg.Name,
@@ -45,9 +52,10 @@ func (k msgServer) CreateProfile(goCtx context.Context, msg *types.MsgCreateProf
 		Skills:      msg.Skills,
 		HourlyRate:  msg.HourlyRate,
 		TotalJobs:   0,
-		TotalEarned: 0,
+		TotalEarned: sdk.NewCoins(),
 		RatingSum:   0,
 		RatingCount: 0,
+		Referrer:    msg.Referrer,
 	}
 
 	err = k.Profile.Set(ctx, profile.Owner, profile)
@@ -55,14 +63,27 @@ func (k msgServer) CreateProfile(goCtx context.Context, msg *types.MsgCreateProf
 		return nil, errorsmod.Wrapf(err, "failed to create profile for creator %s", msg.Creator)
 	}
 
+	if msg.Referrer != "" {
+		referral := types.Referral{
+			Referred:  msg.Creator,
+			Referrer:  msg.Referrer,
+			Earned:    sdk.NewCoins(),
+			CreatedAt: ctx.BlockTime().Unix(),
+		}
+		if err := k.Referral.Set(ctx, msg.Creator, referral); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save referral: %v", err)
+		}
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"profile_created",
 			sdk.NewAttribute("owner", msg.Creator),
 			sdk.NewAttribute("name", msg.Name),
 			sdk.NewAttribute("bio", msg.Bio),
-			sdk.NewAttribute("hourly_rate", string(msg.HourlyRate)),
+			sdk.NewAttribute("hourly_rate", fmt.Sprintf("%d", msg.HourlyRate)),
 			sdk.NewAttribute("skills", string(strings.Join(msg.Skills, ", "))),
+			sdk.NewAttribute("referrer", msg.Referrer),
 		),
 	)
 
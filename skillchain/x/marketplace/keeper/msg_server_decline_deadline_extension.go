@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) DeclineDeadlineExtension(goCtx context.Context, msg *types.MsgDeclineDeadlineExtension) (*types.MsgDeclineDeadlineExtensionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	extension, contract, err := k.pendingExtensionForClient(ctx, msg.ExtensionId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	extension.Status = "declined"
+	extension.DecidedAt = ctx.BlockTime().Unix()
+	if err := k.DeadlineExtension.Set(ctx, extension.Id, extension); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update deadline extension: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"deadline_extension_declined",
+			sdk.NewAttribute("extension_id", fmt.Sprintf("%d", extension.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", msg.Creator),
+		),
+	)
+
+	return &types.MsgDeclineDeadlineExtensionResponse{}, nil
+}
@@ -29,19 +29,45 @@ func (k msgServer) DeliverContract(goCtx context.Context, msg *types.MsgDeliverC
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only freelancer can deliver")
 	}
 
-	if contract.Status != "active" {
+	// a late delivery is accepted until the client cancels the contract
+	if contract.Status != "active" && contract.Status != "overdue" {
 		return nil, errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
-			"contract must be active to deliver (current: %s)",
+			"contract must be active or overdue to deliver (current: %s)",
 			contract.Status,
 		)
 	}
 
+	if contract.IsHourly() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"hourly contracts are billed from time logs, use log-time instead",
+		)
+	}
+
+	if contract.IsStreaming() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"streaming contracts are paid as they accrue, use claim-stream instead",
+		)
+	}
+
+	if len(contract.Milestones) > 0 {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"contract is paid per milestone, use deliver-milestone instead",
+		)
+	}
+
 	contract.Status = "delivered"
+	contract.DeliveredAt = ctx.BlockTime().Unix()
 	err = k.Contract.Set(ctx, contract.Id, contract)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract status: %v", err)
 	}
+	if err := k.scheduleContract(ctx, contract); err != nil {
+		return nil, err
+	}
 
 	// 5. Événement
 	ctx.EventManager().EmitEvent(
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) DeliverMilestone(goCtx context.Context, msg *types.MsgDeliverMilestone) (*types.MsgDeliverMilestoneResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only freelancer can deliver")
+	}
+
+	if contract.Status != "active" && contract.Status != "overdue" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be active or overdue to deliver a milestone (current: %s)",
+			contract.Status,
+		)
+	}
+
+	if len(contract.Milestones) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidMilestone, "contract has no milestones, use deliver-contract instead")
+	}
+
+	// Milestones are delivered in order, only the current one can be delivered.
+	if msg.MilestoneIndex != contract.CurrentMilestone {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidMilestone,
+			"milestone %d is not the current milestone (current: %d)",
+			msg.MilestoneIndex,
+			contract.CurrentMilestone,
+		)
+	}
+
+	milestone := &contract.Milestones[contract.CurrentMilestone]
+	if milestone.Status != "pending" {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMilestone, "milestone is not pending (status: %s)", milestone.Status)
+	}
+
+	milestone.Status = "delivered"
+	milestone.DeliveryNote = msg.DeliveryNote
+	milestone.DeliveredAt = ctx.BlockTime().Unix()
+	contract.Status = "delivered"
+	contract.DeliveredAt = milestone.DeliveredAt
+
+	err = k.Contract.Set(ctx, contract.Id, contract)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract status: %v", err)
+	}
+	if err := k.scheduleContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"milestone_delivered",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", msg.ContractId)),
+			sdk.NewAttribute("milestone_index", fmt.Sprintf("%d", msg.MilestoneIndex)),
+			sdk.NewAttribute("freelancer", msg.Creator),
+			sdk.NewAttribute("delivery_note", msg.DeliveryNote),
+		),
+	)
+
+	return &types.MsgDeliverMilestoneResponse{}, nil
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestEscrowStaking(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	escrowAddr := authtypes.NewModuleAddress(types.EscrowAccountName)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Client, ContractId: contractId, Enabled: true})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	validators := []sdk.ValAddress{sdk.ValAddress("validator1__________"), sdk.ValAddress("validator2__________")}
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.EscrowStaking.Enabled = true
+	params.EscrowStaking.Validators = []string{validators[0].String(), validators[1].String()}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Freelancer, ContractId: contractId, Enabled: true})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Client, ContractId: contractId, Enabled: true})
+	require.NoError(t, err)
+
+	// nothing is delegated while the escrow account could not pay the
+	// contract out in full
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Empty(t, f.stakingKeeper.delegations)
+
+	// half of the 1000skill opted in is delegated, evenly, once an arbiter
+	// stake keeps the payout liquid
+	registerArbiter(t, f, "arbiter1____________", 1000)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Equal(t, math.NewInt(250), f.stakingKeeper.delegations[validators[0].String()])
+	require.Equal(t, math.NewInt(250), f.stakingKeeper.delegations[validators[1].String()])
+	require.Equal(t, int64(1500), f.bankKeeper.GetBalance(ctx, escrowAddr, "skill").Amount.Int64())
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	// rewards are split between client, freelancer and the community pool
+	f.distrKeeper.rewards[validators[0].String()] = sdk.NewCoins(sdk.NewInt64Coin("skill", 100))
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Equal(t, int64(40), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount.Int64())
+	require.Equal(t, int64(40), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Int64())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), f.distrKeeper.communityPool)
+
+	escrow, err := f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)), escrow.StakingRewards)
+
+	// escrow lost to slashing is charged to the contract staked, and its
+	// rewards cover it first
+	f.stakingKeeper.delegations[validators[0].String()] = math.NewInt(200)
+	f.distrKeeper.rewards[validators[1].String()] = sdk.NewCoins(sdk.NewInt64Coin("skill", 30))
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	escrow, err = f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 20)), escrow.StakingLosses)
+	staking, err := qs.EscrowStaking(ctx, &types.QueryEscrowStakingRequest{})
+	require.NoError(t, err)
+	require.True(t, staking.Pool.Shortfall.IsZero())
+	require.Equal(t, sdk.NewInt64Coin("skill", 980), staking.OptedIn)
+	require.Equal(t, int64(40), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount.Int64())
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	// opting out unbonds the stake, returned once the unbonding completed
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Client, ContractId: contractId, Enabled: false})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Empty(t, f.stakingKeeper.delegations)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, f.stakingKeeper.completeUnbonding(ctx))
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	staking, err = qs.EscrowStaking(ctx, &types.QueryEscrowStakingRequest{})
+	require.NoError(t, err)
+	require.True(t, staking.Pool.Bonded.IsZero())
+	require.True(t, staking.Pool.Unbonding.IsZero())
+	require.Equal(t, sdk.NewInt64Coin("skill", 1980), staking.Liquid)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestEscrowStakingReserveOfOptedInEscrow(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	escrowAddr := authtypes.NewModuleAddress(types.EscrowAccountName)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	validator := sdk.ValAddress("validator1__________")
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.EscrowStaking.Enabled = true
+	params.EscrowStaking.Validators = []string{validator.String()}
+	params.EscrowStaking.ReserveBps = 8000
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Client, ContractId: contractId, Enabled: true})
+	require.NoError(t, err)
+
+	// the arbiter stake sitting in the escrow account is not counted towards
+	// the reserve: only 20% of the 1000skill opted in is delegated
+	registerArbiter(t, f, "arbiter1____________", 5000)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Equal(t, math.NewInt(200), f.stakingKeeper.delegations[validator.String()])
+	require.Equal(t, int64(5800), f.bankKeeper.GetBalance(ctx, escrowAddr, "skill").Amount.Int64())
+}
+
+func TestEscrowStakingStakeDenomChange(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.EscrowStaking.Enabled = true
+	params.EscrowStaking.Validators = []string{sdk.ValAddress("validator1__________").String()}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Client, ContractId: contractId, Enabled: true})
+	require.NoError(t, err)
+	registerArbiter(t, f, "arbiter1____________", 1000)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.NotEmpty(t, f.stakingKeeper.delegations)
+
+	// the pool keeps its denom after a change of stake denom, unwinding
+	// before staking in the new one
+	params.StakeDenom = "uatom"
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Empty(t, f.stakingKeeper.delegations)
+	staking, err := qs.EscrowStaking(ctx, &types.QueryEscrowStakingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "skill", staking.Pool.Denom)
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, f.stakingKeeper.completeUnbonding(ctx))
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	staking, err = qs.EscrowStaking(ctx, &types.QueryEscrowStakingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "uatom", staking.Pool.Denom)
+	require.True(t, staking.Pool.Staked().IsZero())
+	msg, broken = invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestEscrowStakingParamsValidation(t *testing.T) {
+	params := types.DefaultEscrowStaking
+	require.NoError(t, params.Validate())
+
+	params.Enabled = true
+	require.Error(t, params.Validate())
+
+	params.Validators = []string{"not-a-validator"}
+	require.Error(t, params.Validate())
+
+	validator := sdk.ValAddress("validator1__________").String()
+	params.Validators = []string{validator, validator}
+	require.Error(t, params.Validate())
+
+	params.Validators = []string{validator}
+	params.TreasuryRewardBps = 0
+	require.Error(t, params.Validate())
+}
+
+func TestEscrowStakingLossBorneByContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, freelancerAddr := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	validator := sdk.ValAddress("validator1__________")
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.EscrowStaking.Enabled = true
+	params.EscrowStaking.Validators = []string{validator.String()}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = ms.SetEscrowStaking(ctx, &types.MsgSetEscrowStaking{Creator: contract.Client, ContractId: contractId, Enabled: true})
+	require.NoError(t, err)
+	arbiterAddr := registerArbiter(t, f, "arbiter1____________", 1000)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	require.Equal(t, math.NewInt(500), f.stakingKeeper.delegations[validator.String()])
+
+	// the slashed escrow is lost by the contract staked, not by the arbiter
+	// stake kept in the same account
+	f.stakingKeeper.delegations[validator.String()] = math.NewInt(400)
+	require.NoError(t, f.keeper.ProcessEscrowStaking(ctx, params))
+	escrow, err := f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 100)), escrow.StakingLosses)
+
+	// the payout is paid out of the liquid escrow, short of the loss
+	before := f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount
+	_, err = ms.DeliverContract(ctx, &types.MsgDeliverContract{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+	_, err = ms.CompleteContract(ctx, &types.MsgCompleteContract{Creator: contract.Client, ContractId: contractId})
+	require.NoError(t, err)
+	escrow, err = f.keeper.ContractEscrow.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.True(t, escrow.Held().IsZero())
+	require.Equal(t, escrow.Released.AmountOf("skill"), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount.Sub(before))
+	require.Equal(t, int64(900), escrow.Released.Add(escrow.Fees...).AmountOf("skill").Int64())
+	arbiter, err := f.keeper.Arbiter.Get(ctx, arbiterAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), arbiter.Stake.Amount.Int64())
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
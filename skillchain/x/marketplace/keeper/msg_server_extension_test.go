@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestDeadlineExtension(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	deadline := contract.DeliveryDeadline
+
+	_, err = ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Client, ContractId: contractId, NewDeadline: deadline + 86400})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Freelancer, ContractId: contractId, NewDeadline: deadline})
+	require.ErrorIs(t, err, types.ErrInvalidExtension)
+
+	requested, err := ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{
+		Creator:     contract.Freelancer,
+		ContractId:  contractId,
+		NewDeadline: deadline + 86400,
+		Reason:      "waiting on api keys",
+	})
+	require.NoError(t, err)
+
+	// one request at a time
+	_, err = ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Freelancer, ContractId: contractId, NewDeadline: deadline + 2*86400})
+	require.ErrorIs(t, err, types.ErrInvalidExtension)
+
+	_, err = ms.ApproveDeadlineExtension(ctx, &types.MsgApproveDeadlineExtension{Creator: contract.Freelancer, ExtensionId: requested.ExtensionId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ApproveDeadlineExtension(ctx, &types.MsgApproveDeadlineExtension{Creator: contract.Client, ExtensionId: requested.ExtensionId})
+	require.NoError(t, err)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, deadline+86400, contract.DeliveryDeadline)
+	require.Equal(t, uint64(1), contract.ExtensionsGranted)
+
+	_, err = ms.ApproveDeadlineExtension(ctx, &types.MsgApproveDeadlineExtension{Creator: contract.Client, ExtensionId: requested.ExtensionId})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a declined request leaves the deadline unchanged
+	requested, err = ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Freelancer, ContractId: contractId, NewDeadline: deadline + 3*86400})
+	require.NoError(t, err)
+	_, err = ms.DeclineDeadlineExtension(ctx, &types.MsgDeclineDeadlineExtension{Creator: contract.Client, ExtensionId: requested.ExtensionId})
+	require.NoError(t, err)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, deadline+86400, contract.DeliveryDeadline)
+	require.Equal(t, uint64(1), contract.ExtensionsGranted)
+
+	extensions, err := qs.ExtensionsByContract(ctx, &types.QueryExtensionsByContractRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Len(t, extensions.Extensions, 2)
+	require.Equal(t, "approved", extensions.Extensions[0].Status)
+	require.Equal(t, deadline, extensions.Extensions[0].PreviousDeadline)
+	require.Equal(t, "declined", extensions.Extensions[1].Status)
+
+	// arbiters get the extension history along with the dispute
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+	dispute, err := qs.GetDispute(ctx, &types.QueryGetDisputeRequest{Id: opened.DisputeId})
+	require.NoError(t, err)
+	require.Equal(t, extensions.Extensions, dispute.Extensions)
+}
+
+func TestDeadlineExtensionCap(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxDeadlineExtensions = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+
+	first, err := ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Freelancer, ContractId: contractId, NewDeadline: contract.DeliveryDeadline + 86400})
+	require.NoError(t, err)
+	_, err = ms.ApproveDeadlineExtension(ctx, &types.MsgApproveDeadlineExtension{Creator: contract.Client, ExtensionId: first.ExtensionId})
+	require.NoError(t, err)
+
+	_, err = ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Freelancer, ContractId: contractId, NewDeadline: contract.DeliveryDeadline + 2*86400})
+	require.ErrorIs(t, err, types.ErrInvalidExtension)
+}
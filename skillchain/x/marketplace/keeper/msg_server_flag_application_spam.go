@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) FlagApplicationSpam(goCtx context.Context, msg *types.MsgFlagApplicationSpam) (*types.MsgFlagApplicationSpamResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	application, err := k.Application.Get(ctx, msg.ApplicationId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "application with id %d not found: %v", msg.ApplicationId, err)
+	}
+
+	gig, err := k.Gig.Get(ctx, application.GigId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "gig with id %d not found: %v", application.GigId, err)
+	}
+
+	if gig.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the owner can flag applications for their gig")
+	}
+
+	if application.Status != "pending" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"can only flag pending applications (current status: %s)",
+			application.Status,
+		)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get params")
+	}
+
+	// the forfeited bond is handled like a platform fee
+	forfeited := applicationBond(application)
+	for _, coin := range forfeited {
+		if err := k.collectFee(ctx, params, coin); err != nil {
+			return nil, err
+		}
+	}
+
+	application.Status = "spam"
+	err = k.Application.Set(ctx, application.Id, application)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update application status: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"application_flagged_spam",
+			sdk.NewAttribute("application_id", fmt.Sprintf("%d", application.Id)),
+			sdk.NewAttribute("owner", gig.Owner),
+			sdk.NewAttribute("gig_id", fmt.Sprintf("%d", application.GigId)),
+			sdk.NewAttribute("forfeited", forfeited.String()),
+		),
+	)
+
+	return &types.MsgFlagApplicationSpamResponse{Forfeited: forfeited}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) FundHourlyContract(goCtx context.Context, msg *types.MsgFundHourlyContract) (*types.MsgFundHourlyContractResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can fund the contract")
+	}
+
+	if !contract.IsHourly() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "only hourly contracts can be funded")
+	}
+
+	if contract.Status != "active" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be active to be funded (current: %s)",
+			contract.Status,
+		)
+	}
+
+	if err := msg.Amount.Validate(); err != nil || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "invalid amount %s", msg.Amount)
+	}
+	if msg.Amount.Denom != contract.Price.Denom {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "contract is funded in %s", contract.Price.Denom)
+	}
+
+	if err := k.addEscrow(ctx, contract, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	contract.Price = contract.Price.Add(msg.Amount)
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"funds_locked_in_escrow",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("amount", msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundHourlyContractResponse{}, nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// createFundedGig posts a gig priced 1000skill whose budget is escrowed, with
+// a freelancer profile ready to apply.
+func createFundedGig(t *testing.T, f *fixture) (uint64, sdk.AccAddress, string) {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	clientAddr := sdk.AccAddress([]byte("client______________"))
+	client, err := f.addressCodec.BytesToString(clientAddr)
+	require.NoError(t, err)
+	freelancer, err := f.addressCodec.BytesToString(sdk.AccAddress([]byte("freelancer__________")))
+	require.NoError(t, err)
+	_, err = ms.CreateProfile(f.ctx, &types.MsgCreateProfile{Creator: freelancer, Name: "Freelancer", Skills: []string{"go"}, HourlyRate: 50})
+	require.NoError(t, err)
+
+	createGig := &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Build a dApp",
+		Description:  "A decentralized application on cosmos.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "development",
+		DeliveryDays: 10,
+		Funded:       true,
+	}
+	_, err = ms.CreateGig(f.ctx, createGig)
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)))
+	gig, err := ms.CreateGig(f.ctx, createGig)
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").IsZero())
+
+	return gig.Id, clientAddr, freelancer
+}
+
+func TestFundedGigRefundsLowerPrice(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	gigId, clientAddr, freelancer := createFundedGig(t, f)
+	gig, err := f.keeper.Gig.Get(f.ctx, gigId)
+	require.NoError(t, err)
+
+	_, err = ms.CreateGig(f.ctx, &types.MsgCreateGig{
+		Creator:      gig.Owner,
+		Title:        "Unfunded gig",
+		Description:  "A gig without escrowed budget.",
+		Price:        sdk.NewInt64Coin("skill", 500),
+		Category:     "development",
+		DeliveryDays: 10,
+	})
+	require.NoError(t, err)
+
+	funded, err := qs.ListGig(f.ctx, &types.QueryAllGigRequest{Funded: true})
+	require.NoError(t, err)
+	require.Len(t, funded.Gig, 1)
+	require.Equal(t, gigId, funded.Gig[0].Id)
+	all, err := qs.ListGig(f.ctx, &types.QueryAllGigRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Gig, 2)
+
+	application, err := ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gigId, ProposedPrice: sdk.NewInt64Coin("skill", 800), ProposedDays: 10})
+	require.NoError(t, err)
+	accepted, err := ms.AcceptApplication(f.ctx, &types.MsgAcceptApplication{Creator: gig.Owner, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+
+	// the difference with the deposit goes back to the client
+	require.Equal(t, math.NewInt(200), f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").Amount)
+	escrow, err := f.keeper.ContractEscrow.Get(f.ctx, accepted.ContractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 800)), escrow.Locked)
+
+	gig, err = f.keeper.Gig.Get(f.ctx, gigId)
+	require.NoError(t, err)
+	require.False(t, gig.Funded)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestFundedGigTopsUpHigherPrice(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	gigId, clientAddr, freelancer := createFundedGig(t, f)
+	gig, err := f.keeper.Gig.Get(f.ctx, gigId)
+	require.NoError(t, err)
+
+	application, err := ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gigId, ProposedPrice: sdk.NewInt64Coin("skill", 1200), ProposedDays: 10})
+	require.NoError(t, err)
+	_, err = ms.AcceptApplication(f.ctx, &types.MsgAcceptApplication{Creator: gig.Owner, ApplicationId: application.ApplicationId})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 200)))
+	accepted, err := ms.AcceptApplication(f.ctx, &types.MsgAcceptApplication{Creator: gig.Owner, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").IsZero())
+	escrow, err := f.keeper.ContractEscrow.Get(f.ctx, accepted.ContractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 1200)), escrow.Locked)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestCancelFundedGigRefundsDeposit(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	gigId, clientAddr, _ := createFundedGig(t, f)
+	gig, err := f.keeper.Gig.Get(f.ctx, gigId)
+	require.NoError(t, err)
+
+	_, err = ms.UpdateGigStatus(f.ctx, &types.MsgUpdateGigStatus{Creator: gig.Owner, GigId: gigId, Status: "cancelled"})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(1000), f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").Amount)
+	gig, err = f.keeper.Gig.Get(f.ctx, gigId)
+	require.NoError(t, err)
+	require.Equal(t, "cancelled", gig.Status)
+	require.False(t, gig.Funded)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
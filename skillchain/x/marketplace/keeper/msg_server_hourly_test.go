@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// setupHourlyContract starts an hourly contract billed at the 50skill profile
+// rate of the freelancer with a weekly cap of 10 hours funded by the client.
+func setupHourlyContract(t *testing.T, f *fixture) (uint64, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	clientAddr := sdk.AccAddress([]byte("client______________"))
+	freelancerAddr := sdk.AccAddress([]byte("freelancer__________"))
+	client, err := f.addressCodec.BytesToString(clientAddr)
+	require.NoError(t, err)
+	freelancer, err := f.addressCodec.BytesToString(freelancerAddr)
+	require.NoError(t, err)
+
+	_, err = ms.CreateProfile(f.ctx, &types.MsgCreateProfile{Creator: freelancer, Name: "Freelancer", Skills: []string{"go"}, HourlyRate: 50})
+	require.NoError(t, err)
+	gig, err := ms.CreateGig(f.ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Maintain a chain",
+		Description:  "Weekly maintenance of a cosmos chain.",
+		Price:        sdk.NewInt64Coin("skill", 500),
+		Category:     "development",
+		DeliveryDays: 90,
+	})
+	require.NoError(t, err)
+
+	// the proposed price must be the weekly cap
+	_, err = ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 400), ProposedDays: 90, WeeklyHoursCap: 10})
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	application, err := ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 500), ProposedDays: 90, WeeklyHoursCap: 10})
+	require.NoError(t, err)
+
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 500)))
+	accepted, err := ms.AcceptApplication(f.ctx, &types.MsgAcceptApplication{Creator: client, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+
+	return accepted.ContractId, clientAddr, freelancerAddr
+}
+
+func TestHourlyContractBilling(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupHourlyContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.True(t, contract.IsHourly())
+	require.True(t, contract.HourlyRate.Equal(math.NewInt(50)))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	logged, err := ms.LogTime(ctx, &types.MsgLogTime{Creator: contract.Freelancer, ContractId: contractId, Hours: 6, DescriptionHash: "hash-1"})
+	require.NoError(t, err)
+	contested, err := ms.LogTime(ctx, &types.MsgLogTime{Creator: contract.Freelancer, ContractId: contractId, Hours: 4, DescriptionHash: "hash-2"})
+	require.NoError(t, err)
+	_, err = ms.LogTime(ctx, &types.MsgLogTime{Creator: contract.Freelancer, ContractId: contractId, Hours: 1})
+	require.Error(t, err, "weekly cap exceeded")
+
+	_, err = ms.ContestTimeLog(ctx, &types.MsgContestTimeLog{Creator: contract.Freelancer, TimeLogId: contested.TimeLogId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ContestTimeLog(ctx, &types.MsgContestTimeLog{Creator: contract.Client, TimeLogId: contested.TimeLogId, Reason: "not agreed"})
+	require.NoError(t, err)
+
+	// nothing is billed before the contest window ends
+	require.NoError(t, f.keeper.BillHourlyContracts(ctx))
+	require.True(t, f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").IsZero())
+
+	ctx = ctx.WithBlockTime(time.Unix(logged.ContestDeadline, 0))
+	require.NoError(t, f.keeper.BillHourlyContracts(ctx))
+
+	// 6 hours at 50 minus the 5% platform fee, the contested hours are not billed
+	require.Equal(t, math.NewInt(285), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.True(t, contract.Billed.Equal(math.NewInt(300)))
+	require.Zero(t, contract.PeriodHours)
+
+	res, err := qs.TimeLogsByContract(ctx, &types.QueryTimeLogsByContractRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Len(t, res.TimeLogs, 2)
+	require.Equal(t, "billed", res.TimeLogs[0].Status)
+	require.Equal(t, "contested", res.TimeLogs[1].Status)
+
+	// the 200 left in escrow do not cover 5 more hours until the client funds it
+	_, err = ms.LogTime(ctx, &types.MsgLogTime{Creator: contract.Freelancer, ContractId: contractId, Hours: 5})
+	require.ErrorIs(t, err, types.ErrInsufficientFunds)
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 300)))
+	_, err = ms.FundHourlyContract(ctx, &types.MsgFundHourlyContract{Creator: contract.Client, ContractId: contractId, Amount: sdk.NewInt64Coin("skill", 300)})
+	require.NoError(t, err)
+	_, err = ms.LogTime(ctx, &types.MsgLogTime{Creator: contract.Freelancer, ContractId: contractId, Hours: 5})
+	require.NoError(t, err)
+
+	// completing bills the pending hours and refunds the rest
+	_, err = ms.CompleteContract(ctx, &types.MsgCompleteContract{Creator: contract.Client, ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(523), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+	require.Equal(t, math.NewInt(250), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "completed", contract.Status)
+
+	profile, err := f.keeper.Profile.Get(ctx, contract.Freelancer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), profile.TotalJobs)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 523)), profile.TotalEarned)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestHourlyContractsAreNotOverdue(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupHourlyContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	_, err = ms.DeliverContract(f.ctx, &types.MsgDeliverContract{Creator: contract.Freelancer, ContractId: contractId})
+	require.Error(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(contract.DeliveryDeadline+1, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+}
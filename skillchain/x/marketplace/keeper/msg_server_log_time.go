@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) LogTime(goCtx context.Context, msg *types.MsgLogTime) (*types.MsgLogTimeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only freelancer can log time")
+	}
+
+	if !contract.IsHourly() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "time can only be logged on hourly contracts")
+	}
+
+	if contract.Status != "active" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be active to log time (current: %s)",
+			contract.Status,
+		)
+	}
+
+	if msg.Hours == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "hours must be greater than zero")
+	}
+
+	if contract.PeriodHours+msg.Hours > contract.WeeklyHoursCap {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"logging %d hours exceeds the weekly cap of %d hours (%d already logged)",
+			msg.Hours,
+			contract.WeeklyHoursCap,
+			contract.PeriodHours,
+		)
+	}
+
+	// every pending hour must stay covered by the escrow
+	pending, err := k.pendingTimeLogs(ctx, contract.Id)
+	if err != nil {
+		return nil, err
+	}
+	hours := msg.Hours
+	for _, log := range pending {
+		hours += log.Hours
+	}
+	if contract.HoursCost(hours).GT(unreleasedAmount(contract)) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"escrow of contract %d does not cover %d pending hours, the client must fund it",
+			contract.Id,
+			hours,
+		)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	id, err := k.TimeLogSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next time log id")
+	}
+	timeLog := types.TimeLog{
+		Id:              id,
+		ContractId:      contract.Id,
+		Freelancer:      contract.Freelancer,
+		Hours:           msg.Hours,
+		DescriptionHash: msg.DescriptionHash,
+		LoggedAt:        ctx.BlockTime().Unix(),
+		ContestDeadline: ctx.BlockTime().Unix() + int64(params.TimeLogContestWindow),
+		Status:          "pending",
+	}
+	if err := k.setTimeLog(ctx, timeLog); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save time log: %v", err)
+	}
+
+	contract.PeriodHours += msg.Hours
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"time_logged",
+			sdk.NewAttribute("time_log_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("hours", fmt.Sprintf("%d", msg.Hours)),
+			sdk.NewAttribute("description_hash", msg.DescriptionHash),
+			sdk.NewAttribute("contest_deadline", fmt.Sprintf("%d", timeLog.ContestDeadline)),
+		),
+	)
+
+	return &types.MsgLogTimeResponse{TimeLogId: id, ContestDeadline: timeLog.ContestDeadline}, nil
+}
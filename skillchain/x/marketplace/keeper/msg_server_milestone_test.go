@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// setupMilestoneContract hires a freelancer on a 1000skill gig split into two
+// milestones and returns the contract id with the client and freelancer addresses.
+func setupMilestoneContract(t *testing.T, f *fixture) (uint64, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	clientAddr := sdk.AccAddress([]byte("client______________"))
+	freelancerAddr := sdk.AccAddress([]byte("freelancer__________"))
+	client, err := f.addressCodec.BytesToString(clientAddr)
+	require.NoError(t, err)
+	freelancer, err := f.addressCodec.BytesToString(freelancerAddr)
+	require.NoError(t, err)
+
+	_, err = ms.CreateProfile(f.ctx, &types.MsgCreateProfile{
+		Creator:    freelancer,
+		Name:       "Freelancer",
+		Skills:     []string{"go"},
+		HourlyRate: 50,
+	})
+	require.NoError(t, err)
+
+	gig, err := ms.CreateGig(f.ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Build a chain",
+		Description:  "Build and launch a cosmos chain.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "development",
+		DeliveryDays: 30,
+	})
+	require.NoError(t, err)
+
+	application, err := ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{
+		Creator:       freelancer,
+		GigId:         gig.Id,
+		CoverLetter:   "I can do it",
+		ProposedPrice: sdk.NewInt64Coin("skill", 1000),
+		ProposedDays:  30,
+		Milestones: []types.Milestone{
+			{Title: "design", Amount: math.NewInt(400), DeliveryDays: 10},
+			{Title: "build", Amount: math.NewInt(600), DeliveryDays: 30},
+		},
+	})
+	require.NoError(t, err)
+
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)))
+	accepted, err := ms.AcceptApplication(f.ctx, &types.MsgAcceptApplication{
+		Creator:       client,
+		ApplicationId: application.ApplicationId,
+	})
+	require.NoError(t, err)
+
+	return accepted.ContractId, clientAddr, freelancerAddr
+}
+
+func TestApplyToGigInvalidMilestones(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	client, err := f.addressCodec.BytesToString([]byte("client______________"))
+	require.NoError(t, err)
+	freelancer, err := f.addressCodec.BytesToString([]byte("freelancer__________"))
+	require.NoError(t, err)
+
+	_, err = ms.CreateProfile(f.ctx, &types.MsgCreateProfile{Creator: freelancer, Skills: []string{"go"}, HourlyRate: 50})
+	require.NoError(t, err)
+	gig, err := ms.CreateGig(f.ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Build a chain",
+		Description:  "Build and launch a cosmos chain.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		DeliveryDays: 30,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		milestones []types.Milestone
+	}{
+		{
+			desc:       "amounts do not add up to the price",
+			milestones: []types.Milestone{{Title: "a", Amount: math.NewInt(400), DeliveryDays: 10}, {Title: "b", Amount: math.NewInt(500), DeliveryDays: 30}},
+		},
+		{
+			desc:       "out of order",
+			milestones: []types.Milestone{{Title: "a", Amount: math.NewInt(400), DeliveryDays: 20}, {Title: "b", Amount: math.NewInt(600), DeliveryDays: 10}},
+		},
+		{
+			desc:       "due after the contract",
+			milestones: []types.Milestone{{Title: "a", Amount: math.NewInt(1000), DeliveryDays: 45}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ms.ApplyToGig(f.ctx, &types.MsgApplyToGig{
+				Creator:       freelancer,
+				GigId:         gig.Id,
+				ProposedPrice: sdk.NewInt64Coin("skill", 1000),
+				ProposedDays:  30,
+				Milestones:    tc.milestones,
+			})
+			require.ErrorIs(t, err, types.ErrInvalidMilestone)
+		})
+	}
+}
+
+func TestMilestoneRelease(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupMilestoneContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Len(t, contract.Milestones, 2)
+	require.Equal(t, "pending", contract.Milestones[0].Status)
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").IsZero())
+
+	// complete-contract is not available on milestone contracts
+	_, err = ms.CompleteContract(f.ctx, &types.MsgCompleteContract{Creator: contract.Client, ContractId: contractId})
+	require.Error(t, err)
+
+	// milestones are delivered in order
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 1})
+	require.ErrorIs(t, err, types.ErrInvalidMilestone)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 0})
+	require.Error(t, err)
+
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+
+	// 400 minus the 5% platform fee
+	require.Equal(t, math.NewInt(380), f.bankKeeper.GetBalance(f.ctx, freelancerAddr, "skill").Amount)
+	contract, err = f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+	require.Equal(t, uint64(1), contract.CurrentMilestone)
+
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(950), f.bankKeeper.GetBalance(f.ctx, freelancerAddr, "skill").Amount)
+	contract, err = f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "completed", contract.Status)
+
+	profile, err := f.keeper.Profile.Get(f.ctx, contract.Freelancer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), profile.TotalJobs)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 950)), profile.TotalEarned)
+}
+
+func TestMilestoneDisputeRefundsRemainingEscrow(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupMilestoneContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(f.ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "late"})
+	require.NoError(t, err)
+
+	dispute, err := f.keeper.Dispute.Get(f.ctx, opened.DisputeId)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), dispute.MilestoneIndex)
+	recordRuling(t, f, dispute.Id, 0)
+
+	_, err = f.keeper.ResolveDispute(f.ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: dispute.Id})
+	require.NoError(t, err)
+	closeAppealWindow(t, f, sdk.UnwrapSDKContext(f.ctx), dispute.Id)
+
+	// the approved milestone stays paid, the disputed one goes back to the
+	// client less the 2% dispute fee
+	require.Equal(t, math.NewInt(380), f.bankKeeper.GetBalance(f.ctx, freelancerAddr, "skill").Amount)
+	require.Equal(t, math.NewInt(588), f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "resolved_client", contract.Status)
+	require.Equal(t, "approved", contract.Milestones[0].Status)
+	require.Equal(t, "refunded", contract.Milestones[1].Status)
+}
+
+func TestMilestoneDisputeSplitResumesContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, _ := setupMilestoneContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	registerJury(t, f)
+	opened, err := ms.OpenDispute(f.ctx, &types.MsgOpenDispute{Creator: contract.Client, ContractId: contractId, Reason: "half done"})
+	require.NoError(t, err)
+	recordRuling(t, f, opened.DisputeId, 5000)
+	_, err = f.keeper.ResolveDispute(f.ctx, &types.MsgResolveDispute{Creator: contract.Client, DisputeId: opened.DisputeId})
+	require.NoError(t, err)
+	closeAppealWindow(t, f, sdk.UnwrapSDKContext(f.ctx), opened.DisputeId)
+
+	// only the disputed milestone is split, the client getting back half of
+	// it less the dispute fee paid to the three jurors, and the contract goes
+	// on with the next one
+	require.Equal(t, math.NewInt(197), f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").Amount)
+	contract, err = f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+	require.Equal(t, uint64(5000), contract.FreelancerPayoutBps)
+	require.Equal(t, uint64(1), contract.CurrentMilestone)
+	require.Equal(t, "split", contract.Milestones[0].Status)
+	require.Equal(t, "pending", contract.Milestones[1].Status)
+
+	escrow, err := f.keeper.ContractEscrow.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 600)), escrow.Held())
+}
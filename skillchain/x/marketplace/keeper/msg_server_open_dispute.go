@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"skillchain/x/marketplace/types"
 
@@ -10,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 )
 
 func (k msgServer) OpenDispute(goCtx context.Context, msg *types.MsgOpenDispute) (*types.MsgOpenDisputeResponse, error) {
@@ -19,97 +21,136 @@ func (k msgServer) OpenDispute(goCtx context.Context, msg *types.MsgOpenDispute)
 
 	// TODO: Handle the message
 	ctx := sdk.UnwrapSDKContext(goCtx)
-    
-    contract, err := k.Contract.Get(ctx, msg.ContractId)
-    if err != nil {
-        return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
-    }
-    
-    isClient := contract.Client == msg.Creator
-    isFreelancer := contract.Freelancer == msg.Creator
-    
-    if !isClient && !isFreelancer {
-        return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can open dispute")
-    }
-    
-    if contract.Status != "active" && contract.Status != "delivered" {
-        return nil, errorsmod.Wrapf(
-            sdkerrors.ErrInvalidRequest,
-            "cannot dispute contract with status %s",
-            contract.Status,
-        )
-    }
-    
-    var existingDisputeErr error
-    err = k.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
-        if dispute.ContractId == msg.ContractId && dispute.Status == "open" {
-            existingDisputeErr = errorsmod.Wrapf(
-                sdkerrors.ErrInvalidRequest,
-                "there is already an open dispute for contract %d",
-                msg.ContractId,
-            )
-            return true, nil 
-        }
-        return false, nil
-    })
-    if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to check existing disputes")
-    }
-    if existingDisputeErr != nil {
-        return nil, existingDisputeErr
-    }
-    
-    params, err := k.Params.Get(ctx)
-    if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to get marketplace params")
-    }
-    deadline := ctx.BlockTime().Unix() + int64(params.DisputeDuration)
-    
-    dispute := types.Dispute{
-        ContractId:         msg.ContractId,
-        Initiator:          msg.Creator,
-        Reason:             msg.Reason,
-        Status:             "open",
-        VotesClient:        0,
-        VotesFreelancer:    0,
-        Resolution:         "",
-        CreatedAt:          ctx.BlockTime().Unix(),
-        Deadline:           deadline,
-    }
-    
-    if isClient {
-        dispute.ClientEvidence = msg.Evidence
-    } else {
-        dispute.FreelancerEvidence = msg.Evidence
-    }
-    
-    disputeId, err := k.DisputeSeq.Next(ctx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	isClient := contract.Client == msg.Creator
+	isFreelancer := contract.Freelancer == msg.Creator
+
+	if !isClient && !isFreelancer {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can open dispute")
+	}
+
+	if contract.Status != "active" && contract.Status != "delivered" && contract.Status != "overdue" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"cannot dispute contract with status %s",
+			contract.Status,
+		)
+	}
+
+	var existingDisputeErr error
+	err = k.Dispute.Walk(ctx, nil, func(_ uint64, dispute types.Dispute) (bool, error) {
+		if dispute.ContractId == msg.ContractId && dispute.Status == "open" {
+			existingDisputeErr = errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"there is already an open dispute for contract %d",
+				msg.ContractId,
+			)
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to check existing disputes")
+	}
+	if existingDisputeErr != nil {
+		return nil, existingDisputeErr
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get marketplace params")
+	}
+	category, err := k.contractCategory(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	rule, _ := params.CategoryRule(category)
+	deadline := ctx.BlockTime().Unix() + int64(rule.DisputeDuration)
+
+	dispute := types.Dispute{
+		ContractId:      msg.ContractId,
+		Initiator:       msg.Creator,
+		Reason:          msg.Reason,
+		Status:          "open",
+		VotesClient:     0,
+		VotesFreelancer: 0,
+		Resolution:      "",
+		CreatedAt:       ctx.BlockTime().Unix(),
+		Deadline:        deadline,
+		ArbiterFees:     sdk.NewCoin(contract.Price.Denom, math.ZeroInt()),
+	}
+
+	// the counterparty has to match the filing fee to contest the dispute;
+	// without a fee it is contested right away
+	dispute.FilingFee = params.DisputeFilingFeeFor(contract.Price)
+	if dispute.FilingFee.IsPositive() {
+		if err := k.lockFilingFee(ctx, msg.Creator, dispute.FilingFee); err != nil {
+			return nil, err
+		}
+		dispute.ResponseDeadline = dispute.CreatedAt + int64(params.DisputeResponsePeriod)
+	} else {
+		dispute.Contested = true
+	}
+
+	if isClient {
+		dispute.ClientEvidence = msg.Evidence
+	} else {
+		dispute.FreelancerEvidence = msg.Evidence
+	}
+
+	// On milestone contracts the dispute only covers the current milestone.
+	if len(contract.Milestones) > 0 {
+		dispute.MilestoneIndex = contract.CurrentMilestone
+		contract.Milestones[contract.CurrentMilestone].Status = "disputed"
+	}
+
+	disputeId, err := k.DisputeSeq.Next(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to get next dispute id")
 	}
 	dispute.Id = disputeId
+	dispute.Arbiters, dispute.Alternates, err = k.drawJury(ctx, params, contract, disputeId, params.MinArbitersRequired)
+	if err != nil {
+		return nil, err
+	}
+	dispute.JurorDeadline = min(dispute.CreatedAt+int64(params.JurorVotePeriod), deadline)
+	dispute.RevealDeadline = deadline + int64(params.RevealPeriod)
 	err = k.Dispute.Set(ctx, disputeId, dispute)
-    if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to set dispute")
-    }
-    
-    contract.Status = "disputed"
-    err = k.Contract.Set(ctx, contract.Id, contract)
-    if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to update contract status")
-    }
-    
-    ctx.EventManager().EmitEvent(
-        sdk.NewEvent(
-            "dispute_opened",
-            sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", disputeId)),
-            sdk.NewAttribute("contract_id", fmt.Sprintf("%d", msg.ContractId)),
-            sdk.NewAttribute("initiator", msg.Creator),
-            sdk.NewAttribute("deadline", fmt.Sprintf("%d", deadline)),
-        ),
-    )
-    
-    return &types.MsgOpenDisputeResponse{
-        DisputeId: disputeId,
-    }, nil
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set dispute")
+	}
+	if err := k.scheduleDispute(ctx, dispute); err != nil {
+		return nil, err
+	}
+
+	contract.Status = "disputed"
+	err = k.Contract.Set(ctx, contract.Id, contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update contract status")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_opened",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", disputeId)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", msg.ContractId)),
+			sdk.NewAttribute("initiator", msg.Creator),
+			sdk.NewAttribute("deadline", fmt.Sprintf("%d", deadline)),
+			sdk.NewAttribute("reveal_deadline", fmt.Sprintf("%d", dispute.RevealDeadline)),
+			sdk.NewAttribute("filing_fee", dispute.FilingFee.String()),
+			sdk.NewAttribute("response_deadline", fmt.Sprintf("%d", dispute.ResponseDeadline)),
+			sdk.NewAttribute("milestone_index", fmt.Sprintf("%d", dispute.MilestoneIndex)),
+			sdk.NewAttribute("jurors", strings.Join(dispute.Arbiters, ",")),
+			sdk.NewAttribute("alternates", strings.Join(dispute.Alternates, ",")),
+		),
+	)
+
+	return &types.MsgOpenDisputeResponse{
+		DisputeId: disputeId,
+	}, nil
 }
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ProposeAmendment(goCtx context.Context, msg *types.MsgProposeAmendment) (*types.MsgProposeAmendmentResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator && contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can propose an amendment")
+	}
+
+	// a new proposal replaces the pending one, which lets the other party
+	// answer with a counter proposal
+	amendment := types.Amendment{
+		ContractId:       contract.Id,
+		Proposer:         msg.Creator,
+		Price:            msg.Price,
+		DeliveryDeadline: msg.DeliveryDeadline,
+		DescriptionHash:  msg.DescriptionHash,
+		Reason:           msg.Reason,
+		CreatedAt:        ctx.BlockTime().Unix(),
+	}
+	if err := k.validateAmendment(ctx, contract, amendment); err != nil {
+		return nil, err
+	}
+
+	if err := k.Amendment.Set(ctx, contract.Id, amendment); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save amendment: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"amendment_proposed",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("proposer", msg.Creator),
+			sdk.NewAttribute("price", amendment.Price.String()),
+			sdk.NewAttribute("delivery_deadline", fmt.Sprintf("%d", amendment.DeliveryDeadline)),
+			sdk.NewAttribute("description_hash", amendment.DescriptionHash),
+		),
+	)
+
+	return &types.MsgProposeAmendmentResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ProposeCancellation(goCtx context.Context, msg *types.MsgProposeCancellation) (*types.MsgProposeCancellationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator && contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can propose a cancellation")
+	}
+
+	if !isCancellable(contract.Status) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"cannot cancel contract with status %s",
+			contract.Status,
+		)
+	}
+
+	if _, pending, err := k.pendingCancellation(ctx, contract.Id); err != nil {
+		return nil, err
+	} else if pending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCancellation, "contract %d already has a pending cancellation proposal", contract.Id)
+	}
+
+	held := unreleasedAmount(contract)
+	if msg.FreelancerPayout.IsNil() || msg.FreelancerPayout.IsNegative() || msg.FreelancerPayout.GT(held) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidCancellation,
+			"freelancer payout must be between 0 and the %s%s held in escrow",
+			held,
+			contract.Price.Denom,
+		)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	proposal := types.CancellationProposal{
+		ContractId:       contract.Id,
+		Proposer:         msg.Creator,
+		FreelancerPayout: msg.FreelancerPayout,
+		Reason:           msg.Reason,
+		CreatedAt:        ctx.BlockTime().Unix(),
+		ExpiresAt:        ctx.BlockTime().Unix() + int64(params.CancellationExpiry),
+	}
+	if err := k.CancellationProposal.Set(ctx, contract.Id, proposal); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save cancellation proposal: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"cancellation_proposed",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("proposer", msg.Creator),
+			sdk.NewAttribute("freelancer_payout", sdk.NewCoin(contract.Price.Denom, msg.FreelancerPayout).String()),
+			sdk.NewAttribute("expires_at", fmt.Sprintf("%d", proposal.ExpiresAt)),
+		),
+	)
+
+	return &types.MsgProposeCancellationResponse{ExpiresAt: proposal.ExpiresAt}, nil
+}
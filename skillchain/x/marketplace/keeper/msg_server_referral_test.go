@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestReferralRewards(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	referrerAddr := sdk.AccAddress([]byte("referrer____________"))
+	clientAddr := sdk.AccAddress([]byte("client______________"))
+	referrer, err := f.addressCodec.BytesToString(referrerAddr)
+	require.NoError(t, err)
+	client, err := f.addressCodec.BytesToString(clientAddr)
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ReferralMaxContracts = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: client, Name: "Client", Skills: []string{"pm"}, HourlyRate: 50, Referrer: client})
+	require.ErrorIs(t, err, types.ErrInvalidReferral)
+	_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: client, Name: "Client", Skills: []string{"pm"}, HourlyRate: 50, Referrer: referrer})
+	require.ErrorIs(t, err, types.ErrInvalidReferral)
+
+	_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: referrer, Name: "Referrer", Skills: []string{"sales"}, HourlyRate: 50})
+	require.NoError(t, err)
+	_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: client, Name: "Client", Skills: []string{"pm"}, HourlyRate: 50, Referrer: referrer})
+	require.NoError(t, err)
+
+	complete := func(contractId uint64) {
+		contract, err := f.keeper.Contract.Get(ctx, contractId)
+		require.NoError(t, err)
+		_, err = ms.DeliverContract(ctx, &types.MsgDeliverContract{Creator: contract.Freelancer, ContractId: contractId})
+		require.NoError(t, err)
+		_, err = ms.CompleteContract(ctx, &types.MsgCompleteContract{Creator: contract.Client, ContractId: contractId})
+		require.NoError(t, err)
+	}
+
+	// the referrer gets 10% of the 50skill platform fee
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	complete(contractId)
+	require.Equal(t, sdk.NewInt64Coin("skill", 5), f.bankKeeper.GetBalance(ctx, referrerAddr, "skill"))
+
+	earnings, err := qs.ReferralEarnings(ctx, &types.QueryReferralEarningsRequest{Referrer: referrer})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 5)), earnings.Earned)
+	require.Equal(t, uint64(1), earnings.ReferredCount)
+
+	referred, err := qs.ReferredAccounts(ctx, &types.QueryReferredAccountsRequest{Referrer: referrer})
+	require.NoError(t, err)
+	require.Len(t, referred.Referrals, 1)
+	require.Equal(t, client, referred.Referrals[0].Referred)
+	require.Equal(t, uint64(1), referred.Referrals[0].RewardedContracts)
+
+	// past the first contract the platform keeps the whole fee
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	gig, err := ms.CreateGig(ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Audit a dApp",
+		Description:  "A security review of the contracts.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "development",
+		DeliveryDays: 10,
+	})
+	require.NoError(t, err)
+	application, err := ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: contract.Freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 1000), ProposedDays: 10})
+	require.NoError(t, err)
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)))
+	accepted, err := ms.AcceptApplication(ctx, &types.MsgAcceptApplication{Creator: client, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+	complete(accepted.ContractId)
+	require.Equal(t, sdk.NewInt64Coin("skill", 5), f.bankKeeper.GetBalance(ctx, referrerAddr, "skill"))
+
+	stats, err := qs.FeeStats(ctx, &types.QueryFeeStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 95)), stats.Stats.Collected)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestReferralCountsContractOnFirstReward(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.ReferralMaxContracts = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	contractId, _, _ := setupMilestoneContract(t, f)
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	referrerAddr := sdk.AccAddress([]byte("referrer____________"))
+	referrer, err := f.addressCodec.BytesToString(referrerAddr)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Referral.Set(ctx, contract.Client, types.Referral{Referred: contract.Client, Referrer: referrer}))
+
+	// the first milestone takes the only slot of the referral
+	approveFirstMilestone(t, f, contractId)
+	referral, err := f.keeper.Referral.Get(ctx, contract.Client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), referral.RewardedContracts)
+	require.Equal(t, []uint64{contractId}, referral.ContractIds)
+	require.Equal(t, sdk.NewInt64Coin("skill", 2), f.bankKeeper.GetBalance(ctx, referrerAddr, "skill"))
+
+	// and the contract keeps paying until it closes
+	_, err = ms.DeliverMilestone(ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+	referral, err = f.keeper.Referral.Get(ctx, contract.Client)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), referral.RewardedContracts)
+	require.Equal(t, sdk.NewInt64Coin("skill", 5), f.bankKeeper.GetBalance(ctx, referrerAddr, "skill"))
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"skillchain/x/marketplace/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterArbiter locks stake into the escrow account to vote on disputes.
+// A bonded arbiter registering again tops up its stake.
+func (k msgServer) RegisterArbiter(goCtx context.Context, msg *types.MsgRegisterArbiter) (*types.MsgRegisterArbiterResponse, error) {
+	arbiterAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	if err := msg.Stake.Validate(); err != nil || !msg.Stake.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "invalid stake %s", msg.Stake)
+	}
+	if msg.Stake.Denom != params.StakeDenom {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "arbiters stake %s", params.StakeDenom)
+	}
+
+	arbiter, err := k.Arbiter.Get(ctx, msg.Creator)
+	if errors.Is(err, collections.ErrNotFound) {
+		arbiter = types.Arbiter{
+			Address:  msg.Creator,
+			Stake:    sdk.NewCoin(params.StakeDenom, math.ZeroInt()),
+			Status:   "bonded",
+			BondedAt: ctx.BlockTime().Unix(),
+		}
+	} else if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get arbiter: %v", err)
+	} else if arbiter.Status != "bonded" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"arbiter must be bonded to add stake (current: %s)",
+			arbiter.Status,
+		)
+	}
+
+	arbiter.Stake = arbiter.Stake.Add(msg.Stake)
+	if arbiter.Stake.Amount.LT(math.NewIntFromUint64(params.ArbiterStakeRequired)) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientFunds,
+			"arbiter must stake at least %d%s",
+			params.ArbiterStakeRequired,
+			params.StakeDenom,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, arbiterAddr, types.EscrowAccountName, sdk.NewCoins(msg.Stake)); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "failed to lock arbiter stake: %v", err)
+	}
+	if err := k.Arbiter.Set(ctx, arbiter.Address, arbiter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save arbiter: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"arbiter_registered",
+			sdk.NewAttribute("arbiter", arbiter.Address),
+			sdk.NewAttribute("stake", arbiter.Stake.String()),
+		),
+	)
+
+	return &types.MsgRegisterArbiterResponse{}, nil
+}
@@ -41,6 +41,10 @@ func (k msgServer) RejectApplication(goCtx context.Context, msg *types.MsgReject
 		)
 	}
 
+	if err := k.refundApplicationBond(ctx, application); err != nil {
+		return nil, err
+	}
+
 	application.Status = "rejected"
 	err = k.Application.Set(ctx, application.Id, application)
 	if err != nil {
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RejectCancellation(goCtx context.Context, msg *types.MsgRejectCancellation) (*types.MsgRejectCancellationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	proposal, pending, err := k.pendingCancellation(ctx, contract.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !pending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCancellation, "contract %d has no pending cancellation proposal", contract.Id)
+	}
+
+	if contract.Client != msg.Creator && contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client or freelancer can reject a cancellation")
+	}
+	if proposal.Proposer == msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "a cancellation must be rejected by the counterparty")
+	}
+
+	if err := k.CancellationProposal.Remove(ctx, contract.Id); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to remove cancellation proposal: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"cancellation_rejected",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("proposer", proposal.Proposer),
+			sdk.NewAttribute("rejecter", msg.Creator),
+		),
+	)
+
+	return &types.MsgRejectCancellationResponse{}, nil
+}
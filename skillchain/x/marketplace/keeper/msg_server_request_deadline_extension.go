@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RequestDeadlineExtension(goCtx context.Context, msg *types.MsgRequestDeadlineExtension) (*types.MsgRequestDeadlineExtensionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only freelancer can request a deadline extension")
+	}
+
+	if err := k.validateExtension(ctx, contract, msg.NewDeadline); err != nil {
+		return nil, err
+	}
+
+	pending, err := k.hasPendingExtension(ctx, contract.Id)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, errorsmod.Wrapf(types.ErrInvalidExtension, "contract %d already has a pending deadline extension request", contract.Id)
+	}
+
+	id, err := k.DeadlineExtensionSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get next deadline extension id")
+	}
+
+	extension := types.DeadlineExtension{
+		Id:                id,
+		ContractId:        contract.Id,
+		Freelancer:        msg.Creator,
+		PreviousDeadline:  contract.DeliveryDeadline,
+		RequestedDeadline: msg.NewDeadline,
+		Reason:            msg.Reason,
+		Status:            "pending",
+		CreatedAt:         ctx.BlockTime().Unix(),
+	}
+	if err := k.DeadlineExtension.Set(ctx, id, extension); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save deadline extension: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"deadline_extension_requested",
+			sdk.NewAttribute("extension_id", fmt.Sprintf("%d", id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer", msg.Creator),
+			sdk.NewAttribute("previous_deadline", fmt.Sprintf("%d", extension.PreviousDeadline)),
+			sdk.NewAttribute("requested_deadline", fmt.Sprintf("%d", extension.RequestedDeadline)),
+		),
+	)
+
+	return &types.MsgRequestDeadlineExtensionResponse{ExtensionId: id}, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	math "cosmossdk.io/math"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -28,95 +28,191 @@ func (k Keeper) resolveDisputeInternal(ctx sdk.Context, disputeId uint64) error
 			dispute.Status,
 		)
 	}
+	if !dispute.Contested {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"dispute awaits the response of the counterparty until %d",
+			dispute.ResponseDeadline,
+		)
+	}
+	if !dispute.RevealClosed(ctx.BlockTime().Unix()) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"votes are still being cast or revealed until %d",
+			dispute.RevealDeadline,
+		)
+	}
 
 	contractId := dispute.ContractId
 
 	contract, errorContract := k.Contract.Get(ctx, contractId)
-    if errorContract != nil {
-        return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", contractId)
-    }
-    
-    var winner string
-    var winnerAddr sdk.AccAddress
-    var errorWinner error
-    
-    if dispute.VotesClient > dispute.VotesFreelancer {
-        winner = "client"
-        dispute.Status = "resolved_client"
-        dispute.Resolution = "Client wins by majority vote"
-        winnerAddr, errorWinner = sdk.AccAddressFromBech32(contract.Client)
-    } else if dispute.VotesFreelancer > dispute.VotesClient {
-        winner = "freelancer"
-        dispute.Status = "resolved_freelancer"
-        dispute.Resolution = "Freelancer wins by majority vote"
-        winnerAddr, errorWinner = sdk.AccAddressFromBech32(contract.Freelancer)
-    } else {
-        // If tie, favor the freelancer as per platform policy
-        winner = "freelancer"
-        dispute.Status = "resolved_freelancer"
-        dispute.Resolution = "Tie resolved in favor of freelancer"
-        winnerAddr, errorWinner = sdk.AccAddressFromBech32(contract.Freelancer)
-    }
-    
-    if errorWinner != nil {
-        return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid winner address")
-    }
-    
-    escrowAmount := sdk.NewCoins(sdk.NewCoin("skill", math.NewIntFromUint64(contract.Price)))
-    
-    errSendCoin := k.bankKeeper.SendCoinsFromModuleToAccount(
-        ctx,
-        types.ModuleName,
-        winnerAddr,
-        escrowAmount,
-    )
-    if errSendCoin != nil {
-        return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to send coins to winner")
-    }
-    
-    err := k.Dispute.Set(ctx, dispute.Id, dispute)
-    if err != nil {
-        return errorsmod.Wrap(err, "failed to update dispute")
-    }
-    
-    contract.Status = "resolved_" + winner
-    contract.CompletedAt = ctx.BlockTime().Unix()
-    err = k.Contract.Set(ctx, contract.Id, contract)
-    if err != nil {
-        return errorsmod.Wrap(err, "failed to update contract")
-    }
-    
-    gig, err := k.Gig.Get(ctx, contract.GigId)
-    if err != nil {
-        return errorsmod.Wrapf(sdkerrors.ErrNotFound, "gig %d not found", contract.GigId)
-    }
-
-    gig.Status = "closed"
-    err = k.Gig.Set(ctx, gig.Id, gig)
-    if err != nil {
-        return errorsmod.Wrap(err, "failed to update gig")
-    }
-    
-    if winner == "freelancer" {
-        profile, err := k.Profile.Get(ctx, contract.Freelancer)
-        if err == nil {
-            profile.TotalJobs++
-            profile.TotalEarned += contract.Price
-            k.Profile.Set(ctx, profile.Owner, profile)
-        }
-    }
-    
-    ctx.EventManager().EmitEvent(
-        sdk.NewEvent(
-            "dispute_resolved",
-            sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
-            sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
-            sdk.NewAttribute("winner", winner),
-            sdk.NewAttribute("amount", escrowAmount.String()),
-        ),
-    )
-
-	return nil
+	if errorContract != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", contractId)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	// the ruling is the median of the payouts revealed by the jury once a
+	// majority of it revealed; without a quorum the freelancer is paid in
+	// full as per platform policy, and an appealed ruling is upheld
+	ruling := dispute.MedianPayoutBps()
+	required := dispute.Quorum()
+	if revealed := dispute.RevealedVotes(); !dispute.HasQuorum() && dispute.Appeals > 0 {
+		ruling = dispute.AppealedPayoutBps
+		dispute.Resolution = fmt.Sprintf(
+			"Insufficient votes (%d/%d required), appealed ruling upheld",
+			revealed,
+			required,
+		)
+	} else if !dispute.HasQuorum() {
+		ruling = types.BasisPoints
+		dispute.Resolution = fmt.Sprintf(
+			"Insufficient votes (%d/%d required), resolved in favor of freelancer",
+			revealed,
+			required,
+		)
+	} else {
+		dispute.Resolution = fmt.Sprintf("Freelancer awarded %d basis points by median vote", ruling)
+	}
+
+	// filing fees are settled on the first ruling, appeals posting bonds
+	forfeitedFee, err := k.settleFilingFees(ctx, dispute, contract, ruling)
+	if err != nil {
+		return err
+	}
+	disputeFee, err := k.settleArbiters(ctx, params, dispute, contract, ruling, forfeitedFee)
+	if err != nil {
+		return err
+	}
+	if dispute.Appeals > 0 {
+		if err := k.settleAppealBond(ctx, params, dispute, contract, ruling); err != nil {
+			return err
+		}
+	}
+
+	winner := types.RulingSide(ruling)
+	dispute.Status = "resolved_" + winner
+	dispute.FreelancerPayoutBps = ruling
+	dispute.ArbiterFees = dispute.ArbiterFees.AddAmount(disputeFee)
+
+	// the payout waits for the appeal window while the ruling can be appealed
+	appealable := dispute.Appeals < params.MaxAppealDepth
+	if appealable {
+		dispute.AppealDeadline = ctx.BlockTime().Unix() + int64(params.AppealPeriod)
+	}
+	if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+		return errorsmod.Wrap(err, "failed to update dispute")
+	}
+	if err := k.scheduleDispute(ctx, dispute); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_resolved",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("winner", winner),
+			sdk.NewAttribute("freelancer_payout_bps", fmt.Sprintf("%d", ruling)),
+			sdk.NewAttribute("appeals", fmt.Sprintf("%d", dispute.Appeals)),
+			sdk.NewAttribute("appeal_deadline", fmt.Sprintf("%d", dispute.AppealDeadline)),
+		),
+	)
+
+	if appealable {
+		return nil
+	}
+	return k.settleDisputePayout(ctx, &dispute)
+}
+
+// settleDisputeForFreelancer releases the disputed amount, less the dispute
+// fee paid to the arbiters, to the freelancer.
+// For milestone contracts only the current milestone is paid and the contract
+// goes back to active when further milestones remain.
+func (k Keeper) settleDisputeForFreelancer(ctx sdk.Context, contract *types.Contract, disputeFee math.Int) (sdk.Coins, error) {
+	contract.FreelancerPayoutBps = types.BasisPoints
+	if len(contract.Milestones) == 0 {
+		payout, _, _, err := k.releaseEscrow(ctx, *contract, unreleasedAmount(*contract).Sub(disputeFee))
+		if err != nil {
+			return nil, err
+		}
+		return payout, k.finishContract(ctx, contract, "resolved_freelancer", "closed", true)
+	}
+
+	milestone := &contract.Milestones[contract.CurrentMilestone]
+	payout, _, _, err := k.releaseEscrow(ctx, *contract, milestone.Amount.Sub(disputeFee))
+	if err != nil {
+		return nil, err
+	}
+	milestone.Status = "approved"
+	milestone.ApprovedAt = ctx.BlockTime().Unix()
+
+	if int(contract.CurrentMilestone) == len(contract.Milestones)-1 {
+		return payout, k.finishContract(ctx, contract, "resolved_freelancer", "closed", true)
+	}
+	return payout, k.resumeContract(ctx, contract)
+}
+
+// resumeContract moves a milestone contract whose disputed milestone was
+// settled on to its next milestone.
+func (k Keeper) resumeContract(ctx sdk.Context, contract *types.Contract) error {
+	contract.CurrentMilestone++
+	contract.Status = "active"
+	if err := k.Contract.Set(ctx, contract.Id, *contract); err != nil {
+		return errorsmod.Wrap(err, "failed to update contract")
+	}
+	return k.scheduleContract(ctx, *contract)
+}
+
+// settleDisputeSplit pays the freelancer payoutBps of the disputed amount,
+// less the dispute fee paid to the arbiters, and refunds the rest of it to
+// the client. For milestone contracts only the disputed milestone is split
+// and the contract goes back to active when further milestones remain, as
+// after a ruling for the freelancer. A ruling of zero finds nothing was
+// delivered: every milestone not approved before the dispute is refunded and
+// the contract is closed.
+func (k Keeper) settleDisputeSplit(ctx sdk.Context, contract *types.Contract, disputeFee math.Int, payoutBps uint64) (sdk.Coins, sdk.Coins, error) {
+	contract.FreelancerPayoutBps = payoutBps
+	resumed := payoutBps > 0 && len(contract.Milestones) > 0 && int(contract.CurrentMilestone) < len(contract.Milestones)-1
+	settled := unreleasedAmount(*contract)
+	if resumed {
+		settled = disputedAmount(*contract)
+	}
+	share := platformFee(disputedAmount(*contract).Sub(disputeFee), payoutBps)
+	refundAmount := settled.Sub(disputeFee).Sub(share)
+
+	var payout sdk.Coins
+	if share.IsPositive() {
+		var err error
+		payout, _, _, err = k.releaseEscrow(ctx, *contract, share)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	refund, err := k.refundEscrow(ctx, *contract, refundAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resumed {
+		contract.Milestones[contract.CurrentMilestone].Status = "split"
+		return payout, refund, k.resumeContract(ctx, contract)
+	}
+	for i := range contract.Milestones {
+		switch {
+		case contract.Milestones[i].Status == "approved":
+		case i == int(contract.CurrentMilestone) && share.IsPositive():
+			contract.Milestones[i].Status = "split"
+		default:
+			contract.Milestones[i].Status = "refunded"
+		}
+	}
+
+	status := "resolved_" + types.RulingSide(payoutBps)
+	return payout, refund, k.finishContract(ctx, contract, status, "closed", payoutBps >= types.BasisPoints/2)
 }
 
 func (k Keeper) ResolveDispute(goCtx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
@@ -125,7 +221,7 @@ func (k Keeper) ResolveDispute(goCtx context.Context, msg *types.MsgResolveDispu
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	
+
 	err := k.resolveDisputeInternal(ctx, msg.DisputeId)
 	if err != nil {
 		return nil, err
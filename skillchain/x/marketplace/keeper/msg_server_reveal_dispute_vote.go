@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+func (k msgServer) RevealDisputeVote(goCtx context.Context, msg *types.MsgRevealDisputeVote) (*types.MsgRevealDisputeVoteResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dispute, err := k.Dispute.Get(ctx, msg.DisputeId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "dispute %d not found", msg.DisputeId)
+	}
+	if dispute.Status != "open" && dispute.Status != "voting" {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "dispute is not open for voting (current: %s)", dispute.Status)
+	}
+	if !dispute.RevealOpen(ctx.BlockTime().Unix()) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"votes are revealed after the voting deadline %d until %d",
+			dispute.Deadline,
+			dispute.RevealDeadline,
+		)
+	}
+
+	index := -1
+	for i, vote := range dispute.Votes {
+		if vote.Arbiter == msg.Creator {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s did not vote on dispute %d", msg.Creator, dispute.Id)
+	}
+	vote := &dispute.Votes[index]
+	if vote.Revealed {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vote already revealed")
+	}
+	if msg.FreelancerPayoutBps > types.BasisPoints {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "freelancer payout cannot exceed %d basis points", types.BasisPoints)
+	}
+	if types.VoteCommitment(dispute.Id, msg.Creator, msg.FreelancerPayoutBps, msg.Salt) != vote.Commitment {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payout and salt do not match the commitment")
+	}
+
+	vote.FreelancerPayoutBps = msg.FreelancerPayoutBps
+	vote.Vote = types.RulingSide(msg.FreelancerPayoutBps)
+	vote.Revealed = true
+	if msg.FreelancerPayoutBps < types.BasisPoints/2 {
+		dispute.VotesClient++
+	} else {
+		dispute.VotesFreelancer++
+	}
+	if err := k.Dispute.Set(ctx, dispute.Id, dispute); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to update dispute")
+	}
+	if err := k.DisputeVote.Set(ctx, vote.Arbiter, *vote); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to record dispute vote")
+	}
+	// the votes are counted as soon as the whole jury revealed
+	if dispute.AllRevealed() {
+		if err := k.RevealQueue.Set(ctx, collections.Join(ctx.BlockTime().Unix(), dispute.Id)); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to queue dispute %d: %v", dispute.Id, err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"dispute_vote_revealed",
+			sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", dispute.Id)),
+			sdk.NewAttribute("arbiter", msg.Creator),
+			sdk.NewAttribute("freelancer_payout_bps", fmt.Sprintf("%d", msg.FreelancerPayoutBps)),
+		),
+	)
+
+	return &types.MsgRevealDisputeVoteResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetEscrowStaking opts the escrow of a contract in or out of escrow staking.
+// Escrow opted in is delegated at the end of the next escrow staking epoch.
+func (k msgServer) SetEscrowStaking(goCtx context.Context, msg *types.MsgSetEscrowStaking) (*types.MsgSetEscrowStakingResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can set escrow staking")
+	}
+
+	if !isCancellable(contract.Status) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"cannot set escrow staking on contract with status %s",
+			contract.Status,
+		)
+	}
+
+	if msg.Enabled {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+		}
+		if !params.EscrowStaking.Enabled {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "escrow staking is disabled")
+		}
+		if contract.Price.Denom != params.StakeDenom {
+			return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "only escrow in %s can be staked", params.StakeDenom)
+		}
+	}
+
+	contract.EscrowStaking = msg.Enabled
+	if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract: %v", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"escrow_staking_set",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("enabled", fmt.Sprintf("%t", msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetEscrowStakingResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) StopStream(goCtx context.Context, msg *types.MsgStopStream) (*types.MsgStopStreamResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator && contract.Freelancer != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the contract parties can stop the stream")
+	}
+
+	if !contract.IsStreaming() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contract is not streaming")
+	}
+
+	if contract.Status != "active" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be active to stop the stream (current: %s)",
+			contract.Status,
+		)
+	}
+
+	// settle what accrued so far and refund the rest to the client
+	paid, err := k.claimStream(ctx, &contract)
+	if err != nil {
+		return nil, err
+	}
+	refund, err := k.refundEscrow(ctx, contract, unreleasedAmount(contract))
+	if err != nil {
+		return nil, err
+	}
+
+	if isFullyStreamed(contract) {
+		err = k.finishContract(ctx, &contract, "completed", "completed", true)
+	} else {
+		err = k.finishContract(ctx, &contract, "cancelled", "closed", false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stream_stopped",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("stopped_by", msg.Creator),
+			sdk.NewAttribute("paid", paid.String()),
+			sdk.NewAttribute("refund", refund.String()),
+			sdk.NewAttribute("status", contract.Status),
+		),
+	)
+
+	return &types.MsgStopStreamResponse{Paid: paid, Refund: refund}, nil
+}
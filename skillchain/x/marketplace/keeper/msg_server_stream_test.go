@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+// setupStreamingContract starts a 1000skill contract streamed over 10 days
+// from the returned block time.
+func setupStreamingContract(t *testing.T, f *fixture) (sdk.Context, uint64, sdk.AccAddress, sdk.AccAddress) {
+	t.Helper()
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+
+	clientAddr := sdk.AccAddress([]byte("client______________"))
+	freelancerAddr := sdk.AccAddress([]byte("freelancer__________"))
+	client, err := f.addressCodec.BytesToString(clientAddr)
+	require.NoError(t, err)
+	freelancer, err := f.addressCodec.BytesToString(freelancerAddr)
+	require.NoError(t, err)
+
+	_, err = ms.CreateProfile(ctx, &types.MsgCreateProfile{Creator: freelancer, Name: "Freelancer", Skills: []string{"go"}, HourlyRate: 50})
+	require.NoError(t, err)
+	gig, err := ms.CreateGig(ctx, &types.MsgCreateGig{
+		Creator:      client,
+		Title:        "Maintain a chain",
+		Description:  "Ongoing maintenance of a cosmos chain.",
+		Price:        sdk.NewInt64Coin("skill", 1000),
+		Category:     "development",
+		DeliveryDays: 10,
+	})
+	require.NoError(t, err)
+
+	_, err = ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 1000), ProposedDays: 10, Streaming: true, WeeklyHoursCap: 20})
+	require.Error(t, err, "hourly and streaming")
+
+	application, err := ms.ApplyToGig(ctx, &types.MsgApplyToGig{Creator: freelancer, GigId: gig.Id, ProposedPrice: sdk.NewInt64Coin("skill", 1000), ProposedDays: 10, Streaming: true})
+	require.NoError(t, err)
+
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 1000)))
+	accepted, err := ms.AcceptApplication(ctx, &types.MsgAcceptApplication{Creator: client, ApplicationId: application.ApplicationId})
+	require.NoError(t, err)
+
+	return ctx, accepted.ContractId, clientAddr, freelancerAddr
+}
+
+func TestStopStream(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, contractId, clientAddr, freelancerAddr := setupStreamingContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.True(t, contract.IsStreaming())
+	require.Equal(t, contract.DeliveryDeadline, contract.StreamEnd)
+
+	_, err = ms.DeliverContract(ctx, &types.MsgDeliverContract{Creator: contract.Freelancer, ContractId: contractId})
+	require.Error(t, err)
+
+	// two of the ten days have passed
+	ctx = ctx.WithBlockTime(time.Unix(contract.StreamStart+2*86400, 0))
+	accrual, err := qs.StreamAccrual(ctx, &types.QueryStreamAccrualRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 200), accrual.Claimable)
+
+	_, err = ms.ClaimStream(ctx, &types.MsgClaimStream{Creator: contract.Client, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	claimed, err := ms.ClaimStream(ctx, &types.MsgClaimStream{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 190)), claimed.Amount)
+
+	// the client stops the stream after six days
+	ctx = ctx.WithBlockTime(time.Unix(contract.StreamStart+6*86400, 0))
+	stopped, err := ms.StopStream(ctx, &types.MsgStopStream{Creator: contract.Client, ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 380)), stopped.Paid)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 400)), stopped.Refund)
+
+	require.Equal(t, math.NewInt(570), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+	require.Equal(t, math.NewInt(400), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "cancelled", contract.Status)
+
+	// nothing accrues once stopped
+	ctx = ctx.WithBlockTime(time.Unix(contract.StreamEnd, 0))
+	accrual, err = qs.StreamAccrual(ctx, &types.QueryStreamAccrualRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("skill", 600), accrual.Accrued)
+	require.True(t, accrual.Claimable.IsZero())
+	_, err = ms.ClaimStream(ctx, &types.MsgClaimStream{Creator: contract.Freelancer, ContractId: contractId})
+	require.Error(t, err)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestClaimStreamCompletesContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, contractId, _, freelancerAddr := setupStreamingContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+
+	// streaming contracts are never overdue
+	ctx = ctx.WithBlockTime(time.Unix(contract.StreamEnd+1, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+
+	claimed, err := ms.ClaimStream(ctx, &types.MsgClaimStream{Creator: contract.Freelancer, ContractId: contractId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 950)), claimed.Amount)
+	require.Equal(t, math.NewInt(950), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "completed", contract.Status)
+
+	profile, err := f.keeper.Profile.Get(ctx, contract.Freelancer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), profile.TotalJobs)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
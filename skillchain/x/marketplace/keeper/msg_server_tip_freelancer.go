@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) TipFreelancer(goCtx context.Context, msg *types.MsgTipFreelancer) (*types.MsgTipFreelancerResponse, error) {
+	clientAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Amount.Validate(); err != nil || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrice, "invalid tip amount %s", msg.Amount)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+	if !params.IsAllowedDenom(msg.Amount.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "denom %s is not allowed", msg.Amount.Denom)
+	}
+
+	contract, err := k.Contract.Get(ctx, msg.ContractId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "contract %d not found", msg.ContractId)
+	}
+
+	if contract.Client != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only client can tip the freelancer")
+	}
+
+	if contract.Status != "completed" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"contract must be completed to tip the freelancer (current: %s)",
+			contract.Status,
+		)
+	}
+
+	freelancerAddr, err := k.addressCodec.StringToBytes(contract.Freelancer)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid freelancer address")
+	}
+
+	fee := sdk.NewCoin(msg.Amount.Denom, math.ZeroInt())
+	if params.TipFeeEnabled {
+		fee.Amount = msg.Amount.Amount.Mul(math.NewIntFromUint64(params.PlatformFeePercent)).Quo(math.NewInt(100))
+	}
+	tip := msg.Amount.Sub(fee)
+
+	if !tip.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, clientAddr, freelancerAddr, sdk.NewCoins(tip)); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pay tip")
+		}
+	}
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, clientAddr, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to collect platform fee")
+		}
+		if err := k.retainFee(ctx, params, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	tipId, err := k.TipSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next tip id")
+	}
+	if err := k.Tip.Set(ctx, tipId, types.Tip{
+		Id:         tipId,
+		ContractId: contract.Id,
+		Client:     contract.Client,
+		Freelancer: contract.Freelancer,
+		Amount:     tip,
+		Fee:        fee,
+		Note:       msg.Note,
+		CreatedAt:  ctx.BlockTime().Unix(),
+	}); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to save tip: %v", err)
+	}
+
+	profile, err := k.Profile.Get(ctx, contract.Freelancer)
+	if err == nil {
+		profile.TotalEarned = profile.TotalEarned.Add(tip)
+		if err := k.Profile.Set(ctx, contract.Freelancer, profile); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update profile: %v", err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"tip_paid",
+			sdk.NewAttribute("tip_id", fmt.Sprintf("%d", tipId)),
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("client", contract.Client),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("amount", tip.String()),
+			sdk.NewAttribute("platform_fee", fee.String()),
+		),
+	)
+
+	return &types.MsgTipFreelancerResponse{TipId: tipId}, nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestTipFreelancer(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupMilestoneContract(t, f)
+	f.bankKeeper.mint(clientAddr, sdk.NewCoins(sdk.NewInt64Coin("skill", 200)))
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	// only completed contracts can be tipped
+	_, err = ms.TipFreelancer(f.ctx, &types.MsgTipFreelancer{Creator: contract.Client, ContractId: contractId, Amount: sdk.NewInt64Coin("skill", 100)})
+	require.Error(t, err)
+
+	approveFirstMilestone(t, f, contractId)
+	_, err = ms.DeliverMilestone(f.ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+	_, err = ms.ApproveMilestone(f.ctx, &types.MsgApproveMilestone{Creator: contract.Client, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+
+	_, err = ms.TipFreelancer(f.ctx, &types.MsgTipFreelancer{Creator: contract.Freelancer, ContractId: contractId, Amount: sdk.NewInt64Coin("skill", 100)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.TipFreelancer(f.ctx, &types.MsgTipFreelancer{Creator: contract.Client, ContractId: contractId, Amount: sdk.NewInt64Coin("uatom", 100)})
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	_, err = ms.TipFreelancer(f.ctx, &types.MsgTipFreelancer{Creator: contract.Client, ContractId: contractId, Amount: sdk.NewInt64Coin("skill", 100), Note: "great work"})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1050), f.bankKeeper.GetBalance(f.ctx, freelancerAddr, "skill").Amount)
+
+	// with the fee enabled the tip is charged like a release
+	params := types.DefaultParams()
+	params.TipFeeEnabled = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = ms.TipFreelancer(f.ctx, &types.MsgTipFreelancer{Creator: contract.Client, ContractId: contractId, Amount: sdk.NewInt64Coin("skill", 100)})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1145), f.bankKeeper.GetBalance(f.ctx, freelancerAddr, "skill").Amount)
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, clientAddr, "skill").IsZero())
+
+	res, err := qs.TipsByContract(f.ctx, &types.QueryTipsByContractRequest{ContractId: contractId})
+	require.NoError(t, err)
+	require.Len(t, res.Tips, 2)
+	require.Equal(t, sdk.NewInt64Coin("skill", 5), res.Tips[1].Fee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 195)), res.Total)
+
+	profile, err := f.keeper.Profile.Get(f.ctx, contract.Freelancer)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 1145)), profile.TotalEarned)
+
+	stats, err := qs.FeeStats(f.ctx, &types.QueryFeeStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 55)), stats.Stats.Collected)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
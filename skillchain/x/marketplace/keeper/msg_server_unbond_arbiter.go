@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"skillchain/x/marketplace/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UnbondArbiter stops an arbiter from voting. Its stake is released once the
+// arbiter unbonding period has passed.
+func (k msgServer) UnbondArbiter(goCtx context.Context, msg *types.MsgUnbondArbiter) (*types.MsgUnbondArbiterResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	arbiter, err := k.Arbiter.Get(ctx, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "arbiter %s not found", msg.Creator)
+	}
+
+	if arbiter.Status != "bonded" {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"arbiter must be bonded to unbond (current: %s)",
+			arbiter.Status,
+		)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	arbiter.Status = "unbonding"
+	arbiter.UnbondingEndsAt = ctx.BlockTime().Unix() + int64(params.ArbiterUnbondingPeriod)
+	if err := k.Arbiter.Set(ctx, arbiter.Address, arbiter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update arbiter: %v", err)
+	}
+	if err := k.scheduleArbiter(ctx, arbiter); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"arbiter_unbonding",
+			sdk.NewAttribute("arbiter", arbiter.Address),
+			sdk.NewAttribute("stake", arbiter.Stake.String()),
+			sdk.NewAttribute("unbonding_ends_at", fmt.Sprintf("%d", arbiter.UnbondingEndsAt)),
+		),
+	)
+
+	return &types.MsgUnbondArbiterResponse{UnbondingEndsAt: arbiter.UnbondingEndsAt}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"skillchain/x/marketplace/types"
 
@@ -53,6 +54,14 @@ func (k msgServer) UpdateGigStatus(goCtx context.Context, msg *types.MsgUpdateGi
 		)
 	}
 
+	// the deposit of a funded gig is only held while it is open
+	if gig.Funded {
+		if err := k.refundGigDeposit(ctx, gig, sdk.NewCoins(gig.Price)); err != nil {
+			return nil, err
+		}
+		gig.Funded = false
+	}
+
 	gig.Status = msg.Status
 
 	err = k.Gig.Set(ctx, gig.Id, gig)
@@ -63,7 +72,7 @@ func (k msgServer) UpdateGigStatus(goCtx context.Context, msg *types.MsgUpdateGi
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			"gig_status_updated",
-			sdk.NewAttribute("gig_id", string(msg.GigId)),
+			sdk.NewAttribute("gig_id", fmt.Sprintf("%d", msg.GigId)),
 			sdk.NewAttribute("new_status", msg.Status),
 		),
 	)
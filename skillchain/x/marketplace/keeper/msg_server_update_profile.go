@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"skillchain/x/marketplace/types"
@@ -51,7 +52,7 @@ func (k msgServer) UpdateProfile(goCtx context.Context, msg *types.MsgUpdateProf
 			sdk.NewAttribute("owner", msg.Creator),
 			sdk.NewAttribute("name", msg.Name),
 			sdk.NewAttribute("bio", msg.Bio),
-			sdk.NewAttribute("hourly_rate", string(msg.HourlyRate)),
+			sdk.NewAttribute("hourly_rate", fmt.Sprintf("%d", msg.HourlyRate)),
 			sdk.NewAttribute("skills", string(strings.Join(msg.Skills, ", "))),
 		),
 	)
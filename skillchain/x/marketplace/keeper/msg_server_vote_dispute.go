@@ -2,9 +2,10 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-
-	math "cosmossdk.io/math"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -48,39 +49,27 @@ func (k msgServer) VoteDispute(goCtx context.Context, msg *types.MsgVoteDispute)
         return nil, errorsmod.Wrap(types.ErrUnauthorized, "parties cannot vote on their own dispute")
     }
     
-    params, err := k.Params.Get(ctx)
-    if err != nil {
-        return nil, errorsmod.Wrap(err, "failed to get params")
+    // jurors stay slashable, and keep their vote, while unbonding
+    if !slices.Contains(dispute.Arbiters, msg.Creator) {
+        return nil, errorsmod.Wrapf(types.ErrInvalidArbiter, "%s is not a juror of dispute %d", msg.Creator, dispute.Id)
     }
-    voterAddr, _ := sdk.AccAddressFromBech32(msg.Creator)
-    balance := k.bankKeeper.GetBalance(ctx, voterAddr, "skill")
     
-    if balance.Amount.LT(math.NewIntFromUint64(params.ArbiterStakeRequired)) {
-        return nil, errorsmod.Wrapf(
-            types.ErrInsufficientFunds,
-            "arbiter must have at least %d skill (has %s)",
-            params.ArbiterStakeRequired,
-            balance.String(),
-        )
+    for _, vote := range dispute.Votes {
+        if vote.Arbiter == msg.Creator {
+            return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "already voted on this dispute")
+        }
     }
     
-    k.DisputeVote.Walk(ctx, nil, func(key string, disputeVote types.DisputeVote) (stop bool, err error) {
-		if disputeVote.DisputeId == msg.DisputeId && disputeVote.Arbiter == msg.Creator {
-			return true, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "already voted on this dispute")
-		}
-		return false, nil
-	})
-    
-    
-    if msg.Vote != "client" && msg.Vote != "freelancer" {
-        return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "vote must be 'client' or 'freelancer'")
+    // the vote stays secret until revealed so later jurors cannot follow it
+    if commitment, err := hex.DecodeString(msg.Commitment); err != nil || len(commitment) != sha256.Size {
+        return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commitment must be a hex encoded sha256 hash")
     }
     
     vote := types.DisputeVote{
-        Arbiter:   msg.Creator,
-        DisputeId: msg.DisputeId,
-        Vote:      msg.Vote,
-        VotedAt:   ctx.BlockTime().Unix(),
+        Arbiter:    msg.Creator,
+        DisputeId:  msg.DisputeId,
+        VotedAt:    ctx.BlockTime().Unix(),
+        Commitment: msg.Commitment,
     }
 
     err = k.DisputeVote.Set(ctx, vote.Arbiter, vote)
@@ -88,12 +77,7 @@ func (k msgServer) VoteDispute(goCtx context.Context, msg *types.MsgVoteDispute)
         return nil, errorsmod.Wrap(err, "failed to record dispute vote")
     }
     
-    if msg.Vote == "client" {
-        dispute.VotesClient++
-    } else {
-        dispute.VotesFreelancer++
-    }
-    
+    dispute.Votes = append(dispute.Votes, vote)
     if dispute.Status == "open" {
         dispute.Status = "voting"
     }
@@ -103,22 +87,12 @@ func (k msgServer) VoteDispute(goCtx context.Context, msg *types.MsgVoteDispute)
         return nil, errorsmod.Wrap(err, "failed to update dispute")
     }
 
-    totalVotes := dispute.VotesClient + dispute.VotesFreelancer
-    if totalVotes >= params.MinArbitersRequired {
-		msgResolveDispute := &types.MsgResolveDispute{
-			Creator:   "AutomatedResolver",
-			DisputeId: dispute.Id,
-		}
-        k.ResolveDispute(ctx, msgResolveDispute)
-    }
-    
     ctx.EventManager().EmitEvent(
         sdk.NewEvent(
             "dispute_vote_cast",
             sdk.NewAttribute("dispute_id", fmt.Sprintf("%d", msg.DisputeId)),
             sdk.NewAttribute("arbiter", msg.Creator),
-            sdk.NewAttribute("vote", msg.Vote),
-            sdk.NewAttribute("total_votes", fmt.Sprintf("%d", totalVotes)),
+            sdk.NewAttribute("total_votes", fmt.Sprintf("%d", len(dispute.Votes))),
         ),
     )
 
@@ -36,6 +36,10 @@ func (k msgServer) WithdrawApplication(goCtx context.Context, msg *types.MsgWith
 		)
 	}
 
+	if err := k.refundApplicationBond(ctx, application); err != nil {
+		return nil, err
+	}
+
 	application.Status = "withdrawn"
 	err = k.Application.Set(ctx, application.Id, application)
 	if err != nil {
@@ -41,7 +41,8 @@ func TestMsgUpdateParams(t *testing.T) {
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "min contract duration",
 		},
 		{
 			name: "all good",
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// ProcessOverdueContracts flags the active contracts that were not delivered
+// by their delivery deadline plus the grace period as overdue. The freelancer
+// can still deliver an overdue contract until the client cancels it.
+func (k Keeper) ProcessOverdueContracts(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	cutoff := ctx.BlockTime().Unix() - int64(params.DeadlineGracePeriod)
+	// a deadline equal to the cutoff is not overdue yet
+	due, err := dueEntries(ctx, k.DeadlineQueue, cutoff-1)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing overdue contracts")
+	}
+
+	var overdue []types.Contract
+	for _, entry := range due {
+		contract, err := k.Contract.Get(ctx, entry.K2())
+		if err == nil && isDeliverable(contract) && contract.DeliveryDeadline == entry.K1() {
+			overdue = append(overdue, contract)
+		}
+		// the contract is flagged below, or was delivered or given another
+		// deadline since it was queued
+		if err := k.DeadlineQueue.Remove(ctx, entry); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to dequeue deadline of contract %d: %v", entry.K2(), err)
+		}
+	}
+
+	for _, contract := range overdue {
+		contract.Status = "overdue"
+		if err := k.Contract.Set(ctx, contract.Id, contract); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update contract status: %v", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_overdue",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+				sdk.NewAttribute("client", contract.Client),
+				sdk.NewAttribute("freelancer", contract.Freelancer),
+				sdk.NewAttribute("delivery_deadline", fmt.Sprintf("%d", contract.DeliveryDeadline)),
+			),
+		)
+	}
+
+	return nil
+}
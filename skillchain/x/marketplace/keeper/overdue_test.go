@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestCancelOverdueContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, clientAddr, freelancerAddr := setupMilestoneContract(t, f)
+	approveFirstMilestone(t, f, contractId)
+
+	params := types.DefaultParams()
+	params.DeadlineGracePeriod = 3600
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+
+	// not overdue before the grace period ends
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(contract.DeliveryDeadline+3600, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+	_, err = ms.CancelOverdueContract(ctx, &types.MsgCancelOverdueContract{Creator: contract.Client, ContractId: contractId})
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "overdue", contract.Status)
+
+	_, err = ms.CancelOverdueContract(ctx, &types.MsgCancelOverdueContract{Creator: contract.Freelancer, ContractId: contractId})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := ms.CancelOverdueContract(ctx, &types.MsgCancelOverdueContract{Creator: contract.Client, ContractId: contractId, ReopenGig: true})
+	require.NoError(t, err)
+
+	// the approved milestone stays paid, the remaining 600 are refunded
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 600)), res.Refund)
+	require.Equal(t, math.NewInt(600), f.bankKeeper.GetBalance(ctx, clientAddr, "skill").Amount)
+	require.Equal(t, math.NewInt(380), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "cancelled", contract.Status)
+	require.Equal(t, "approved", contract.Milestones[0].Status)
+	require.Equal(t, "refunded", contract.Milestones[1].Status)
+
+	gig, err := f.keeper.Gig.Get(ctx, contract.GigId)
+	require.NoError(t, err)
+	require.Equal(t, "open", gig.Status)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestDeliverOverdueContract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupMilestoneContract(t, f)
+
+	contract, err := f.keeper.Contract.Get(f.ctx, contractId)
+	require.NoError(t, err)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(contract.DeliveryDeadline+1, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+
+	// the freelancer can still deliver late while the client has not cancelled
+	_, err = ms.DeliverMilestone(ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+	_, err = ms.CancelOverdueContract(ctx, &types.MsgCancelOverdueContract{Creator: contract.Client, ContractId: contractId})
+	require.Error(t, err)
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "delivered", contract.Status)
+}
+
+func TestExtendedContractOverdueAtNewDeadline(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, _ := setupSinglePaymentContract(t, f)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	deadline := contract.DeliveryDeadline
+
+	requested, err := ms.RequestDeadlineExtension(ctx, &types.MsgRequestDeadlineExtension{Creator: contract.Freelancer, ContractId: contractId, NewDeadline: deadline + 86400})
+	require.NoError(t, err)
+	_, err = ms.ApproveDeadlineExtension(ctx, &types.MsgApproveDeadlineExtension{Creator: contract.Client, ExtensionId: requested.ExtensionId})
+	require.NoError(t, err)
+
+	// the former deadline is dropped from the queue
+	ctx = ctx.WithBlockTime(time.Unix(deadline+1, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+
+	ctx = ctx.WithBlockTime(time.Unix(deadline+86401, 0))
+	require.NoError(t, f.keeper.ProcessOverdueContracts(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "overdue", contract.Status)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"skillchain/x/marketplace/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Amendment(ctx context.Context, req *types.QueryAmendmentRequest) (*types.QueryAmendmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amendment, err := q.k.Amendment.Get(ctx, req.ContractId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAmendmentResponse{Amendment: amendment}, nil
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -23,10 +25,12 @@ func createNApplication(keeper keeper.Keeper, ctx context.Context, n int) []type
 		items[i].GigId = uint64(i)
 		items[i].Freelancer = strconv.Itoa(i)
 		items[i].CoverLetter = strconv.Itoa(i)
-		items[i].ProposedPrice = uint64(i)
+		items[i].ProposedPrice = sdk.NewInt64Coin("skill", int64(i))
 		items[i].ProposedDays = uint64(i)
 		items[i].Status = strconv.Itoa(i)
 		items[i].CreatedAt = int64(i)
+		items[i].HourlyRate = math.ZeroInt()
+		items[i].Bond = sdk.NewInt64Coin("skill", 0)
 		_ = keeper.Application.Set(ctx, iu, items[i])
 		_ = keeper.ApplicationSeq.Set(ctx, iu)
 	}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"skillchain/x/marketplace/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Arbiter(ctx context.Context, req *types.QueryArbiterRequest) (*types.QueryArbiterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	arbiter, err := q.k.Arbiter.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryArbiterResponse{Arbiter: arbiter, AccuracyBps: arbiter.AccuracyBps()}, nil
+}
+
+func (q queryServer) ActiveArbiters(ctx context.Context, req *types.QueryActiveArbitersRequest) (*types.QueryActiveArbitersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	arbiters, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Arbiter,
+		req.Pagination,
+		func(_ string, value types.Arbiter) (bool, error) {
+			return value.IsActive(params), nil
+		},
+		func(_ string, value types.Arbiter) (types.Arbiter, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	total := math.ZeroInt()
+	err = q.k.Arbiter.Walk(ctx, nil, func(_ string, arbiter types.Arbiter) (bool, error) {
+		if arbiter.IsActive(params) {
+			total = total.Add(arbiter.Stake.Amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryActiveArbitersResponse{
+		Arbiters:   arbiters,
+		Pagination: pageRes,
+		TotalStake: sdk.NewCoin(params.StakeDenom, total),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"skillchain/x/marketplace/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) CancellationProposal(ctx context.Context, req *types.QueryCancellationProposalRequest) (*types.QueryCancellationProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposal, err := q.k.CancellationProposal.Get(ctx, req.ContractId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryCancellationProposalResponse{Proposal: proposal}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) CategoryRule(ctx context.Context, req *types.QueryCategoryRuleRequest) (*types.QueryCategoryRuleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	rule, overridden := params.CategoryRule(req.Category)
+	return &types.QueryCategoryRuleResponse{Rule: rule, Overridden: overridden}, nil
+}
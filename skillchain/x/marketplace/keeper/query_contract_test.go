@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -24,11 +26,13 @@ func createNContract(keeper keeper.Keeper, ctx context.Context, n int) []types.C
 		items[i].ApplicationId = uint64(i)
 		items[i].Client = strconv.Itoa(i)
 		items[i].Freelancer = strconv.Itoa(i)
-		items[i].Price = uint64(i)
+		items[i].Price = sdk.NewInt64Coin("skill", int64(i))
 		items[i].DeliveryDeadline = int64(i)
 		items[i].Status = strconv.Itoa(i)
 		items[i].CreatedAt = int64(i)
 		items[i].CompletedAt = int64(i)
+		items[i].HourlyRate = math.ZeroInt()
+		items[i].Billed = math.ZeroInt()
 		_ = keeper.Contract.Set(ctx, iu, items[i])
 		_ = keeper.ContractSeq.Set(ctx, iu)
 	}
@@ -48,5 +48,12 @@ func (q queryServer) GetDispute(ctx context.Context, req *types.QueryGetDisputeR
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &types.QueryGetDisputeResponse{Dispute: dispute}, nil
+	// arbiters weigh the dispute against the deadline extensions asked and
+	// granted on the contract
+	extensions, err := q.k.contractExtensions(ctx, dispute.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetDisputeResponse{Dispute: dispute, Extensions: extensions}, nil
 }
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,9 @@ func createNDispute(keeper keeper.Keeper, ctx context.Context, n int) []types.Di
 		items[i].Resolution = strconv.Itoa(i)
 		items[i].CreatedAt = int64(i)
 		items[i].Deadline = int64(i)
+		items[i].AppealBond = sdk.NewInt64Coin("skill", 0)
+		items[i].ArbiterFees = sdk.NewInt64Coin("skill", int64(i))
+		items[i].FilingFee = sdk.NewInt64Coin("skill", 0)
 		_ = keeper.Dispute.Set(ctx, iu, items[i])
 		_ = keeper.DisputeSeq.Set(ctx, iu)
 	}
@@ -18,8 +18,13 @@ func (q queryServer) EscrowBalance(goCtx context.Context, req *types.QueryEscrow
 	// TODO: Process the query
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	addr := q.k.accountKeeper.GetModuleAddress(types.ModuleName)
-	balance := q.k.bankKeeper.GetBalance(ctx, addr, "skill")
+	addr := q.k.accountKeeper.GetModuleAddress(types.EscrowAccountName)
+	balances := q.k.bankKeeper.GetAllBalances(ctx, addr)
 
-	return &types.QueryEscrowBalanceResponse{Balance: &balance}, nil
+	retainedFees, err := q.k.retainedFees(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve retained fees")
+	}
+
+	return &types.QueryEscrowBalanceResponse{Balances: balances, RetainedFees: retainedFees}, nil
 }
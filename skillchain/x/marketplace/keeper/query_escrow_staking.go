@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) EscrowStaking(ctx context.Context, req *types.QueryEscrowStakingRequest) (*types.QueryEscrowStakingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve params")
+	}
+
+	pool, err := q.k.getEscrowStakingPool(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve escrow staking pool")
+	}
+
+	_, optedIn, err := q.k.stakedEscrows(ctx, params.StakeDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve staked escrows")
+	}
+
+	return &types.QueryEscrowStakingResponse{
+		Pool:    pool,
+		OptedIn: sdk.NewCoin(params.StakeDenom, optedIn),
+		Liquid:  q.k.bankKeeper.GetBalance(ctx, q.k.accountKeeper.GetModuleAddress(types.EscrowAccountName), params.StakeDenom),
+	}, nil
+}
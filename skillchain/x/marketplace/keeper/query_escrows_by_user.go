@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) EscrowsByUser(ctx context.Context, req *types.QueryEscrowsByUserRequest) (*types.QueryEscrowsByUserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var escrows []types.ContractEscrow
+	held := sdk.NewCoins()
+	err := q.k.ContractEscrow.Walk(ctx, nil, func(_ uint64, escrow types.ContractEscrow) (stop bool, err error) {
+		if escrow.Client == req.User || escrow.Freelancer == req.User {
+			escrows = append(escrows, escrow)
+			held = held.Add(escrow.Held()...)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve escrows")
+	}
+
+	return &types.QueryEscrowsByUserResponse{Escrows: escrows, Held: held}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ExtensionsByContract(ctx context.Context, req *types.QueryExtensionsByContractRequest) (*types.QueryExtensionsByContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	extensions, err := q.k.contractExtensions(ctx, req.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve deadline extensions")
+	}
+
+	return &types.QueryExtensionsByContractResponse{Extensions: extensions}, nil
+}
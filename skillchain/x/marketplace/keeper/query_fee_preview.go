@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) FeePreview(ctx context.Context, req *types.QueryFeePreviewRequest) (*types.QueryFeePreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	if err := req.Price.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid price")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	tier, feeBps, err := q.k.feeTier(ctx, params, req.Category, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	fee := platformFee(req.Price.Amount, feeBps)
+
+	return &types.QueryFeePreviewResponse{
+		Tier:   tier,
+		FeeBps: feeBps,
+		Fee:    sdk.NewCoin(req.Price.Denom, fee),
+		Net:    sdk.NewCoin(req.Price.Denom, req.Price.Amount.Sub(fee)),
+	}, nil
+}
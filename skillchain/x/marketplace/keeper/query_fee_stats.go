@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) FeeStats(ctx context.Context, req *types.QueryFeeStatsRequest) (*types.QueryFeeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stats, err := q.k.getFeeStats(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve fee stats")
+	}
+
+	pending, err := q.k.retainedFees(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve retained fees")
+	}
+
+	return &types.QueryFeeStatsResponse{Stats: stats, Pending: pending}, nil
+}
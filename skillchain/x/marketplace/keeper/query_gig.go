@@ -18,10 +18,18 @@ func (q queryServer) ListGig(ctx context.Context, req *types.QueryAllGigRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	gigs, pageRes, err := query.CollectionPaginate(
+	var predicate func(uint64, types.Gig) (bool, error)
+	if req.Funded {
+		predicate = func(_ uint64, value types.Gig) (bool, error) {
+			return value.Funded, nil
+		}
+	}
+
+	gigs, pageRes, err := query.CollectionFilteredPaginate(
 		ctx,
 		q.k.Gig,
 		req.Pagination,
+		predicate,
 		func(_ uint64, value types.Gig) (types.Gig, error) {
 			return value, nil
 		},
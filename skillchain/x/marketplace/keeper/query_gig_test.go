@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -23,7 +24,7 @@ func createNGig(keeper keeper.Keeper, ctx context.Context, n int) []types.Gig {
 		items[i].Title = strconv.Itoa(i)
 		items[i].Description = strconv.Itoa(i)
 		items[i].Owner = strconv.Itoa(i)
-		items[i].Price = uint64(i)
+		items[i].Price = sdk.NewInt64Coin("skill", int64(i))
 		items[i].Category = strconv.Itoa(i)
 		items[i].DeliveryDays = uint64(i)
 		items[i].Status = strconv.Itoa(i)
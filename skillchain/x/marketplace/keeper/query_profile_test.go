@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -23,7 +24,7 @@ func createNProfile(keeper keeper.Keeper, ctx context.Context, n int) []types.Pr
 		items[i].Skills = []string{`abc` + strconv.Itoa(i), `xyz` + strconv.Itoa(i)}
 		items[i].HourlyRate = uint64(i)
 		items[i].TotalJobs = uint64(i)
-		items[i].TotalEarned = uint64(i)
+		items[i].TotalEarned = sdk.NewCoins(sdk.NewInt64Coin("skill", int64(i+1)))
 		items[i].RatingSum = uint64(i)
 		items[i].RatingCount = uint64(i)
 		_ = keeper.Profile.Set(ctx, items[i].Owner, items[i])
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ReferralEarnings(ctx context.Context, req *types.QueryReferralEarningsRequest) (*types.QueryReferralEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	earned := sdk.NewCoins()
+	var count uint64
+	err := q.k.Referral.Walk(ctx, nil, func(_ string, referral types.Referral) (stop bool, err error) {
+		if referral.Referrer == req.Referrer {
+			earned = earned.Add(referral.Earned...)
+			count++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve referrals")
+	}
+
+	return &types.QueryReferralEarningsResponse{Earned: earned, ReferredCount: count}, nil
+}
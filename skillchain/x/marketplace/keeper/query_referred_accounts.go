@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ReferredAccounts(ctx context.Context, req *types.QueryReferredAccountsRequest) (*types.QueryReferredAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var referrals []types.Referral
+	err := q.k.Referral.Walk(ctx, nil, func(_ string, referral types.Referral) (stop bool, err error) {
+		if referral.Referrer == req.Referrer {
+			referrals = append(referrals, referral)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve referrals")
+	}
+
+	return &types.QueryReferredAccountsResponse{Referrals: referrals}, nil
+}
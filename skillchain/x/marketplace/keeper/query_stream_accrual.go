@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"skillchain/x/marketplace/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) StreamAccrual(ctx context.Context, req *types.QueryStreamAccrualRequest) (*types.QueryStreamAccrualResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, err := q.k.Contract.Get(ctx, req.ContractId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !contract.IsStreaming() {
+		return nil, status.Error(codes.InvalidArgument, "contract is not streaming")
+	}
+
+	claimed := contract.BilledAmount()
+	accrued, claimable := claimed, math.ZeroInt()
+	// a stopped stream accrues nothing more
+	if contract.Status == "active" {
+		accrued = contract.StreamedAmount(sdk.UnwrapSDKContext(ctx).BlockTime().Unix())
+		claimable = accrued.Sub(claimed)
+	}
+
+	denom := contract.Price.Denom
+	return &types.QueryStreamAccrualResponse{
+		Accrued:   sdk.NewCoin(denom, accrued),
+		Claimed:   sdk.NewCoin(denom, claimed),
+		Claimable: sdk.NewCoin(denom, claimable),
+	}, nil
+}
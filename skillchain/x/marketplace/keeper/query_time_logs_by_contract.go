@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) TimeLogsByContract(ctx context.Context, req *types.QueryTimeLogsByContractRequest) (*types.QueryTimeLogsByContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	logs, err := q.k.contractTimeLogs(ctx, req.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve time logs")
+	}
+
+	return &types.QueryTimeLogsByContractResponse{TimeLogs: logs}, nil
+}
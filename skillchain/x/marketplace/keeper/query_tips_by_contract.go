@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"skillchain/x/marketplace/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) TipsByContract(ctx context.Context, req *types.QueryTipsByContractRequest) (*types.QueryTipsByContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tips []types.Tip
+	total := sdk.NewCoins()
+	err := q.k.Tip.Walk(ctx, nil, func(_ uint64, tip types.Tip) (stop bool, err error) {
+		if tip.ContractId == req.ContractId {
+			tips = append(tips, tip)
+			total = total.Add(tip.Amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retrieve tips")
+	}
+
+	return &types.QueryTipsByContractResponse{Tips: tips, Total: total}, nil
+}
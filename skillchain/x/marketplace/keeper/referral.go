@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// validateReferrer checks that referrer can refer the new account owner.
+// Referrers need a profile and cannot be referred by the account they refer.
+func (k Keeper) validateReferrer(ctx context.Context, owner, referrer string) error {
+	if _, err := k.addressCodec.StringToBytes(referrer); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReferral, "invalid referrer address")
+	}
+	if referrer == owner {
+		return errorsmod.Wrap(types.ErrInvalidReferral, "an account cannot refer itself")
+	}
+
+	profile, err := k.Profile.Get(ctx, referrer)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrInvalidReferral, "referrer %s has no profile", referrer)
+	} else if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get referrer profile: %v", err)
+	}
+	if profile.Referrer == owner {
+		return errorsmod.Wrap(types.ErrInvalidReferral, "accounts cannot refer each other")
+	}
+
+	return nil
+}
+
+// activeReferral returns the referral of a party of the contract when its
+// referrer earns fee shares on the contract: the contract already counts
+// towards the referral or the referral has contracts left. Referrers taking
+// part in the contract earn nothing, so parties cannot rebate their own fees.
+func (k Keeper) activeReferral(ctx context.Context, params types.Params, contract types.Contract, party string) (types.Referral, bool, error) {
+	referral, err := k.Referral.Get(ctx, party)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Referral{}, false, nil
+	} else if err != nil {
+		return types.Referral{}, false, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to get referral: %v", err)
+	}
+
+	if referral.Referrer == contract.Client || referral.Referrer == contract.Freelancer {
+		return referral, false, nil
+	}
+	counted := slices.Contains(referral.ContractIds, contract.Id)
+	return referral, counted || referral.RewardedContracts < params.ReferralMaxContracts, nil
+}
+
+// payReferralRewards pays the referrers of the contract parties their share
+// of a platform fee held in escrow. The first share paid on a contract counts
+// it towards the referral, so contracts paid in several releases take a
+// single slot. It returns the part of the fee left for the platform.
+func (k Keeper) payReferralRewards(ctx sdk.Context, params types.Params, contract types.Contract, fee sdk.Coin) (sdk.Coin, error) {
+	if fee.IsZero() || params.ReferralFeeShareBps == 0 {
+		return fee, nil
+	}
+
+	share := fee.Amount.Mul(math.NewIntFromUint64(params.ReferralFeeShareBps)).Quo(math.NewInt(types.BasisPoints))
+	if share.IsZero() {
+		return fee, nil
+	}
+
+	remaining := fee
+	for _, party := range []string{contract.Client, contract.Freelancer} {
+		referral, active, err := k.activeReferral(ctx, params, contract, party)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if !active {
+			continue
+		}
+
+		referrerAddr, err := k.addressCodec.StringToBytes(referral.Referrer)
+		if err != nil {
+			return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid referrer address")
+		}
+		reward := sdk.NewCoin(fee.Denom, share)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, referrerAddr, sdk.NewCoins(reward)); err != nil {
+			return sdk.Coin{}, errorsmod.Wrap(err, "failed to pay referral reward")
+		}
+		remaining = remaining.Sub(reward)
+
+		referral.Earned = referral.Earned.Add(reward)
+		if !slices.Contains(referral.ContractIds, contract.Id) {
+			referral.ContractIds = append(referral.ContractIds, contract.Id)
+			referral.RewardedContracts++
+		}
+		if err := k.Referral.Set(ctx, party, referral); err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to update referral: %v", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"referral_reward_paid",
+				sdk.NewAttribute("referrer", referral.Referrer),
+				sdk.NewAttribute("referred", party),
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+				sdk.NewAttribute("amount", reward.String()),
+			),
+		)
+	}
+
+	return remaining, nil
+}
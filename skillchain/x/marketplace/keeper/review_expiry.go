@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"skillchain/x/marketplace/types"
+)
+
+// ProcessExpiredReviews accepts the deliveries the client did not review within
+// the review period: single payment contracts are completed and the delivered
+// milestone of milestone contracts is approved, exactly as if the client had
+// done it.
+func (k Keeper) ProcessExpiredReviews(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "failed to get params")
+	}
+
+	cutoff := ctx.BlockTime().Unix() - int64(params.ReviewPeriod)
+	due, err := dueEntries(ctx, k.ReviewQueue, cutoff)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "error processing expired reviews")
+	}
+
+	var expired []types.Contract
+	for _, entry := range due {
+		contract, err := k.Contract.Get(ctx, entry.K2())
+		if err == nil && contract.Status == "delivered" && contract.DeliveredAt == entry.K1() {
+			expired = append(expired, contract)
+			continue
+		}
+		// the contract was reviewed or redelivered since it was queued
+		if err := k.ReviewQueue.Remove(ctx, entry); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to dequeue review of contract %d: %v", entry.K2(), err)
+		}
+	}
+
+	for _, contract := range expired {
+		// a contract that cannot be settled must not halt the chain, its
+		// changes are discarded and it is retried on the next block
+		cacheCtx, write := ctx.CacheContext()
+		if len(contract.Milestones) == 0 {
+			err = k.completeContract(cacheCtx, contract)
+		} else {
+			err = k.approveMilestone(cacheCtx, contract)
+		}
+		if err != nil {
+			ctx.Logger().Error("failed to auto-complete contract", "contract_id", contract.Id, "error", err)
+			continue
+		}
+		write()
+		if err := k.ReviewQueue.Remove(ctx, collections.Join(contract.DeliveredAt, contract.Id)); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "failed to dequeue review of contract %d: %v", contract.Id, err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"contract_auto_completed",
+				sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+				sdk.NewAttribute("delivered_at", fmt.Sprintf("%d", contract.DeliveredAt)),
+				sdk.NewAttribute("review_period", fmt.Sprintf("%d", params.ReviewPeriod)),
+			),
+		)
+	}
+
+	return nil
+}
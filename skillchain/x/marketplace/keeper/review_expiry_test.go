@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"skillchain/x/marketplace/keeper"
+	"skillchain/x/marketplace/types"
+)
+
+func TestProcessExpiredReviews(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	contractId, _, freelancerAddr := setupMilestoneContract(t, f)
+	reviewPeriod := time.Duration(types.DefaultReviewPeriod) * time.Second
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000_000, 0))
+	contract, err := f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	_, err = ms.DeliverMilestone(ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 0})
+	require.NoError(t, err)
+
+	// the review window is still open
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(reviewPeriod - time.Second))
+	require.NoError(t, f.keeper.ProcessExpiredReviews(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "delivered", contract.Status)
+	require.True(t, f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").IsZero())
+
+	// the milestone is approved once the window expires
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(t, f.keeper.ProcessExpiredReviews(ctx))
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "active", contract.Status)
+	require.Equal(t, "approved", contract.Milestones[0].Status)
+	require.Equal(t, uint64(1), contract.CurrentMilestone)
+	require.Equal(t, math.NewInt(380), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+
+	_, err = ms.DeliverMilestone(ctx, &types.MsgDeliverMilestone{Creator: contract.Freelancer, ContractId: contractId, MilestoneIndex: 1})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(reviewPeriod))
+	require.NoError(t, f.keeper.ProcessExpiredReviews(ctx))
+
+	contract, err = f.keeper.Contract.Get(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, "completed", contract.Status)
+	require.Equal(t, math.NewInt(950), f.bankKeeper.GetBalance(ctx, freelancerAddr, "skill").Amount)
+
+	profile, err := f.keeper.Profile.Get(ctx, contract.Freelancer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), profile.TotalJobs)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("skill", 950)), profile.TotalEarned)
+
+	// the settled deliveries are no longer queued
+	queued, err := f.keeper.ReviewQueue.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := queued.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	msg, broken := keeper.EscrowBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
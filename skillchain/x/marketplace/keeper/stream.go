@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"skillchain/x/marketplace/types"
+)
+
+// claimStream releases the amount a streaming contract accrued since the last
+// claim to the freelancer. Accrual is computed on demand so streams need no
+// per block processing. The caller saves the contract.
+func (k Keeper) claimStream(ctx sdk.Context, contract *types.Contract) (sdk.Coins, error) {
+	claimable := contract.StreamedAmount(ctx.BlockTime().Unix()).Sub(contract.BilledAmount())
+	if !claimable.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	freelancerCoins, platformFee, feeTier, err := k.releaseEscrow(ctx, *contract, claimable)
+	if err != nil {
+		return nil, err
+	}
+	contract.Billed = contract.BilledAmount().Add(claimable)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"stream_claimed",
+			sdk.NewAttribute("contract_id", fmt.Sprintf("%d", contract.Id)),
+			sdk.NewAttribute("freelancer", contract.Freelancer),
+			sdk.NewAttribute("amount", freelancerCoins.String()),
+			sdk.NewAttribute("platform_fee", platformFee.String()),
+			sdk.NewAttribute("fee_tier", feeTier),
+		),
+	)
+
+	return freelancerCoins, nil
+}
+
+// isFullyStreamed reports whether the whole price of a streaming contract was
+// claimed.
+func isFullyStreamed(contract types.Contract) bool {
+	return contract.BilledAmount().GTE(contract.Price.Amount)
+}
@@ -124,6 +124,106 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-dispute-vote"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "arbiter"}},
 				},
+				{
+					RpcMethod:      "ContractEscrow",
+					Use:            "contract-escrow [contract-id]",
+					Short:          "Query contract-escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+
+				{
+					RpcMethod:      "EscrowsByUser",
+					Use:            "escrows-by-user [user]",
+					Short:          "Query escrows-by-user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user"}},
+				},
+
+				{
+					RpcMethod:      "FeeStats",
+					Use:            "fee-stats",
+					Short:          "Query fee-stats",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
+				},
+
+				{
+					RpcMethod:      "CancellationProposal",
+					Use:            "cancellation-proposal [contract-id]",
+					Short:          "Query cancellation-proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+
+				{
+					RpcMethod:      "TipsByContract",
+					Use:            "tips-by-contract [contract-id]",
+					Short:          "Query tips-by-contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+
+				{
+					RpcMethod:      "TimeLogsByContract",
+					Use:            "time-logs-by-contract [contract-id]",
+					Short:          "Query time-logs-by-contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "StreamAccrual",
+					Use:            "stream-accrual [contract-id]",
+					Short:          "Query stream-accrual",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "Amendment",
+					Use:            "amendment [contract-id]",
+					Short:          "Query amendment",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "FeePreview",
+					Use:            "fee-preview [address] [price]",
+					Short:          "Query fee-preview",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "ReferralEarnings",
+					Use:            "referral-earnings [referrer]",
+					Short:          "Query referral-earnings",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "referrer"}},
+				},
+				{
+					RpcMethod:      "ReferredAccounts",
+					Use:            "referred-accounts [referrer]",
+					Short:          "Query referred-accounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "referrer"}},
+				},
+				{
+					RpcMethod: "EscrowStaking",
+					Use:       "escrow-staking",
+					Short:     "Query escrow-staking",
+				},
+				{
+					RpcMethod:      "CategoryRule",
+					Use:            "category-rule [category]",
+					Short:          "Query category-rule",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "category"}},
+				},
+				{
+					RpcMethod:      "Arbiter",
+					Use:            "arbiter [address]",
+					Short:          "Query arbiter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "ActiveArbiters",
+					Use:       "active-arbiters",
+					Short:     "Query active-arbiters",
+				},
+				{
+					RpcMethod:      "ExtensionsByContract",
+					Use:            "extensions-by-contract [contract-id]",
+					Short:          "Query extensions-by-contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -177,24 +277,6 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete application",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
-				{
-					RpcMethod:      "CreateContract",
-					Use:            "create-contract [gig-id] [application-id] [client] [freelancer] [price] [delivery-deadline] [status] [created-at] [completed-at]",
-					Short:          "Create contract",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gig_id"}, {ProtoField: "application_id"}, {ProtoField: "client"}, {ProtoField: "freelancer"}, {ProtoField: "price"}, {ProtoField: "delivery_deadline"}, {ProtoField: "status"}, {ProtoField: "created_at"}, {ProtoField: "completed_at"}},
-				},
-				{
-					RpcMethod:      "UpdateContract",
-					Use:            "update-contract [id] [gig-id] [application-id] [client] [freelancer] [price] [delivery-deadline] [status] [created-at] [completed-at]",
-					Short:          "Update contract",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "gig_id"}, {ProtoField: "application_id"}, {ProtoField: "client"}, {ProtoField: "freelancer"}, {ProtoField: "price"}, {ProtoField: "delivery_deadline"}, {ProtoField: "status"}, {ProtoField: "created_at"}, {ProtoField: "completed_at"}},
-				},
-				{
-					RpcMethod:      "DeleteContract",
-					Use:            "delete-contract [id]",
-					Short:          "Delete contract",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
-				},
 				{
 					RpcMethod:      "ApplyToGig",
 					Use:            "apply-to-gig [gig-id] [cover-letter] [proposed-price] [proposed-days]",
@@ -251,9 +333,9 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "VoteDispute",
-					Use:            "vote-dispute [dispute-id] [vote]",
+					Use:            "vote-dispute [dispute-id] [commitment]",
 					Short:          "Send a vote-dispute tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "vote"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "commitment"}},
 				},
 				{
 					RpcMethod:      "ResolveDispute",
@@ -261,6 +343,149 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a resolve-dispute tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "winner"}},
 				},
+				{
+					RpcMethod:      "DeliverMilestone",
+					Use:            "deliver-milestone [contract-id] [milestone-index] [delivery-note]",
+					Short:          "Send a deliver-milestone tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "milestone_index"}, {ProtoField: "delivery_note"}},
+				},
+				{
+					RpcMethod:      "ApproveMilestone",
+					Use:            "approve-milestone [contract-id] [milestone-index]",
+					Short:          "Send a approve-milestone tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "milestone_index"}},
+				},
+				{
+					RpcMethod:      "CancelOverdueContract",
+					Use:            "cancel-overdue-contract [contract-id] [reopen-gig]",
+					Short:          "Send a cancel-overdue-contract tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "reopen_gig"}},
+				},
+				{
+					RpcMethod:      "ProposeCancellation",
+					Use:            "propose-cancellation [contract-id] [freelancer-payout] [reason]",
+					Short:          "Send a propose-cancellation tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "freelancer_payout"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "AcceptCancellation",
+					Use:            "accept-cancellation [contract-id]",
+					Short:          "Send a accept-cancellation tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "RejectCancellation",
+					Use:            "reject-cancellation [contract-id]",
+					Short:          "Send a reject-cancellation tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "TipFreelancer",
+					Use:            "tip-freelancer [contract-id] [amount] [note]",
+					Short:          "Send a tip-freelancer tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "amount"}, {ProtoField: "note"}},
+				},
+				{
+					RpcMethod:      "LogTime",
+					Use:            "log-time [contract-id] [hours] [description-hash]",
+					Short:          "Send a log-time tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "hours"}, {ProtoField: "description_hash"}},
+				},
+				{
+					RpcMethod:      "ContestTimeLog",
+					Use:            "contest-time-log [time-log-id] [reason]",
+					Short:          "Send a contest-time-log tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "time_log_id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "FundHourlyContract",
+					Use:            "fund-hourly-contract [contract-id] [amount]",
+					Short:          "Send a fund-hourly-contract tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ClaimStream",
+					Use:            "claim-stream [contract-id]",
+					Short:          "Send a claim-stream tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "StopStream",
+					Use:            "stop-stream [contract-id]",
+					Short:          "Send a stop-stream tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "FlagApplicationSpam",
+					Use:            "flag-application-spam [application-id]",
+					Short:          "Send a flag-application-spam tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "application_id"}},
+				},
+				{
+					RpcMethod:      "ProposeAmendment",
+					Use:            "propose-amendment [contract-id]",
+					Short:          "Send a propose-amendment tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "AcceptAmendment",
+					Use:            "accept-amendment [contract-id]",
+					Short:          "Send a accept-amendment tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}},
+				},
+				{
+					RpcMethod:      "RequestDeadlineExtension",
+					Use:            "request-deadline-extension [contract-id] [new-deadline] [reason]",
+					Short:          "Send a request-deadline-extension tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "new_deadline"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "ApproveDeadlineExtension",
+					Use:            "approve-deadline-extension [extension-id]",
+					Short:          "Send a approve-deadline-extension tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "extension_id"}},
+				},
+				{
+					RpcMethod:      "DeclineDeadlineExtension",
+					Use:            "decline-deadline-extension [extension-id]",
+					Short:          "Send a decline-deadline-extension tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "extension_id"}},
+				},
+				{
+					RpcMethod:      "SetEscrowStaking",
+					Use:            "set-escrow-staking [contract-id] [enabled]",
+					Short:          "Send a set-escrow-staking tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_id"}, {ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "RegisterArbiter",
+					Use:            "register-arbiter [stake]",
+					Short:          "Send a register-arbiter tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stake"}},
+				},
+				{
+					RpcMethod: "UnbondArbiter",
+					Use:       "unbond-arbiter",
+					Short:     "Send a unbond-arbiter tx",
+				},
+				{
+					RpcMethod:      "RevealDisputeVote",
+					Use:            "reveal-dispute-vote [dispute-id] [freelancer-payout-bps] [salt]",
+					Short:          "Send a reveal-dispute-vote tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "freelancer_payout_bps"}, {ProtoField: "salt"}},
+				},
+				{
+					RpcMethod:      "AppealDispute",
+					Use:            "appeal-dispute [dispute-id] [reason]",
+					Short:          "Send a appeal-dispute tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "ContestDispute",
+					Use:            "contest-dispute [dispute-id]",
+					Short:          "Send a contest-dispute tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "dispute_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"skillchain/x/marketplace/keeper"
 	"skillchain/x/marketplace/types"
@@ -36,6 +37,8 @@ type ModuleInputs struct {
 	AuthKeeper    types.AuthKeeper
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	DistrKeeper   types.DistributionKeeper
+	StakingKeeper types.StakingKeeper
 }
 
 type ModuleOutputs struct {
@@ -43,6 +46,7 @@ type ModuleOutputs struct {
 
 	MarketplaceKeeper keeper.Keeper
 	Module            appmodule.AppModule
+	EpochHooks        epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -58,8 +62,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.BankKeeper,
 		in.AccountKeeper,
+		in.DistrKeeper,
+		in.StakingKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{MarketplaceKeeper: k, Module: m}
+	return ModuleOutputs{MarketplaceKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()}}
 }
@@ -12,7 +12,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
-	"google.golang.org/grpc"
 
 	"skillchain/x/marketplace/keeper"
 	"skillchain/x/marketplace/types"
@@ -22,6 +21,8 @@ var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
 	_ module.AppModule      = (*AppModule)(nil)
 	_ module.HasGenesis     = (*AppModule)(nil)
+	_ module.HasServices    = (*AppModule)(nil)
+	_ module.HasInvariants  = (*AppModule)(nil)
 
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
@@ -73,12 +74,92 @@ func (AppModule) RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registrar)
 }
 
-// RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
-func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
-	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
+// RegisterServices registers the module gRPC services and its store migrations.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 
-	return nil
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 1 to 2: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 2 to 3: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 3 to 4: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 4 to 5: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 5 to 6: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 6 to 7: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 7 to 8: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 8 to 9: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 9 to 10: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 10, m.Migrate10to11); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 10 to 11: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 11, m.Migrate11to12); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 11 to 12: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 12, m.Migrate12to13); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 12 to 13: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 13, m.Migrate13to14); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 13 to 14: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 14, m.Migrate14to15); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 14 to 15: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 15, m.Migrate15to16); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 15 to 16: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 16, m.Migrate16to17); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 16 to 17: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 17, m.Migrate17to18); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 17 to 18: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 18, m.Migrate18to19); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 18 to 19: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 19, m.Migrate19to20); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 19 to 20: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 20, m.Migrate20to21); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 20 to 21: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 21, m.Migrate21to22); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 21 to 22: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 22, m.Migrate22to23); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 22 to 23: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 23, m.Migrate23to24); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 23 to 24: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 24, m.Migrate24to25); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 24 to 25: %w", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 25, m.Migrate25to26); err != nil {
+		panic(fmt.Errorf("failed to migrate %s from version 25 to 26: %w", types.ModuleName, err))
+	}
+}
+
+// RegisterInvariants registers the marketplace module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // DefaultGenesis returns a default GenesisState for the module, marshalled to json.RawMessage.
@@ -128,7 +209,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 26 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -140,5 +221,26 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 // The end block implementation is optional.
 func (am AppModule) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	return am.keeper.ProcessExpiredDisputes(sdkCtx)
+	if err := am.keeper.ProcessExpiredReviews(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessOverdueContracts(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessExpiredCancellations(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessUnbondedArbiters(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessResponseDeadlines(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessJurorDeadlines(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessExpiredDisputes(sdkCtx); err != nil {
+		return err
+	}
+	return am.keeper.ProcessAppealDeadlines(sdkCtx)
 }
@@ -137,51 +137,6 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgDeleteApplication,
 		marketplacesimulation.SimulateMsgDeleteApplication(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
-	const (
-		opWeightMsgCreateContract          = "op_weight_msg_marketplace"
-		defaultWeightMsgCreateContract int = 100
-	)
-
-	var weightMsgCreateContract int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateContract, &weightMsgCreateContract, nil,
-		func(_ *rand.Rand) {
-			weightMsgCreateContract = defaultWeightMsgCreateContract
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateContract,
-		marketplacesimulation.SimulateMsgCreateContract(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgUpdateContract          = "op_weight_msg_marketplace"
-		defaultWeightMsgUpdateContract int = 100
-	)
-
-	var weightMsgUpdateContract int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateContract, &weightMsgUpdateContract, nil,
-		func(_ *rand.Rand) {
-			weightMsgUpdateContract = defaultWeightMsgUpdateContract
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateContract,
-		marketplacesimulation.SimulateMsgUpdateContract(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
-	const (
-		opWeightMsgDeleteContract          = "op_weight_msg_marketplace"
-		defaultWeightMsgDeleteContract int = 100
-	)
-
-	var weightMsgDeleteContract int
-	simState.AppParams.GetOrGenerate(opWeightMsgDeleteContract, &weightMsgDeleteContract, nil,
-		func(_ *rand.Rand) {
-			weightMsgDeleteContract = defaultWeightMsgDeleteContract
-		},
-	)
-	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgDeleteContract,
-		marketplacesimulation.SimulateMsgDeleteContract(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
-	))
 	const (
 		opWeightMsgApplyToGig          = "op_weight_msg_marketplace"
 		defaultWeightMsgApplyToGig int = 100
@@ -347,6 +302,366 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgResolveDispute,
 		marketplacesimulation.SimulateMsgResolveDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgDeliverMilestone          = "op_weight_msg_marketplace"
+		defaultWeightMsgDeliverMilestone int = 100
+	)
+
+	var weightMsgDeliverMilestone int
+	simState.AppParams.GetOrGenerate(opWeightMsgDeliverMilestone, &weightMsgDeliverMilestone, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeliverMilestone = defaultWeightMsgDeliverMilestone
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeliverMilestone,
+		marketplacesimulation.SimulateMsgDeliverMilestone(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgApproveMilestone          = "op_weight_msg_marketplace"
+		defaultWeightMsgApproveMilestone int = 100
+	)
+
+	var weightMsgApproveMilestone int
+	simState.AppParams.GetOrGenerate(opWeightMsgApproveMilestone, &weightMsgApproveMilestone, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveMilestone = defaultWeightMsgApproveMilestone
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgApproveMilestone,
+		marketplacesimulation.SimulateMsgApproveMilestone(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCancelOverdueContract          = "op_weight_msg_marketplace"
+		defaultWeightMsgCancelOverdueContract int = 100
+	)
+
+	var weightMsgCancelOverdueContract int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelOverdueContract, &weightMsgCancelOverdueContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelOverdueContract = defaultWeightMsgCancelOverdueContract
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelOverdueContract,
+		marketplacesimulation.SimulateMsgCancelOverdueContract(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgProposeCancellation          = "op_weight_msg_marketplace"
+		defaultWeightMsgProposeCancellation int = 100
+	)
+
+	var weightMsgProposeCancellation int
+	simState.AppParams.GetOrGenerate(opWeightMsgProposeCancellation, &weightMsgProposeCancellation, nil,
+		func(_ *rand.Rand) {
+			weightMsgProposeCancellation = defaultWeightMsgProposeCancellation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgProposeCancellation,
+		marketplacesimulation.SimulateMsgProposeCancellation(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgAcceptCancellation          = "op_weight_msg_marketplace"
+		defaultWeightMsgAcceptCancellation int = 100
+	)
+
+	var weightMsgAcceptCancellation int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptCancellation, &weightMsgAcceptCancellation, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptCancellation = defaultWeightMsgAcceptCancellation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptCancellation,
+		marketplacesimulation.SimulateMsgAcceptCancellation(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRejectCancellation          = "op_weight_msg_marketplace"
+		defaultWeightMsgRejectCancellation int = 100
+	)
+
+	var weightMsgRejectCancellation int
+	simState.AppParams.GetOrGenerate(opWeightMsgRejectCancellation, &weightMsgRejectCancellation, nil,
+		func(_ *rand.Rand) {
+			weightMsgRejectCancellation = defaultWeightMsgRejectCancellation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRejectCancellation,
+		marketplacesimulation.SimulateMsgRejectCancellation(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgTipFreelancer          = "op_weight_msg_marketplace"
+		defaultWeightMsgTipFreelancer int = 100
+	)
+
+	var weightMsgTipFreelancer int
+	simState.AppParams.GetOrGenerate(opWeightMsgTipFreelancer, &weightMsgTipFreelancer, nil,
+		func(_ *rand.Rand) {
+			weightMsgTipFreelancer = defaultWeightMsgTipFreelancer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTipFreelancer,
+		marketplacesimulation.SimulateMsgTipFreelancer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgLogTime          = "op_weight_msg_marketplace"
+		defaultWeightMsgLogTime int = 100
+	)
+
+	var weightMsgLogTime int
+	simState.AppParams.GetOrGenerate(opWeightMsgLogTime, &weightMsgLogTime, nil,
+		func(_ *rand.Rand) {
+			weightMsgLogTime = defaultWeightMsgLogTime
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgLogTime,
+		marketplacesimulation.SimulateMsgLogTime(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgContestTimeLog          = "op_weight_msg_marketplace"
+		defaultWeightMsgContestTimeLog int = 100
+	)
+
+	var weightMsgContestTimeLog int
+	simState.AppParams.GetOrGenerate(opWeightMsgContestTimeLog, &weightMsgContestTimeLog, nil,
+		func(_ *rand.Rand) {
+			weightMsgContestTimeLog = defaultWeightMsgContestTimeLog
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgContestTimeLog,
+		marketplacesimulation.SimulateMsgContestTimeLog(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgFundHourlyContract          = "op_weight_msg_marketplace"
+		defaultWeightMsgFundHourlyContract int = 100
+	)
+
+	var weightMsgFundHourlyContract int
+	simState.AppParams.GetOrGenerate(opWeightMsgFundHourlyContract, &weightMsgFundHourlyContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundHourlyContract = defaultWeightMsgFundHourlyContract
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFundHourlyContract,
+		marketplacesimulation.SimulateMsgFundHourlyContract(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgClaimStream          = "op_weight_msg_marketplace"
+		defaultWeightMsgClaimStream int = 100
+	)
+
+	var weightMsgClaimStream int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimStream, &weightMsgClaimStream, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimStream = defaultWeightMsgClaimStream
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimStream,
+		marketplacesimulation.SimulateMsgClaimStream(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgStopStream          = "op_weight_msg_marketplace"
+		defaultWeightMsgStopStream int = 100
+	)
+
+	var weightMsgStopStream int
+	simState.AppParams.GetOrGenerate(opWeightMsgStopStream, &weightMsgStopStream, nil,
+		func(_ *rand.Rand) {
+			weightMsgStopStream = defaultWeightMsgStopStream
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgStopStream,
+		marketplacesimulation.SimulateMsgStopStream(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgFlagApplicationSpam          = "op_weight_msg_marketplace"
+		defaultWeightMsgFlagApplicationSpam int = 100
+	)
+
+	var weightMsgFlagApplicationSpam int
+	simState.AppParams.GetOrGenerate(opWeightMsgFlagApplicationSpam, &weightMsgFlagApplicationSpam, nil,
+		func(_ *rand.Rand) {
+			weightMsgFlagApplicationSpam = defaultWeightMsgFlagApplicationSpam
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFlagApplicationSpam,
+		marketplacesimulation.SimulateMsgFlagApplicationSpam(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgProposeAmendment          = "op_weight_msg_marketplace"
+		defaultWeightMsgProposeAmendment int = 100
+	)
+
+	var weightMsgProposeAmendment int
+	simState.AppParams.GetOrGenerate(opWeightMsgProposeAmendment, &weightMsgProposeAmendment, nil,
+		func(_ *rand.Rand) {
+			weightMsgProposeAmendment = defaultWeightMsgProposeAmendment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgProposeAmendment,
+		marketplacesimulation.SimulateMsgProposeAmendment(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgAcceptAmendment          = "op_weight_msg_marketplace"
+		defaultWeightMsgAcceptAmendment int = 100
+	)
+
+	var weightMsgAcceptAmendment int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptAmendment, &weightMsgAcceptAmendment, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptAmendment = defaultWeightMsgAcceptAmendment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptAmendment,
+		marketplacesimulation.SimulateMsgAcceptAmendment(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRequestDeadlineExtension          = "op_weight_msg_marketplace"
+		defaultWeightMsgRequestDeadlineExtension int = 100
+	)
+
+	var weightMsgRequestDeadlineExtension int
+	simState.AppParams.GetOrGenerate(opWeightMsgRequestDeadlineExtension, &weightMsgRequestDeadlineExtension, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestDeadlineExtension = defaultWeightMsgRequestDeadlineExtension
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRequestDeadlineExtension,
+		marketplacesimulation.SimulateMsgRequestDeadlineExtension(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgApproveDeadlineExtension          = "op_weight_msg_marketplace"
+		defaultWeightMsgApproveDeadlineExtension int = 100
+	)
+
+	var weightMsgApproveDeadlineExtension int
+	simState.AppParams.GetOrGenerate(opWeightMsgApproveDeadlineExtension, &weightMsgApproveDeadlineExtension, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveDeadlineExtension = defaultWeightMsgApproveDeadlineExtension
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgApproveDeadlineExtension,
+		marketplacesimulation.SimulateMsgApproveDeadlineExtension(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgDeclineDeadlineExtension          = "op_weight_msg_marketplace"
+		defaultWeightMsgDeclineDeadlineExtension int = 100
+	)
+
+	var weightMsgDeclineDeadlineExtension int
+	simState.AppParams.GetOrGenerate(opWeightMsgDeclineDeadlineExtension, &weightMsgDeclineDeadlineExtension, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeclineDeadlineExtension = defaultWeightMsgDeclineDeadlineExtension
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeclineDeadlineExtension,
+		marketplacesimulation.SimulateMsgDeclineDeadlineExtension(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgSetEscrowStaking          = "op_weight_msg_marketplace"
+		defaultWeightMsgSetEscrowStaking int = 100
+	)
+
+	var weightMsgSetEscrowStaking int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetEscrowStaking, &weightMsgSetEscrowStaking, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetEscrowStaking = defaultWeightMsgSetEscrowStaking
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetEscrowStaking,
+		marketplacesimulation.SimulateMsgSetEscrowStaking(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRegisterArbiter          = "op_weight_msg_marketplace"
+		defaultWeightMsgRegisterArbiter int = 100
+	)
+
+	var weightMsgRegisterArbiter int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterArbiter, &weightMsgRegisterArbiter, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterArbiter = defaultWeightMsgRegisterArbiter
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterArbiter,
+		marketplacesimulation.SimulateMsgRegisterArbiter(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUnbondArbiter          = "op_weight_msg_marketplace"
+		defaultWeightMsgUnbondArbiter int = 100
+	)
+
+	var weightMsgUnbondArbiter int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnbondArbiter, &weightMsgUnbondArbiter, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnbondArbiter = defaultWeightMsgUnbondArbiter
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnbondArbiter,
+		marketplacesimulation.SimulateMsgUnbondArbiter(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRevealDisputeVote          = "op_weight_msg_marketplace"
+		defaultWeightMsgRevealDisputeVote int = 100
+	)
+
+	var weightMsgRevealDisputeVote int
+	simState.AppParams.GetOrGenerate(opWeightMsgRevealDisputeVote, &weightMsgRevealDisputeVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevealDisputeVote = defaultWeightMsgRevealDisputeVote
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRevealDisputeVote,
+		marketplacesimulation.SimulateMsgRevealDisputeVote(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgAppealDispute          = "op_weight_msg_marketplace"
+		defaultWeightMsgAppealDispute int = 100
+	)
+
+	var weightMsgAppealDispute int
+	simState.AppParams.GetOrGenerate(opWeightMsgAppealDispute, &weightMsgAppealDispute, nil,
+		func(_ *rand.Rand) {
+			weightMsgAppealDispute = defaultWeightMsgAppealDispute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAppealDispute,
+		marketplacesimulation.SimulateMsgAppealDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgContestDispute          = "op_weight_msg_marketplace"
+		defaultWeightMsgContestDispute int = 100
+	)
+
+	var weightMsgContestDispute int
+	simState.AppParams.GetOrGenerate(opWeightMsgContestDispute, &weightMsgContestDispute, nil,
+		func(_ *rand.Rand) {
+			weightMsgContestDispute = defaultWeightMsgContestDispute
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgContestDispute,
+		marketplacesimulation.SimulateMsgContestDispute(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 
 	return operations
 }
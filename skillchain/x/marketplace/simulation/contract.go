@@ -1,163 +0,0 @@
-package simulation
-
-import (
-	"math/rand"
-
-	"github.com/cosmos/cosmos-sdk/baseapp"
-	"github.com/cosmos/cosmos-sdk/client"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
-
-	"skillchain/x/marketplace/keeper"
-	"skillchain/x/marketplace/types"
-)
-
-func SimulateMsgCreateContract(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		msg := &types.MsgCreateContract{
-			Creator: simAccount.Address.String(),
-		}
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
-func SimulateMsgUpdateContract(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount = simtypes.Account{}
-			contract   = types.Contract{}
-			msg        = &types.MsgUpdateContract{}
-			found      = false
-		)
-
-		var allContract []types.Contract
-		err := k.Contract.Walk(ctx, nil, func(key uint64, value types.Contract) (stop bool, err error) {
-			allContract = append(allContract, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allContract {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				contract = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "contract creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Id = contract.Id
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
-func SimulateMsgDeleteContract(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount = simtypes.Account{}
-			contract   = types.Contract{}
-			msg        = &types.MsgDeleteContract{}
-			found      = false
-		)
-
-		var allContract []types.Contract
-		err := k.Contract.Walk(ctx, nil, func(key uint64, value types.Contract) (stop bool, err error) {
-			allContract = append(allContract, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allContract {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				contract = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "contract creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Id = contract.Id
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
@@ -0,0 +1,890 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/amendment.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Amendment is a pending change of the terms of a contract proposed by one of
+// its parties. It applies once the other party accepts it. Fields left empty
+// keep the current terms.
+type Amendment struct {
+	ContractId       uint64     `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Proposer         string     `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Price            types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	DeliveryDeadline int64      `protobuf:"varint,4,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	DescriptionHash  string     `protobuf:"bytes,5,opt,name=description_hash,json=descriptionHash,proto3" json:"description_hash,omitempty"`
+	Reason           string     `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt        int64      `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *Amendment) Reset()         { *m = Amendment{} }
+func (m *Amendment) String() string { return proto.CompactTextString(m) }
+func (*Amendment) ProtoMessage()    {}
+func (*Amendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9256793f8141697, []int{0}
+}
+func (m *Amendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Amendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Amendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Amendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Amendment.Merge(m, src)
+}
+func (m *Amendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Amendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Amendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Amendment proto.InternalMessageInfo
+
+func (m *Amendment) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *Amendment) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Amendment) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Amendment) GetDeliveryDeadline() int64 {
+	if m != nil {
+		return m.DeliveryDeadline
+	}
+	return 0
+}
+
+func (m *Amendment) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
+}
+
+func (m *Amendment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Amendment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// ContractTerms records the terms of a contract replaced by an amendment.
+type ContractTerms struct {
+	Price            types.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	DeliveryDeadline int64      `protobuf:"varint,2,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	DescriptionHash  string     `protobuf:"bytes,3,opt,name=description_hash,json=descriptionHash,proto3" json:"description_hash,omitempty"`
+	// Time the terms were replaced.
+	AmendedAt int64 `protobuf:"varint,4,opt,name=amended_at,json=amendedAt,proto3" json:"amended_at,omitempty"`
+}
+
+func (m *ContractTerms) Reset()         { *m = ContractTerms{} }
+func (m *ContractTerms) String() string { return proto.CompactTextString(m) }
+func (*ContractTerms) ProtoMessage()    {}
+func (*ContractTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9256793f8141697, []int{1}
+}
+func (m *ContractTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTerms.Merge(m, src)
+}
+func (m *ContractTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractTerms proto.InternalMessageInfo
+
+func (m *ContractTerms) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *ContractTerms) GetDeliveryDeadline() int64 {
+	if m != nil {
+		return m.DeliveryDeadline
+	}
+	return 0
+}
+
+func (m *ContractTerms) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
+}
+
+func (m *ContractTerms) GetAmendedAt() int64 {
+	if m != nil {
+		return m.AmendedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Amendment)(nil), "skillchain.marketplace.v1.Amendment")
+	proto.RegisterType((*ContractTerms)(nil), "skillchain.marketplace.v1.ContractTerms")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/amendment.proto", fileDescriptor_a9256793f8141697)
+}
+
+var fileDescriptor_a9256793f8141697 = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0xca, 0xd3, 0x40,
+	0x14, 0x85, 0x33, 0x6d, 0xff, 0x6a, 0xe6, 0x47, 0xfc, 0x1d, 0x44, 0xd2, 0x42, 0xa7, 0xa1, 0xab,
+	0x14, 0x21, 0xa1, 0x8a, 0xe0, 0xb6, 0xad, 0x0b, 0xdd, 0x06, 0x57, 0x6e, 0xc2, 0x74, 0xe6, 0xd2,
+	0x0c, 0x4d, 0x66, 0xc2, 0xcc, 0x50, 0xec, 0x4b, 0x88, 0x2f, 0xe4, 0xbe, 0xcb, 0x2e, 0x5d, 0x89,
+	0xb4, 0x2f, 0x22, 0x4d, 0x52, 0x5b, 0xc1, 0x8d, 0xb8, 0x9b, 0xfb, 0xe5, 0x5c, 0xee, 0x39, 0xe1,
+	0xe0, 0xa9, 0xdd, 0xc8, 0xa2, 0xe0, 0x39, 0x93, 0x2a, 0x29, 0x99, 0xd9, 0x80, 0xab, 0x0a, 0xc6,
+	0x21, 0xd9, 0xce, 0x12, 0x56, 0x82, 0x12, 0x25, 0x28, 0x17, 0x57, 0x46, 0x3b, 0x4d, 0x06, 0x57,
+	0x69, 0x7c, 0x23, 0x8d, 0xb7, 0xb3, 0x21, 0xe5, 0xda, 0x96, 0xda, 0x26, 0x2b, 0x66, 0xcf, 0xab,
+	0x2b, 0x70, 0x6c, 0x96, 0x70, 0x2d, 0x55, 0xb3, 0x3a, 0x7c, 0xbe, 0xd6, 0x6b, 0x5d, 0x3f, 0x93,
+	0xf3, 0xab, 0xa1, 0x93, 0x2f, 0x1d, 0xec, 0xcf, 0x2f, 0x47, 0xc8, 0x18, 0xdf, 0x73, 0xad, 0x9c,
+	0x61, 0xdc, 0x65, 0x52, 0x04, 0x28, 0x44, 0x51, 0x2f, 0xc5, 0x17, 0xf4, 0x41, 0x90, 0x21, 0x7e,
+	0x5c, 0x19, 0x5d, 0x69, 0x0b, 0x26, 0xe8, 0x84, 0x28, 0xf2, 0xd3, 0xdf, 0x33, 0x79, 0x83, 0xef,
+	0x2a, 0x23, 0x39, 0x04, 0xdd, 0x10, 0x45, 0xf7, 0xaf, 0x06, 0x71, 0x63, 0x28, 0x3e, 0x1b, 0x8a,
+	0x5b, 0x43, 0xf1, 0x52, 0x4b, 0xb5, 0xe8, 0xed, 0x7f, 0x8c, 0xbd, 0xb4, 0x51, 0x93, 0x97, 0xf8,
+	0x99, 0x80, 0x42, 0x6e, 0xc1, 0xec, 0x32, 0x01, 0x4c, 0x14, 0x52, 0x41, 0xd0, 0x0b, 0x51, 0xd4,
+	0x4d, 0x1f, 0x2e, 0x1f, 0xde, 0xb5, 0x9c, 0x4c, 0xf1, 0x83, 0x00, 0xcb, 0x8d, 0xac, 0x9c, 0xd4,
+	0x2a, 0xcb, 0x99, 0xcd, 0x83, 0xbb, 0xda, 0xc7, 0xd3, 0x1b, 0xfe, 0x9e, 0xd9, 0x9c, 0xbc, 0xc0,
+	0x7d, 0x03, 0xcc, 0x6a, 0x15, 0xf4, 0x6b, 0x41, 0x3b, 0x91, 0x11, 0xc6, 0xdc, 0x00, 0x73, 0x20,
+	0x32, 0xe6, 0x82, 0x47, 0xf5, 0x21, 0xbf, 0x25, 0x73, 0x37, 0xf9, 0x86, 0xf0, 0x93, 0x65, 0x1b,
+	0xf8, 0x23, 0x98, 0xd2, 0x5e, 0x73, 0xa1, 0xff, 0xcf, 0xd5, 0xf9, 0x87, 0x5c, 0xdd, 0xbf, 0xe7,
+	0x1a, 0x61, 0x5c, 0xb7, 0xa2, 0xf1, 0xdf, 0xfc, 0x28, 0xbf, 0x25, 0x73, 0xb7, 0x78, 0xbb, 0x3f,
+	0x52, 0x74, 0x38, 0x52, 0xf4, 0xf3, 0x48, 0xd1, 0xd7, 0x13, 0xf5, 0x0e, 0x27, 0xea, 0x7d, 0x3f,
+	0x51, 0xef, 0x13, 0xbd, 0xa9, 0xd9, 0xe7, 0x3f, 0x8a, 0xe6, 0x76, 0x15, 0xd8, 0x55, 0xbf, 0x6e,
+	0xc4, 0xeb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x08, 0x72, 0x0e, 0x42, 0x8f, 0x02, 0x00, 0x00,
+}
+
+func (m *Amendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Amendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Amendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintAmendment(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAmendment(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DescriptionHash) > 0 {
+		i -= len(m.DescriptionHash)
+		copy(dAtA[i:], m.DescriptionHash)
+		i = encodeVarintAmendment(dAtA, i, uint64(len(m.DescriptionHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DeliveryDeadline != 0 {
+		i = encodeVarintAmendment(dAtA, i, uint64(m.DeliveryDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAmendment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintAmendment(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintAmendment(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmendedAt != 0 {
+		i = encodeVarintAmendment(dAtA, i, uint64(m.AmendedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DescriptionHash) > 0 {
+		i -= len(m.DescriptionHash)
+		copy(dAtA[i:], m.DescriptionHash)
+		i = encodeVarintAmendment(dAtA, i, uint64(len(m.DescriptionHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DeliveryDeadline != 0 {
+		i = encodeVarintAmendment(dAtA, i, uint64(m.DeliveryDeadline))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAmendment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAmendment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAmendment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Amendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovAmendment(uint64(m.ContractId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovAmendment(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovAmendment(uint64(l))
+	if m.DeliveryDeadline != 0 {
+		n += 1 + sovAmendment(uint64(m.DeliveryDeadline))
+	}
+	l = len(m.DescriptionHash)
+	if l > 0 {
+		n += 1 + l + sovAmendment(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAmendment(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAmendment(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *ContractTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovAmendment(uint64(l))
+	if m.DeliveryDeadline != 0 {
+		n += 1 + sovAmendment(uint64(m.DeliveryDeadline))
+	}
+	l = len(m.DescriptionHash)
+	if l > 0 {
+		n += 1 + l + sovAmendment(uint64(l))
+	}
+	if m.AmendedAt != 0 {
+		n += 1 + sovAmendment(uint64(m.AmendedAt))
+	}
+	return n
+}
+
+func sovAmendment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAmendment(x uint64) (n int) {
+	return sovAmendment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Amendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmendment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Amendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Amendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
+			}
+			m.DeliveryDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmendment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAmendment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
+			}
+			m.DeliveryDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendedAt", wireType)
+			}
+			m.AmendedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmendedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAmendment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAmendment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAmendment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAmendment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAmendment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAmendment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAmendment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAmendment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAmendment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAmendment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAmendment = fmt.Errorf("proto: unexpected end of group")
+)
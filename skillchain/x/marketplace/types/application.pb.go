@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,15 +28,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Application defines the Application message.
 type Application struct {
-	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	GigId         uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	Freelancer    string `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
-	CoverLetter   string `protobuf:"bytes,4,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	ProposedPrice uint64 `protobuf:"varint,5,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price,omitempty"`
-	ProposedDays  uint64 `protobuf:"varint,6,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
-	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Creator       string `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GigId       uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
+	Freelancer  string `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	CoverLetter string `protobuf:"bytes,4,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	// Deprecated: replaced by proposed_price, only read by the store migration.
+	LegacyProposedPrice uint64 `protobuf:"varint,5,opt,name=legacy_proposed_price,json=legacyProposedPrice,proto3" json:"legacy_proposed_price,omitempty"`
+	ProposedDays        uint64 `protobuf:"varint,6,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
+	Status              string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt           int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Creator             string `protobuf:"bytes,9,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Milestones proposed by the freelancer, copied to the contract on acceptance.
+	Milestones    []Milestone `protobuf:"bytes,10,rep,name=milestones,proto3" json:"milestones"`
+	ProposedPrice types.Coin  `protobuf:"bytes,11,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price"`
+	// Rate billed per hour logged, in the proposed price denom. Only set on
+	// hourly applications.
+	HourlyRate cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=hourly_rate,json=hourlyRate,proto3,customtype=cosmossdk.io/math.Int" json:"hourly_rate"`
+	// Maximum hours billed per billing period. An application is hourly when it
+	// is set, the proposed price is then the weekly cap funded by the client.
+	WeeklyHoursCap uint64 `protobuf:"varint,13,opt,name=weekly_hours_cap,json=weeklyHoursCap,proto3" json:"weekly_hours_cap,omitempty"`
+	// Release the proposed price linearly over the proposed days instead of
+	// on delivery.
+	Streaming bool `protobuf:"varint,14,opt,name=streaming,proto3" json:"streaming,omitempty"`
+	// Bond locked by the freelancer while the application is pending.
+	Bond types.Coin `protobuf:"bytes,15,opt,name=bond,proto3" json:"bond"`
 }
 
 func (m *Application) Reset()         { *m = Application{} }
@@ -96,9 +115,9 @@ func (m *Application) GetCoverLetter() string {
 	return ""
 }
 
-func (m *Application) GetProposedPrice() uint64 {
+func (m *Application) GetLegacyProposedPrice() uint64 {
 	if m != nil {
-		return m.ProposedPrice
+		return m.LegacyProposedPrice
 	}
 	return 0
 }
@@ -131,6 +150,41 @@ func (m *Application) GetCreator() string {
 	return ""
 }
 
+func (m *Application) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Application) GetProposedPrice() types.Coin {
+	if m != nil {
+		return m.ProposedPrice
+	}
+	return types.Coin{}
+}
+
+func (m *Application) GetWeeklyHoursCap() uint64 {
+	if m != nil {
+		return m.WeeklyHoursCap
+	}
+	return 0
+}
+
+func (m *Application) GetStreaming() bool {
+	if m != nil {
+		return m.Streaming
+	}
+	return false
+}
+
+func (m *Application) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Application)(nil), "skillchain.marketplace.v1.Application")
 }
@@ -140,26 +194,41 @@ func init() {
 }
 
 var fileDescriptor_ed954d196966b03a = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0xb1, 0x4a, 0x43, 0x31,
-	0x14, 0x06, 0xe0, 0xe6, 0xb6, 0xbd, 0xb5, 0xa7, 0xb5, 0x43, 0x40, 0x89, 0x83, 0xa1, 0x2a, 0x42,
-	0x41, 0x68, 0x29, 0x2e, 0xae, 0x15, 0x17, 0xc1, 0x41, 0x3a, 0xba, 0x5c, 0x62, 0x72, 0xbc, 0x86,
-	0xc6, 0x26, 0x24, 0xb1, 0xd8, 0xb7, 0x70, 0xf0, 0xa1, 0x1c, 0x3b, 0x3a, 0x4a, 0xfb, 0x22, 0x62,
-	0x68, 0x6d, 0x1d, 0xff, 0x8f, 0xff, 0x70, 0xe0, 0x87, 0x8b, 0x30, 0xd1, 0xc6, 0xc8, 0x67, 0xa1,
-	0xa7, 0x83, 0x17, 0xe1, 0x27, 0x18, 0x9d, 0x11, 0x12, 0x07, 0xb3, 0xe1, 0x40, 0x38, 0x67, 0xb4,
-	0x14, 0x51, 0xdb, 0x69, 0xdf, 0x79, 0x1b, 0x2d, 0x3d, 0xda, 0x96, 0xfb, 0x3b, 0xe5, 0xfe, 0x6c,
-	0x78, 0xfa, 0x91, 0x41, 0x6b, 0xb4, 0x3d, 0xa0, 0x1d, 0xc8, 0xb4, 0x62, 0xa4, 0x4b, 0x7a, 0xb5,
-	0x71, 0xa6, 0x15, 0x3d, 0x80, 0xbc, 0xd4, 0x65, 0xa1, 0x15, 0xcb, 0x92, 0xd5, 0x4b, 0x5d, 0xde,
-	0x2a, 0xca, 0x01, 0x9e, 0x3c, 0xa2, 0x11, 0x53, 0x89, 0x9e, 0x55, 0xbb, 0xa4, 0xd7, 0x1c, 0xef,
-	0x08, 0x3d, 0x81, 0xb6, 0xb4, 0x33, 0xf4, 0x85, 0xc1, 0x18, 0xd1, 0xb3, 0x5a, 0x6a, 0xb4, 0x92,
-	0xdd, 0x25, 0xa2, 0xe7, 0xd0, 0x71, 0xde, 0x3a, 0x1b, 0x50, 0x15, 0xce, 0x6b, 0x89, 0xac, 0x9e,
-	0x3e, 0xec, 0x6f, 0xf4, 0xfe, 0x17, 0xe9, 0x19, 0xfc, 0x41, 0xa1, 0xc4, 0x3c, 0xb0, 0x3c, 0xb5,
-	0xda, 0x1b, 0xbc, 0x11, 0xf3, 0x40, 0x0f, 0x21, 0x0f, 0x51, 0xc4, 0xd7, 0xc0, 0x1a, 0xe9, 0xd1,
-	0x3a, 0xd1, 0x63, 0x00, 0xe9, 0x51, 0x44, 0x54, 0x85, 0x88, 0x6c, 0xaf, 0x4b, 0x7a, 0xd5, 0x71,
-	0x73, 0x2d, 0xa3, 0x48, 0x19, 0x34, 0x52, 0xb0, 0x9e, 0x35, 0xd3, 0xdd, 0x26, 0x5e, 0x5f, 0x7d,
-	0x2e, 0x39, 0x59, 0x2c, 0x39, 0xf9, 0x5e, 0x72, 0xf2, 0xbe, 0xe2, 0x95, 0xc5, 0x8a, 0x57, 0xbe,
-	0x56, 0xbc, 0xf2, 0xc0, 0x77, 0x86, 0x7f, 0xfb, 0x37, 0x7d, 0x9c, 0x3b, 0x0c, 0x8f, 0x79, 0x9a,
-	0xfc, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x55, 0x04, 0xbf, 0xa1, 0x01, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0x36, 0x4d, 0x9b, 0x71, 0x9a, 0xdf, 0x4f, 0x0b, 0x41, 0x9b, 0x0a, 0x5c, 0xf3,
+	0xe7, 0x60, 0xa9, 0xc2, 0x56, 0xd2, 0x0b, 0xd7, 0xa6, 0x08, 0x11, 0x54, 0xa4, 0xca, 0x47, 0x2e,
+	0xd6, 0x66, 0xbd, 0x38, 0xab, 0x38, 0x5e, 0x6b, 0x77, 0x1b, 0xf0, 0x8d, 0x47, 0xe0, 0x61, 0x78,
+	0x88, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x85, 0x92, 0x17, 0x41, 0x5e, 0x3b, 0x4d, 0x40, 0xaa, 0xc4,
+	0x6d, 0xe7, 0x33, 0xdf, 0xd9, 0x99, 0xd9, 0xfd, 0xc2, 0x89, 0x9a, 0xf1, 0x34, 0xa5, 0x53, 0xc2,
+	0xb3, 0x60, 0x4e, 0xe4, 0x8c, 0xe9, 0x3c, 0x25, 0x94, 0x05, 0x8b, 0x41, 0x40, 0xf2, 0x3c, 0xe5,
+	0x94, 0x68, 0x2e, 0x32, 0x3f, 0x97, 0x42, 0x0b, 0xd4, 0xdf, 0x88, 0xfd, 0x2d, 0xb1, 0xbf, 0x18,
+	0x1c, 0x39, 0x54, 0xa8, 0xb9, 0x50, 0xc1, 0x84, 0xa8, 0xb2, 0x78, 0xc2, 0x34, 0x19, 0x04, 0x54,
+	0xf0, 0xba, 0xf4, 0xa8, 0x5f, 0xe5, 0x23, 0x13, 0x05, 0x55, 0x50, 0xa7, 0x1e, 0x26, 0x22, 0x11,
+	0x15, 0x2f, 0x4f, 0x35, 0xf5, 0xee, 0x1f, 0x8c, 0x8a, 0x4c, 0x4b, 0x42, 0x75, 0xa5, 0x7c, 0xf6,
+	0x65, 0x0f, 0xec, 0xb3, 0xcd, 0xac, 0xa8, 0x0b, 0x3b, 0x3c, 0xc6, 0x96, 0x6b, 0x79, 0xcd, 0x70,
+	0x87, 0xc7, 0xa8, 0x07, 0xad, 0x84, 0x27, 0x11, 0x8f, 0xf1, 0x8e, 0x61, 0x7b, 0x09, 0x4f, 0xc6,
+	0x31, 0x72, 0x00, 0x3e, 0x4a, 0xc6, 0x52, 0x92, 0x51, 0x26, 0xf1, 0xae, 0x6b, 0x79, 0xed, 0x70,
+	0x8b, 0xa0, 0xa7, 0xd0, 0xa1, 0x62, 0xc1, 0x64, 0x94, 0x32, 0xad, 0x99, 0xc4, 0x4d, 0xa3, 0xb0,
+	0x0d, 0xbb, 0x30, 0x08, 0x0d, 0xa1, 0x97, 0xb2, 0x84, 0xd0, 0xa2, 0x5c, 0x2b, 0x17, 0x8a, 0xc5,
+	0x51, 0x2e, 0x39, 0x65, 0x78, 0xcf, 0x34, 0x7a, 0x50, 0x25, 0x2f, 0xeb, 0xdc, 0x65, 0x99, 0x42,
+	0xcf, 0xe1, 0xf0, 0x4e, 0x1c, 0x93, 0x42, 0xe1, 0x96, 0xd1, 0x76, 0xd6, 0xf0, 0x35, 0x29, 0x14,
+	0x7a, 0x04, 0x2d, 0xa5, 0x89, 0xbe, 0x52, 0x78, 0xdf, 0x74, 0xad, 0x23, 0xf4, 0x04, 0x80, 0x4a,
+	0x46, 0x34, 0x8b, 0x23, 0xa2, 0xf1, 0x81, 0x6b, 0x79, 0xbb, 0x61, 0xbb, 0x26, 0x67, 0x1a, 0x61,
+	0xd8, 0x37, 0x81, 0x90, 0xb8, 0x6d, 0xea, 0xd6, 0x21, 0x7a, 0x07, 0x30, 0xe7, 0x29, 0x53, 0x5a,
+	0x64, 0x4c, 0x61, 0x70, 0x77, 0x3d, 0x7b, 0xf8, 0xc2, 0xbf, 0xf7, 0x3b, 0xfd, 0xf7, 0x6b, 0xf1,
+	0xa8, 0x79, 0x7d, 0x7b, 0xdc, 0x08, 0xb7, 0xaa, 0xd1, 0x1b, 0xe8, 0xfe, 0xb5, 0xae, 0xed, 0x5a,
+	0x9e, 0x3d, 0xec, 0xfb, 0xf5, 0xb7, 0x96, 0x1e, 0xf0, 0x6b, 0x0f, 0xf8, 0xe7, 0x82, 0x67, 0xf5,
+	0x25, 0x77, 0x8b, 0x57, 0x2f, 0x71, 0x01, 0xf6, 0x54, 0x5c, 0xc9, 0xb4, 0x88, 0x24, 0xd1, 0x0c,
+	0x77, 0xca, 0x89, 0x47, 0x27, 0xa5, 0xf2, 0xe7, 0xed, 0x71, 0xaf, 0xba, 0x4b, 0xc5, 0x33, 0x9f,
+	0x8b, 0x60, 0x4e, 0xf4, 0xd4, 0x1f, 0x67, 0xfa, 0xfb, 0xb7, 0x97, 0x50, 0x37, 0x19, 0x67, 0x3a,
+	0x84, 0xaa, 0x3e, 0x24, 0x9a, 0x21, 0x0f, 0xfe, 0xff, 0xc4, 0xd8, 0x2c, 0x2d, 0xa2, 0x12, 0xaa,
+	0x88, 0x92, 0x1c, 0x1f, 0x9a, 0xa7, 0xed, 0x56, 0xfc, 0x6d, 0x89, 0xcf, 0x49, 0x8e, 0x1e, 0x43,
+	0x5b, 0x69, 0xc9, 0xc8, 0x9c, 0x67, 0x09, 0xee, 0xba, 0x96, 0x77, 0x10, 0x6e, 0x00, 0x3a, 0x85,
+	0xe6, 0x44, 0x64, 0x31, 0xfe, 0xef, 0xdf, 0x76, 0x32, 0xe2, 0xd1, 0xab, 0xeb, 0xa5, 0x63, 0xdd,
+	0x2c, 0x1d, 0xeb, 0xd7, 0xd2, 0xb1, 0xbe, 0xae, 0x9c, 0xc6, 0xcd, 0xca, 0x69, 0xfc, 0x58, 0x39,
+	0x8d, 0x0f, 0xce, 0x96, 0x8d, 0x3f, 0xff, 0x61, 0x64, 0x5d, 0xe4, 0x4c, 0x4d, 0x5a, 0xc6, 0xc3,
+	0xa7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x2c, 0xac, 0x55, 0x88, 0x03, 0x00, 0x00,
 }
 
 func (m *Application) Marshal() (dAtA []byte, err error) {
@@ -182,6 +251,65 @@ func (m *Application) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.Streaming {
+		i--
+		if m.Streaming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.WeeklyHoursCap != 0 {
+		i = encodeVarintApplication(dAtA, i, uint64(m.WeeklyHoursCap))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.HourlyRate.Size()
+		i -= size
+		if _, err := m.HourlyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.ProposedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -206,8 +334,8 @@ func (m *Application) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.ProposedPrice != 0 {
-		i = encodeVarintApplication(dAtA, i, uint64(m.ProposedPrice))
+	if m.LegacyProposedPrice != 0 {
+		i = encodeVarintApplication(dAtA, i, uint64(m.LegacyProposedPrice))
 		i--
 		dAtA[i] = 0x28
 	}
@@ -269,8 +397,8 @@ func (m *Application) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApplication(uint64(l))
 	}
-	if m.ProposedPrice != 0 {
-		n += 1 + sovApplication(uint64(m.ProposedPrice))
+	if m.LegacyProposedPrice != 0 {
+		n += 1 + sovApplication(uint64(m.LegacyProposedPrice))
 	}
 	if m.ProposedDays != 0 {
 		n += 1 + sovApplication(uint64(m.ProposedDays))
@@ -286,6 +414,24 @@ func (m *Application) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = m.ProposedPrice.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	l = m.HourlyRate.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	if m.WeeklyHoursCap != 0 {
+		n += 1 + sovApplication(uint64(m.WeeklyHoursCap))
+	}
+	if m.Streaming {
+		n += 2
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovApplication(uint64(l))
 	return n
 }
 
@@ -428,9 +574,9 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyProposedPrice", wireType)
 			}
-			m.ProposedPrice = 0
+			m.LegacyProposedPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -440,7 +586,7 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposedPrice |= uint64(b&0x7F) << shift
+				m.LegacyProposedPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -547,6 +693,179 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourlyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyHoursCap", wireType)
+			}
+			m.WeeklyHoursCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeeklyHoursCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Streaming = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
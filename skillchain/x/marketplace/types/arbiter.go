@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/math"
+
+// IsActive reports whether the arbiter is bonded with at least
+// arbiter_stake_required, and so gets assigned to new disputes.
+func (a Arbiter) IsActive(params Params) bool {
+	return a.Status == "bonded" && a.Stake.Amount.GTE(math.NewIntFromUint64(params.ArbiterStakeRequired))
+}
+
+// AccuracyBps returns the share of the resolved disputes assigned to the
+// arbiter it voted on with the majority, in basis points.
+func (a Arbiter) AccuracyBps() uint64 {
+	total := a.MajorityVotes + a.MinorityVotes + a.MissedVotes
+	if total == 0 {
+		return 0
+	}
+	return a.MajorityVotes * BasisPoints / total
+}
@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/arbiter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Arbiter is an account that locked stake in the module to vote on disputes.
+// Only bonded arbiters are assigned to disputes. An unbonding arbiter gets its
+// stake back once the unbonding period of the params has passed.
+type Arbiter struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Stake   types.Coin `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake"`
+	// Either bonded or unbonding.
+	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	BondedAt int64  `protobuf:"varint,4,opt,name=bonded_at,json=bondedAt,proto3" json:"bonded_at,omitempty"`
+	// Time the stake is released at, set once unbonding.
+	UnbondingEndsAt int64 `protobuf:"varint,5,opt,name=unbonding_ends_at,json=unbondingEndsAt,proto3" json:"unbonding_ends_at,omitempty"`
+	// Resolved disputes the arbiter voted on with the majority.
+	MajorityVotes uint64 `protobuf:"varint,6,opt,name=majority_votes,json=majorityVotes,proto3" json:"majority_votes,omitempty"`
+	// Resolved disputes the arbiter voted on with the minority.
+	MinorityVotes uint64 `protobuf:"varint,7,opt,name=minority_votes,json=minorityVotes,proto3" json:"minority_votes,omitempty"`
+	// Resolved disputes the arbiter was assigned to but did not vote on.
+	MissedVotes uint64 `protobuf:"varint,8,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	// Dispute fees earned voting with the majority.
+	Earned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=earned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned"`
+	// Stake slashed for minority and missed votes.
+	Slashed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=slashed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed"`
+}
+
+func (m *Arbiter) Reset()         { *m = Arbiter{} }
+func (m *Arbiter) String() string { return proto.CompactTextString(m) }
+func (*Arbiter) ProtoMessage()    {}
+func (*Arbiter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_927adbe3638bc805, []int{0}
+}
+func (m *Arbiter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Arbiter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Arbiter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Arbiter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Arbiter.Merge(m, src)
+}
+func (m *Arbiter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Arbiter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Arbiter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Arbiter proto.InternalMessageInfo
+
+func (m *Arbiter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Arbiter) GetStake() types.Coin {
+	if m != nil {
+		return m.Stake
+	}
+	return types.Coin{}
+}
+
+func (m *Arbiter) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Arbiter) GetBondedAt() int64 {
+	if m != nil {
+		return m.BondedAt
+	}
+	return 0
+}
+
+func (m *Arbiter) GetUnbondingEndsAt() int64 {
+	if m != nil {
+		return m.UnbondingEndsAt
+	}
+	return 0
+}
+
+func (m *Arbiter) GetMajorityVotes() uint64 {
+	if m != nil {
+		return m.MajorityVotes
+	}
+	return 0
+}
+
+func (m *Arbiter) GetMinorityVotes() uint64 {
+	if m != nil {
+		return m.MinorityVotes
+	}
+	return 0
+}
+
+func (m *Arbiter) GetMissedVotes() uint64 {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return 0
+}
+
+func (m *Arbiter) GetEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earned
+	}
+	return nil
+}
+
+func (m *Arbiter) GetSlashed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Slashed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Arbiter)(nil), "skillchain.marketplace.v1.Arbiter")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/arbiter.proto", fileDescriptor_927adbe3638bc805)
+}
+
+var fileDescriptor_927adbe3638bc805 = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x8e, 0x13, 0x31,
+	0x14, 0xc6, 0x63, 0xb2, 0x9b, 0x6c, 0xbc, 0xfc, 0xd1, 0x5a, 0x08, 0x79, 0x17, 0xc9, 0x3b, 0x20,
+	0x21, 0x46, 0x2b, 0x61, 0x13, 0x68, 0x10, 0x5d, 0x16, 0x71, 0x81, 0x29, 0x28, 0x68, 0x22, 0xcf,
+	0xd8, 0x4a, 0x4c, 0x32, 0x76, 0x34, 0xcf, 0x89, 0xd8, 0x5b, 0x70, 0x0c, 0x44, 0xc5, 0x31, 0xb6,
+	0x4c, 0x49, 0x05, 0x28, 0x29, 0x38, 0x05, 0x12, 0x1a, 0xdb, 0x81, 0xd0, 0x50, 0xd1, 0xcc, 0xf8,
+	0x7d, 0xfe, 0xbd, 0xef, 0x93, 0x9e, 0x1f, 0x7e, 0x0c, 0x33, 0x33, 0x9f, 0x57, 0x53, 0x69, 0xac,
+	0xa8, 0x65, 0x33, 0xd3, 0x7e, 0x31, 0x97, 0x95, 0x16, 0xab, 0xa1, 0x90, 0x4d, 0x69, 0xbc, 0x6e,
+	0xf8, 0xa2, 0x71, 0xde, 0x91, 0xd3, 0x3f, 0x20, 0xdf, 0x03, 0xf9, 0x6a, 0x78, 0x76, 0x22, 0x6b,
+	0x63, 0x9d, 0x08, 0xdf, 0x48, 0x9f, 0xb1, 0xca, 0x41, 0xed, 0x40, 0x94, 0x12, 0x5a, 0xaf, 0x52,
+	0x7b, 0x39, 0x14, 0x95, 0x33, 0x36, 0xdd, 0xdf, 0x9d, 0xb8, 0x89, 0x0b, 0x47, 0xd1, 0x9e, 0xa2,
+	0xfa, 0xf0, 0x67, 0x17, 0xf7, 0x47, 0x31, 0x95, 0x50, 0xdc, 0x97, 0x4a, 0x35, 0x1a, 0x80, 0xa2,
+	0x0c, 0xe5, 0x83, 0x62, 0x57, 0x92, 0x97, 0xf8, 0x10, 0xbc, 0x9c, 0x69, 0x7a, 0x23, 0x43, 0xf9,
+	0xf1, 0xb3, 0x53, 0x1e, 0xb3, 0x78, 0x9b, 0xc5, 0x53, 0x16, 0x7f, 0xe5, 0x8c, 0xbd, 0x1c, 0x5c,
+	0x7f, 0x3d, 0xef, 0x7c, 0xfc, 0xf1, 0xf9, 0x02, 0x15, 0xb1, 0x85, 0xdc, 0xc3, 0x3d, 0xf0, 0xd2,
+	0x2f, 0x81, 0x76, 0x83, 0x69, 0xaa, 0xc8, 0x7d, 0x3c, 0x28, 0x9d, 0x55, 0x5a, 0x8d, 0xa5, 0xa7,
+	0x07, 0x19, 0xca, 0xbb, 0xc5, 0x51, 0x14, 0x46, 0x9e, 0x5c, 0xe0, 0x93, 0xa5, 0x6d, 0x2b, 0x63,
+	0x27, 0x63, 0x6d, 0x15, 0xb4, 0xd0, 0x61, 0x80, 0xee, 0xfc, 0xbe, 0x78, 0x6d, 0x15, 0x8c, 0x3c,
+	0x79, 0x84, 0x6f, 0xd7, 0xf2, 0x9d, 0x6b, 0x8c, 0xbf, 0x1a, 0xaf, 0x9c, 0xd7, 0x40, 0x7b, 0x19,
+	0xca, 0x0f, 0x8a, 0x5b, 0x3b, 0xf5, 0x4d, 0x2b, 0x06, 0xcc, 0xd8, 0x7d, 0xac, 0x9f, 0xb0, 0xa4,
+	0x46, 0xec, 0x01, 0xbe, 0x59, 0x1b, 0x00, 0xad, 0x12, 0x74, 0x14, 0xa0, 0xe3, 0xa8, 0x45, 0xa4,
+	0xc2, 0x3d, 0x2d, 0x1b, 0xab, 0x15, 0x1d, 0x64, 0xdd, 0x7f, 0x8f, 0xe3, 0x69, 0x3b, 0x8e, 0x4f,
+	0xdf, 0xce, 0xf3, 0x89, 0xf1, 0xd3, 0x65, 0xc9, 0x2b, 0x57, 0x8b, 0xf4, 0x4e, 0xf1, 0xf7, 0x04,
+	0xd4, 0x4c, 0xf8, 0xab, 0x85, 0x86, 0xd0, 0x00, 0x45, 0xb2, 0x26, 0x1a, 0xf7, 0x61, 0x2e, 0x61,
+	0xaa, 0x15, 0xc5, 0xff, 0x3f, 0x65, 0xe7, 0x7d, 0xf9, 0xe2, 0x7a, 0xc3, 0xd0, 0x7a, 0xc3, 0xd0,
+	0xf7, 0x0d, 0x43, 0x1f, 0xb6, 0xac, 0xb3, 0xde, 0xb2, 0xce, 0x97, 0x2d, 0xeb, 0xbc, 0x65, 0x7b,
+	0x6b, 0xfa, 0xfe, 0xaf, 0x45, 0x0d, 0x46, 0x65, 0x2f, 0x2c, 0xd0, 0xf3, 0x5f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xb3, 0xac, 0xb1, 0x4f, 0xcf, 0x02, 0x00, 0x00,
+}
+
+func (m *Arbiter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Arbiter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Arbiter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slashed) > 0 {
+		for iNdEx := len(m.Slashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArbiter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Earned) > 0 {
+		for iNdEx := len(m.Earned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArbiter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MissedVotes != 0 {
+		i = encodeVarintArbiter(dAtA, i, uint64(m.MissedVotes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinorityVotes != 0 {
+		i = encodeVarintArbiter(dAtA, i, uint64(m.MinorityVotes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MajorityVotes != 0 {
+		i = encodeVarintArbiter(dAtA, i, uint64(m.MajorityVotes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingEndsAt != 0 {
+		i = encodeVarintArbiter(dAtA, i, uint64(m.UnbondingEndsAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BondedAt != 0 {
+		i = encodeVarintArbiter(dAtA, i, uint64(m.BondedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintArbiter(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintArbiter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintArbiter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArbiter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArbiter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Arbiter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovArbiter(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovArbiter(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovArbiter(uint64(l))
+	}
+	if m.BondedAt != 0 {
+		n += 1 + sovArbiter(uint64(m.BondedAt))
+	}
+	if m.UnbondingEndsAt != 0 {
+		n += 1 + sovArbiter(uint64(m.UnbondingEndsAt))
+	}
+	if m.MajorityVotes != 0 {
+		n += 1 + sovArbiter(uint64(m.MajorityVotes))
+	}
+	if m.MinorityVotes != 0 {
+		n += 1 + sovArbiter(uint64(m.MinorityVotes))
+	}
+	if m.MissedVotes != 0 {
+		n += 1 + sovArbiter(uint64(m.MissedVotes))
+	}
+	if len(m.Earned) > 0 {
+		for _, e := range m.Earned {
+			l = e.Size()
+			n += 1 + l + sovArbiter(uint64(l))
+		}
+	}
+	if len(m.Slashed) > 0 {
+		for _, e := range m.Slashed {
+			l = e.Size()
+			n += 1 + l + sovArbiter(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovArbiter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArbiter(x uint64) (n int) {
+	return sovArbiter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Arbiter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArbiter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Arbiter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Arbiter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedAt", wireType)
+			}
+			m.BondedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndsAt", wireType)
+			}
+			m.UnbondingEndsAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndsAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MajorityVotes", wireType)
+			}
+			m.MajorityVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MajorityVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorityVotes", wireType)
+			}
+			m.MinorityVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinorityVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			m.MissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earned = append(m.Earned, types.Coin{})
+			if err := m.Earned[len(m.Earned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashed = append(m.Slashed, types.Coin{})
+			if err := m.Slashed[len(m.Slashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArbiter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArbiter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArbiter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArbiter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArbiter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArbiter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArbiter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArbiter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArbiter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArbiter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArbiter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/cancellation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CancellationProposal is a pending offer from one party of a contract to end
+// it by mutual agreement. The funds still in escrow are split between the
+// freelancer payout and a refund to the client.
+type CancellationProposal struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// Part of the escrow paid to the freelancer, before the platform fee. The
+	// rest of the escrow is refunded to the client.
+	FreelancerPayout cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=freelancer_payout,json=freelancerPayout,proto3,customtype=cosmossdk.io/math.Int" json:"freelancer_payout"`
+	Reason           string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt        int64                 `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt        int64                 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *CancellationProposal) Reset()         { *m = CancellationProposal{} }
+func (m *CancellationProposal) String() string { return proto.CompactTextString(m) }
+func (*CancellationProposal) ProtoMessage()    {}
+func (*CancellationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a85585873eeaca, []int{0}
+}
+func (m *CancellationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancellationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancellationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancellationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationProposal.Merge(m, src)
+}
+func (m *CancellationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancellationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancellationProposal proto.InternalMessageInfo
+
+func (m *CancellationProposal) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *CancellationProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *CancellationProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CancellationProposal) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *CancellationProposal) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CancellationProposal)(nil), "skillchain.marketplace.v1.CancellationProposal")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/cancellation.proto", fileDescriptor_f3a85585873eeaca)
+}
+
+var fileDescriptor_f3a85585873eeaca = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbf, 0x4e, 0x32, 0x41,
+	0x14, 0xc5, 0x77, 0x80, 0x8f, 0x7c, 0x8c, 0x8d, 0x6e, 0xd0, 0x2c, 0x24, 0x0e, 0xc4, 0x8a, 0x44,
+	0xdd, 0x0d, 0xb1, 0xb1, 0x05, 0x2b, 0x3a, 0xb2, 0x95, 0xb1, 0xd9, 0x8c, 0xb3, 0x23, 0x6c, 0x18,
+	0x66, 0x26, 0x33, 0x57, 0x02, 0xb5, 0x2f, 0xe0, 0xc3, 0xf8, 0x10, 0x94, 0xc4, 0xca, 0x58, 0x10,
+	0x03, 0x2f, 0x62, 0xf6, 0x8f, 0xb2, 0x76, 0x7b, 0xce, 0xf9, 0xed, 0xb9, 0x37, 0x73, 0xf1, 0x95,
+	0x9d, 0x25, 0x42, 0xb0, 0x29, 0x4d, 0x64, 0x30, 0xa7, 0x66, 0xc6, 0x41, 0x0b, 0xca, 0x78, 0xb0,
+	0xe8, 0x07, 0x8c, 0x4a, 0xc6, 0x85, 0xa0, 0x90, 0x28, 0xe9, 0x6b, 0xa3, 0x40, 0xb9, 0xad, 0x03,
+	0xed, 0x97, 0x68, 0x7f, 0xd1, 0x6f, 0xb7, 0x98, 0xb2, 0x73, 0x65, 0xa3, 0x0c, 0x0c, 0x72, 0x91,
+	0xff, 0xd5, 0x6e, 0x4e, 0xd4, 0x44, 0xe5, 0x7e, 0xfa, 0x95, 0xbb, 0x17, 0x2f, 0x15, 0xdc, 0xbc,
+	0x2b, 0x8d, 0x18, 0x1b, 0xa5, 0x95, 0xa5, 0xc2, 0xed, 0xe0, 0x23, 0xa6, 0x24, 0x18, 0xca, 0x20,
+	0x4a, 0x62, 0x0f, 0x75, 0x51, 0xaf, 0x16, 0xe2, 0x1f, 0x6b, 0x14, 0xbb, 0x6d, 0xfc, 0x5f, 0x67,
+	0x30, 0x37, 0x5e, 0xa5, 0x8b, 0x7a, 0x8d, 0xf0, 0x57, 0xbb, 0xf7, 0xf8, 0xe4, 0xc9, 0x70, 0x2e,
+	0xd2, 0x62, 0x13, 0x69, 0xba, 0x52, 0xcf, 0xe0, 0x55, 0x53, 0x68, 0x78, 0xb9, 0xde, 0x76, 0x9c,
+	0xcf, 0x6d, 0xe7, 0x34, 0x5f, 0xce, 0xc6, 0x33, 0x3f, 0x51, 0xc1, 0x9c, 0xc2, 0xd4, 0x1f, 0x49,
+	0x78, 0x7f, 0xbb, 0xc6, 0xc5, 0xd6, 0x23, 0x09, 0xe1, 0xf1, 0xa1, 0x65, 0x9c, 0x95, 0xb8, 0x67,
+	0xb8, 0x6e, 0x38, 0xb5, 0x4a, 0x7a, 0xb5, 0x6c, 0x66, 0xa1, 0xdc, 0x73, 0x8c, 0x99, 0xe1, 0x14,
+	0x78, 0x1c, 0x51, 0xf0, 0xfe, 0x75, 0x51, 0xaf, 0x1a, 0x36, 0x0a, 0x67, 0x00, 0x69, 0xcc, 0x97,
+	0x3a, 0x31, 0xdc, 0xa6, 0x71, 0x3d, 0x8f, 0x0b, 0x67, 0x00, 0xc3, 0xdb, 0xf5, 0x8e, 0xa0, 0xcd,
+	0x8e, 0xa0, 0xaf, 0x1d, 0x41, 0xaf, 0x7b, 0xe2, 0x6c, 0xf6, 0xc4, 0xf9, 0xd8, 0x13, 0xe7, 0x81,
+	0x94, 0x2e, 0xb3, 0xfc, 0x73, 0x1b, 0x58, 0x69, 0x6e, 0x1f, 0xeb, 0xd9, 0x33, 0xde, 0x7c, 0x07,
+	0x00, 0x00, 0xff, 0xff, 0x6f, 0x21, 0x01, 0xf0, 0xc2, 0x01, 0x00, 0x00,
+}
+
+func (m *CancellationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintCancellation(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintCancellation(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCancellation(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FreelancerPayout.Size()
+		i -= size
+		if _, err := m.FreelancerPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCancellation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintCancellation(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintCancellation(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCancellation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCancellation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CancellationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovCancellation(uint64(m.ContractId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovCancellation(uint64(l))
+	}
+	l = m.FreelancerPayout.Size()
+	n += 1 + l + sovCancellation(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCancellation(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCancellation(uint64(m.CreatedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovCancellation(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func sovCancellation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCancellation(x uint64) (n int) {
+	return sovCancellation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CancellationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCancellation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreelancerPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCancellation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCancellation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCancellation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCancellation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCancellation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCancellation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCancellation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCancellation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCancellation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCancellation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCancellation = fmt.Errorf("proto: unexpected end of group")
+)
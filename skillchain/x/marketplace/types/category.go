@@ -0,0 +1,82 @@
+package types
+
+import "fmt"
+
+// MaxDeliveryDays is the longest delivery time of a gig when its category
+// sets none.
+const MaxDeliveryDays = 365
+
+// CategoryRule returns the rules applied to the gigs of a category, the
+// global params filling the fields it does not override, and whether
+// governance set rules for the category.
+func (p Params) CategoryRule(category string) (CategoryRule, bool) {
+	rule := CategoryRule{
+		Category:           category,
+		MinPrice:           p.MinGigPrice,
+		FeeBps:             p.PlatformFeePercent * BasisPoints / 100,
+		MaxDeliveryDays:    MaxDeliveryDays,
+		DisputeDuration:    p.DisputeDuration,
+		MinPriceSet:        true,
+		FeeBpsSet:          true,
+		MaxDeliveryDaysSet: true,
+		DisputeDurationSet: true,
+	}
+
+	for _, override := range p.CategoryRules {
+		if override.Category != category {
+			continue
+		}
+		if override.MinPriceSet {
+			rule.MinPrice = override.MinPrice
+		}
+		if override.FeeBpsSet {
+			rule.FeeBps = override.FeeBps
+		}
+		if override.MaxDeliveryDaysSet {
+			rule.MaxDeliveryDays = override.MaxDeliveryDays
+		}
+		if override.DisputeDurationSet {
+			rule.DisputeDuration = override.DisputeDuration
+		}
+		return rule, true
+	}
+
+	return rule, false
+}
+
+// Validate validates the category rule.
+func (r CategoryRule) Validate() error {
+	if r.Category == "" {
+		return fmt.Errorf("category cannot be empty")
+	}
+	// a value without its flag would be silently ignored
+	if !r.MinPriceSet && !r.MinPrice.IsNil() && !r.MinPrice.IsZero() {
+		return fmt.Errorf("min price is given but not flagged as set")
+	}
+	if !r.FeeBpsSet && r.FeeBps != 0 {
+		return fmt.Errorf("fee is given but not flagged as set")
+	}
+	if !r.MaxDeliveryDaysSet && r.MaxDeliveryDays != 0 {
+		return fmt.Errorf("max delivery days are given but not flagged as set")
+	}
+	if !r.DisputeDurationSet && r.DisputeDuration != 0 {
+		return fmt.Errorf("dispute duration is given but not flagged as set")
+	}
+
+	if r.MinPriceSet && r.MinPrice.IsNil() {
+		return fmt.Errorf("min price is flagged as set but not given")
+	}
+	if r.MinPriceSet && r.MinPrice.IsNegative() {
+		return fmt.Errorf("min price cannot be negative")
+	}
+	if r.FeeBps > BasisPoints {
+		return fmt.Errorf("fee cannot exceed %d basis points", BasisPoints)
+	}
+	if r.MaxDeliveryDaysSet && (r.MaxDeliveryDays < 1 || r.MaxDeliveryDays > MaxDeliveryDays) {
+		return fmt.Errorf("max delivery days must be between 1 and %d", MaxDeliveryDays)
+	}
+	if r.DisputeDurationSet && r.DisputeDuration < 86400 {
+		return fmt.Errorf("dispute duration must be at least 1 day")
+	}
+	return nil
+}
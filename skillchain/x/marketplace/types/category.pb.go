@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/category.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CategoryRule overrides the global params for the gigs of a category. Only
+// the fields flagged as set override them, so a rule can set a field to zero;
+// the others fall back to the global params.
+type CategoryRule struct {
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Minimum price of the gigs and applications of the category.
+	MinPrice cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_price,json=minPrice,proto3,customtype=cosmossdk.io/math.Int" json:"min_price"`
+	// Platform fee of the category in basis points, replacing
+	// platform_fee_percent. Fee tiers still apply when lower.
+	FeeBps uint64 `protobuf:"varint,3,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	// Maximum delivery days of the gigs and applications of the category.
+	MaxDeliveryDays uint64 `protobuf:"varint,4,opt,name=max_delivery_days,json=maxDeliveryDays,proto3" json:"max_delivery_days,omitempty"`
+	// Duration of the disputes of the category in seconds.
+	DisputeDuration    uint64 `protobuf:"varint,5,opt,name=dispute_duration,json=disputeDuration,proto3" json:"dispute_duration,omitempty"`
+	MinPriceSet        bool   `protobuf:"varint,6,opt,name=min_price_set,json=minPriceSet,proto3" json:"min_price_set,omitempty"`
+	FeeBpsSet          bool   `protobuf:"varint,7,opt,name=fee_bps_set,json=feeBpsSet,proto3" json:"fee_bps_set,omitempty"`
+	MaxDeliveryDaysSet bool   `protobuf:"varint,8,opt,name=max_delivery_days_set,json=maxDeliveryDaysSet,proto3" json:"max_delivery_days_set,omitempty"`
+	DisputeDurationSet bool   `protobuf:"varint,9,opt,name=dispute_duration_set,json=disputeDurationSet,proto3" json:"dispute_duration_set,omitempty"`
+}
+
+func (m *CategoryRule) Reset()         { *m = CategoryRule{} }
+func (m *CategoryRule) String() string { return proto.CompactTextString(m) }
+func (*CategoryRule) ProtoMessage()    {}
+func (*CategoryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff3a8c77f3c2dc7f, []int{0}
+}
+func (m *CategoryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CategoryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CategoryRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CategoryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryRule.Merge(m, src)
+}
+func (m *CategoryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CategoryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryRule proto.InternalMessageInfo
+
+func (m *CategoryRule) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *CategoryRule) GetFeeBps() uint64 {
+	if m != nil {
+		return m.FeeBps
+	}
+	return 0
+}
+
+func (m *CategoryRule) GetMaxDeliveryDays() uint64 {
+	if m != nil {
+		return m.MaxDeliveryDays
+	}
+	return 0
+}
+
+func (m *CategoryRule) GetDisputeDuration() uint64 {
+	if m != nil {
+		return m.DisputeDuration
+	}
+	return 0
+}
+
+func (m *CategoryRule) GetMinPriceSet() bool {
+	if m != nil {
+		return m.MinPriceSet
+	}
+	return false
+}
+
+func (m *CategoryRule) GetFeeBpsSet() bool {
+	if m != nil {
+		return m.FeeBpsSet
+	}
+	return false
+}
+
+func (m *CategoryRule) GetMaxDeliveryDaysSet() bool {
+	if m != nil {
+		return m.MaxDeliveryDaysSet
+	}
+	return false
+}
+
+func (m *CategoryRule) GetDisputeDurationSet() bool {
+	if m != nil {
+		return m.DisputeDurationSet
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*CategoryRule)(nil), "skillchain.marketplace.v1.CategoryRule")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/category.proto", fileDescriptor_ff3a8c77f3c2dc7f)
+}
+
+var fileDescriptor_ff3a8c77f3c2dc7f = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x34, 0xa4, 0xf6, 0x15, 0x04, 0x3d, 0xb5, 0xc2, 0xcd, 0x70, 0x89, 0x3a, 0x99,
+	0x4a, 0xd8, 0x8d, 0x58, 0x10, 0x63, 0xc8, 0xd2, 0x01, 0x09, 0xb9, 0x1b, 0x8b, 0x75, 0xb5, 0x5f,
+	0xd3, 0x53, 0x7c, 0x3e, 0xcb, 0x77, 0x89, 0xe2, 0x6f, 0xc1, 0xc8, 0xc8, 0xc8, 0xc8, 0xc0, 0x87,
+	0xe8, 0x58, 0x31, 0x21, 0x86, 0x0a, 0x25, 0x03, 0x7c, 0x0c, 0xe4, 0x3b, 0x27, 0x84, 0xb0, 0x58,
+	0x7e, 0xff, 0xf7, 0x7b, 0x7e, 0x3f, 0xc9, 0x0f, 0x07, 0x6a, 0xca, 0xf3, 0x3c, 0xbd, 0x61, 0xbc,
+	0x88, 0x04, 0xab, 0xa6, 0xa0, 0xcb, 0x9c, 0xa5, 0x10, 0xcd, 0x87, 0x51, 0xca, 0x34, 0x4c, 0x64,
+	0x55, 0x87, 0x65, 0x25, 0xb5, 0x24, 0x27, 0x7f, 0xc9, 0x70, 0x8b, 0x0c, 0xe7, 0xc3, 0xde, 0x21,
+	0x13, 0xbc, 0x90, 0x91, 0x79, 0x5a, 0xba, 0x77, 0x92, 0x4a, 0x25, 0xa4, 0x4a, 0x4c, 0x15, 0xd9,
+	0xa2, 0x6d, 0x1d, 0x4d, 0xe4, 0x44, 0xda, 0xbc, 0x79, 0xb3, 0xe9, 0xe9, 0xc7, 0x3d, 0xfc, 0xe8,
+	0x4d, 0xbb, 0x31, 0x9e, 0xe5, 0x40, 0x7a, 0xd8, 0x5d, 0x1b, 0xf8, 0x68, 0x80, 0x02, 0x2f, 0xde,
+	0xd4, 0xe4, 0x2d, 0xf6, 0x04, 0x2f, 0x92, 0xb2, 0xe2, 0x29, 0xf8, 0x0f, 0x9a, 0xe6, 0xe8, 0xfc,
+	0xf6, 0xbe, 0xef, 0xfc, 0xb8, 0xef, 0x1f, 0xdb, 0x5d, 0x2a, 0x9b, 0x86, 0x5c, 0x46, 0x82, 0xe9,
+	0x9b, 0xf0, 0xa2, 0xd0, 0xdf, 0xbe, 0xbe, 0xc0, 0xad, 0xc4, 0x45, 0xa1, 0x3f, 0xff, 0xfa, 0x72,
+	0x86, 0x62, 0x57, 0xf0, 0xe2, 0x5d, 0xf3, 0x05, 0xf2, 0x0c, 0xef, 0x5f, 0x03, 0x24, 0x57, 0xa5,
+	0xf2, 0xf7, 0x06, 0x28, 0xe8, 0xc4, 0xdd, 0x6b, 0x80, 0x51, 0xa9, 0xc8, 0x19, 0x3e, 0x14, 0x6c,
+	0x91, 0x64, 0x90, 0xf3, 0x39, 0x54, 0x75, 0x92, 0xb1, 0x5a, 0xf9, 0x1d, 0x83, 0x3c, 0x11, 0x6c,
+	0x31, 0x6e, 0xf3, 0x31, 0xab, 0x15, 0x79, 0x8e, 0x9f, 0x66, 0x5c, 0x95, 0x33, 0x0d, 0x49, 0x36,
+	0xab, 0x98, 0xe6, 0xb2, 0xf0, 0x1f, 0x5a, 0xb4, 0xcd, 0xc7, 0x6d, 0x4c, 0x4e, 0xf1, 0xe3, 0x8d,
+	0x7e, 0xa2, 0x40, 0xfb, 0xdd, 0x01, 0x0a, 0xdc, 0xf8, 0x60, 0x2d, 0x74, 0x09, 0x9a, 0x50, 0x7c,
+	0xd0, 0x3a, 0x19, 0x62, 0xdf, 0x10, 0x9e, 0xf5, 0x6a, 0xfa, 0x43, 0x7c, 0xfc, 0x9f, 0x9a, 0x21,
+	0x5d, 0x43, 0x92, 0x1d, 0xbd, 0x66, 0xe4, 0x1c, 0x1f, 0xed, 0x1a, 0x9a, 0x09, 0xcf, 0x4e, 0xec,
+	0x58, 0x5e, 0x82, 0x7e, 0xdd, 0xf9, 0xfd, 0xa9, 0x8f, 0x46, 0xaf, 0x6e, 0x97, 0x14, 0xdd, 0x2d,
+	0x29, 0xfa, 0xb9, 0xa4, 0xe8, 0xc3, 0x8a, 0x3a, 0x77, 0x2b, 0xea, 0x7c, 0x5f, 0x51, 0xe7, 0x3d,
+	0xdd, 0xba, 0x9e, 0xc5, 0x3f, 0xf7, 0xa3, 0xeb, 0x12, 0xd4, 0x55, 0xd7, 0xfc, 0xdb, 0x97, 0x7f,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x85, 0x5f, 0xfb, 0x66, 0x02, 0x00, 0x00,
+}
+
+func (this *CategoryRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CategoryRule)
+	if !ok {
+		that2, ok := that.(CategoryRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if !this.MinPrice.Equal(that1.MinPrice) {
+		return false
+	}
+	if this.FeeBps != that1.FeeBps {
+		return false
+	}
+	if this.MaxDeliveryDays != that1.MaxDeliveryDays {
+		return false
+	}
+	if this.DisputeDuration != that1.DisputeDuration {
+		return false
+	}
+	if this.MinPriceSet != that1.MinPriceSet {
+		return false
+	}
+	if this.FeeBpsSet != that1.FeeBpsSet {
+		return false
+	}
+	if this.MaxDeliveryDaysSet != that1.MaxDeliveryDaysSet {
+		return false
+	}
+	if this.DisputeDurationSet != that1.DisputeDurationSet {
+		return false
+	}
+	return true
+}
+func (m *CategoryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CategoryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CategoryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeDurationSet {
+		i--
+		if m.DisputeDurationSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxDeliveryDaysSet {
+		i--
+		if m.MaxDeliveryDaysSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FeeBpsSet {
+		i--
+		if m.FeeBpsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinPriceSet {
+		i--
+		if m.MinPriceSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisputeDuration != 0 {
+		i = encodeVarintCategory(dAtA, i, uint64(m.DisputeDuration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDeliveryDays != 0 {
+		i = encodeVarintCategory(dAtA, i, uint64(m.MaxDeliveryDays))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeeBps != 0 {
+		i = encodeVarintCategory(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinPrice.Size()
+		i -= size
+		if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCategory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintCategory(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCategory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCategory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CategoryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovCategory(uint64(l))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovCategory(uint64(l))
+	if m.FeeBps != 0 {
+		n += 1 + sovCategory(uint64(m.FeeBps))
+	}
+	if m.MaxDeliveryDays != 0 {
+		n += 1 + sovCategory(uint64(m.MaxDeliveryDays))
+	}
+	if m.DisputeDuration != 0 {
+		n += 1 + sovCategory(uint64(m.DisputeDuration))
+	}
+	if m.MinPriceSet {
+		n += 2
+	}
+	if m.FeeBpsSet {
+		n += 2
+	}
+	if m.MaxDeliveryDaysSet {
+		n += 2
+	}
+	if m.DisputeDurationSet {
+		n += 2
+	}
+	return n
+}
+
+func sovCategory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCategory(x uint64) (n int) {
+	return sovCategory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CategoryRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCategory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CategoryRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CategoryRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCategory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeliveryDays", wireType)
+			}
+			m.MaxDeliveryDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeliveryDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDuration", wireType)
+			}
+			m.DisputeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinPriceSet = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBpsSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeBpsSet = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeliveryDaysSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxDeliveryDaysSet = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDurationSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisputeDurationSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCategory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCategory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCategory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCategory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCategory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCategory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCategory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCategory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCategory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCategory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCategory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,6 +7,102 @@ import (
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgContestDispute{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAppealDispute{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevealDisputeVote{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnbondArbiter{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterArbiter{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetEscrowStaking{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeclineDeadlineExtension{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgApproveDeadlineExtension{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestDeadlineExtension{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptAmendment{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeAmendment{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFlagApplicationSpam{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgStopStream{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimStream{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundHourlyContract{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgContestTimeLog{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgLogTime{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTipFreelancer{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRejectCancellation{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptCancellation{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeCancellation{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelOverdueContract{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgApproveMilestone{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeliverMilestone{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgResolveDispute{},
 	)
@@ -51,12 +147,6 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgApplyToGig{},
 	)
 
-	registrar.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgCreateContract{},
-		&MsgUpdateContract{},
-		&MsgDeleteContract{},
-	)
-
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateApplication{},
 		&MsgUpdateApplication{},
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,17 +28,53 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Contract defines the Contract message.
 type Contract struct {
-	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	GigId            uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	ApplicationId    uint64 `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Client           string `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	Freelancer       string `protobuf:"bytes,5,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
-	Price            uint64 `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GigId         uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
+	ApplicationId uint64 `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Client        string `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Freelancer    string `protobuf:"bytes,5,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	// Deprecated: replaced by price, only read by the store migration.
+	LegacyPrice      uint64 `protobuf:"varint,6,opt,name=legacy_price,json=legacyPrice,proto3" json:"legacy_price,omitempty"`
 	DeliveryDeadline int64  `protobuf:"varint,7,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
 	Status           string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt        int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	CompletedAt      int64  `protobuf:"varint,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	Creator          string `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Ordered payment checkpoints. When empty the whole price is released at
+	// once by CompleteContract.
+	Milestones []Milestone `protobuf:"bytes,12,rep,name=milestones,proto3" json:"milestones"`
+	// Index of the milestone currently being worked on.
+	CurrentMilestone uint64 `protobuf:"varint,13,opt,name=current_milestone,json=currentMilestone,proto3" json:"current_milestone,omitempty"`
+	// Amount held in escrow for the contract, in any allowed denom.
+	Price types.Coin `protobuf:"bytes,14,opt,name=price,proto3" json:"price"`
+	// Time of the last delivery awaiting the client review. The delivery is
+	// accepted automatically once the review period has passed.
+	DeliveredAt int64 `protobuf:"varint,15,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	// Rate billed per hour logged on hourly contracts.
+	HourlyRate cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=hourly_rate,json=hourlyRate,proto3,customtype=cosmossdk.io/math.Int" json:"hourly_rate"`
+	// Maximum hours logged per billing period. A contract is hourly when it is
+	// set, its price is then the total funded by the client.
+	WeeklyHoursCap uint64 `protobuf:"varint,17,opt,name=weekly_hours_cap,json=weeklyHoursCap,proto3" json:"weekly_hours_cap,omitempty"`
+	// Amount billed from escrow so far on hourly and streaming contracts, fees
+	// included.
+	Billed cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=billed,proto3,customtype=cosmossdk.io/math.Int" json:"billed"`
+	// Hours logged during the current billing period.
+	PeriodHours uint64 `protobuf:"varint,19,opt,name=period_hours,json=periodHours,proto3" json:"period_hours,omitempty"`
+	// Time range over which the price of a streaming contract accrues linearly
+	// to the freelancer. A contract is streaming when stream_end is set.
+	StreamStart int64 `protobuf:"varint,20,opt,name=stream_start,json=streamStart,proto3" json:"stream_start,omitempty"`
+	StreamEnd   int64 `protobuf:"varint,21,opt,name=stream_end,json=streamEnd,proto3" json:"stream_end,omitempty"`
+	// Hash of the agreed scope of work, changed by amendments.
+	DescriptionHash string `protobuf:"bytes,22,opt,name=description_hash,json=descriptionHash,proto3" json:"description_hash,omitempty"`
+	// Terms in force before each accepted amendment, oldest first.
+	PriorTerms []ContractTerms `protobuf:"bytes,23,rep,name=prior_terms,json=priorTerms,proto3" json:"prior_terms"`
+	// Number of deadline extensions approved by the client.
+	ExtensionsGranted uint64 `protobuf:"varint,24,opt,name=extensions_granted,json=extensionsGranted,proto3" json:"extensions_granted,omitempty"`
+	// Whether the client opted the escrow of the contract in to staking.
+	EscrowStaking bool `protobuf:"varint,25,opt,name=escrow_staking,json=escrowStaking,proto3" json:"escrow_staking,omitempty"`
+	// Share of the disputed amount paid to the freelancer by the last dispute
+	// ruling settled on the contract, in basis points.
+	FreelancerPayoutBps uint64 `protobuf:"varint,26,opt,name=freelancer_payout_bps,json=freelancerPayoutBps,proto3" json:"freelancer_payout_bps,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -105,9 +145,9 @@ func (m *Contract) GetFreelancer() string {
 	return ""
 }
 
-func (m *Contract) GetPrice() uint64 {
+func (m *Contract) GetLegacyPrice() uint64 {
 	if m != nil {
-		return m.Price
+		return m.LegacyPrice
 	}
 	return 0
 }
@@ -147,8 +187,206 @@ func (m *Contract) GetCreator() string {
 	return ""
 }
 
+func (m *Contract) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Contract) GetCurrentMilestone() uint64 {
+	if m != nil {
+		return m.CurrentMilestone
+	}
+	return 0
+}
+
+func (m *Contract) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Contract) GetDeliveredAt() int64 {
+	if m != nil {
+		return m.DeliveredAt
+	}
+	return 0
+}
+
+func (m *Contract) GetWeeklyHoursCap() uint64 {
+	if m != nil {
+		return m.WeeklyHoursCap
+	}
+	return 0
+}
+
+func (m *Contract) GetPeriodHours() uint64 {
+	if m != nil {
+		return m.PeriodHours
+	}
+	return 0
+}
+
+func (m *Contract) GetStreamStart() int64 {
+	if m != nil {
+		return m.StreamStart
+	}
+	return 0
+}
+
+func (m *Contract) GetStreamEnd() int64 {
+	if m != nil {
+		return m.StreamEnd
+	}
+	return 0
+}
+
+func (m *Contract) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
+}
+
+func (m *Contract) GetPriorTerms() []ContractTerms {
+	if m != nil {
+		return m.PriorTerms
+	}
+	return nil
+}
+
+func (m *Contract) GetExtensionsGranted() uint64 {
+	if m != nil {
+		return m.ExtensionsGranted
+	}
+	return 0
+}
+
+func (m *Contract) GetEscrowStaking() bool {
+	if m != nil {
+		return m.EscrowStaking
+	}
+	return false
+}
+
+func (m *Contract) GetFreelancerPayoutBps() uint64 {
+	if m != nil {
+		return m.FreelancerPayoutBps
+	}
+	return 0
+}
+
+// Milestone defines a single payment checkpoint of a Contract.
+type Milestone struct {
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Deprecated: replaced by amount, only read by the store migration.
+	LegacyAmount uint64 `protobuf:"varint,2,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	// Number of days after the contract start the milestone is due. Set by the
+	// freelancer when applying, converted to deadline when the contract starts.
+	DeliveryDays uint64 `protobuf:"varint,3,opt,name=delivery_days,json=deliveryDays,proto3" json:"delivery_days,omitempty"`
+	Deadline     int64  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	DeliveryNote string `protobuf:"bytes,6,opt,name=delivery_note,json=deliveryNote,proto3" json:"delivery_note,omitempty"`
+	DeliveredAt  int64  `protobuf:"varint,7,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	ApprovedAt   int64  `protobuf:"varint,8,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	// Amount released when the milestone is approved, in the contract denom.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4509a2873347ab9e, []int{1}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Milestone) GetLegacyAmount() uint64 {
+	if m != nil {
+		return m.LegacyAmount
+	}
+	return 0
+}
+
+func (m *Milestone) GetDeliveryDays() uint64 {
+	if m != nil {
+		return m.DeliveryDays
+	}
+	return 0
+}
+
+func (m *Milestone) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Milestone) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Milestone) GetDeliveryNote() string {
+	if m != nil {
+		return m.DeliveryNote
+	}
+	return ""
+}
+
+func (m *Milestone) GetDeliveredAt() int64 {
+	if m != nil {
+		return m.DeliveredAt
+	}
+	return 0
+}
+
+func (m *Milestone) GetApprovedAt() int64 {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Contract)(nil), "skillchain.marketplace.v1.Contract")
+	proto.RegisterType((*Milestone)(nil), "skillchain.marketplace.v1.Milestone")
 }
 
 func init() {
@@ -156,28 +394,61 @@ func init() {
 }
 
 var fileDescriptor_4509a2873347ab9e = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x41, 0x4f, 0x22, 0x31,
-	0x18, 0x86, 0xe9, 0x00, 0x03, 0x7c, 0xec, 0x92, 0xdd, 0x66, 0xd7, 0xd4, 0x83, 0x0d, 0x9a, 0x98,
-	0x4c, 0x62, 0x02, 0x21, 0x5e, 0xbc, 0xa2, 0x5e, 0xb8, 0x72, 0xf4, 0x42, 0x6a, 0xfb, 0x39, 0x36,
-	0x94, 0x99, 0xa6, 0x53, 0x89, 0xfc, 0x0b, 0xff, 0x95, 0x1e, 0x39, 0x7a, 0x34, 0xf0, 0x47, 0x0c,
-	0x65, 0xd0, 0xf1, 0xf8, 0x3e, 0xef, 0xd3, 0x7e, 0x87, 0x17, 0x92, 0x62, 0xae, 0x8d, 0x91, 0x8f,
-	0x42, 0x67, 0xc3, 0x85, 0x70, 0x73, 0xf4, 0xd6, 0x08, 0x89, 0xc3, 0xe5, 0x68, 0x28, 0xf3, 0xcc,
-	0x3b, 0x21, 0xfd, 0xc0, 0xba, 0xdc, 0xe7, 0xf4, 0xf8, 0xdb, 0x1c, 0x54, 0xcc, 0xc1, 0x72, 0x74,
-	0xf6, 0x1a, 0x41, 0xfb, 0xa6, 0xb4, 0x69, 0x0f, 0x22, 0xad, 0x18, 0xe9, 0x93, 0xa4, 0x31, 0x8d,
-	0xb4, 0xa2, 0xff, 0x21, 0x4e, 0x75, 0x3a, 0xd3, 0x8a, 0x45, 0x81, 0x35, 0x53, 0x9d, 0x4e, 0x14,
-	0x3d, 0x87, 0x9e, 0xb0, 0xd6, 0x68, 0x29, 0xbc, 0xce, 0xb3, 0x5d, 0x5d, 0x0f, 0xf5, 0xef, 0x0a,
-	0x9d, 0x28, 0x7a, 0x04, 0xb1, 0x34, 0x1a, 0x33, 0xcf, 0x1a, 0x7d, 0x92, 0x74, 0xa6, 0x65, 0xa2,
-	0x1c, 0xe0, 0xc1, 0x21, 0x1a, 0x91, 0x49, 0x74, 0xac, 0x19, 0xba, 0x0a, 0xa1, 0xff, 0xa0, 0x69,
-	0x9d, 0x96, 0xc8, 0xe2, 0xfd, 0xd1, 0x10, 0xe8, 0x05, 0xfc, 0x55, 0x68, 0xf4, 0x12, 0xdd, 0x6a,
-	0xa6, 0x50, 0x28, 0xa3, 0x33, 0x64, 0xad, 0x3e, 0x49, 0xea, 0xd3, 0x3f, 0x87, 0xe2, 0xb6, 0xe4,
-	0xbb, 0xd3, 0x85, 0x17, 0xfe, 0xa9, 0x60, 0xed, 0xfd, 0xe9, 0x7d, 0xa2, 0x27, 0x00, 0xd2, 0xa1,
-	0xf0, 0xa8, 0x66, 0xc2, 0xb3, 0x4e, 0x78, 0xdd, 0x29, 0xc9, 0xd8, 0xd3, 0x53, 0xf8, 0x25, 0xf3,
-	0x85, 0x35, 0x58, 0x0a, 0x10, 0x84, 0xee, 0x17, 0x1b, 0x7b, 0xca, 0xa0, 0x15, 0xfc, 0xdc, 0xb1,
-	0x6e, 0xf8, 0xfa, 0x10, 0xaf, 0xaf, 0xde, 0x36, 0x9c, 0xac, 0x37, 0x9c, 0x7c, 0x6c, 0x38, 0x79,
-	0xd9, 0xf2, 0xda, 0x7a, 0xcb, 0x6b, 0xef, 0x5b, 0x5e, 0xbb, 0xe3, 0x95, 0xa1, 0x9e, 0x7f, 0x4c,
-	0xe5, 0x57, 0x16, 0x8b, 0xfb, 0x38, 0xac, 0x74, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xa4,
-	0xee, 0x8f, 0xd1, 0x01, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x69, 0xec, 0xd8, 0xe3, 0x24, 0x75, 0xa6, 0x49, 0x99, 0x44, 0x62, 0x63, 0x0a,
+	0x48, 0x5b, 0x55, 0x5d, 0xab, 0x41, 0x48, 0xdc, 0x3a, 0x01, 0xd1, 0x20, 0x3e, 0x2a, 0x97, 0x2b,
+	0x6e, 0x56, 0xe3, 0xdd, 0xc3, 0x7a, 0xe4, 0xdd, 0x99, 0xd5, 0xcc, 0x71, 0x5a, 0xbf, 0x05, 0x0f,
+	0xc3, 0x43, 0xf4, 0x06, 0x51, 0x71, 0x85, 0xb8, 0xa8, 0x50, 0xf2, 0x22, 0x68, 0x3e, 0xfc, 0x51,
+	0x55, 0x45, 0x82, 0x3b, 0x9f, 0xdf, 0xf9, 0x98, 0x73, 0xd6, 0xff, 0x73, 0x48, 0x62, 0x66, 0xa2,
+	0xaa, 0xf2, 0x29, 0x17, 0x72, 0x58, 0x73, 0x3d, 0x03, 0x6c, 0x2a, 0x9e, 0xc3, 0xf0, 0xfa, 0xc9,
+	0x30, 0x57, 0x12, 0x35, 0xcf, 0x31, 0x6d, 0xb4, 0x42, 0x45, 0x4f, 0xd6, 0x91, 0xe9, 0x46, 0x64,
+	0x7a, 0xfd, 0xe4, 0x34, 0xce, 0x95, 0xa9, 0x95, 0x19, 0x4e, 0xb8, 0xb1, 0x99, 0x13, 0x40, 0x6e,
+	0xd3, 0x85, 0xf4, 0xa9, 0xa7, 0x27, 0xde, 0x9f, 0x39, 0x6b, 0xe8, 0x8d, 0xe0, 0x3a, 0x2a, 0x55,
+	0xa9, 0x3c, 0xb7, 0xbf, 0x02, 0x7d, 0xf8, 0xfe, 0xae, 0x78, 0x0d, 0xb2, 0xa8, 0x41, 0x86, 0xb6,
+	0x1e, 0xfc, 0xde, 0x21, 0x9d, 0xcb, 0xd0, 0x29, 0x3d, 0x20, 0xdb, 0xa2, 0x60, 0xd1, 0x20, 0x4a,
+	0x76, 0xc6, 0xdb, 0xa2, 0xa0, 0xc7, 0xa4, 0x5d, 0x8a, 0x32, 0x13, 0x05, 0xdb, 0x76, 0xac, 0x55,
+	0x8a, 0xf2, 0xaa, 0xa0, 0x9f, 0x92, 0x03, 0xde, 0x34, 0x95, 0xc8, 0x39, 0x0a, 0x25, 0xad, 0xfb,
+	0x8e, 0x73, 0xef, 0x6f, 0xd0, 0xab, 0x82, 0xde, 0x27, 0xed, 0xbc, 0x12, 0x20, 0x91, 0xed, 0x0c,
+	0xa2, 0xa4, 0x3b, 0x0e, 0x16, 0x8d, 0x09, 0xf9, 0x59, 0x03, 0x54, 0x5c, 0xe6, 0xa0, 0x59, 0xcb,
+	0xf9, 0x36, 0x08, 0xfd, 0x88, 0xec, 0x55, 0x50, 0xf2, 0x7c, 0x91, 0x35, 0x5a, 0xe4, 0xc0, 0xda,
+	0xae, 0x78, 0xcf, 0xb3, 0x67, 0x16, 0xd1, 0x47, 0xe4, 0xb0, 0x80, 0x4a, 0x5c, 0x83, 0x5e, 0x64,
+	0x05, 0xf0, 0xa2, 0x12, 0x12, 0xd8, 0xee, 0x20, 0x4a, 0xee, 0x8c, 0xfb, 0x4b, 0xc7, 0x97, 0x81,
+	0xdb, 0x3e, 0x0c, 0x72, 0x9c, 0x1b, 0xd6, 0xf1, 0x7d, 0x78, 0x8b, 0x7e, 0x48, 0x48, 0xae, 0x81,
+	0x23, 0x14, 0x19, 0x47, 0xd6, 0x75, 0xd9, 0xdd, 0x40, 0x46, 0x68, 0xdb, 0xc8, 0x55, 0xdd, 0x54,
+	0x10, 0x02, 0x88, 0x0b, 0xe8, 0xad, 0xd8, 0x08, 0x29, 0x23, 0xbb, 0x2e, 0x5e, 0x69, 0xd6, 0x73,
+	0xa5, 0x97, 0x26, 0xfd, 0x86, 0x90, 0x5a, 0x54, 0x60, 0x50, 0x49, 0x30, 0x6c, 0x6f, 0x70, 0x27,
+	0xe9, 0x9d, 0x7f, 0x92, 0xbe, 0x57, 0x02, 0xe9, 0x77, 0xcb, 0xe0, 0x8b, 0x9d, 0x57, 0x6f, 0xce,
+	0xb6, 0xc6, 0x1b, 0xd9, 0x76, 0xd8, 0x7c, 0xae, 0x35, 0x48, 0xcc, 0x56, 0x94, 0xed, 0xbb, 0x8f,
+	0xd2, 0x0f, 0x8e, 0x55, 0x3a, 0xfd, 0x9c, 0xb4, 0xfc, 0x57, 0x3b, 0x18, 0x44, 0x49, 0xef, 0xfc,
+	0x24, 0x0d, 0x72, 0xb1, 0xda, 0x4a, 0x83, 0xb6, 0xd2, 0x4b, 0x25, 0x64, 0x78, 0xc8, 0x47, 0xdb,
+	0x61, 0xc3, 0x77, 0xf3, 0xc3, 0xde, 0xf5, 0xc3, 0xae, 0xd8, 0x08, 0xe9, 0xb7, 0xa4, 0x37, 0x55,
+	0x73, 0x5d, 0x2d, 0x32, 0xcd, 0x11, 0x58, 0xdf, 0x0e, 0x7c, 0xf1, 0xc8, 0x16, 0xf9, 0xeb, 0xcd,
+	0xd9, 0xb1, 0x7f, 0xc6, 0x14, 0xb3, 0x54, 0xa8, 0x61, 0xcd, 0x71, 0x9a, 0x5e, 0x49, 0xfc, 0xe3,
+	0xd7, 0xc7, 0x24, 0xbc, 0x7f, 0x25, 0x71, 0x4c, 0x7c, 0xfe, 0x98, 0x23, 0xd0, 0x84, 0xf4, 0x5f,
+	0x00, 0xcc, 0xaa, 0x45, 0x66, 0xa1, 0xc9, 0x72, 0xde, 0xb0, 0x43, 0x37, 0xd3, 0x81, 0xe7, 0x4f,
+	0x2d, 0xbe, 0xe4, 0x0d, 0xbd, 0x24, 0xed, 0x89, 0xa8, 0x2a, 0x28, 0x18, 0xfd, 0xef, 0x4f, 0x86,
+	0x54, 0x3b, 0x5f, 0x03, 0x5a, 0xa8, 0xc2, 0x3f, 0xc7, 0xee, 0x79, 0x4d, 0x79, 0xe6, 0x9e, 0xb2,
+	0x21, 0x06, 0x35, 0xf0, 0x3a, 0x33, 0xc8, 0x35, 0xb2, 0x23, 0xff, 0x09, 0x3c, 0x7b, 0x6e, 0x91,
+	0x55, 0x4c, 0x08, 0x01, 0x59, 0xb0, 0x63, 0xaf, 0x18, 0x4f, 0xbe, 0x92, 0x05, 0x7d, 0x48, 0xfa,
+	0x05, 0x98, 0x5c, 0x8b, 0xc6, 0xed, 0xc5, 0x94, 0x9b, 0x29, 0xbb, 0xef, 0x74, 0x71, 0x77, 0x83,
+	0x3f, 0xe5, 0x66, 0x4a, 0x7f, 0x20, 0xbd, 0x46, 0x0b, 0xa5, 0x33, 0x04, 0x5d, 0x1b, 0xf6, 0x81,
+	0x13, 0x48, 0xf2, 0x2f, 0x02, 0x59, 0xee, 0xe8, 0x8f, 0x36, 0x7e, 0x29, 0x12, 0x57, 0xc2, 0x11,
+	0xfa, 0x98, 0x50, 0x78, 0x89, 0x20, 0x8d, 0x50, 0xd2, 0x64, 0xa5, 0xe6, 0x12, 0xa1, 0x60, 0xcc,
+	0x8d, 0x79, 0xb8, 0xf6, 0x7c, 0xed, 0x1d, 0x76, 0x85, 0x6d, 0x47, 0xea, 0x85, 0x1d, 0x76, 0x26,
+	0x64, 0xc9, 0x4e, 0x06, 0x51, 0xd2, 0x19, 0xef, 0x7b, 0xfa, 0xdc, 0x43, 0x7a, 0x4e, 0x8e, 0xd7,
+	0x8b, 0x99, 0x35, 0x7c, 0xa1, 0xe6, 0x98, 0x4d, 0x1a, 0xc3, 0x4e, 0x5d, 0xe1, 0x7b, 0x6b, 0xe7,
+	0x33, 0xe7, 0xbb, 0x68, 0xcc, 0x83, 0xdf, 0xb6, 0x49, 0x77, 0xad, 0xc7, 0x23, 0xd2, 0x42, 0x81,
+	0x15, 0xb8, 0xab, 0xd2, 0x1d, 0x7b, 0x83, 0x7e, 0x4c, 0xf6, 0xc3, 0x8a, 0xf3, 0x5a, 0xcd, 0x25,
+	0x86, 0xfb, 0x12, 0xf6, 0x7e, 0xe4, 0x98, 0x0d, 0x5a, 0x2f, 0x39, 0x5f, 0x98, 0x70, 0x65, 0xf6,
+	0x56, 0x0b, 0xce, 0x17, 0x86, 0x9e, 0x92, 0xce, 0xea, 0x00, 0xec, 0xb8, 0x3f, 0x64, 0x65, 0x6f,
+	0x2c, 0x7e, 0xeb, 0xad, 0xc5, 0xdf, 0x2c, 0x2c, 0x15, 0xfa, 0x0b, 0xd3, 0x5d, 0x17, 0xfe, 0x5e,
+	0xe1, 0xbb, 0x1b, 0xb1, 0xfb, 0xee, 0x46, 0x9c, 0x91, 0x1e, 0x6f, 0x1a, 0xad, 0xae, 0x7d, 0x44,
+	0xc7, 0x45, 0x90, 0x25, 0x1a, 0xa1, 0x95, 0x6e, 0x98, 0xaf, 0xfb, 0x3f, 0xa4, 0xeb, 0x53, 0x2f,
+	0xbe, 0x78, 0x75, 0x13, 0x47, 0xaf, 0x6f, 0xe2, 0xe8, 0xef, 0x9b, 0x38, 0xfa, 0xe5, 0x36, 0xde,
+	0x7a, 0x7d, 0x1b, 0x6f, 0xfd, 0x79, 0x1b, 0x6f, 0xfd, 0x14, 0x6f, 0x9c, 0xf9, 0x97, 0x6f, 0x1d,
+	0x7a, 0x5c, 0x34, 0x60, 0x26, 0x6d, 0x77, 0xe2, 0x3f, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0x43,
+	0x05, 0x3d, 0xc5, 0xa5, 0x06, 0x00, 0x00,
 }
 
 func (m *Contract) Marshal() (dAtA []byte, err error) {
@@ -200,6 +471,143 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FreelancerPayoutBps != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.FreelancerPayoutBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.EscrowStaking {
+		i--
+		if m.EscrowStaking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ExtensionsGranted != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.ExtensionsGranted))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.PriorTerms) > 0 {
+		for iNdEx := len(m.PriorTerms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriorTerms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContract(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.DescriptionHash) > 0 {
+		i -= len(m.DescriptionHash)
+		copy(dAtA[i:], m.DescriptionHash)
+		i = encodeVarintContract(dAtA, i, uint64(len(m.DescriptionHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.StreamEnd != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.StreamEnd))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.StreamStart != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.StreamStart))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.PeriodHours != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.PeriodHours))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size := m.Billed.Size()
+		i -= size
+		if _, err := m.Billed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.WeeklyHoursCap != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.WeeklyHoursCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.HourlyRate.Size()
+		i -= size
+		if _, err := m.HourlyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.DeliveredAt != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.DeliveredAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.CurrentMilestone != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.CurrentMilestone))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintContract(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -229,8 +637,8 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x38
 	}
-	if m.Price != 0 {
-		i = encodeVarintContract(dAtA, i, uint64(m.Price))
+	if m.LegacyPrice != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.LegacyPrice))
 		i--
 		dAtA[i] = 0x30
 	}
@@ -266,6 +674,85 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ApprovedAt != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.ApprovedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DeliveredAt != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.DeliveredAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DeliveryNote) > 0 {
+		i -= len(m.DeliveryNote)
+		copy(dAtA[i:], m.DeliveryNote)
+		i = encodeVarintContract(dAtA, i, uint64(len(m.DeliveryNote)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintContract(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeliveryDays != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.DeliveryDays))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LegacyAmount != 0 {
+		i = encodeVarintContract(dAtA, i, uint64(m.LegacyAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintContract(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintContract(dAtA []byte, offset int, v uint64) int {
 	offset -= sovContract(v)
 	base := offset
@@ -300,8 +787,8 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovContract(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovContract(uint64(m.Price))
+	if m.LegacyPrice != 0 {
+		n += 1 + sovContract(uint64(m.LegacyPrice))
 	}
 	if m.DeliveryDeadline != 0 {
 		n += 1 + sovContract(uint64(m.DeliveryDeadline))
@@ -320,6 +807,93 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovContract(uint64(l))
 	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovContract(uint64(l))
+		}
+	}
+	if m.CurrentMilestone != 0 {
+		n += 1 + sovContract(uint64(m.CurrentMilestone))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovContract(uint64(l))
+	if m.DeliveredAt != 0 {
+		n += 1 + sovContract(uint64(m.DeliveredAt))
+	}
+	l = m.HourlyRate.Size()
+	n += 2 + l + sovContract(uint64(l))
+	if m.WeeklyHoursCap != 0 {
+		n += 2 + sovContract(uint64(m.WeeklyHoursCap))
+	}
+	l = m.Billed.Size()
+	n += 2 + l + sovContract(uint64(l))
+	if m.PeriodHours != 0 {
+		n += 2 + sovContract(uint64(m.PeriodHours))
+	}
+	if m.StreamStart != 0 {
+		n += 2 + sovContract(uint64(m.StreamStart))
+	}
+	if m.StreamEnd != 0 {
+		n += 2 + sovContract(uint64(m.StreamEnd))
+	}
+	l = len(m.DescriptionHash)
+	if l > 0 {
+		n += 2 + l + sovContract(uint64(l))
+	}
+	if len(m.PriorTerms) > 0 {
+		for _, e := range m.PriorTerms {
+			l = e.Size()
+			n += 2 + l + sovContract(uint64(l))
+		}
+	}
+	if m.ExtensionsGranted != 0 {
+		n += 2 + sovContract(uint64(m.ExtensionsGranted))
+	}
+	if m.EscrowStaking {
+		n += 3
+	}
+	if m.FreelancerPayoutBps != 0 {
+		n += 2 + sovContract(uint64(m.FreelancerPayoutBps))
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovContract(uint64(l))
+	}
+	if m.LegacyAmount != 0 {
+		n += 1 + sovContract(uint64(m.LegacyAmount))
+	}
+	if m.DeliveryDays != 0 {
+		n += 1 + sovContract(uint64(m.DeliveryDays))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovContract(uint64(m.Deadline))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovContract(uint64(l))
+	}
+	l = len(m.DeliveryNote)
+	if l > 0 {
+		n += 1 + l + sovContract(uint64(l))
+	}
+	if m.DeliveredAt != 0 {
+		n += 1 + sovContract(uint64(m.DeliveredAt))
+	}
+	if m.ApprovedAt != 0 {
+		n += 1 + sovContract(uint64(m.ApprovedAt))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovContract(uint64(l))
 	return n
 }
 
@@ -481,9 +1055,9 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyPrice", wireType)
 			}
-			m.Price = 0
+			m.LegacyPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowContract
@@ -493,7 +1067,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.LegacyPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -619,6 +1193,654 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMilestone", wireType)
+			}
+			m.CurrentMilestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMilestone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
+			}
+			m.DeliveredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourlyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyHoursCap", wireType)
+			}
+			m.WeeklyHoursCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeeklyHoursCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Billed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Billed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodHours", wireType)
+			}
+			m.PeriodHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamStart", wireType)
+			}
+			m.StreamStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamEnd", wireType)
+			}
+			m.StreamEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorTerms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorTerms = append(m.PriorTerms, ContractTerms{})
+			if err := m.PriorTerms[len(m.PriorTerms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionsGranted", wireType)
+			}
+			m.ExtensionsGranted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtensionsGranted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowStaking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EscrowStaking = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerPayoutBps", wireType)
+			}
+			m.FreelancerPayoutBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreelancerPayoutBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContract(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContract
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContract
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyAmount", wireType)
+			}
+			m.LegacyAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacyAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDays", wireType)
+			}
+			m.DeliveryDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
+			}
+			m.DeliveredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			m.ApprovedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContract
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipContract(dAtA[iNdEx:])
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// VoteCommitment returns the commitment a juror submits for its vote on a
+// dispute: the hex encoded sha256 of
+// "<dispute id>:<juror>:<freelancer payout bps>:<salt>".
+// Binding the dispute and the juror keeps commitments from being copied.
+func VoteCommitment(disputeId uint64, juror string, freelancerPayoutBps uint64, salt string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d:%s:%d:%s", disputeId, juror, freelancerPayoutBps, salt)))
+	return hex.EncodeToString(hash[:])
+}
+
+// RulingSide returns the side a freelancer payout favors: client when the
+// freelancer gets nothing, freelancer when it gets everything, split
+// otherwise.
+func RulingSide(freelancerPayoutBps uint64) string {
+	switch freelancerPayoutBps {
+	case 0:
+		return "client"
+	case BasisPoints:
+		return "freelancer"
+	default:
+		return "split"
+	}
+}
+
+// MedianPayoutBps returns the median of the freelancer payouts of the
+// revealed votes, rounded down between the two middle votes.
+func (d Dispute) MedianPayoutBps() uint64 {
+	var payouts []uint64
+	for _, vote := range d.Votes {
+		if vote.Revealed {
+			payouts = append(payouts, vote.FreelancerPayoutBps)
+		}
+	}
+	if len(payouts) == 0 {
+		return 0
+	}
+
+	slices.Sort(payouts)
+	middle := len(payouts) / 2
+	if len(payouts)%2 == 1 {
+		return payouts[middle]
+	}
+	return (payouts[middle-1] + payouts[middle]) / 2
+}
+
+// RevealedVotes returns the number of votes revealed on the dispute.
+func (d Dispute) RevealedVotes() uint64 {
+	var count uint64
+	for _, vote := range d.Votes {
+		if vote.Revealed {
+			count++
+		}
+	}
+	return count
+}
+
+// Quorum returns the number of jurors who must reveal a vote for the dispute
+// to be ruled on by the median of the votes: a majority of the jury. The
+// jurors who did not reveal abstain.
+func (d Dispute) Quorum() uint64 {
+	return uint64(len(d.Arbiters))/2 + 1
+}
+
+// HasQuorum reports whether a majority of the jurors drawn for the dispute
+// revealed a vote.
+func (d Dispute) HasQuorum() bool {
+	return len(d.Arbiters) > 0 && uint64(d.countJurorVotes(true)) >= d.Quorum()
+}
+
+// AllCommitted reports whether every juror of the dispute committed to a vote.
+func (d Dispute) AllCommitted() bool {
+	return d.countJurorVotes(false) == len(d.Arbiters)
+}
+
+// AllRevealed reports whether the dispute has jurors and every one of them
+// revealed its vote.
+func (d Dispute) AllRevealed() bool {
+	return len(d.Arbiters) > 0 && d.countJurorVotes(true) == len(d.Arbiters)
+}
+
+// RevealOpen reports whether jurors can reveal their votes at now: once the
+// voting deadline passed or every juror committed, until the reveal deadline.
+func (d Dispute) RevealOpen(now int64) bool {
+	return (now > d.Deadline || d.AllCommitted()) && now <= d.RevealDeadline
+}
+
+// RevealClosed reports whether the votes of the dispute can be counted at now:
+// once the reveal deadline passed or every juror revealed.
+func (d Dispute) RevealClosed(now int64) bool {
+	return now >= d.RevealDeadline || d.AllRevealed()
+}
+
+// IsResolved reports whether the jurors ruled on the dispute.
+func (d Dispute) IsResolved() bool {
+	return strings.HasPrefix(d.Status, "resolved_")
+}
+
+// AppealBondHeld reports whether the bond of the last appeal of the dispute
+// is held in escrow, until the appeal is ruled on.
+func (d Dispute) AppealBondHeld() bool {
+	return d.Appeals > 0 && (d.Status == "open" || d.Status == "voting")
+}
+
+func (d Dispute) countJurorVotes(revealed bool) int {
+	count := 0
+	for _, vote := range d.Votes {
+		if slices.Contains(d.Arbiters, vote.Arbiter) && (vote.Revealed || !revealed) {
+			count++
+		}
+	}
+	return count
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -31,11 +33,60 @@ type Dispute struct {
 	ClientEvidence     string `protobuf:"bytes,5,opt,name=client_evidence,json=clientEvidence,proto3" json:"client_evidence,omitempty"`
 	FreelancerEvidence string `protobuf:"bytes,6,opt,name=freelancer_evidence,json=freelancerEvidence,proto3" json:"freelancer_evidence,omitempty"`
 	Status             string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	VotesClient        uint64 `protobuf:"varint,8,opt,name=votes_client,json=votesClient,proto3" json:"votes_client,omitempty"`
-	VotesFreelancer    uint64 `protobuf:"varint,9,opt,name=votes_freelancer,json=votesFreelancer,proto3" json:"votes_freelancer,omitempty"`
-	Resolution         string `protobuf:"bytes,10,opt,name=resolution,proto3" json:"resolution,omitempty"`
-	CreatedAt          int64  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Deadline           int64  `protobuf:"varint,12,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Revealed votes paying the freelancer less than half.
+	VotesClient uint64 `protobuf:"varint,8,opt,name=votes_client,json=votesClient,proto3" json:"votes_client,omitempty"`
+	// Revealed votes paying the freelancer at least half.
+	VotesFreelancer uint64 `protobuf:"varint,9,opt,name=votes_freelancer,json=votesFreelancer,proto3" json:"votes_freelancer,omitempty"`
+	Resolution      string `protobuf:"bytes,10,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	CreatedAt       int64  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Deadline        int64  `protobuf:"varint,12,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Milestone under dispute for contracts paid per milestone.
+	MilestoneIndex uint64 `protobuf:"varint,13,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+	// Jurors drawn from the active arbiters when the dispute was opened. Only
+	// they vote, and those who did not are slashed once the deadline passed.
+	Arbiters []string `protobuf:"bytes,14,rep,name=arbiters,proto3" json:"arbiters,omitempty"`
+	// Votes cast on the dispute.
+	Votes []DisputeVote `protobuf:"bytes,15,rep,name=votes,proto3" json:"votes"`
+	// Arbiters drawn after the jurors, in order, promoted to replace the jurors
+	// who did not vote by the juror deadline.
+	Alternates []string `protobuf:"bytes,16,rep,name=alternates,proto3" json:"alternates,omitempty"`
+	// Time the jurors must vote by before alternates replace them.
+	JurorDeadline int64 `protobuf:"varint,17,opt,name=juror_deadline,json=jurorDeadline,proto3" json:"juror_deadline,omitempty"`
+	// Jurors replaced for not voting by the juror deadline, slashed as missing
+	// their vote.
+	Dismissed []string `protobuf:"bytes,18,rep,name=dismissed,proto3" json:"dismissed,omitempty"`
+	// Time the jurors must reveal their votes by. The dispute resolves then,
+	// or once every juror revealed.
+	RevealDeadline int64 `protobuf:"varint,19,opt,name=reveal_deadline,json=revealDeadline,proto3" json:"reveal_deadline,omitempty"`
+	// Share of the disputed amount paid to the freelancer, in basis points: the
+	// median of the revealed votes. The client is refunded the rest.
+	FreelancerPayoutBps uint64 `protobuf:"varint,20,opt,name=freelancer_payout_bps,json=freelancerPayoutBps,proto3" json:"freelancer_payout_bps,omitempty"`
+	// Times the dispute was appealed. Every appeal draws a larger jury with a
+	// longer deadline.
+	Appeals uint64 `protobuf:"varint,21,opt,name=appeals,proto3" json:"appeals,omitempty"`
+	// Time the ruling can be appealed until. The escrow is paid out then,
+	// unless the ruling was appealed.
+	AppealDeadline int64 `protobuf:"varint,22,opt,name=appeal_deadline,json=appealDeadline,proto3" json:"appeal_deadline,omitempty"`
+	// Party who filed the last appeal, and the bond it posted. The bond is
+	// refunded when the appeal overturns the ruling and forfeited otherwise.
+	Appellant    string     `protobuf:"bytes,23,opt,name=appellant,proto3" json:"appellant,omitempty"`
+	AppealBond   types.Coin `protobuf:"bytes,24,opt,name=appeal_bond,json=appealBond,proto3" json:"appeal_bond"`
+	AppealReason string     `protobuf:"bytes,25,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	// Ruling under appeal, in basis points paid to the freelancer.
+	AppealedPayoutBps uint64 `protobuf:"varint,26,opt,name=appealed_payout_bps,json=appealedPayoutBps,proto3" json:"appealed_payout_bps,omitempty"`
+	// Dispute fees paid to the arbiters over every round, deducted from the
+	// escrow paid out.
+	ArbiterFees types.Coin `protobuf:"bytes,27,opt,name=arbiter_fees,json=arbiterFees,proto3" json:"arbiter_fees"`
+	// Whether the escrow was paid out according to the final ruling.
+	Settled bool `protobuf:"varint,28,opt,name=settled,proto3" json:"settled,omitempty"`
+	// Filing fee paid by the initiator and matched by the counterparty to
+	// contest the dispute. The winner of the ruling is refunded its fee, the
+	// fee of the loser rewards the arbiters.
+	FilingFee types.Coin `protobuf:"bytes,29,opt,name=filing_fee,json=filingFee,proto3" json:"filing_fee"`
+	// Whether the counterparty contested the dispute. An uncontested dispute
+	// resolves in favor of the initiator at the response deadline.
+	Contested        bool  `protobuf:"varint,30,opt,name=contested,proto3" json:"contested,omitempty"`
+	ResponseDeadline int64 `protobuf:"varint,31,opt,name=response_deadline,json=responseDeadline,proto3" json:"response_deadline,omitempty"`
 }
 
 func (m *Dispute) Reset()         { *m = Dispute{} }
@@ -155,6 +206,139 @@ func (m *Dispute) GetDeadline() int64 {
 	return 0
 }
 
+func (m *Dispute) GetMilestoneIndex() uint64 {
+	if m != nil {
+		return m.MilestoneIndex
+	}
+	return 0
+}
+
+func (m *Dispute) GetArbiters() []string {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *Dispute) GetVotes() []DisputeVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *Dispute) GetAlternates() []string {
+	if m != nil {
+		return m.Alternates
+	}
+	return nil
+}
+
+func (m *Dispute) GetJurorDeadline() int64 {
+	if m != nil {
+		return m.JurorDeadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetDismissed() []string {
+	if m != nil {
+		return m.Dismissed
+	}
+	return nil
+}
+
+func (m *Dispute) GetRevealDeadline() int64 {
+	if m != nil {
+		return m.RevealDeadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetFreelancerPayoutBps() uint64 {
+	if m != nil {
+		return m.FreelancerPayoutBps
+	}
+	return 0
+}
+
+func (m *Dispute) GetAppeals() uint64 {
+	if m != nil {
+		return m.Appeals
+	}
+	return 0
+}
+
+func (m *Dispute) GetAppealDeadline() int64 {
+	if m != nil {
+		return m.AppealDeadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetAppellant() string {
+	if m != nil {
+		return m.Appellant
+	}
+	return ""
+}
+
+func (m *Dispute) GetAppealBond() types.Coin {
+	if m != nil {
+		return m.AppealBond
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetAppealReason() string {
+	if m != nil {
+		return m.AppealReason
+	}
+	return ""
+}
+
+func (m *Dispute) GetAppealedPayoutBps() uint64 {
+	if m != nil {
+		return m.AppealedPayoutBps
+	}
+	return 0
+}
+
+func (m *Dispute) GetArbiterFees() types.Coin {
+	if m != nil {
+		return m.ArbiterFees
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *Dispute) GetFilingFee() types.Coin {
+	if m != nil {
+		return m.FilingFee
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetContested() bool {
+	if m != nil {
+		return m.Contested
+	}
+	return false
+}
+
+func (m *Dispute) GetResponseDeadline() int64 {
+	if m != nil {
+		return m.ResponseDeadline
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Dispute)(nil), "skillchain.marketplace.v1.Dispute")
 }
@@ -164,29 +348,53 @@ func init() {
 }
 
 var fileDescriptor_3b7805406a77bff0 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbd, 0x8e, 0x1a, 0x31,
-	0x14, 0x85, 0x19, 0x20, 0x03, 0x73, 0x41, 0x10, 0x39, 0x12, 0x72, 0xa2, 0xc4, 0x21, 0x69, 0x20,
-	0x0d, 0x23, 0x94, 0x26, 0x6d, 0x7e, 0xa5, 0xb4, 0x94, 0x69, 0x46, 0x8e, 0x7d, 0xa3, 0x58, 0x4c,
-	0xec, 0x91, 0x7d, 0x19, 0x65, 0x9f, 0x61, 0x9b, 0x7d, 0xac, 0x2d, 0x29, 0xb7, 0x5c, 0xc1, 0x8b,
-	0xac, 0xe4, 0x61, 0x19, 0xb6, 0xbc, 0xdf, 0xf9, 0x66, 0x8e, 0xac, 0x03, 0x8b, 0xb0, 0x35, 0x65,
-	0xa9, 0xfe, 0x4a, 0x63, 0xf3, 0x7f, 0xd2, 0x6f, 0x91, 0xaa, 0x52, 0x2a, 0xcc, 0xeb, 0x75, 0xae,
-	0x4d, 0xa8, 0x76, 0x84, 0xab, 0xca, 0x3b, 0x72, 0xec, 0x65, 0x2b, 0xae, 0x2e, 0xc4, 0x55, 0xbd,
-	0x7e, 0x7f, 0xdd, 0x83, 0xc1, 0xb7, 0x46, 0x66, 0x13, 0xe8, 0x1a, 0xcd, 0x93, 0x79, 0xb2, 0xec,
-	0x6f, 0xba, 0x46, 0xb3, 0xb7, 0x30, 0x52, 0xce, 0x92, 0x97, 0x8a, 0x0a, 0xa3, 0x79, 0x37, 0x06,
-	0xf0, 0x88, 0x7e, 0x6a, 0xf6, 0x1a, 0x32, 0x63, 0x0d, 0x19, 0x49, 0xce, 0xf3, 0xde, 0x3c, 0x59,
-	0x66, 0x9b, 0x16, 0xb0, 0x19, 0xa4, 0x1e, 0x65, 0x70, 0x96, 0xf7, 0x63, 0x74, 0xba, 0xd8, 0x02,
-	0xa6, 0xaa, 0x34, 0x68, 0xa9, 0xc0, 0xda, 0x68, 0xb4, 0x0a, 0xf9, 0xb3, 0x28, 0x4c, 0x1a, 0xfc,
-	0xfd, 0x44, 0x59, 0x0e, 0x2f, 0xfe, 0x78, 0xc4, 0x52, 0x5a, 0x85, 0xbe, 0x95, 0xd3, 0x28, 0xb3,
-	0x36, 0x3a, 0x7f, 0x30, 0x83, 0x34, 0x90, 0xa4, 0x5d, 0xe0, 0x83, 0xa6, 0xb1, 0xb9, 0xd8, 0x3b,
-	0x18, 0xd7, 0x8e, 0x30, 0x14, 0x4d, 0x01, 0x1f, 0xc6, 0x97, 0x8c, 0x22, 0xfb, 0x1a, 0x11, 0xfb,
-	0x00, 0xcf, 0x1b, 0xa5, 0xfd, 0x2d, 0xcf, 0xa2, 0x36, 0x8d, 0xfc, 0xc7, 0x19, 0x33, 0x01, 0xe0,
-	0x31, 0xb8, 0x72, 0x47, 0xc6, 0x59, 0x0e, 0xb1, 0xe9, 0x82, 0xb0, 0x37, 0x00, 0xca, 0xa3, 0x24,
-	0xd4, 0x85, 0x24, 0x3e, 0x9a, 0x27, 0xcb, 0xde, 0x26, 0x3b, 0x91, 0xcf, 0xc4, 0x5e, 0xc1, 0x50,
-	0xa3, 0xd4, 0xa5, 0xb1, 0xc8, 0xc7, 0x31, 0x3c, 0xdf, 0x5f, 0x3e, 0xdd, 0x1e, 0x44, 0xb2, 0x3f,
-	0x88, 0xe4, 0xfe, 0x20, 0x92, 0x9b, 0xa3, 0xe8, 0xec, 0x8f, 0xa2, 0x73, 0x77, 0x14, 0x9d, 0x5f,
-	0xe2, 0x62, 0xeb, 0xff, 0x4f, 0xd6, 0xa6, 0xab, 0x0a, 0xc3, 0xef, 0x34, 0x2e, 0xfd, 0xf1, 0x21,
-	0x00, 0x00, 0xff, 0xff, 0x2e, 0xdd, 0xbc, 0x06, 0x14, 0x02, 0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x4f, 0x1b, 0x3d,
+	0x10, 0xc7, 0xb3, 0x04, 0x02, 0xeb, 0x84, 0x00, 0xe6, 0xe5, 0x31, 0x79, 0x60, 0xc9, 0xf3, 0x54,
+	0x2d, 0xa9, 0x5a, 0x6d, 0x04, 0xbd, 0xf4, 0x54, 0xb5, 0x81, 0x22, 0x71, 0xab, 0x72, 0xe8, 0xa1,
+	0x97, 0x95, 0xb3, 0x1e, 0xa8, 0xcb, 0xc6, 0x5e, 0xd9, 0x4e, 0x04, 0xdf, 0xa2, 0xdf, 0xa6, 0x5f,
+	0x81, 0x23, 0xc7, 0x9e, 0xaa, 0x0a, 0xbe, 0x48, 0xb5, 0xf6, 0xbe, 0xf5, 0x50, 0x95, 0x9b, 0xe7,
+	0x37, 0x33, 0xff, 0xf1, 0xd8, 0x1e, 0xa3, 0x43, 0x7d, 0xc5, 0x93, 0x24, 0xfe, 0x4c, 0xb9, 0x18,
+	0x4e, 0xa9, 0xba, 0x02, 0x93, 0x26, 0x34, 0x86, 0xe1, 0xfc, 0x68, 0xc8, 0xb8, 0x4e, 0x67, 0x06,
+	0xc2, 0x54, 0x49, 0x23, 0xf1, 0x6e, 0x15, 0x18, 0xd6, 0x02, 0xc3, 0xf9, 0x51, 0x2f, 0x88, 0xa5,
+	0x9e, 0x4a, 0x3d, 0x9c, 0x50, 0x9d, 0x25, 0x4e, 0xc0, 0xd0, 0xa3, 0x61, 0x2c, 0xb9, 0x70, 0xa9,
+	0xbd, 0xad, 0x4b, 0x79, 0x29, 0xed, 0x72, 0x98, 0xad, 0x72, 0xfa, 0xf2, 0xaf, 0x95, 0xa3, 0xb9,
+	0x2c, 0xca, 0xff, 0xff, 0xcd, 0x47, 0xcb, 0xa7, 0x0e, 0xe3, 0x2e, 0x5a, 0xe0, 0x8c, 0x78, 0x7d,
+	0x6f, 0xb0, 0x38, 0x5e, 0xe0, 0x0c, 0x1f, 0xa0, 0x76, 0x2c, 0x85, 0x51, 0x34, 0x36, 0x11, 0x67,
+	0x64, 0xc1, 0x3a, 0x50, 0x81, 0xce, 0x19, 0xde, 0x43, 0x3e, 0x17, 0xdc, 0x70, 0x6a, 0xa4, 0x22,
+	0xcd, 0xbe, 0x37, 0xf0, 0xc7, 0x15, 0xc0, 0x3b, 0xa8, 0xa5, 0x80, 0x6a, 0x29, 0xc8, 0xa2, 0x75,
+	0xe5, 0x16, 0x3e, 0x44, 0x6b, 0x71, 0xc2, 0x41, 0x98, 0x08, 0xe6, 0x9c, 0x81, 0x88, 0x81, 0x2c,
+	0xd9, 0x80, 0xae, 0xc3, 0xef, 0x73, 0x8a, 0x87, 0x68, 0xf3, 0x42, 0x01, 0x24, 0x54, 0xc4, 0xa0,
+	0xaa, 0xe0, 0x96, 0x0d, 0xc6, 0x95, 0xab, 0x4c, 0xd8, 0x41, 0x2d, 0x6d, 0xa8, 0x99, 0x69, 0xb2,
+	0xec, 0x2a, 0x3a, 0x0b, 0xff, 0x87, 0x3a, 0x59, 0xcb, 0x3a, 0x72, 0x05, 0xc8, 0x8a, 0xed, 0xa4,
+	0x6d, 0xd9, 0x89, 0x45, 0xf8, 0x39, 0x5a, 0x77, 0x21, 0x95, 0x2c, 0xf1, 0x6d, 0xd8, 0x9a, 0xe5,
+	0x67, 0x25, 0xc6, 0x01, 0x42, 0x0a, 0xb4, 0x4c, 0x66, 0x86, 0x4b, 0x41, 0x90, 0xad, 0x54, 0x23,
+	0x78, 0x1f, 0xa1, 0x58, 0x01, 0x35, 0xc0, 0x22, 0x6a, 0x48, 0xbb, 0xef, 0x0d, 0x9a, 0x63, 0x3f,
+	0x27, 0xef, 0x0c, 0xee, 0xa1, 0x15, 0x06, 0x94, 0x25, 0x5c, 0x00, 0xe9, 0x58, 0x67, 0x69, 0x67,
+	0x47, 0x33, 0xe5, 0x09, 0x68, 0x23, 0x05, 0x44, 0x5c, 0x30, 0xb8, 0x26, 0xab, 0x76, 0x13, 0xdd,
+	0x12, 0x9f, 0x67, 0x34, 0x13, 0xa1, 0x6a, 0xc2, 0x0d, 0x28, 0x4d, 0xba, 0xfd, 0xe6, 0xc0, 0x1f,
+	0x97, 0x36, 0x1e, 0xa1, 0x25, 0xbb, 0x65, 0xb2, 0xd6, 0x6f, 0x0e, 0xda, 0xc7, 0xcf, 0xc2, 0x3f,
+	0xbe, 0xb0, 0x30, 0xbf, 0xf9, 0x8f, 0xd2, 0xc0, 0x68, 0xf1, 0xf6, 0xc7, 0x41, 0x63, 0xec, 0x52,
+	0xb3, 0x1e, 0x69, 0x62, 0x40, 0x09, 0x9a, 0x09, 0xad, 0xdb, 0x0a, 0x35, 0x82, 0x9f, 0xa2, 0xee,
+	0x97, 0x99, 0x92, 0x2a, 0x2a, 0x5b, 0xd9, 0xb0, 0xad, 0xac, 0x5a, 0x7a, 0x5a, 0xf4, 0xb3, 0x87,
+	0x7c, 0xc6, 0xf5, 0x94, 0x6b, 0x0d, 0x8c, 0x60, 0xab, 0x52, 0x81, 0xac, 0x5b, 0x05, 0x73, 0xa0,
+	0x49, 0xa5, 0xb2, 0x69, 0x55, 0xba, 0x0e, 0x97, 0x32, 0xc7, 0x68, 0xbb, 0xf6, 0x10, 0x52, 0x7a,
+	0x23, 0x67, 0x26, 0x9a, 0xa4, 0x9a, 0x6c, 0xd9, 0xc3, 0xa9, 0xbd, 0x92, 0x0f, 0xd6, 0x37, 0x4a,
+	0x35, 0x26, 0x68, 0x99, 0xa6, 0x29, 0xd0, 0x44, 0x93, 0x6d, 0x1b, 0x55, 0x98, 0x59, 0x59, 0xb7,
+	0xac, 0xca, 0xee, 0xb8, 0xb2, 0x0e, 0xd7, 0x77, 0x9f, 0x91, 0x24, 0xa1, 0xc2, 0x90, 0x7f, 0xdc,
+	0xf3, 0x2e, 0x01, 0x7e, 0x8b, 0xda, 0xb9, 0xcc, 0x44, 0x0a, 0x46, 0x48, 0xdf, 0x1b, 0xb4, 0x8f,
+	0x77, 0x43, 0x37, 0xb3, 0x61, 0x36, 0xb3, 0x61, 0x3e, 0xb3, 0xe1, 0x89, 0xe4, 0x22, 0x3f, 0x5f,
+	0xe4, 0x72, 0x46, 0x52, 0x30, 0xfc, 0x04, 0xad, 0xe6, 0x0a, 0xf9, 0x9c, 0xec, 0xda, 0x1a, 0x1d,
+	0x07, 0xc7, 0x6e, 0x5a, 0x42, 0xb4, 0xe9, 0x6c, 0x60, 0xf5, 0xce, 0x7b, 0xb6, 0xa7, 0x8d, 0xc2,
+	0x55, 0xf5, 0x3d, 0x42, 0x9d, 0xfc, 0x25, 0x44, 0x17, 0x00, 0x9a, 0xfc, 0xfb, 0xb8, 0x7d, 0xb5,
+	0xf3, 0xa4, 0x33, 0x00, 0x7b, 0x76, 0x1a, 0x8c, 0x49, 0x80, 0x91, 0xbd, 0xbe, 0x37, 0x58, 0x19,
+	0x17, 0x26, 0x7e, 0x83, 0xd0, 0x05, 0x4f, 0xb8, 0xb8, 0xcc, 0xc4, 0xc9, 0xfe, 0xe3, 0xb4, 0x7d,
+	0x97, 0x72, 0x06, 0xf6, 0x48, 0xb3, 0xff, 0x03, 0xb4, 0x01, 0x46, 0x02, 0xab, 0x5d, 0x01, 0xfc,
+	0x02, 0x6d, 0x28, 0xd0, 0xa9, 0x14, 0x1a, 0xaa, 0xbb, 0x39, 0xb0, 0x77, 0xb3, 0x5e, 0x38, 0x8a,
+	0xdb, 0x19, 0xbd, 0xbe, 0xbd, 0x0f, 0xbc, 0xbb, 0xfb, 0xc0, 0xfb, 0x79, 0x1f, 0x78, 0x5f, 0x1f,
+	0x82, 0xc6, 0xdd, 0x43, 0xd0, 0xf8, 0xfe, 0x10, 0x34, 0x3e, 0x05, 0xb5, 0x1f, 0xf0, 0xfa, 0xb7,
+	0x3f, 0xd0, 0xdc, 0xa4, 0xa0, 0x27, 0x2d, 0xfb, 0xf5, 0xbd, 0xfa, 0x15, 0x00, 0x00, 0xff, 0xff,
+	0x07, 0xd6, 0xe9, 0x1e, 0xa4, 0x05, 0x00, 0x00,
 }
 
 func (m *Dispute) Marshal() (dAtA []byte, err error) {
@@ -209,6 +417,183 @@ func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResponseDeadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ResponseDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.Contested {
+		i--
+		if m.Contested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size, err := m.FilingFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	{
+		size, err := m.ArbiterFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.AppealedPayoutBps != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.AppealedPayoutBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.AppealReason) > 0 {
+		i -= len(m.AppealReason)
+		copy(dAtA[i:], m.AppealReason)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.AppealReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	{
+		size, err := m.AppealBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if len(m.Appellant) > 0 {
+		i -= len(m.Appellant)
+		copy(dAtA[i:], m.Appellant)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Appellant)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.AppealDeadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.AppealDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Appeals != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Appeals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.FreelancerPayoutBps != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.FreelancerPayoutBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RevealDeadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.RevealDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Dismissed) > 0 {
+		for iNdEx := len(m.Dismissed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dismissed[iNdEx])
+			copy(dAtA[i:], m.Dismissed[iNdEx])
+			i = encodeVarintDispute(dAtA, i, uint64(len(m.Dismissed[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.JurorDeadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.JurorDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Alternates) > 0 {
+		for iNdEx := len(m.Alternates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Alternates[iNdEx])
+			copy(dAtA[i:], m.Alternates[iNdEx])
+			i = encodeVarintDispute(dAtA, i, uint64(len(m.Alternates[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Arbiters) > 0 {
+		for iNdEx := len(m.Arbiters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arbiters[iNdEx])
+			copy(dAtA[i:], m.Arbiters[iNdEx])
+			i = encodeVarintDispute(dAtA, i, uint64(len(m.Arbiters[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MilestoneIndex != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.MilestoneIndex))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.Deadline != 0 {
 		i = encodeVarintDispute(dAtA, i, uint64(m.Deadline))
 		i--
@@ -343,6 +728,74 @@ func (m *Dispute) Size() (n int) {
 	if m.Deadline != 0 {
 		n += 1 + sovDispute(uint64(m.Deadline))
 	}
+	if m.MilestoneIndex != 0 {
+		n += 1 + sovDispute(uint64(m.MilestoneIndex))
+	}
+	if len(m.Arbiters) > 0 {
+		for _, s := range m.Arbiters {
+			l = len(s)
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	if len(m.Alternates) > 0 {
+		for _, s := range m.Alternates {
+			l = len(s)
+			n += 2 + l + sovDispute(uint64(l))
+		}
+	}
+	if m.JurorDeadline != 0 {
+		n += 2 + sovDispute(uint64(m.JurorDeadline))
+	}
+	if len(m.Dismissed) > 0 {
+		for _, s := range m.Dismissed {
+			l = len(s)
+			n += 2 + l + sovDispute(uint64(l))
+		}
+	}
+	if m.RevealDeadline != 0 {
+		n += 2 + sovDispute(uint64(m.RevealDeadline))
+	}
+	if m.FreelancerPayoutBps != 0 {
+		n += 2 + sovDispute(uint64(m.FreelancerPayoutBps))
+	}
+	if m.Appeals != 0 {
+		n += 2 + sovDispute(uint64(m.Appeals))
+	}
+	if m.AppealDeadline != 0 {
+		n += 2 + sovDispute(uint64(m.AppealDeadline))
+	}
+	l = len(m.Appellant)
+	if l > 0 {
+		n += 2 + l + sovDispute(uint64(l))
+	}
+	l = m.AppealBond.Size()
+	n += 2 + l + sovDispute(uint64(l))
+	l = len(m.AppealReason)
+	if l > 0 {
+		n += 2 + l + sovDispute(uint64(l))
+	}
+	if m.AppealedPayoutBps != 0 {
+		n += 2 + sovDispute(uint64(m.AppealedPayoutBps))
+	}
+	l = m.ArbiterFees.Size()
+	n += 2 + l + sovDispute(uint64(l))
+	if m.Settled {
+		n += 3
+	}
+	l = m.FilingFee.Size()
+	n += 2 + l + sovDispute(uint64(l))
+	if m.Contested {
+		n += 3
+	}
+	if m.ResponseDeadline != 0 {
+		n += 2 + sovDispute(uint64(m.ResponseDeadline))
+	}
 	return n
 }
 
@@ -687,6 +1140,491 @@ func (m *Dispute) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneIndex", wireType)
+			}
+			m.MilestoneIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MilestoneIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, DisputeVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alternates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alternates = append(m.Alternates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurorDeadline", wireType)
+			}
+			m.JurorDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JurorDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dismissed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dismissed = append(m.Dismissed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealDeadline", wireType)
+			}
+			m.RevealDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerPayoutBps", wireType)
+			}
+			m.FreelancerPayoutBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreelancerPayoutBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appeals", wireType)
+			}
+			m.Appeals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Appeals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealDeadline", wireType)
+			}
+			m.AppealDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appellant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appellant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AppealBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppealReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealedPayoutBps", wireType)
+			}
+			m.AppealedPayoutBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealedPayoutBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArbiterFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilingFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FilingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Contested = bool(v != 0)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDeadline", wireType)
+			}
+			m.ResponseDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispute(dAtA[iNdEx:])
@@ -23,11 +23,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DisputeVote defines the DisputeVote message.
+// The vote is empty until the juror revealed it.
 type DisputeVote struct {
 	Arbiter   string `protobuf:"bytes,1,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
 	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
-	Vote      string `protobuf:"bytes,3,opt,name=vote,proto3" json:"vote,omitempty"`
-	VotedAt   int64  `protobuf:"varint,4,opt,name=voted_at,json=votedAt,proto3" json:"voted_at,omitempty"`
+	// Side the revealed payout favors: client, freelancer or split.
+	Vote       string `protobuf:"bytes,3,opt,name=vote,proto3" json:"vote,omitempty"`
+	VotedAt    int64  `protobuf:"varint,4,opt,name=voted_at,json=votedAt,proto3" json:"voted_at,omitempty"`
+	Commitment string `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Revealed   bool   `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// Share of the disputed amount the juror would pay the freelancer, in basis
+	// points.
+	FreelancerPayoutBps uint64 `protobuf:"varint,7,opt,name=freelancer_payout_bps,json=freelancerPayoutBps,proto3" json:"freelancer_payout_bps,omitempty"`
 }
 
 func (m *DisputeVote) Reset()         { *m = DisputeVote{} }
@@ -91,6 +98,27 @@ func (m *DisputeVote) GetVotedAt() int64 {
 	return 0
 }
 
+func (m *DisputeVote) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+func (m *DisputeVote) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *DisputeVote) GetFreelancerPayoutBps() uint64 {
+	if m != nil {
+		return m.FreelancerPayoutBps
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DisputeVote)(nil), "skillchain.marketplace.v1.DisputeVote")
 }
@@ -100,21 +128,25 @@ func init() {
 }
 
 var fileDescriptor_9b535fbcf01bf513 = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0xce, 0xce, 0xcc,
-	0xc9, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0x29, 0xc8, 0x49,
-	0x4c, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0xc9, 0x2c, 0x2e, 0x28, 0x2d, 0x49, 0x8d, 0x2f, 0xcb,
-	0x2f, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x44, 0xa8, 0xd6, 0x43, 0x52, 0xad,
-	0x57, 0x66, 0xa8, 0x54, 0xca, 0xc5, 0xed, 0x02, 0xd1, 0x10, 0x96, 0x5f, 0x92, 0x2a, 0x24, 0xc1,
-	0xc5, 0x9e, 0x58, 0x94, 0x94, 0x59, 0x92, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04,
-	0xe3, 0x0a, 0xc9, 0x72, 0x71, 0xc1, 0x4c, 0xce, 0x4c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09,
-	0xe2, 0x84, 0x8a, 0x78, 0xa6, 0x08, 0x09, 0x71, 0xb1, 0x80, 0x2c, 0x94, 0x60, 0x06, 0xeb, 0x02,
-	0xb3, 0x85, 0x24, 0xb9, 0x38, 0x40, 0x74, 0x4a, 0x7c, 0x62, 0x89, 0x04, 0x8b, 0x02, 0xa3, 0x06,
-	0x73, 0x10, 0x3b, 0x98, 0xef, 0x58, 0xe2, 0x64, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x72, 0x48, 0x3e, 0xab, 0x40, 0xf1, 0x5b, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0xd8, 0x4b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x92, 0xa1, 0xc3, 0x02, 0x01,
-	0x00, 0x00,
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0xbf, 0x4e, 0xf3, 0x30,
+	0x10, 0xaf, 0xbf, 0xf6, 0x6b, 0x52, 0xb3, 0x19, 0x21, 0xb9, 0x48, 0x58, 0x11, 0x53, 0x06, 0x94,
+	0xa8, 0xb0, 0xb0, 0x52, 0xb1, 0xb0, 0xa1, 0x0c, 0x0c, 0x2c, 0x91, 0x93, 0x1c, 0xc2, 0x6a, 0x12,
+	0x5b, 0xce, 0x35, 0xa2, 0x6f, 0xc1, 0x63, 0x31, 0x76, 0x64, 0x44, 0x89, 0x78, 0x0f, 0x54, 0x43,
+	0x69, 0x98, 0xec, 0xdf, 0xbf, 0xbb, 0xd3, 0x8f, 0x5e, 0x34, 0x2b, 0x55, 0x96, 0xf9, 0xb3, 0x54,
+	0x75, 0x5c, 0x49, 0xbb, 0x02, 0x34, 0xa5, 0xcc, 0x21, 0x6e, 0x17, 0x71, 0xa1, 0x1a, 0xb3, 0x46,
+	0x48, 0x5b, 0x8d, 0x10, 0x19, 0xab, 0x51, 0xb3, 0xf9, 0xc1, 0x1d, 0x0d, 0xdc, 0x51, 0xbb, 0x38,
+	0xff, 0x24, 0xf4, 0xe8, 0xf6, 0x3b, 0xf1, 0xa0, 0x11, 0x18, 0xa7, 0x9e, 0xb4, 0x99, 0x42, 0xb0,
+	0x9c, 0x04, 0x24, 0x9c, 0x25, 0x7b, 0xc8, 0xce, 0x28, 0xdd, 0x8f, 0x56, 0x05, 0xff, 0x17, 0x90,
+	0x70, 0x92, 0xcc, 0x7e, 0x98, 0xbb, 0x82, 0x31, 0x3a, 0xd9, 0x6d, 0xe4, 0x63, 0x97, 0x72, 0x7f,
+	0x36, 0xa7, 0xfe, 0xee, 0x2d, 0x52, 0x89, 0x7c, 0x12, 0x90, 0x70, 0x9c, 0x78, 0x0e, 0xdf, 0x20,
+	0x13, 0x94, 0xe6, 0xba, 0xaa, 0x14, 0x56, 0x50, 0x23, 0xff, 0xef, 0x42, 0x03, 0x86, 0x9d, 0x52,
+	0xdf, 0x42, 0x0b, 0xb2, 0x84, 0x82, 0x4f, 0x03, 0x12, 0xfa, 0xc9, 0x2f, 0x66, 0x97, 0xf4, 0xe4,
+	0xc9, 0x02, 0x94, 0xb2, 0xce, 0xc1, 0xa6, 0x46, 0x6e, 0xf4, 0x1a, 0xd3, 0xcc, 0x34, 0xdc, 0x73,
+	0x47, 0x1d, 0x1f, 0xc4, 0x7b, 0xa7, 0x2d, 0x4d, 0xb3, 0xbc, 0x7e, 0xeb, 0x04, 0xd9, 0x76, 0x82,
+	0x7c, 0x74, 0x82, 0xbc, 0xf6, 0x62, 0xb4, 0xed, 0xc5, 0xe8, 0xbd, 0x17, 0xa3, 0x47, 0x31, 0xa8,
+	0xf2, 0xe5, 0x4f, 0x99, 0xb8, 0x31, 0xd0, 0x64, 0x53, 0xd7, 0xe1, 0xd5, 0x57, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xdd, 0xdb, 0xf9, 0x0d, 0x73, 0x01, 0x00, 0x00,
 }
 
 func (m *DisputeVote) Marshal() (dAtA []byte, err error) {
@@ -137,6 +169,28 @@ func (m *DisputeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FreelancerPayoutBps != 0 {
+		i = encodeVarintDisputeVote(dAtA, i, uint64(m.FreelancerPayoutBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintDisputeVote(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.VotedAt != 0 {
 		i = encodeVarintDisputeVote(dAtA, i, uint64(m.VotedAt))
 		i--
@@ -195,6 +249,16 @@ func (m *DisputeVote) Size() (n int) {
 	if m.VotedAt != 0 {
 		n += 1 + sovDisputeVote(uint64(m.VotedAt))
 	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovDisputeVote(uint64(l))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	if m.FreelancerPayoutBps != 0 {
+		n += 1 + sovDisputeVote(uint64(m.FreelancerPayoutBps))
+	}
 	return n
 }
 
@@ -335,6 +399,77 @@ func (m *DisputeVote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisputeVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDisputeVote
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDisputeVote
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisputeVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerPayoutBps", wireType)
+			}
+			m.FreelancerPayoutBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDisputeVote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreelancerPayoutBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDisputeVote(dAtA[iNdEx:])
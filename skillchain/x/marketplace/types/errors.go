@@ -8,12 +8,20 @@ import (
 
 // x/marketplace module sentinel errors
 var (
-	ErrInvalidSigner     = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrProfileNotFound   = errors.Register(ModuleName, 1101, "profile not found")
-	ErrProfileExists     = errors.Register(ModuleName, 1102, "profile already exists")
-	ErrGigNotFound       = errors.Register(ModuleName, 1200, "gig not found")
-	ErrInvalidGigStatus  = errors.Register(ModuleName, 1201, "invalid gig status transition")
-	ErrUnauthorized      = errors.Register(ModuleName, 1300, "unauthorized")
-	ErrInsufficientFunds = errors.Register(ModuleName, 1400, "insufficient funds")
-	ErrInvalidPrice      = errors.Register(ModuleName, 1401, "invalid price")
+	ErrInvalidSigner       = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrProfileNotFound     = errors.Register(ModuleName, 1101, "profile not found")
+	ErrProfileExists       = errors.Register(ModuleName, 1102, "profile already exists")
+	ErrGigNotFound         = errors.Register(ModuleName, 1200, "gig not found")
+	ErrInvalidGigStatus    = errors.Register(ModuleName, 1201, "invalid gig status transition")
+	ErrUnauthorized        = errors.Register(ModuleName, 1300, "unauthorized")
+	ErrInsufficientFunds   = errors.Register(ModuleName, 1400, "insufficient funds")
+	ErrInvalidPrice        = errors.Register(ModuleName, 1401, "invalid price")
+	ErrDenomNotAllowed     = errors.Register(ModuleName, 1402, "denom not allowed")
+	ErrInvalidMilestone    = errors.Register(ModuleName, 1500, "invalid milestone")
+	ErrInvalidCancellation = errors.Register(ModuleName, 1600, "invalid cancellation")
+	ErrInvalidAmendment    = errors.Register(ModuleName, 1700, "invalid amendment")
+	ErrInvalidExtension    = errors.Register(ModuleName, 1800, "invalid deadline extension")
+	ErrInvalidReferral     = errors.Register(ModuleName, 1900, "invalid referral")
+	ErrInvalidArbiter      = errors.Register(ModuleName, 2000, "invalid arbiter")
+	ErrNotEnoughArbiters   = errors.Register(ModuleName, 2001, "not enough arbiters")
 )
@@ -0,0 +1,14 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// Outflows returns the funds that left the contract escrow.
+func (e ContractEscrow) Outflows() sdk.Coins {
+	return e.Released.Add(e.Refunded...).Add(e.Fees...).Add(e.DisputeFees...)
+}
+
+// Held returns the funds still held in escrow for the contract.
+func (e ContractEscrow) Held() sdk.Coins {
+	held, _ := e.Locked.SafeSub(e.Outflows().Add(e.StakingLosses...)...)
+	return held
+}
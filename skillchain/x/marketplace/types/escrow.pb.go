@@ -0,0 +1,867 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractEscrow records the funds a contract moved through the module account.
+// The amount still held for the contract is locked - released - refunded - fees
+// - dispute fees - staking losses.
+type ContractEscrow struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Client     string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Freelancer string `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	// Funds locked by the client.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// Funds paid out to the freelancer, net of fees.
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+	// Funds returned to the client.
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+	// Platform fees charged on the releases.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// Staking rewards earned by the escrow, before they were split between
+	// the parties and the treasury.
+	StakingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=staking_rewards,json=stakingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_rewards"`
+	// Dispute fees paid to the arbiters.
+	DisputeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=dispute_fees,json=disputeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dispute_fees"`
+	// Escrow lost to slashing while staked and not covered by the staking
+	// rewards of the contract yet.
+	StakingLosses github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=staking_losses,json=stakingLosses,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staking_losses"`
+}
+
+func (m *ContractEscrow) Reset()         { *m = ContractEscrow{} }
+func (m *ContractEscrow) String() string { return proto.CompactTextString(m) }
+func (*ContractEscrow) ProtoMessage()    {}
+func (*ContractEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db6c7cc50ddeea0b, []int{0}
+}
+func (m *ContractEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractEscrow.Merge(m, src)
+}
+func (m *ContractEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractEscrow proto.InternalMessageInfo
+
+func (m *ContractEscrow) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *ContractEscrow) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *ContractEscrow) GetFreelancer() string {
+	if m != nil {
+		return m.Freelancer
+	}
+	return ""
+}
+
+func (m *ContractEscrow) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *ContractEscrow) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func (m *ContractEscrow) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+func (m *ContractEscrow) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *ContractEscrow) GetStakingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StakingRewards
+	}
+	return nil
+}
+
+func (m *ContractEscrow) GetDisputeFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DisputeFees
+	}
+	return nil
+}
+
+func (m *ContractEscrow) GetStakingLosses() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StakingLosses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ContractEscrow)(nil), "skillchain.marketplace.v1.ContractEscrow")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/escrow.proto", fileDescriptor_db6c7cc50ddeea0b)
+}
+
+var fileDescriptor_db6c7cc50ddeea0b = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xb1, 0x8e, 0x13, 0x31,
+	0x10, 0x86, 0xb3, 0x5c, 0x58, 0xee, 0x1c, 0x38, 0x24, 0x0b, 0x21, 0xdf, 0x15, 0x4e, 0x44, 0x81,
+	0xd2, 0xb0, 0x4b, 0xa0, 0xa1, 0xbe, 0x13, 0x48, 0x48, 0x54, 0x29, 0x69, 0x56, 0x8e, 0x3d, 0xd9,
+	0xb3, 0xd6, 0xb1, 0x23, 0x8f, 0x73, 0x07, 0x6f, 0xc1, 0x73, 0xf0, 0x24, 0x57, 0x5e, 0x89, 0x84,
+	0x04, 0x28, 0x79, 0x11, 0xb4, 0x5e, 0x07, 0x42, 0xbf, 0x54, 0x3b, 0xfb, 0x7b, 0xe6, 0xff, 0xfe,
+	0x29, 0x86, 0x3c, 0xc7, 0x46, 0x1b, 0x23, 0xaf, 0x84, 0xb6, 0xe5, 0x4a, 0xf8, 0x06, 0xc2, 0xda,
+	0x08, 0x09, 0xe5, 0xf5, 0xac, 0x04, 0x94, 0xde, 0xdd, 0x14, 0x6b, 0xef, 0x82, 0xa3, 0x67, 0x7f,
+	0xfb, 0x8a, 0x83, 0xbe, 0xe2, 0x7a, 0x76, 0xce, 0xa5, 0xc3, 0x95, 0xc3, 0x72, 0x21, 0xb0, 0x9d,
+	0x5b, 0x40, 0x10, 0xb3, 0x52, 0x3a, 0x6d, 0xbb, 0xd1, 0xf3, 0x27, 0xb5, 0xab, 0x5d, 0x2c, 0xcb,
+	0xb6, 0xea, 0xd4, 0x67, 0xdf, 0x73, 0x72, 0x7a, 0xe9, 0x6c, 0xf0, 0x42, 0x86, 0xb7, 0x91, 0x44,
+	0xc7, 0x64, 0x24, 0x93, 0x52, 0x69, 0xc5, 0xb2, 0x49, 0x36, 0x1d, 0xce, 0xc9, 0x5e, 0x7a, 0xaf,
+	0xe8, 0x53, 0x92, 0x4b, 0xa3, 0xc1, 0x06, 0x76, 0x6f, 0x92, 0x4d, 0x4f, 0xe6, 0xe9, 0x8f, 0x72,
+	0x42, 0x96, 0x1e, 0xc0, 0x08, 0x2b, 0xc1, 0xb3, 0xa3, 0xf8, 0x76, 0xa0, 0x50, 0x49, 0x72, 0xe3,
+	0x64, 0x03, 0x8a, 0x0d, 0x27, 0x47, 0xd3, 0xd1, 0xab, 0xb3, 0xa2, 0x8b, 0x5c, 0xb4, 0x91, 0x8b,
+	0x14, 0xb9, 0xb8, 0x74, 0xda, 0x5e, 0xbc, 0xbc, 0xfd, 0x31, 0x1e, 0x7c, 0xfd, 0x39, 0x9e, 0xd6,
+	0x3a, 0x5c, 0x6d, 0x16, 0x85, 0x74, 0xab, 0x32, 0xed, 0xd7, 0x7d, 0x5e, 0xa0, 0x6a, 0xca, 0xf0,
+	0x79, 0x0d, 0x18, 0x07, 0x70, 0x9e, 0xac, 0x69, 0x4d, 0x8e, 0x3d, 0x18, 0x10, 0x08, 0x8a, 0xdd,
+	0xef, 0x1f, 0xf3, 0xc7, 0xbc, 0x03, 0x2d, 0x37, 0x56, 0x81, 0x62, 0xf9, 0x7f, 0x01, 0x75, 0xe6,
+	0xb4, 0x22, 0xc3, 0x25, 0x00, 0xb2, 0x07, 0xfd, 0x43, 0xa2, 0x31, 0x0d, 0xe4, 0x31, 0x06, 0xd1,
+	0x68, 0x5b, 0x57, 0x1e, 0x6e, 0x84, 0x57, 0xc8, 0x8e, 0xfb, 0x67, 0x9d, 0x26, 0xc6, 0xbc, 0x43,
+	0x50, 0x4b, 0x1e, 0x2a, 0x8d, 0xeb, 0x4d, 0x80, 0x2a, 0xae, 0x77, 0xd2, 0x3f, 0x72, 0x94, 0x00,
+	0xef, 0xda, 0x2d, 0x3d, 0xd9, 0x27, 0xa8, 0x8c, 0x43, 0x04, 0x64, 0xa4, 0x7f, 0xe2, 0xa3, 0x84,
+	0xf8, 0x10, 0x09, 0x17, 0x6f, 0x6e, 0xb7, 0x3c, 0xbb, 0xdb, 0xf2, 0xec, 0xd7, 0x96, 0x67, 0x5f,
+	0x76, 0x7c, 0x70, 0xb7, 0xe3, 0x83, 0x6f, 0x3b, 0x3e, 0xf8, 0xc8, 0x0f, 0x0e, 0xfe, 0xd3, 0x3f,
+	0x27, 0x1f, 0xed, 0x16, 0x79, 0x3c, 0xcf, 0xd7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x20, 0x71,
+	0xf1, 0x42, 0x19, 0x04, 0x00, 0x00,
+}
+
+func (m *ContractEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingLosses) > 0 {
+		for iNdEx := len(m.StakingLosses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingLosses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DisputeFees) > 0 {
+		for iNdEx := len(m.DisputeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisputeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StakingRewards) > 0 {
+		for iNdEx := len(m.StakingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEscrow(uint64(m.ContractId))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if len(m.StakingRewards) > 0 {
+		for _, e := range m.StakingRewards {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if len(m.DisputeFees) > 0 {
+		for _, e := range m.DisputeFees {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if len(m.StakingLosses) > 0 {
+		for _, e := range m.StakingLosses {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingRewards = append(m.StakingRewards, types.Coin{})
+			if err := m.StakingRewards[len(m.StakingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeFees = append(m.DisputeFees, types.Coin{})
+			if err := m.DisputeFees[len(m.DisputeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingLosses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingLosses = append(m.StakingLosses, types.Coin{})
+			if err := m.StakingLosses[len(m.StakingLosses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrow = fmt.Errorf("proto: unexpected end of group")
+)
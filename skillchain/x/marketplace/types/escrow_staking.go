@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// NewEscrowStakingPool returns an empty escrow staking pool.
+func NewEscrowStakingPool() EscrowStakingPool {
+	return EscrowStakingPool{
+		Bonded:    math.ZeroInt(),
+		Unbonding: math.ZeroInt(),
+		Shortfall: math.ZeroInt(),
+	}
+}
+
+// Validate validates the escrow staking pool.
+func (p EscrowStakingPool) Validate() error {
+	for name, amount := range map[string]math.Int{"bonded": p.Bonded, "unbonding": p.Unbonding, "shortfall": p.Shortfall} {
+		if !amount.IsNil() && amount.IsNegative() {
+			return fmt.Errorf("%s amount cannot be negative", name)
+		}
+	}
+	if p.Denom != "" {
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return fmt.Errorf("invalid denom: %w", err)
+		}
+	}
+	return p.Rewards.Validate()
+}
+
+// Staked returns the escrow staked, unbonding or lost to slashing.
+func (p EscrowStakingPool) Staked() sdk.Coins {
+	amount := math.ZeroInt()
+	for _, part := range []math.Int{p.Bonded, p.Unbonding, p.Shortfall} {
+		if !part.IsNil() {
+			amount = amount.Add(part)
+		}
+	}
+	if p.Denom == "" || !amount.IsPositive() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewCoin(p.Denom, amount))
+}
+
+// Validate validates the escrow staking params.
+func (s EscrowStakingParams) Validate() error {
+	if s.MaxStakedBps > BasisPoints {
+		return fmt.Errorf("max staked share cannot exceed %d basis points", BasisPoints)
+	}
+	if s.ReserveBps > BasisPoints {
+		return fmt.Errorf("reserve cannot exceed %d basis points", BasisPoints)
+	}
+	if s.ClientRewardBps+s.FreelancerRewardBps+s.TreasuryRewardBps != BasisPoints {
+		return fmt.Errorf("reward shares must add up to %d basis points", BasisPoints)
+	}
+	if s.Epoch == "" {
+		return fmt.Errorf("epoch cannot be empty")
+	}
+	if s.Enabled && len(s.Validators) == 0 {
+		return fmt.Errorf("at least one validator is required")
+	}
+	seen := make(map[string]bool, len(s.Validators))
+	for _, validator := range s.Validators {
+		if _, _, err := bech32.DecodeAndConvert(validator); err != nil {
+			return fmt.Errorf("invalid validator address %q: %w", validator, err)
+		}
+		if seen[validator] {
+			return fmt.Errorf("duplicate validator %q", validator)
+		}
+		seen[validator] = true
+	}
+	return nil
+}
@@ -0,0 +1,1061 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/escrow_staking.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EscrowStakingParams defines how idle escrow of the contracts opted in is
+// delegated to validators. Shares are in basis points.
+type EscrowStakingParams struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Operator addresses of the validators the escrow is delegated to, in
+	// equal parts.
+	Validators []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	// Maximum share of the escrow of the contracts opted in that is delegated.
+	MaxStakedBps uint64 `protobuf:"varint,3,opt,name=max_staked_bps,json=maxStakedBps,proto3" json:"max_staked_bps,omitempty"`
+	// Share of the escrow of the contracts opted in kept liquid. The escrow
+	// account also never delegates below the escrow of the largest of them, so
+	// no payout waits on an unbonding.
+	ReserveBps uint64 `protobuf:"varint,4,opt,name=reserve_bps,json=reserveBps,proto3" json:"reserve_bps,omitempty"`
+	// Split of the staking rewards, adding up to 10000. The treasury share
+	// goes to the fee distribution treasury, or to the community pool when
+	// there is none.
+	ClientRewardBps     uint64 `protobuf:"varint,5,opt,name=client_reward_bps,json=clientRewardBps,proto3" json:"client_reward_bps,omitempty"`
+	FreelancerRewardBps uint64 `protobuf:"varint,6,opt,name=freelancer_reward_bps,json=freelancerRewardBps,proto3" json:"freelancer_reward_bps,omitempty"`
+	TreasuryRewardBps   uint64 `protobuf:"varint,7,opt,name=treasury_reward_bps,json=treasuryRewardBps,proto3" json:"treasury_reward_bps,omitempty"`
+	// Epoch at the end of which rewards are withdrawn and the stake rebalanced.
+	Epoch string `protobuf:"bytes,8,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *EscrowStakingParams) Reset()         { *m = EscrowStakingParams{} }
+func (m *EscrowStakingParams) String() string { return proto.CompactTextString(m) }
+func (*EscrowStakingParams) ProtoMessage()    {}
+func (*EscrowStakingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8e17301511420f1, []int{0}
+}
+func (m *EscrowStakingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowStakingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowStakingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowStakingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowStakingParams.Merge(m, src)
+}
+func (m *EscrowStakingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowStakingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowStakingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowStakingParams proto.InternalMessageInfo
+
+func (m *EscrowStakingParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EscrowStakingParams) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *EscrowStakingParams) GetMaxStakedBps() uint64 {
+	if m != nil {
+		return m.MaxStakedBps
+	}
+	return 0
+}
+
+func (m *EscrowStakingParams) GetReserveBps() uint64 {
+	if m != nil {
+		return m.ReserveBps
+	}
+	return 0
+}
+
+func (m *EscrowStakingParams) GetClientRewardBps() uint64 {
+	if m != nil {
+		return m.ClientRewardBps
+	}
+	return 0
+}
+
+func (m *EscrowStakingParams) GetFreelancerRewardBps() uint64 {
+	if m != nil {
+		return m.FreelancerRewardBps
+	}
+	return 0
+}
+
+func (m *EscrowStakingParams) GetTreasuryRewardBps() uint64 {
+	if m != nil {
+		return m.TreasuryRewardBps
+	}
+	return 0
+}
+
+func (m *EscrowStakingParams) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
+	}
+	return ""
+}
+
+// EscrowStakingPool tracks the escrow delegated to validators.
+type EscrowStakingPool struct {
+	// Escrow currently delegated.
+	Bonded cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=bonded,proto3,customtype=cosmossdk.io/math.Int" json:"bonded"`
+	// Escrow undelegated and not returned to the escrow account yet.
+	Unbonding cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=unbonding,proto3,customtype=cosmossdk.io/math.Int" json:"unbonding"`
+	// Escrow lost to slashing that no contract bears: the rounding dust of the
+	// losses split between the contracts opted in, or the whole loss when none
+	// is opted in anymore. It is covered by the next rewards.
+	Shortfall cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=shortfall,proto3,customtype=cosmossdk.io/math.Int" json:"shortfall"`
+	// Rewards earned by the contracts since staking started.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// Denom of the escrow staked. It changes with stake_denom only once the
+	// pool unwound.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EscrowStakingPool) Reset()         { *m = EscrowStakingPool{} }
+func (m *EscrowStakingPool) String() string { return proto.CompactTextString(m) }
+func (*EscrowStakingPool) ProtoMessage()    {}
+func (*EscrowStakingPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8e17301511420f1, []int{1}
+}
+func (m *EscrowStakingPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowStakingPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowStakingPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowStakingPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowStakingPool.Merge(m, src)
+}
+func (m *EscrowStakingPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowStakingPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowStakingPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowStakingPool proto.InternalMessageInfo
+
+func (m *EscrowStakingPool) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *EscrowStakingPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EscrowStakingParams)(nil), "skillchain.marketplace.v1.EscrowStakingParams")
+	proto.RegisterType((*EscrowStakingPool)(nil), "skillchain.marketplace.v1.EscrowStakingPool")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/escrow_staking.proto", fileDescriptor_e8e17301511420f1)
+}
+
+var fileDescriptor_e8e17301511420f1 = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0x6e, 0x13, 0x4f,
+	0x10, 0xc7, 0x7d, 0xb6, 0x63, 0xc7, 0x9b, 0x9f, 0x7e, 0xc8, 0xe7, 0x44, 0x3a, 0xa7, 0x38, 0x5b,
+	0x11, 0xc5, 0x29, 0x52, 0xf6, 0x70, 0x10, 0x12, 0xa2, 0x34, 0x42, 0x22, 0x0d, 0x42, 0x97, 0x8e,
+	0xc6, 0xda, 0xbb, 0xdb, 0xd8, 0x8b, 0xf7, 0x76, 0x4f, 0xbb, 0x6b, 0xc7, 0x79, 0x0b, 0x1e, 0x81,
+	0x12, 0x51, 0x51, 0xf0, 0x04, 0x54, 0x29, 0x23, 0x2a, 0x44, 0x11, 0x90, 0x5d, 0xc0, 0x5b, 0x80,
+	0xf6, 0x8f, 0xb1, 0xd3, 0xa6, 0xb1, 0x77, 0x66, 0x3e, 0xf3, 0x9d, 0xbd, 0x9d, 0x19, 0x00, 0xe5,
+	0x94, 0x50, 0x9a, 0x4d, 0x10, 0x61, 0x71, 0x81, 0xc4, 0x14, 0xab, 0x92, 0xa2, 0x0c, 0xc7, 0xf3,
+	0x41, 0x8c, 0x65, 0x26, 0xf8, 0xe5, 0x48, 0x2a, 0x34, 0x25, 0x6c, 0x0c, 0x4b, 0xc1, 0x15, 0xf7,
+	0xbb, 0x1b, 0x1e, 0x6e, 0xf1, 0x70, 0x3e, 0x38, 0x6c, 0xa3, 0x82, 0x30, 0x1e, 0x9b, 0x5f, 0x4b,
+	0x1f, 0x86, 0x19, 0x97, 0x05, 0x97, 0x71, 0x8a, 0xa4, 0x96, 0x4c, 0xb1, 0x42, 0x83, 0x38, 0xe3,
+	0x84, 0xb9, 0x78, 0xd7, 0xc6, 0x47, 0xc6, 0x8a, 0xad, 0xe1, 0x42, 0xfb, 0x63, 0x3e, 0xe6, 0xd6,
+	0xaf, 0x4f, 0xd6, 0x7b, 0xf4, 0xa5, 0x0a, 0x3a, 0x2f, 0xcc, 0xbd, 0xce, 0xed, 0xb5, 0x5e, 0x23,
+	0x81, 0x0a, 0xe9, 0x07, 0xa0, 0x89, 0x19, 0x4a, 0x29, 0xce, 0x03, 0xaf, 0xef, 0x45, 0xbb, 0xc9,
+	0xda, 0xf4, 0x43, 0x00, 0xe6, 0x88, 0x92, 0x1c, 0x29, 0x2e, 0x64, 0x50, 0xed, 0xd7, 0xa2, 0x56,
+	0xb2, 0xe5, 0xf1, 0x1f, 0x82, 0xff, 0x0b, 0xb4, 0x30, 0x5f, 0x89, 0xf3, 0x51, 0x5a, 0xca, 0xa0,
+	0xd6, 0xf7, 0xa2, 0x7a, 0xf2, 0x5f, 0x81, 0x16, 0xe7, 0xc6, 0x39, 0x2c, 0xa5, 0xdf, 0x03, 0x7b,
+	0x02, 0x4b, 0x2c, 0xe6, 0xd8, 0x20, 0x75, 0x83, 0x00, 0xe7, 0xd2, 0xc0, 0x31, 0x68, 0x67, 0x94,
+	0x60, 0xa6, 0x46, 0x02, 0x5f, 0x22, 0x61, 0x95, 0x76, 0x0c, 0xf6, 0xc0, 0x06, 0x12, 0xe3, 0xd7,
+	0xec, 0x29, 0x38, 0xb8, 0x10, 0x18, 0x53, 0xc4, 0x32, 0x2c, 0xb6, 0xf9, 0x86, 0xe1, 0x3b, 0x9b,
+	0xe0, 0x26, 0x07, 0x82, 0x8e, 0x12, 0x18, 0xc9, 0x99, 0xb8, 0xda, 0xce, 0x68, 0x9a, 0x8c, 0xf6,
+	0x3a, 0xb4, 0xe1, 0xf7, 0xc1, 0x0e, 0x2e, 0x79, 0x36, 0x09, 0x76, 0xfb, 0x5e, 0xd4, 0x4a, 0xac,
+	0xf1, 0xac, 0xfe, 0xfb, 0x7d, 0xcf, 0x3b, 0xfa, 0x53, 0x05, 0xed, 0xbb, 0x8f, 0xc8, 0x39, 0xf5,
+	0x5f, 0x82, 0x46, 0xca, 0x59, 0xee, 0x5e, 0xb0, 0x35, 0x7c, 0x74, 0x7d, 0xdb, 0xab, 0x7c, 0xbf,
+	0xed, 0x1d, 0xd8, 0xb6, 0xc8, 0x7c, 0x0a, 0x09, 0x8f, 0x0b, 0xa4, 0x26, 0xf0, 0x8c, 0xa9, 0xaf,
+	0x9f, 0x4f, 0x80, 0xeb, 0xd7, 0x19, 0x53, 0x1f, 0x7e, 0x7d, 0x3a, 0xf6, 0x12, 0x97, 0xef, 0xbf,
+	0x02, 0xad, 0x19, 0xd3, 0x67, 0xc2, 0xc6, 0x41, 0xf5, 0x9e, 0x62, 0x1b, 0x09, 0xad, 0x27, 0x27,
+	0x5c, 0xa8, 0x0b, 0x44, 0xa9, 0xe9, 0xce, 0xbd, 0xf4, 0xfe, 0x49, 0xf8, 0x6f, 0x41, 0xd3, 0x3e,
+	0xa1, 0x6e, 0x64, 0x2d, 0xda, 0x3b, 0xed, 0x42, 0x47, 0xeb, 0x39, 0x85, 0x6e, 0x4e, 0xe1, 0x73,
+	0x4e, 0xd8, 0xf0, 0x89, 0x2e, 0xf4, 0xf1, 0x47, 0x2f, 0x1a, 0x13, 0x35, 0x99, 0xa5, 0x30, 0xe3,
+	0x85, 0x9b, 0x53, 0xf7, 0x77, 0x22, 0xf3, 0x69, 0xac, 0xae, 0x4a, 0x2c, 0x4d, 0x82, 0xb4, 0xd5,
+	0xd6, 0x05, 0x74, 0x1f, 0x72, 0xcc, 0x78, 0x61, 0x66, 0xa1, 0x95, 0x58, 0x63, 0xf8, 0xf4, 0x7a,
+	0x19, 0x7a, 0x37, 0xcb, 0xd0, 0xfb, 0xb9, 0x0c, 0xbd, 0x77, 0xab, 0xb0, 0x72, 0xb3, 0x0a, 0x2b,
+	0xdf, 0x56, 0x61, 0xe5, 0x4d, 0xb8, 0xb5, 0x8f, 0x8b, 0x3b, 0x1b, 0x69, 0x6a, 0xa4, 0x0d, 0xb3,
+	0x07, 0x8f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xf0, 0x2a, 0x4e, 0xb8, 0x03, 0x00, 0x00,
+}
+
+func (this *EscrowStakingParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EscrowStakingParams)
+	if !ok {
+		that2, ok := that.(EscrowStakingParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if len(this.Validators) != len(that1.Validators) {
+		return false
+	}
+	for i := range this.Validators {
+		if this.Validators[i] != that1.Validators[i] {
+			return false
+		}
+	}
+	if this.MaxStakedBps != that1.MaxStakedBps {
+		return false
+	}
+	if this.ReserveBps != that1.ReserveBps {
+		return false
+	}
+	if this.ClientRewardBps != that1.ClientRewardBps {
+		return false
+	}
+	if this.FreelancerRewardBps != that1.FreelancerRewardBps {
+		return false
+	}
+	if this.TreasuryRewardBps != that1.TreasuryRewardBps {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (m *EscrowStakingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowStakingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowStakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TreasuryRewardBps != 0 {
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(m.TreasuryRewardBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FreelancerRewardBps != 0 {
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(m.FreelancerRewardBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClientRewardBps != 0 {
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(m.ClientRewardBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReserveBps != 0 {
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(m.ReserveBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxStakedBps != 0 {
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(m.MaxStakedBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintEscrowStaking(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowStakingPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowStakingPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowStakingPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrowStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Shortfall.Size()
+		i -= size
+		if _, err := m.Shortfall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Unbonding.Size()
+		i -= size
+		if _, err := m.Unbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Bonded.Size()
+		i -= size
+		if _, err := m.Bonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrowStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrowStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrowStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EscrowStakingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovEscrowStaking(uint64(l))
+		}
+	}
+	if m.MaxStakedBps != 0 {
+		n += 1 + sovEscrowStaking(uint64(m.MaxStakedBps))
+	}
+	if m.ReserveBps != 0 {
+		n += 1 + sovEscrowStaking(uint64(m.ReserveBps))
+	}
+	if m.ClientRewardBps != 0 {
+		n += 1 + sovEscrowStaking(uint64(m.ClientRewardBps))
+	}
+	if m.FreelancerRewardBps != 0 {
+		n += 1 + sovEscrowStaking(uint64(m.FreelancerRewardBps))
+	}
+	if m.TreasuryRewardBps != 0 {
+		n += 1 + sovEscrowStaking(uint64(m.TreasuryRewardBps))
+	}
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEscrowStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *EscrowStakingPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bonded.Size()
+	n += 1 + l + sovEscrowStaking(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovEscrowStaking(uint64(l))
+	l = m.Shortfall.Size()
+	n += 1 + l + sovEscrowStaking(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEscrowStaking(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEscrowStaking(uint64(l))
+	}
+	return n
+}
+
+func sovEscrowStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrowStaking(x uint64) (n int) {
+	return sovEscrowStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EscrowStakingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowStakingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowStakingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakedBps", wireType)
+			}
+			m.MaxStakedBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakedBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveBps", wireType)
+			}
+			m.ReserveBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReserveBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRewardBps", wireType)
+			}
+			m.ClientRewardBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientRewardBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerRewardBps", wireType)
+			}
+			m.FreelancerRewardBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreelancerRewardBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRewardBps", wireType)
+			}
+			m.TreasuryRewardBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryRewardBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrowStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowStakingPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowStakingPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowStakingPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shortfall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrowStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrowStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrowStaking(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrowStaking
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrowStaking
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrowStaking
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrowStaking
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrowStaking
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrowStaking        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrowStaking          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrowStaking = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/extension.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeadlineExtension is a request of the freelancer to move the delivery
+// deadline of an active contract. The deadline only moves once the client
+// approves it.
+type DeadlineExtension struct {
+	Id                uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractId        uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Freelancer        string `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	PreviousDeadline  int64  `protobuf:"varint,4,opt,name=previous_deadline,json=previousDeadline,proto3" json:"previous_deadline,omitempty"`
+	RequestedDeadline int64  `protobuf:"varint,5,opt,name=requested_deadline,json=requestedDeadline,proto3" json:"requested_deadline,omitempty"`
+	Reason            string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// pending, approved or declined.
+	Status    string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt int64  `protobuf:"varint,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+}
+
+func (m *DeadlineExtension) Reset()         { *m = DeadlineExtension{} }
+func (m *DeadlineExtension) String() string { return proto.CompactTextString(m) }
+func (*DeadlineExtension) ProtoMessage()    {}
+func (*DeadlineExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f15526bcf9134a6b, []int{0}
+}
+func (m *DeadlineExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadlineExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadlineExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadlineExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineExtension.Merge(m, src)
+}
+func (m *DeadlineExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadlineExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlineExtension proto.InternalMessageInfo
+
+func (m *DeadlineExtension) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeadlineExtension) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *DeadlineExtension) GetFreelancer() string {
+	if m != nil {
+		return m.Freelancer
+	}
+	return ""
+}
+
+func (m *DeadlineExtension) GetPreviousDeadline() int64 {
+	if m != nil {
+		return m.PreviousDeadline
+	}
+	return 0
+}
+
+func (m *DeadlineExtension) GetRequestedDeadline() int64 {
+	if m != nil {
+		return m.RequestedDeadline
+	}
+	return 0
+}
+
+func (m *DeadlineExtension) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DeadlineExtension) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DeadlineExtension) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *DeadlineExtension) GetDecidedAt() int64 {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeadlineExtension)(nil), "skillchain.marketplace.v1.DeadlineExtension")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/extension.proto", fileDescriptor_f15526bcf9134a6b)
+}
+
+var fileDescriptor_f15526bcf9134a6b = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbf, 0x4e, 0x42, 0x31,
+	0x14, 0xc6, 0xe9, 0x05, 0x51, 0x8e, 0x89, 0x91, 0x0e, 0xa6, 0x0e, 0x56, 0xe2, 0x84, 0x31, 0x42,
+	0x88, 0x8b, 0x2b, 0x46, 0x07, 0x57, 0x46, 0x17, 0x52, 0xdb, 0x63, 0x6c, 0xb8, 0xb6, 0xd7, 0xf6,
+	0x40, 0xf0, 0x2d, 0x7c, 0x12, 0x9f, 0xc3, 0x91, 0xd1, 0xd1, 0xc0, 0x8b, 0x18, 0xee, 0x1f, 0xc0,
+	0xf1, 0xfc, 0x7e, 0x5f, 0xcf, 0x69, 0xf2, 0xc1, 0x65, 0x9c, 0xd8, 0x34, 0xd5, 0xaf, 0xca, 0xba,
+	0xfe, 0x9b, 0x0a, 0x13, 0xa4, 0x2c, 0x55, 0x1a, 0xfb, 0xb3, 0x41, 0x1f, 0xe7, 0x84, 0x2e, 0x5a,
+	0xef, 0x7a, 0x59, 0xf0, 0xe4, 0xf9, 0xe9, 0x36, 0xda, 0xdb, 0x89, 0xf6, 0x66, 0x83, 0x8b, 0xaf,
+	0x04, 0xda, 0xf7, 0xa8, 0x4c, 0x6a, 0x1d, 0x3e, 0x54, 0xcf, 0xf8, 0x11, 0x24, 0xd6, 0x08, 0xd6,
+	0x61, 0xdd, 0xc6, 0x28, 0xb1, 0x86, 0x9f, 0xc3, 0xa1, 0xf6, 0x8e, 0x82, 0xd2, 0x34, 0xb6, 0x46,
+	0x24, 0xb9, 0x80, 0x0a, 0x3d, 0x1a, 0x2e, 0x01, 0x5e, 0x02, 0x62, 0xaa, 0x9c, 0xc6, 0x20, 0xea,
+	0x1d, 0xd6, 0x6d, 0x8d, 0x76, 0x08, 0xbf, 0x82, 0x76, 0x16, 0x70, 0x66, 0xfd, 0x34, 0x8e, 0x4d,
+	0x79, 0x4e, 0x34, 0x3a, 0xac, 0x5b, 0x1f, 0x1d, 0x57, 0xa2, 0xfa, 0x06, 0xbf, 0x06, 0x1e, 0xf0,
+	0x7d, 0x8a, 0x91, 0xd0, 0x6c, 0xd3, 0x7b, 0x79, 0xba, 0xbd, 0x31, 0x9b, 0xf8, 0x09, 0x34, 0x03,
+	0xaa, 0xe8, 0x9d, 0x68, 0xe6, 0x77, 0xcb, 0x69, 0xcd, 0x23, 0x29, 0x9a, 0x46, 0xb1, 0x5f, 0xf0,
+	0x62, 0xe2, 0x67, 0x00, 0x3a, 0xa0, 0x5a, 0x2f, 0x57, 0x24, 0x0e, 0xf2, 0xb5, 0xad, 0x92, 0x0c,
+	0x69, 0xad, 0x0d, 0x6a, 0x6b, 0x0a, 0xdd, 0x2a, 0x74, 0x49, 0x86, 0x74, 0x77, 0xfb, 0xbd, 0x94,
+	0x6c, 0xb1, 0x94, 0xec, 0x77, 0x29, 0xd9, 0xe7, 0x4a, 0xd6, 0x16, 0x2b, 0x59, 0xfb, 0x59, 0xc9,
+	0xda, 0x93, 0xdc, 0x29, 0x64, 0xfe, 0xaf, 0x12, 0xfa, 0xc8, 0x30, 0x3e, 0x37, 0xf3, 0x32, 0x6e,
+	0xfe, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xb2, 0x43, 0xf9, 0xb9, 0x01, 0x00, 0x00,
+}
+
+func (m *DeadlineExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadlineExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadlineExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecidedAt != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.DecidedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RequestedDeadline != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.RequestedDeadline))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousDeadline != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.PreviousDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeadlineExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovExtension(uint64(m.Id))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovExtension(uint64(m.ContractId))
+	}
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	if m.PreviousDeadline != 0 {
+		n += 1 + sovExtension(uint64(m.PreviousDeadline))
+	}
+	if m.RequestedDeadline != 0 {
+		n += 1 + sovExtension(uint64(m.RequestedDeadline))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovExtension(uint64(m.CreatedAt))
+	}
+	if m.DecidedAt != 0 {
+		n += 1 + sovExtension(uint64(m.DecidedAt))
+	}
+	return n
+}
+
+func sovExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtension(x uint64) (n int) {
+	return sovExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeadlineExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadlineExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadlineExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDeadline", wireType)
+			}
+			m.PreviousDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedDeadline", wireType)
+			}
+			m.RequestedDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecidedAt", wireType)
+			}
+			m.DecidedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecidedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExtension = fmt.Errorf("proto: unexpected end of group")
+)
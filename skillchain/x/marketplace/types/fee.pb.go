@@ -0,0 +1,1221 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/fee.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeDistribution defines how collected platform fees are split, in basis
+// points. The shares must add up to 10000.
+type FeeDistribution struct {
+	// Address receiving the treasury share, required when treasury_bps is set.
+	Treasury         string `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	TreasuryBps      uint64 `protobuf:"varint,2,opt,name=treasury_bps,json=treasuryBps,proto3" json:"treasury_bps,omitempty"`
+	CommunityPoolBps uint64 `protobuf:"varint,3,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+	BurnBps          uint64 `protobuf:"varint,4,opt,name=burn_bps,json=burnBps,proto3" json:"burn_bps,omitempty"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeee209d96dc2b8e, []int{0}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
+
+func (m *FeeDistribution) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *FeeDistribution) GetTreasuryBps() uint64 {
+	if m != nil {
+		return m.TreasuryBps
+	}
+	return 0
+}
+
+func (m *FeeDistribution) GetCommunityPoolBps() uint64 {
+	if m != nil {
+		return m.CommunityPoolBps
+	}
+	return 0
+}
+
+func (m *FeeDistribution) GetBurnBps() uint64 {
+	if m != nil {
+		return m.BurnBps
+	}
+	return 0
+}
+
+// FeeTier is a platform fee rate granted to freelancers meeting every
+// threshold set on the tier. Unset thresholds are ignored.
+type FeeTier struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Minimum number of contracts completed by the freelancer.
+	MinCompletedJobs uint64 `protobuf:"varint,2,opt,name=min_completed_jobs,json=minCompletedJobs,proto3" json:"min_completed_jobs,omitempty"`
+	// Minimum average rating received by the freelancer.
+	MinRating uint64 `protobuf:"varint,3,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	// Minimum amount of the stake denom the freelancer has bonded to
+	// validators.
+	MinStake cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_stake,json=minStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_stake"`
+	// Platform fee of the tier in basis points.
+	FeeBps uint64 `protobuf:"varint,5,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeee209d96dc2b8e, []int{1}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+func (m *FeeTier) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeeTier) GetMinCompletedJobs() uint64 {
+	if m != nil {
+		return m.MinCompletedJobs
+	}
+	return 0
+}
+
+func (m *FeeTier) GetMinRating() uint64 {
+	if m != nil {
+		return m.MinRating
+	}
+	return 0
+}
+
+func (m *FeeTier) GetFeeBps() uint64 {
+	if m != nil {
+		return m.FeeBps
+	}
+	return 0
+}
+
+// FeeStats records the platform fees collected and where they went.
+type FeeStats struct {
+	Collected     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected"`
+	Treasury      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=treasury,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	Burned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *FeeStats) Reset()         { *m = FeeStats{} }
+func (m *FeeStats) String() string { return proto.CompactTextString(m) }
+func (*FeeStats) ProtoMessage()    {}
+func (*FeeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aeee209d96dc2b8e, []int{2}
+}
+func (m *FeeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeStats.Merge(m, src)
+}
+func (m *FeeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeStats proto.InternalMessageInfo
+
+func (m *FeeStats) GetCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collected
+	}
+	return nil
+}
+
+func (m *FeeStats) GetTreasury() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+func (m *FeeStats) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *FeeStats) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeeDistribution)(nil), "skillchain.marketplace.v1.FeeDistribution")
+	proto.RegisterType((*FeeTier)(nil), "skillchain.marketplace.v1.FeeTier")
+	proto.RegisterType((*FeeStats)(nil), "skillchain.marketplace.v1.FeeStats")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/fee.proto", fileDescriptor_aeee209d96dc2b8e)
+}
+
+var fileDescriptor_aeee209d96dc2b8e = []byte{
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0xae, 0xb7, 0xa5, 0x3f, 0x5e, 0x7e, 0x16, 0x0b, 0x44, 0x5b, 0x89, 0xb4, 0x2c, 0x97, 0x0a,
+	0xb1, 0x09, 0x05, 0x21, 0x21, 0x8e, 0x5d, 0x54, 0x69, 0x91, 0x90, 0x50, 0x97, 0x13, 0x97, 0xc8,
+	0x49, 0xa7, 0xad, 0x69, 0x6c, 0x47, 0xb1, 0xb3, 0xd0, 0xb7, 0xe0, 0x0d, 0xe0, 0x88, 0x38, 0x71,
+	0xe0, 0x21, 0x56, 0xe2, 0xb2, 0xe2, 0xb4, 0xe2, 0xb0, 0xa0, 0xf6, 0x00, 0x8f, 0x81, 0xec, 0xa4,
+	0xdb, 0xf6, 0x01, 0x7a, 0x49, 0x3c, 0x33, 0x5f, 0xbe, 0xef, 0xb3, 0x67, 0x62, 0x7c, 0x5f, 0x4d,
+	0x59, 0x14, 0x85, 0x13, 0xca, 0x84, 0xc7, 0x69, 0x32, 0x05, 0x1d, 0x47, 0x34, 0x04, 0xef, 0xa4,
+	0xeb, 0x8d, 0x00, 0xdc, 0x38, 0x91, 0x5a, 0x92, 0xc6, 0x0a, 0xe4, 0xae, 0x81, 0xdc, 0x93, 0x6e,
+	0xf3, 0x26, 0xe5, 0x4c, 0x48, 0xcf, 0x3e, 0x33, 0x74, 0xd3, 0x09, 0xa5, 0xe2, 0x52, 0x79, 0x01,
+	0x55, 0x86, 0x27, 0x00, 0x4d, 0xbb, 0x5e, 0x28, 0x99, 0xc8, 0xeb, 0x8d, 0xac, 0xee, 0xdb, 0xc8,
+	0xcb, 0x82, 0xbc, 0x74, 0x6b, 0x2c, 0xc7, 0x32, 0xcb, 0x9b, 0x55, 0x96, 0xdd, 0xff, 0x84, 0xf0,
+	0x8d, 0x3e, 0xc0, 0x0b, 0xa6, 0x74, 0xc2, 0x82, 0x54, 0x33, 0x29, 0x48, 0x13, 0x57, 0x75, 0x02,
+	0x54, 0xa5, 0xc9, 0xac, 0x8e, 0xda, 0xa8, 0x53, 0x1b, 0x5c, 0xc6, 0xe4, 0x1e, 0xbe, 0xba, 0x5c,
+	0xfb, 0x41, 0xac, 0xea, 0x3b, 0x6d, 0xd4, 0x29, 0x0d, 0x76, 0x97, 0xb9, 0x5e, 0xac, 0xc8, 0x43,
+	0x4c, 0x42, 0xc9, 0x79, 0x2a, 0x98, 0x9e, 0xf9, 0xb1, 0x94, 0x91, 0x05, 0x16, 0x2d, 0x70, 0xef,
+	0xb2, 0xf2, 0x5a, 0xca, 0xc8, 0xa0, 0x1b, 0xb8, 0x1a, 0xa4, 0x89, 0xb0, 0x98, 0x92, 0xc5, 0x54,
+	0x4c, 0xdc, 0x8b, 0xd5, 0xf3, 0xd2, 0xbf, 0xcf, 0x2d, 0xb4, 0x7f, 0x8e, 0x70, 0xa5, 0x0f, 0xf0,
+	0x86, 0x41, 0x42, 0x08, 0x2e, 0x09, 0xca, 0x21, 0x77, 0x65, 0xd7, 0x46, 0x8e, 0x33, 0xe1, 0x87,
+	0x92, 0xc7, 0x11, 0x68, 0x18, 0xfa, 0xef, 0x64, 0xb0, 0xf4, 0xb5, 0xc7, 0x99, 0x38, 0x5c, 0x16,
+	0x5e, 0xca, 0x40, 0x91, 0xbb, 0x18, 0x1b, 0x74, 0x42, 0x35, 0x13, 0xe3, 0xdc, 0x54, 0x8d, 0x33,
+	0x31, 0xb0, 0x09, 0xf2, 0x0a, 0x9b, 0xc0, 0x57, 0x9a, 0x4e, 0xc1, 0xda, 0xa9, 0xf5, 0x1e, 0x9d,
+	0x5e, 0xb4, 0x0a, 0xbf, 0x2e, 0x5a, 0xb7, 0xb3, 0xd3, 0x54, 0xc3, 0xa9, 0xcb, 0xa4, 0xc7, 0xa9,
+	0x9e, 0xb8, 0x47, 0x42, 0xff, 0xfc, 0x7e, 0x80, 0xf3, 0x63, 0x3e, 0x12, 0xfa, 0xcb, 0xdf, 0x6f,
+	0x0f, 0xd0, 0xa0, 0xca, 0x99, 0x38, 0x36, 0x0c, 0xe4, 0x0e, 0xae, 0x8c, 0x00, 0xec, 0xde, 0xae,
+	0x58, 0xa9, 0xf2, 0x08, 0x60, 0xb5, 0xb5, 0x1f, 0x45, 0x5c, 0xed, 0x03, 0x1c, 0x6b, 0xaa, 0x15,
+	0x11, 0xb8, 0x16, 0xca, 0x28, 0x82, 0x50, 0xc3, 0xb0, 0x8e, 0xda, 0xc5, 0xce, 0xee, 0xe3, 0x86,
+	0x9b, 0x53, 0x9b, 0x76, 0xbb, 0x79, 0xbb, 0xdd, 0x43, 0xc9, 0x44, 0xef, 0xa9, 0x71, 0xf5, 0xf5,
+	0x77, 0xab, 0x33, 0x66, 0x7a, 0x92, 0x06, 0x6e, 0x28, 0x79, 0xde, 0xee, 0xfc, 0x75, 0xa0, 0x86,
+	0x53, 0x4f, 0xcf, 0x62, 0x50, 0xf6, 0x03, 0x95, 0x59, 0x5b, 0x49, 0x90, 0x68, 0xad, 0xcb, 0x3b,
+	0x5b, 0x92, 0x5b, 0xcd, 0xcd, 0x7b, 0x7c, 0x7d, 0x73, 0x28, 0xea, 0xc5, 0x2d, 0x69, 0x5e, 0xdb,
+	0x18, 0x31, 0x32, 0xc1, 0x65, 0x33, 0x4f, 0x30, 0xac, 0x97, 0xb6, 0x24, 0x98, 0xf3, 0xf7, 0x9e,
+	0x9d, 0xce, 0x1d, 0x74, 0x36, 0x77, 0xd0, 0x9f, 0xb9, 0x83, 0x3e, 0x2e, 0x9c, 0xc2, 0xd9, 0xc2,
+	0x29, 0x9c, 0x2f, 0x9c, 0xc2, 0x5b, 0x67, 0xed, 0x22, 0xf8, 0xb0, 0x71, 0x15, 0x58, 0xb2, 0xa0,
+	0x6c, 0xff, 0xc5, 0x27, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x62, 0x2b, 0xac, 0x31, 0x04,
+	0x00, 0x00,
+}
+
+func (this *FeeDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDistribution)
+	if !ok {
+		that2, ok := that.(FeeDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Treasury != that1.Treasury {
+		return false
+	}
+	if this.TreasuryBps != that1.TreasuryBps {
+		return false
+	}
+	if this.CommunityPoolBps != that1.CommunityPoolBps {
+		return false
+	}
+	if this.BurnBps != that1.BurnBps {
+		return false
+	}
+	return true
+}
+func (this *FeeTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeTier)
+	if !ok {
+		that2, ok := that.(FeeTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.MinCompletedJobs != that1.MinCompletedJobs {
+		return false
+	}
+	if this.MinRating != that1.MinRating {
+		return false
+	}
+	if !this.MinStake.Equal(that1.MinStake) {
+		return false
+	}
+	if this.FeeBps != that1.FeeBps {
+		return false
+	}
+	return true
+}
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnBps != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.BurnBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommunityPoolBps != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.CommunityPoolBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TreasuryBps != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.TreasuryBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeBps != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MinRating != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MinRating))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinCompletedJobs != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MinCompletedJobs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.TreasuryBps != 0 {
+		n += 1 + sovFee(uint64(m.TreasuryBps))
+	}
+	if m.CommunityPoolBps != 0 {
+		n += 1 + sovFee(uint64(m.CommunityPoolBps))
+	}
+	if m.BurnBps != 0 {
+		n += 1 + sovFee(uint64(m.BurnBps))
+	}
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.MinCompletedJobs != 0 {
+		n += 1 + sovFee(uint64(m.MinCompletedJobs))
+	}
+	if m.MinRating != 0 {
+		n += 1 + sovFee(uint64(m.MinRating))
+	}
+	l = m.MinStake.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if m.FeeBps != 0 {
+		n += 1 + sovFee(uint64(m.FeeBps))
+	}
+	return n
+}
+
+func (m *FeeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryBps", wireType)
+			}
+			m.TreasuryBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolBps", wireType)
+			}
+			m.CommunityPoolBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnBps", wireType)
+			}
+			m.BurnBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCompletedJobs", wireType)
+			}
+			m.MinCompletedJobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCompletedJobs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRating", wireType)
+			}
+			m.MinRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collected = append(m.Collected, types.Coin{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,12 +1,16 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:     DefaultParams(),
-		ProfileMap: []Profile{}, GigList: []Gig{}, ApplicationList: []Application{}, ContractList: []Contract{}, DisputeList: []Dispute{}, DisputeVoteMap: []DisputeVote{}}
+		ProfileMap: []Profile{}, GigList: []Gig{}, ApplicationList: []Application{}, ContractList: []Contract{}, DisputeList: []Dispute{}, DisputeVoteMap: []DisputeVote{}, ContractEscrowList: []ContractEscrow{}, CancellationProposalList: []CancellationProposal{}, TipList: []Tip{}, TimeLogList: []TimeLog{}, AmendmentList: []Amendment{}, DeadlineExtensionList: []DeadlineExtension{}, ReferralList: []Referral{}, EscrowStakingPool: NewEscrowStakingPool(), ArbiterList: []Arbiter{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -74,6 +78,94 @@ func (gs GenesisState) Validate() error {
 		}
 		disputeVoteIndexMap[index] = struct{}{}
 	}
+	contractEscrowIdMap := make(map[uint64]bool)
+	for _, elem := range gs.ContractEscrowList {
+		if _, ok := contractEscrowIdMap[elem.ContractId]; ok {
+			return fmt.Errorf("duplicated id for contractEscrow")
+		}
+		if !elem.Outflows().IsAllLTE(elem.Locked) {
+			return fmt.Errorf("escrow of contract %d pays out more than it locked", elem.ContractId)
+		}
+		contractEscrowIdMap[elem.ContractId] = true
+	}
+	if err := gs.RetainedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid retained fees: %w", err)
+	}
+	for _, coins := range []sdk.Coins{gs.FeeStats.Collected, gs.FeeStats.Treasury, gs.FeeStats.CommunityPool, gs.FeeStats.Burned} {
+		if err := coins.Validate(); err != nil {
+			return fmt.Errorf("invalid fee stats: %w", err)
+		}
+	}
+	cancellationProposalIdMap := make(map[uint64]bool)
+	for _, elem := range gs.CancellationProposalList {
+		if _, ok := cancellationProposalIdMap[elem.ContractId]; ok {
+			return fmt.Errorf("duplicated id for cancellationProposal")
+		}
+		if elem.FreelancerPayout.IsNil() || elem.FreelancerPayout.IsNegative() {
+			return fmt.Errorf("invalid freelancer payout for cancellationProposal of contract %d", elem.ContractId)
+		}
+		cancellationProposalIdMap[elem.ContractId] = true
+	}
+	tipIdMap := make(map[uint64]bool)
+	tipCount := gs.GetTipCount()
+	for _, elem := range gs.TipList {
+		if _, ok := tipIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for tip")
+		}
+		if elem.Id >= tipCount {
+			return fmt.Errorf("tip id should be lower or equal than the last id")
+		}
+		tipIdMap[elem.Id] = true
+	}
+	timeLogIdMap := make(map[uint64]bool)
+	timeLogCount := gs.GetTimeLogCount()
+	for _, elem := range gs.TimeLogList {
+		if _, ok := timeLogIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for timeLog")
+		}
+		if elem.Id >= timeLogCount {
+			return fmt.Errorf("timeLog id should be lower or equal than the last id")
+		}
+		timeLogIdMap[elem.Id] = true
+	}
+	amendmentIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AmendmentList {
+		if _, ok := amendmentIdMap[elem.ContractId]; ok {
+			return fmt.Errorf("duplicated id for amendment")
+		}
+		amendmentIdMap[elem.ContractId] = true
+	}
+	deadlineExtensionIdMap := make(map[uint64]bool)
+	deadlineExtensionCount := gs.GetDeadlineExtensionCount()
+	for _, elem := range gs.DeadlineExtensionList {
+		if _, ok := deadlineExtensionIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for deadlineExtension")
+		}
+		if elem.Id >= deadlineExtensionCount {
+			return fmt.Errorf("deadlineExtension id should be lower or equal than the last id")
+		}
+		deadlineExtensionIdMap[elem.Id] = true
+	}
+	referralIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ReferralList {
+		if _, ok := referralIndexMap[elem.Referred]; ok {
+			return fmt.Errorf("duplicated index for referral")
+		}
+		referralIndexMap[elem.Referred] = struct{}{}
+	}
+	if err := gs.EscrowStakingPool.Validate(); err != nil {
+		return fmt.Errorf("invalid escrow staking pool: %w", err)
+	}
+	arbiterIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ArbiterList {
+		if _, ok := arbiterIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated index for arbiter")
+		}
+		if err := elem.Stake.Validate(); err != nil {
+			return fmt.Errorf("invalid stake of arbiter %s: %w", elem.Address, err)
+		}
+		arbiterIndexMap[elem.Address] = struct{}{}
+	}
 
 	return gs.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,17 +29,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the marketplace module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params           Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ProfileMap       []Profile     `protobuf:"bytes,2,rep,name=profile_map,json=profileMap,proto3" json:"profile_map"`
-	GigList          []Gig         `protobuf:"bytes,3,rep,name=gig_list,json=gigList,proto3" json:"gig_list"`
-	GigCount         uint64        `protobuf:"varint,4,opt,name=gig_count,json=gigCount,proto3" json:"gig_count,omitempty"`
-	ApplicationList  []Application `protobuf:"bytes,5,rep,name=application_list,json=applicationList,proto3" json:"application_list"`
-	ApplicationCount uint64        `protobuf:"varint,6,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
-	ContractList     []Contract    `protobuf:"bytes,7,rep,name=contract_list,json=contractList,proto3" json:"contract_list"`
-	ContractCount    uint64        `protobuf:"varint,8,opt,name=contract_count,json=contractCount,proto3" json:"contract_count,omitempty"`
-	DisputeList      []Dispute     `protobuf:"bytes,9,rep,name=dispute_list,json=disputeList,proto3" json:"dispute_list"`
-	DisputeCount     uint64        `protobuf:"varint,10,opt,name=dispute_count,json=disputeCount,proto3" json:"dispute_count,omitempty"`
-	DisputeVoteMap   []DisputeVote `protobuf:"bytes,11,rep,name=dispute_vote_map,json=disputeVoteMap,proto3" json:"dispute_vote_map"`
+	Params             Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ProfileMap         []Profile        `protobuf:"bytes,2,rep,name=profile_map,json=profileMap,proto3" json:"profile_map"`
+	GigList            []Gig            `protobuf:"bytes,3,rep,name=gig_list,json=gigList,proto3" json:"gig_list"`
+	GigCount           uint64           `protobuf:"varint,4,opt,name=gig_count,json=gigCount,proto3" json:"gig_count,omitempty"`
+	ApplicationList    []Application    `protobuf:"bytes,5,rep,name=application_list,json=applicationList,proto3" json:"application_list"`
+	ApplicationCount   uint64           `protobuf:"varint,6,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
+	ContractList       []Contract       `protobuf:"bytes,7,rep,name=contract_list,json=contractList,proto3" json:"contract_list"`
+	ContractCount      uint64           `protobuf:"varint,8,opt,name=contract_count,json=contractCount,proto3" json:"contract_count,omitempty"`
+	DisputeList        []Dispute        `protobuf:"bytes,9,rep,name=dispute_list,json=disputeList,proto3" json:"dispute_list"`
+	DisputeCount       uint64           `protobuf:"varint,10,opt,name=dispute_count,json=disputeCount,proto3" json:"dispute_count,omitempty"`
+	DisputeVoteMap     []DisputeVote    `protobuf:"bytes,11,rep,name=dispute_vote_map,json=disputeVoteMap,proto3" json:"dispute_vote_map"`
+	ContractEscrowList []ContractEscrow `protobuf:"bytes,12,rep,name=contract_escrow_list,json=contractEscrowList,proto3" json:"contract_escrow_list"`
+	// Platform fees held by the module account.
+	RetainedFees             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=retained_fees,json=retainedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"retained_fees"`
+	FeeStats                 FeeStats                                 `protobuf:"bytes,14,opt,name=fee_stats,json=feeStats,proto3" json:"fee_stats"`
+	CancellationProposalList []CancellationProposal                   `protobuf:"bytes,15,rep,name=cancellation_proposal_list,json=cancellationProposalList,proto3" json:"cancellation_proposal_list"`
+	TipList                  []Tip                                    `protobuf:"bytes,16,rep,name=tip_list,json=tipList,proto3" json:"tip_list"`
+	TipCount                 uint64                                   `protobuf:"varint,17,opt,name=tip_count,json=tipCount,proto3" json:"tip_count,omitempty"`
+	TimeLogList              []TimeLog                                `protobuf:"bytes,18,rep,name=time_log_list,json=timeLogList,proto3" json:"time_log_list"`
+	TimeLogCount             uint64                                   `protobuf:"varint,19,opt,name=time_log_count,json=timeLogCount,proto3" json:"time_log_count,omitempty"`
+	AmendmentList            []Amendment                              `protobuf:"bytes,20,rep,name=amendment_list,json=amendmentList,proto3" json:"amendment_list"`
+	DeadlineExtensionList    []DeadlineExtension                      `protobuf:"bytes,21,rep,name=deadline_extension_list,json=deadlineExtensionList,proto3" json:"deadline_extension_list"`
+	DeadlineExtensionCount   uint64                                   `protobuf:"varint,22,opt,name=deadline_extension_count,json=deadlineExtensionCount,proto3" json:"deadline_extension_count,omitempty"`
+	ReferralList             []Referral                               `protobuf:"bytes,23,rep,name=referral_list,json=referralList,proto3" json:"referral_list"`
+	EscrowStakingPool        EscrowStakingPool                        `protobuf:"bytes,24,opt,name=escrow_staking_pool,json=escrowStakingPool,proto3" json:"escrow_staking_pool"`
+	ArbiterList              []Arbiter                                `protobuf:"bytes,25,rep,name=arbiter_list,json=arbiterList,proto3" json:"arbiter_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +167,104 @@ func (m *GenesisState) GetDisputeVoteMap() []DisputeVote {
 	return nil
 }
 
+func (m *GenesisState) GetContractEscrowList() []ContractEscrow {
+	if m != nil {
+		return m.ContractEscrowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetainedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RetainedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeStats() FeeStats {
+	if m != nil {
+		return m.FeeStats
+	}
+	return FeeStats{}
+}
+
+func (m *GenesisState) GetCancellationProposalList() []CancellationProposal {
+	if m != nil {
+		return m.CancellationProposalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTipList() []Tip {
+	if m != nil {
+		return m.TipList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTipCount() uint64 {
+	if m != nil {
+		return m.TipCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTimeLogList() []TimeLog {
+	if m != nil {
+		return m.TimeLogList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimeLogCount() uint64 {
+	if m != nil {
+		return m.TimeLogCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAmendmentList() []Amendment {
+	if m != nil {
+		return m.AmendmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeadlineExtensionList() []DeadlineExtension {
+	if m != nil {
+		return m.DeadlineExtensionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeadlineExtensionCount() uint64 {
+	if m != nil {
+		return m.DeadlineExtensionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetReferralList() []Referral {
+	if m != nil {
+		return m.ReferralList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowStakingPool() EscrowStakingPool {
+	if m != nil {
+		return m.EscrowStakingPool
+	}
+	return EscrowStakingPool{}
+}
+
+func (m *GenesisState) GetArbiterList() []Arbiter {
+	if m != nil {
+		return m.ArbiterList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "skillchain.marketplace.v1.GenesisState")
 }
@@ -159,38 +274,66 @@ func init() {
 }
 
 var fileDescriptor_bd644ff2113776b0 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x1b, 0xd6, 0x75, 0xab, 0xd3, 0x8d, 0x2d, 0xe2, 0x10, 0x86, 0x64, 0xca, 0x2a, 0x46,
-	0xc4, 0x50, 0xa2, 0x8d, 0x0b, 0x37, 0x44, 0x37, 0x69, 0x42, 0x30, 0x84, 0x8a, 0x34, 0x24, 0x2e,
-	0x95, 0xc9, 0x82, 0xb1, 0x96, 0xc4, 0x56, 0xec, 0x55, 0xf0, 0x16, 0x3c, 0x06, 0x47, 0x1e, 0x82,
-	0xc3, 0x8e, 0x3d, 0x72, 0x42, 0xa8, 0x3d, 0xf0, 0x1a, 0x28, 0xf6, 0x97, 0x34, 0x1c, 0x92, 0x5c,
-	0x2a, 0xd7, 0xfa, 0x7d, 0xff, 0xdf, 0x17, 0xfb, 0x33, 0x7a, 0x24, 0xaf, 0x58, 0x1c, 0x87, 0x9f,
-	0x09, 0x4b, 0x83, 0x84, 0x64, 0x57, 0x91, 0x12, 0x31, 0x09, 0xa3, 0x60, 0x76, 0x14, 0xd0, 0x28,
-	0x8d, 0x24, 0x93, 0xbe, 0xc8, 0xb8, 0xe2, 0xce, 0xdd, 0x15, 0xe8, 0x57, 0x40, 0x7f, 0x76, 0xb4,
-	0xb7, 0x4b, 0x12, 0x96, 0xf2, 0x40, 0xff, 0x1a, 0x7a, 0xef, 0x0e, 0xe5, 0x94, 0xeb, 0x65, 0x90,
-	0xaf, 0x60, 0xf7, 0xb0, 0x5e, 0x46, 0x84, 0x88, 0x59, 0x48, 0x14, 0xe3, 0x29, 0xc0, 0x5e, 0x3d,
-	0x1c, 0xf2, 0x54, 0x65, 0x24, 0x54, 0x40, 0x36, 0x7c, 0xc3, 0x25, 0x93, 0xe2, 0x5a, 0x45, 0x00,
-	0x3e, 0x69, 0x05, 0xa7, 0x33, 0x5e, 0xd2, 0xa3, 0x86, 0xa3, 0x61, 0x14, 0xa0, 0x83, 0x7a, 0x48,
-	0x90, 0x8c, 0x24, 0xb2, 0xbd, 0x47, 0x91, 0xf1, 0x4f, 0x2c, 0x06, 0xeb, 0xfe, 0xcf, 0x75, 0x34,
-	0x38, 0x33, 0x27, 0xff, 0x4e, 0x11, 0x15, 0x39, 0xa7, 0xa8, 0x67, 0x92, 0x5c, 0x6b, 0x68, 0x79,
-	0xf6, 0xf1, 0x03, 0xbf, 0xf6, 0x26, 0xfc, 0xb7, 0x1a, 0x1c, 0xf7, 0x6f, 0x7e, 0xdf, 0xef, 0x7c,
-	0xff, 0xfb, 0xe3, 0xb1, 0x35, 0x81, 0x5a, 0xe7, 0x25, 0xb2, 0xc1, 0x33, 0x4d, 0x88, 0x70, 0x6f,
-	0x0d, 0xd7, 0x3c, 0xfb, 0x78, 0xbf, 0x29, 0xca, 0xd0, 0xe3, 0x6e, 0x9e, 0x35, 0x41, 0x50, 0x7c,
-	0x4e, 0x84, 0xf3, 0x1c, 0x6d, 0x52, 0x46, 0xa7, 0x31, 0x93, 0xca, 0x5d, 0xd3, 0x39, 0xb8, 0x21,
-	0xe7, 0x8c, 0x51, 0xc8, 0xd8, 0xa0, 0x8c, 0xbe, 0x66, 0x52, 0x39, 0xf7, 0x50, 0x3f, 0x0f, 0x08,
-	0xf9, 0x75, 0xaa, 0xdc, 0xee, 0xd0, 0xf2, 0xba, 0x93, 0x3c, 0xf1, 0x24, 0xff, 0xef, 0xbc, 0x47,
-	0x3b, 0x95, 0x59, 0x30, 0x96, 0x75, 0x6d, 0x39, 0x68, 0xb0, 0xbc, 0x58, 0x95, 0x80, 0xed, 0x76,
-	0x25, 0x45, 0x5b, 0x0f, 0xd1, 0x6e, 0x35, 0xd8, 0xd8, 0x7b, 0xda, 0x5e, 0x35, 0x9a, 0x2e, 0xde,
-	0xa0, 0xad, 0x62, 0xc8, 0x4c, 0x0b, 0x1b, 0xba, 0x85, 0x51, 0x43, 0x0b, 0x27, 0xc0, 0x83, 0x7f,
-	0x50, 0xd4, 0x6b, 0xf9, 0x43, 0xb4, 0x5d, 0xe6, 0x19, 0xf3, 0xa6, 0x36, 0x97, 0x16, 0xa3, 0x7d,
-	0x85, 0x06, 0xc5, 0x20, 0x6a, 0x6b, 0xbf, 0xf5, 0x9a, 0x4e, 0x0d, 0x0e, 0x52, 0x1b, 0xaa, 0xb5,
-	0x73, 0x84, 0xb6, 0x8a, 0x30, 0xa3, 0x44, 0x5a, 0x59, 0x18, 0x8c, 0xf1, 0x02, 0xed, 0x54, 0x47,
-	0x5f, 0x0f, 0x87, 0xdd, 0x7a, 0xdc, 0x60, 0xbd, 0xe0, 0xa5, 0x79, 0xfb, 0x72, 0xb5, 0x75, 0x4e,
-	0xc4, 0xf8, 0xd9, 0xcd, 0x02, 0x5b, 0xf3, 0x05, 0xb6, 0xfe, 0x2c, 0xb0, 0xf5, 0x6d, 0x89, 0x3b,
-	0xf3, 0x25, 0xee, 0xfc, 0x5a, 0xe2, 0xce, 0x07, 0x5c, 0x79, 0x09, 0x5f, 0xfe, 0x7b, 0x0b, 0xea,
-	0xab, 0x88, 0xe4, 0xc7, 0x9e, 0x7e, 0x07, 0x4f, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x59,
-	0x2e, 0xb0, 0x9a, 0x04, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcd, 0x72, 0xdc, 0x44,
+	0x10, 0xc7, 0xbd, 0xc4, 0x38, 0xf6, 0xec, 0x47, 0x6c, 0xc5, 0x49, 0x64, 0x53, 0xa5, 0x98, 0x38,
+	0x84, 0x0d, 0x09, 0x12, 0x0e, 0x97, 0xdc, 0x52, 0xb1, 0x13, 0xa7, 0x28, 0x0c, 0x65, 0x36, 0xa9,
+	0x50, 0xc5, 0x45, 0x35, 0xab, 0xed, 0x55, 0x06, 0x4b, 0x9a, 0x29, 0xcd, 0xd8, 0x84, 0xb7, 0xe0,
+	0x19, 0x38, 0x51, 0x9c, 0x78, 0x8c, 0x1c, 0x73, 0xe4, 0x04, 0x94, 0x7d, 0xe0, 0x35, 0x28, 0x4d,
+	0xcf, 0x68, 0xe5, 0xe0, 0xd5, 0xe4, 0x62, 0xeb, 0xe3, 0xdf, 0xff, 0x5f, 0xf7, 0x68, 0x7a, 0x7a,
+	0xc9, 0xa7, 0xf2, 0x88, 0x65, 0x59, 0xf2, 0x8a, 0xb2, 0x22, 0xca, 0x69, 0x79, 0x04, 0x4a, 0x64,
+	0x34, 0x81, 0xe8, 0x64, 0x27, 0x4a, 0xa1, 0x00, 0xc9, 0x64, 0x28, 0x4a, 0xae, 0xb8, 0xb7, 0x31,
+	0x13, 0x86, 0x0d, 0x61, 0x78, 0xb2, 0xb3, 0xb9, 0x46, 0x73, 0x56, 0xf0, 0x48, 0xff, 0x45, 0xf5,
+	0x66, 0x90, 0x70, 0x99, 0x73, 0x19, 0x8d, 0xa9, 0xac, 0xbc, 0xc6, 0xa0, 0xe8, 0x4e, 0x94, 0x70,
+	0x56, 0x98, 0xf7, 0xeb, 0x29, 0x4f, 0xb9, 0xbe, 0x8c, 0xaa, 0x2b, 0xf3, 0xf4, 0xee, 0xfc, 0x64,
+	0x68, 0x0e, 0xc5, 0x24, 0x87, 0x42, 0x19, 0xe9, 0xbd, 0x16, 0xa9, 0x10, 0x19, 0x4b, 0xa8, 0x62,
+	0xdc, 0xd2, 0x5a, 0x8a, 0xa4, 0xe5, 0x98, 0x29, 0x28, 0x8d, 0xf0, 0xfe, 0x7c, 0x61, 0x42, 0x8b,
+	0x04, 0xb2, 0xac, 0x69, 0x3b, 0x6c, 0x51, 0xf3, 0x42, 0x95, 0x34, 0x51, 0xee, 0x04, 0x26, 0x4c,
+	0x8a, 0x63, 0x05, 0xee, 0x04, 0x8c, 0x30, 0x3e, 0xe1, 0xb5, 0xfa, 0xce, 0x7c, 0x35, 0xc8, 0xa4,
+	0xe4, 0x3f, 0x19, 0x5d, 0xe8, 0xd2, 0xc5, 0x52, 0xd1, 0x23, 0x56, 0xa4, 0xee, 0xef, 0x00, 0xaf,
+	0x15, 0x14, 0x72, 0xb6, 0x06, 0xdb, 0xf3, 0xa5, 0x53, 0x00, 0xb7, 0x28, 0x65, 0xa9, 0xbb, 0x18,
+	0x41, 0x4b, 0x9a, 0x4b, 0xf7, 0x5a, 0x8a, 0x92, 0x4f, 0x59, 0x06, 0xee, 0xcf, 0x53, 0xc2, 0x14,
+	0xca, 0x92, 0x66, 0x6e, 0xa5, 0x62, 0x39, 0xc4, 0x19, 0x4f, 0xdd, 0x95, 0x28, 0x26, 0x50, 0x74,
+	0xeb, 0xd7, 0x01, 0xe9, 0x3d, 0xc3, 0xe6, 0x79, 0xae, 0xa8, 0x02, 0xef, 0x09, 0x59, 0xc2, 0x12,
+	0xfc, 0xce, 0x56, 0x67, 0xd8, 0x7d, 0xf0, 0x71, 0x38, 0xb7, 0x99, 0xc2, 0x43, 0x2d, 0xdc, 0x5d,
+	0x79, 0xf3, 0xd7, 0xcd, 0x85, 0xdf, 0xfe, 0xfd, 0xe3, 0xb3, 0xce, 0xc8, 0xc4, 0x7a, 0x5f, 0x91,
+	0xae, 0x29, 0x30, 0xce, 0xa9, 0xf0, 0x3f, 0xd8, 0xba, 0x34, 0xec, 0x3e, 0xb8, 0xd5, 0x66, 0x85,
+	0xea, 0xdd, 0xc5, 0xca, 0x6b, 0x44, 0x4c, 0xf0, 0x37, 0x54, 0x78, 0x8f, 0xc8, 0x72, 0xca, 0xd2,
+	0x38, 0x63, 0x52, 0xf9, 0x97, 0xb4, 0x4f, 0xd0, 0xe2, 0xf3, 0x8c, 0xa5, 0xc6, 0xe3, 0x72, 0xca,
+	0xd2, 0x03, 0x26, 0x95, 0xf7, 0x11, 0x59, 0xa9, 0x0c, 0x12, 0x7e, 0x5c, 0x28, 0x7f, 0x71, 0xab,
+	0x33, 0x5c, 0x1c, 0x55, 0x8e, 0x7b, 0xd5, 0xbd, 0xf7, 0x3d, 0x59, 0x6d, 0xf4, 0x20, 0x52, 0x3e,
+	0xd4, 0x94, 0x3b, 0x2d, 0x94, 0xc7, 0xb3, 0x10, 0x43, 0xbb, 0xd2, 0x70, 0xd1, 0xd4, 0x7b, 0x64,
+	0xad, 0x69, 0x8c, 0xf4, 0x25, 0x4d, 0x6f, 0x12, 0x31, 0x8b, 0x6f, 0x49, 0xdf, 0x76, 0x21, 0xa6,
+	0x70, 0x59, 0xa7, 0xb0, 0xdd, 0x92, 0xc2, 0x9e, 0xd1, 0x1b, 0x7e, 0xcf, 0xc6, 0x6b, 0xf8, 0x27,
+	0x64, 0x50, 0xfb, 0x21, 0x79, 0x59, 0x93, 0x6b, 0x0a, 0x62, 0xbf, 0x26, 0x3d, 0xdb, 0xa9, 0x9a,
+	0xba, 0xe2, 0xfc, 0x4c, 0x4f, 0x50, 0x6e, 0xa0, 0x5d, 0x13, 0xad, 0x99, 0xdb, 0xa4, 0x6f, 0xcd,
+	0x10, 0x49, 0x34, 0xd2, 0x12, 0x90, 0xf8, 0x92, 0xac, 0x36, 0xcf, 0x06, 0xbd, 0x39, 0xba, 0xce,
+	0xe5, 0x36, 0xd4, 0x97, 0xbc, 0x26, 0x0f, 0x26, 0xb3, 0x47, 0xd5, 0x26, 0xa1, 0x64, 0xbd, 0x2e,
+	0xd8, 0x1c, 0x13, 0xba, 0xa2, 0x9e, 0xf6, 0xbe, 0xfb, 0x1e, 0xeb, 0xf8, 0x54, 0x47, 0x19, 0x7b,
+	0x2f, 0x39, 0xf7, 0x54, 0xd7, 0x27, 0x48, 0xbf, 0x04, 0x45, 0x59, 0x01, 0x93, 0x78, 0x0a, 0x20,
+	0xfd, 0xbe, 0xf6, 0xde, 0x08, 0x71, 0x7c, 0x84, 0xd5, 0xf8, 0x08, 0xcd, 0xf8, 0x08, 0xf7, 0x38,
+	0x2b, 0x76, 0xbf, 0xa8, 0xbc, 0x7e, 0xff, 0xfb, 0xe6, 0x30, 0x65, 0xea, 0xd5, 0xf1, 0x38, 0x4c,
+	0x78, 0x1e, 0x99, 0x59, 0x83, 0xff, 0x3e, 0x97, 0x93, 0xa3, 0x48, 0xfd, 0x2c, 0x40, 0xea, 0x00,
+	0x39, 0xea, 0x59, 0xc2, 0x3e, 0x80, 0xf4, 0xf6, 0xc9, 0xca, 0x14, 0xa0, 0x3a, 0xef, 0x94, 0xf4,
+	0x07, 0xba, 0x1b, 0xdb, 0x76, 0xc4, 0x3e, 0x40, 0xd5, 0xc2, 0xd2, 0xd4, 0xb0, 0x3c, 0x35, 0xf7,
+	0x9e, 0x24, 0x9b, 0xcd, 0x89, 0x10, 0x8b, 0x92, 0x0b, 0x2e, 0x69, 0x86, 0x4b, 0x74, 0x45, 0x97,
+	0x11, 0xb5, 0x2d, 0x51, 0x23, 0xf8, 0xd0, 0xc4, 0x1a, 0x88, 0x9f, 0x5c, 0xf0, 0x4e, 0x2f, 0xd7,
+	0x23, 0xb2, 0xac, 0x98, 0x40, 0xc4, 0xaa, 0xb3, 0x6d, 0x5f, 0x30, 0x61, 0xdb, 0x56, 0x31, 0x61,
+	0xdb, 0xb6, 0x32, 0xc0, 0xbd, 0xb4, 0x86, 0x6d, 0xab, 0x98, 0xc0, 0x7d, 0x74, 0x40, 0xfa, 0xf6,
+	0xb4, 0x43, 0x84, 0xe7, 0xdc, 0xba, 0x2f, 0x58, 0x0e, 0x07, 0xdc, 0x9e, 0x0e, 0x5d, 0x85, 0xb7,
+	0x1a, 0x75, 0x9b, 0x0c, 0x6a, 0x37, 0xe4, 0x5d, 0xc5, 0xbd, 0x6b, 0x44, 0xc8, 0xfc, 0x8e, 0x0c,
+	0xea, 0xc9, 0x8e, 0xd0, 0x75, 0x0d, 0xbd, 0xdd, 0x76, 0x50, 0xd8, 0x00, 0x83, 0xed, 0xd7, 0x0e,
+	0x1a, 0xfc, 0x23, 0xb9, 0x31, 0x01, 0x3a, 0xc9, 0x58, 0x01, 0x71, 0x3d, 0xad, 0xd0, 0xfb, 0x9a,
+	0xf6, 0xbe, 0xdf, 0xd6, 0x15, 0x26, 0xf2, 0xa9, 0x0d, 0x34, 0x8c, 0x6b, 0x93, 0x77, 0x5f, 0x68,
+	0xd6, 0x43, 0xe2, 0x5f, 0xc0, 0xc2, 0x72, 0xaf, 0xeb, 0x72, 0xaf, 0xff, 0x2f, 0xb0, 0x3e, 0x9d,
+	0xec, 0x10, 0xc2, 0xdc, 0x6e, 0x38, 0x4f, 0xa7, 0x91, 0xd1, 0xdb, 0xd3, 0xc9, 0xc6, 0xeb, 0x4c,
+	0xc6, 0xe4, 0xea, 0xf9, 0x51, 0x1e, 0x0b, 0xce, 0x33, 0xdf, 0xd7, 0x3b, 0xbc, 0xad, 0x62, 0xec,
+	0xc6, 0xe7, 0x18, 0x74, 0xc8, 0xb9, 0xb5, 0x5f, 0x83, 0x77, 0x5f, 0x54, 0x47, 0x9b, 0xf9, 0xb9,
+	0x84, 0x29, 0x6f, 0x38, 0xf7, 0xc7, 0x63, 0x94, 0xdb, 0xfd, 0x61, 0xa2, 0xab, 0x84, 0x77, 0x1f,
+	0xbe, 0x39, 0x0d, 0x3a, 0x6f, 0x4f, 0x83, 0xce, 0x3f, 0xa7, 0x41, 0xe7, 0x97, 0xb3, 0x60, 0xe1,
+	0xed, 0x59, 0xb0, 0xf0, 0xe7, 0x59, 0xb0, 0xf0, 0x43, 0xd0, 0x98, 0xb1, 0xaf, 0xcf, 0x4d, 0x59,
+	0xdd, 0xd6, 0xe3, 0x25, 0x3d, 0x65, 0xbf, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x49, 0x8c,
+	0x2c, 0xbb, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +356,187 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArbiterList) > 0 {
+		for iNdEx := len(m.ArbiterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArbiterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	{
+		size, err := m.EscrowStakingPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if len(m.ReferralList) > 0 {
+		for iNdEx := len(m.ReferralList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.DeadlineExtensionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeadlineExtensionCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.DeadlineExtensionList) > 0 {
+		for iNdEx := len(m.DeadlineExtensionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadlineExtensionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.AmendmentList) > 0 {
+		for iNdEx := len(m.AmendmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmendmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.TimeLogCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeLogCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.TimeLogList) > 0 {
+		for iNdEx := len(m.TimeLogList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeLogList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.TipCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TipCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.TipList) > 0 {
+		for iNdEx := len(m.TipList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TipList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CancellationProposalList) > 0 {
+		for iNdEx := len(m.CancellationProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CancellationProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size, err := m.FeeStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.RetainedFees) > 0 {
+		for iNdEx := len(m.RetainedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetainedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ContractEscrowList) > 0 {
+		for iNdEx := len(m.ContractEscrowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractEscrowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.DisputeVoteMap) > 0 {
 		for iNdEx := len(m.DisputeVoteMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -397,6 +721,73 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractEscrowList) > 0 {
+		for _, e := range m.ContractEscrowList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetainedFees) > 0 {
+		for _, e := range m.RetainedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeStats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CancellationProposalList) > 0 {
+		for _, e := range m.CancellationProposalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TipList) > 0 {
+		for _, e := range m.TipList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TipCount != 0 {
+		n += 2 + sovGenesis(uint64(m.TipCount))
+	}
+	if len(m.TimeLogList) > 0 {
+		for _, e := range m.TimeLogList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TimeLogCount != 0 {
+		n += 2 + sovGenesis(uint64(m.TimeLogCount))
+	}
+	if len(m.AmendmentList) > 0 {
+		for _, e := range m.AmendmentList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeadlineExtensionList) > 0 {
+		for _, e := range m.DeadlineExtensionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DeadlineExtensionCount != 0 {
+		n += 2 + sovGenesis(uint64(m.DeadlineExtensionCount))
+	}
+	if len(m.ReferralList) > 0 {
+		for _, e := range m.ReferralList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EscrowStakingPool.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.ArbiterList) > 0 {
+		for _, e := range m.ArbiterList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -748,6 +1139,435 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractEscrowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractEscrowList = append(m.ContractEscrowList, ContractEscrow{})
+			if err := m.ContractEscrowList[len(m.ContractEscrowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetainedFees = append(m.RetainedFees, types.Coin{})
+			if err := m.RetainedFees[len(m.RetainedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationProposalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancellationProposalList = append(m.CancellationProposalList, CancellationProposal{})
+			if err := m.CancellationProposalList[len(m.CancellationProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TipList = append(m.TipList, Tip{})
+			if err := m.TipList[len(m.TipList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipCount", wireType)
+			}
+			m.TipCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TipCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLogList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeLogList = append(m.TimeLogList, TimeLog{})
+			if err := m.TimeLogList[len(m.TimeLogList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLogCount", wireType)
+			}
+			m.TimeLogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeLogCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmendmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmendmentList = append(m.AmendmentList, Amendment{})
+			if err := m.AmendmentList[len(m.AmendmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExtensionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineExtensionList = append(m.DeadlineExtensionList, DeadlineExtension{})
+			if err := m.DeadlineExtensionList[len(m.DeadlineExtensionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExtensionCount", wireType)
+			}
+			m.DeadlineExtensionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineExtensionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralList = append(m.ReferralList, Referral{})
+			if err := m.ReferralList[len(m.ReferralList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowStakingPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowStakingPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArbiterList = append(m.ArbiterList, Arbiter{})
+			if err := m.ArbiterList[len(m.ArbiterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
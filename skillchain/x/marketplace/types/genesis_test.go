@@ -5,6 +5,8 @@ import (
 
 	"skillchain/x/marketplace/types"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,7 +23,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{ProfileMap: []types.Profile{{Owner: "0"}, {Owner: "1"}}, GigList: []types.Gig{{Id: 0}, {Id: 1}}, GigCount: 2, ApplicationList: []types.Application{{Id: 0}, {Id: 1}}, ApplicationCount: 2, ContractList: []types.Contract{{Id: 0}, {Id: 1}}, ContractCount: 2, DisputeList: []types.Dispute{{Id: 0}, {Id: 1}}, DisputeCount: 2, DisputeVoteMap: []types.DisputeVote{{Arbiter: "0"}, {Arbiter: "1"}}}, valid: true,
+			genState: &types.GenesisState{Params: types.DefaultParams(), ProfileMap: []types.Profile{{Owner: "0"}, {Owner: "1"}}, GigList: []types.Gig{{Id: 0}, {Id: 1}}, GigCount: 2, ApplicationList: []types.Application{{Id: 0}, {Id: 1}}, ApplicationCount: 2, ContractList: []types.Contract{{Id: 0}, {Id: 1}}, ContractCount: 2, DisputeList: []types.Dispute{{Id: 0}, {Id: 1}}, DisputeCount: 2, DisputeVoteMap: []types.DisputeVote{{Arbiter: "0"}, {Arbiter: "1"}}}, valid: true,
 		}, {
 			desc: "duplicated profile",
 			genState: &types.GenesisState{
@@ -144,6 +146,183 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 			},
 			valid: false,
+		}, {
+			desc: "duplicated contractEscrow",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractEscrowList: []types.ContractEscrow{
+					{
+						ContractId: 0,
+					},
+					{
+						ContractId: 0,
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "contractEscrow pays out more than locked",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractEscrowList: []types.ContractEscrow{
+					{
+						ContractId: 0,
+						Locked:     sdk.NewCoins(sdk.NewInt64Coin("skill", 100)),
+						Released:   sdk.NewCoins(sdk.NewInt64Coin("skill", 101)),
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated cancellationProposal",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				CancellationProposalList: []types.CancellationProposal{
+					{
+						ContractId:       0,
+						FreelancerPayout: math.NewInt(10),
+					},
+					{
+						ContractId:       0,
+						FreelancerPayout: math.NewInt(10),
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated tip",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TipList: []types.Tip{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				TipCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid tip count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TipList: []types.Tip{
+					{
+						Id: 1,
+					},
+				},
+				TipCount: 0,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated time log",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TimeLogList: []types.TimeLog{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				TimeLogCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid time log count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TimeLogList: []types.TimeLog{
+					{
+						Id: 1,
+					},
+				},
+				TimeLogCount: 0,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated amendment",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AmendmentList: []types.Amendment{
+					{
+						ContractId: 0,
+					},
+					{
+						ContractId: 0,
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated deadline extension",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeadlineExtensionList: []types.DeadlineExtension{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				DeadlineExtensionCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "invalid deadline extension count",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DeadlineExtensionList: []types.DeadlineExtension{
+					{
+						Id: 1,
+					},
+				},
+				DeadlineExtensionCount: 0,
+			},
+			valid: false,
+		}, {
+			desc: "duplicated referral",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ReferralList: []types.Referral{
+					{
+						Referred: "0",
+					},
+					{
+						Referred: "0",
+					},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "negative staked escrow",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EscrowStakingPool: types.EscrowStakingPool{
+					Bonded: math.NewInt(-1),
+				},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated arbiter",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ArbiterList: []types.Arbiter{
+					{
+						Address: "0",
+						Stake:   sdk.NewInt64Coin("skill", 1000),
+					},
+					{
+						Address: "0",
+						Stake:   sdk.NewInt64Coin("skill", 1000),
+					},
+				},
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
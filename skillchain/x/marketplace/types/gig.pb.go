@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,15 +26,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Gig defines the Gig message.
 type Gig struct {
-	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Owner        string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Price        uint64 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Category     string `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
-	DeliveryDays uint64 `protobuf:"varint,7,opt,name=delivery_days,json=deliveryDays,proto3" json:"delivery_days,omitempty"`
-	Status       string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt    int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Owner       string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Deprecated: prices were plain amounts of the native denom before v2, only
+	// read by the store migration.
+	LegacyPrice  uint64     `protobuf:"varint,5,opt,name=legacy_price,json=legacyPrice,proto3" json:"legacy_price,omitempty"`
+	Category     string     `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	DeliveryDays uint64     `protobuf:"varint,7,opt,name=delivery_days,json=deliveryDays,proto3" json:"delivery_days,omitempty"`
+	Status       string     `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt    int64      `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Price        types.Coin `protobuf:"bytes,10,opt,name=price,proto3" json:"price"`
+	// Set while the price of the gig is held in escrow, from its creation until
+	// an application is accepted or the gig is cancelled.
+	Funded bool `protobuf:"varint,11,opt,name=funded,proto3" json:"funded,omitempty"`
 }
 
 func (m *Gig) Reset()         { *m = Gig{} }
@@ -96,9 +104,9 @@ func (m *Gig) GetOwner() string {
 	return ""
 }
 
-func (m *Gig) GetPrice() uint64 {
+func (m *Gig) GetLegacyPrice() uint64 {
 	if m != nil {
-		return m.Price
+		return m.LegacyPrice
 	}
 	return 0
 }
@@ -131,6 +139,20 @@ func (m *Gig) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *Gig) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Gig) GetFunded() bool {
+	if m != nil {
+		return m.Funded
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Gig)(nil), "skillchain.marketplace.v1.Gig")
 }
@@ -140,25 +162,31 @@ func init() {
 }
 
 var fileDescriptor_6eff631f6efae16b = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0xeb, 0xfe, 0xd1, 0x9a, 0x9f, 0xc1, 0x42, 0xc8, 0x20, 0x61, 0x45, 0x74, 0xc9, 0x94,
-	0xa8, 0x62, 0x61, 0x05, 0x21, 0xb1, 0x67, 0x64, 0xa9, 0x8c, 0x7d, 0x15, 0xac, 0x86, 0x24, 0xb2,
-	0x2f, 0x81, 0xbc, 0x05, 0x8f, 0xc5, 0xd8, 0x91, 0x11, 0x25, 0x4f, 0xc0, 0x1b, 0xa0, 0x3a, 0x05,
-	0xc2, 0xf8, 0x9d, 0x7b, 0x3e, 0x5d, 0xe9, 0xd0, 0x85, 0x5b, 0x9b, 0x2c, 0x53, 0x8f, 0xd2, 0xe4,
-	0xf1, 0x93, 0xb4, 0x6b, 0xc0, 0x32, 0x93, 0x0a, 0xe2, 0x6a, 0x19, 0xa7, 0x26, 0x8d, 0x4a, 0x5b,
-	0x60, 0xc1, 0x4e, 0xff, 0x4a, 0x51, 0xaf, 0x14, 0x55, 0xcb, 0x8b, 0x2f, 0x42, 0x47, 0x77, 0x26,
-	0x65, 0x47, 0x74, 0x68, 0x34, 0x27, 0x01, 0x09, 0xc7, 0xc9, 0xd0, 0x68, 0x76, 0x4c, 0x27, 0x68,
-	0x30, 0x03, 0x3e, 0x0c, 0x48, 0x38, 0x4f, 0x3a, 0x60, 0x01, 0xdd, 0xd7, 0xe0, 0x94, 0x35, 0x25,
-	0x9a, 0x22, 0xe7, 0x23, 0x7f, 0xeb, 0x47, 0x5b, 0xaf, 0x78, 0xc9, 0xc1, 0xf2, 0x71, 0xe7, 0x79,
-	0xd8, 0xa6, 0xa5, 0x35, 0x0a, 0xf8, 0xc4, 0x3f, 0xe8, 0x80, 0x9d, 0xd1, 0x99, 0x92, 0x08, 0x69,
-	0x61, 0x6b, 0x3e, 0xf5, 0xf5, 0x5f, 0x66, 0x0b, 0x7a, 0xa8, 0x21, 0x33, 0x15, 0xd8, 0x7a, 0xa5,
-	0x65, 0xed, 0xf8, 0x9e, 0x37, 0x0f, 0x7e, 0xc2, 0x5b, 0x59, 0x3b, 0x76, 0x42, 0xa7, 0x0e, 0x25,
-	0x3e, 0x3b, 0x3e, 0xf3, 0xfa, 0x8e, 0xd8, 0x39, 0xa5, 0xca, 0x82, 0x44, 0xd0, 0x2b, 0x89, 0x7c,
-	0x1e, 0x90, 0x70, 0x94, 0xcc, 0x77, 0xc9, 0x35, 0xde, 0x5c, 0xbd, 0x37, 0x82, 0x6c, 0x1a, 0x41,
-	0x3e, 0x1b, 0x41, 0xde, 0x5a, 0x31, 0xd8, 0xb4, 0x62, 0xf0, 0xd1, 0x8a, 0xc1, 0xbd, 0xe8, 0xad,
-	0xf9, 0xfa, 0x6f, 0x4f, 0xac, 0x4b, 0x70, 0x0f, 0x53, 0xbf, 0xe7, 0xe5, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x8c, 0xdd, 0xcd, 0x86, 0x76, 0x01, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0x87, 0xe3, 0xf4, 0x0f, 0xad, 0x53, 0x38, 0x58, 0x15, 0x72, 0x2b, 0x61, 0x02, 0xbd, 0xe4,
+	0x94, 0xa8, 0x20, 0x24, 0xae, 0x14, 0x24, 0xae, 0x28, 0x47, 0x2e, 0x95, 0x6b, 0x9b, 0x60, 0x35,
+	0x8d, 0x23, 0xdb, 0x2d, 0xe4, 0x2d, 0x78, 0xac, 0x5e, 0x90, 0x7a, 0xdc, 0xd3, 0x6a, 0xd5, 0xbe,
+	0xc8, 0x2a, 0x76, 0x76, 0xb7, 0x7b, 0x9b, 0xdf, 0xe7, 0xf9, 0x34, 0xd6, 0x0c, 0x5c, 0x98, 0xad,
+	0x2c, 0x4b, 0xf6, 0x9b, 0xca, 0x2a, 0xdb, 0x51, 0xbd, 0x15, 0xb6, 0x2e, 0x29, 0x13, 0xd9, 0x61,
+	0x99, 0x15, 0xb2, 0x48, 0x6b, 0xad, 0xac, 0x42, 0xb3, 0xa7, 0xa6, 0xf4, 0xaa, 0x29, 0x3d, 0x2c,
+	0xe7, 0x84, 0x29, 0xb3, 0x53, 0x26, 0xdb, 0x50, 0xd3, 0x4a, 0x1b, 0x61, 0xe9, 0x32, 0x63, 0x4a,
+	0x56, 0x5e, 0x9d, 0x4f, 0x0b, 0x55, 0x28, 0x57, 0x66, 0x6d, 0xe5, 0xe9, 0xfb, 0xff, 0x21, 0xec,
+	0x7d, 0x97, 0x05, 0x7a, 0x05, 0x43, 0xc9, 0x31, 0x88, 0x41, 0xd2, 0xcf, 0x43, 0xc9, 0xd1, 0x14,
+	0x0e, 0xac, 0xb4, 0xa5, 0xc0, 0x61, 0x0c, 0x92, 0x71, 0xee, 0x03, 0x8a, 0x61, 0xc4, 0x85, 0x61,
+	0x5a, 0xd6, 0x56, 0xaa, 0x0a, 0xf7, 0xdc, 0xdb, 0x35, 0x6a, 0x3d, 0xf5, 0xa7, 0x12, 0x1a, 0xf7,
+	0xbd, 0xe7, 0x02, 0x7a, 0x07, 0x27, 0xa5, 0x28, 0x28, 0x6b, 0xd6, 0xb5, 0x96, 0x4c, 0xe0, 0x81,
+	0x9b, 0x13, 0x79, 0xf6, 0xa3, 0x45, 0x68, 0x0e, 0x47, 0x8c, 0x5a, 0x51, 0x28, 0xdd, 0xe0, 0xa1,
+	0x73, 0x1f, 0x33, 0x5a, 0xc0, 0x97, 0x5c, 0x94, 0xf2, 0x20, 0x74, 0xb3, 0xe6, 0xb4, 0x31, 0xf8,
+	0x85, 0xf3, 0x27, 0x0f, 0xf0, 0x1b, 0x6d, 0x0c, 0x7a, 0x0d, 0x87, 0xc6, 0x52, 0xbb, 0x37, 0x78,
+	0xe4, 0xf4, 0x2e, 0xa1, 0x37, 0x10, 0x32, 0x2d, 0xa8, 0x15, 0x7c, 0x4d, 0x2d, 0x1e, 0xc7, 0x20,
+	0xe9, 0xe5, 0xe3, 0x8e, 0x7c, 0xb1, 0xe8, 0x13, 0x1c, 0xf8, 0x3f, 0xc1, 0x18, 0x24, 0xd1, 0x87,
+	0x59, 0xea, 0xd7, 0x98, 0xb6, 0x6b, 0x4c, 0xbb, 0x35, 0xa6, 0x5f, 0x95, 0xac, 0x56, 0xfd, 0xe3,
+	0xed, 0xdb, 0x20, 0xf7, 0xdd, 0xed, 0xb4, 0x5f, 0xfb, 0x8a, 0x0b, 0x8e, 0xa3, 0x18, 0x24, 0xa3,
+	0xbc, 0x4b, 0xab, 0xcf, 0xc7, 0x33, 0x01, 0xa7, 0x33, 0x01, 0x77, 0x67, 0x02, 0xfe, 0x5d, 0x48,
+	0x70, 0xba, 0x90, 0xe0, 0xe6, 0x42, 0x82, 0x9f, 0xe4, 0xea, 0xbe, 0x7f, 0x9f, 0x5d, 0xd8, 0x36,
+	0xb5, 0x30, 0x9b, 0xa1, 0x3b, 0xc8, 0xc7, 0xfb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xc4, 0xdc,
+	0x9d, 0x08, 0x02, 0x00, 0x00,
 }
 
 func (m *Gig) Marshal() (dAtA []byte, err error) {
@@ -181,6 +209,26 @@ func (m *Gig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Funded {
+		i--
+		if m.Funded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if m.CreatedAt != 0 {
 		i = encodeVarintGig(dAtA, i, uint64(m.CreatedAt))
 		i--
@@ -205,8 +253,8 @@ func (m *Gig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.Price != 0 {
-		i = encodeVarintGig(dAtA, i, uint64(m.Price))
+	if m.LegacyPrice != 0 {
+		i = encodeVarintGig(dAtA, i, uint64(m.LegacyPrice))
 		i--
 		dAtA[i] = 0x28
 	}
@@ -271,8 +319,8 @@ func (m *Gig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGig(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovGig(uint64(m.Price))
+	if m.LegacyPrice != 0 {
+		n += 1 + sovGig(uint64(m.LegacyPrice))
 	}
 	l = len(m.Category)
 	if l > 0 {
@@ -288,6 +336,11 @@ func (m *Gig) Size() (n int) {
 	if m.CreatedAt != 0 {
 		n += 1 + sovGig(uint64(m.CreatedAt))
 	}
+	l = m.Price.Size()
+	n += 1 + l + sovGig(uint64(l))
+	if m.Funded {
+		n += 2
+	}
 	return n
 }
 
@@ -443,9 +496,9 @@ func (m *Gig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyPrice", wireType)
 			}
-			m.Price = 0
+			m.LegacyPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGig
@@ -455,7 +508,7 @@ func (m *Gig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.LegacyPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -562,6 +615,59 @@ func (m *Gig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Funded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGig(dAtA[iNdEx:])
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// IsHourly reports whether the contract bills logged hours instead of a
+// fixed price.
+func (c Contract) IsHourly() bool {
+	return c.WeeklyHoursCap > 0
+}
+
+// BilledAmount returns the amount billed from escrow so far, zero for fixed
+// price contracts and contracts created before hourly billing.
+func (c Contract) BilledAmount() math.Int {
+	if c.Billed.IsNil() {
+		return math.ZeroInt()
+	}
+	return c.Billed
+}
+
+// HoursCost returns the amount billed for hours at the contract hourly rate.
+func (c Contract) HoursCost(hours uint64) math.Int {
+	return c.HourlyRate.Mul(math.NewIntFromUint64(hours))
+}
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// AmendmentKey is the prefix to retrieve all Amendment
+var AmendmentKey = collections.NewPrefix("amendment/value/")
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ArbiterKey is the prefix to retrieve all Arbiter
+var ArbiterKey = collections.NewPrefix("arbiter/value/")
+
+// UnbondingQueueKey is the prefix to retrieve the unbonding arbiters by end of unbonding
+var UnbondingQueueKey = collections.NewPrefix("unbondingQueue/value/")
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// CancellationProposalKey is the prefix to retrieve all CancellationProposal
+var CancellationProposalKey = collections.NewPrefix("cancellationProposal/value/")
@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ContractEscrowKey is the prefix to retrieve all ContractEscrow
+var ContractEscrowKey = collections.NewPrefix("contractEscrow/value/")
+
+// RetainedFeesKey is the prefix to retrieve the retained fees by denom
+var RetainedFeesKey = collections.NewPrefix("retainedFees/value/")
+
+// FeeStatsKey is the prefix to retrieve the FeeStats
+var FeeStatsKey = collections.NewPrefix("feeStats/value/")
+
+// EscrowStakingPoolKey is the prefix to retrieve the EscrowStakingPool
+var EscrowStakingPoolKey = collections.NewPrefix("escrowStakingPool/value/")
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ReviewQueueKey is the prefix to retrieve the delivered contracts by delivery time
+var ReviewQueueKey = collections.NewPrefix("reviewQueue/value/")
+
+// DeadlineQueueKey is the prefix to retrieve the active contracts by delivery deadline
+var DeadlineQueueKey = collections.NewPrefix("deadlineQueue/value/")
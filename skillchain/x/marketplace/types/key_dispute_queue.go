@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ResponseQueueKey is the prefix to retrieve the uncontested disputes by response deadline
+	ResponseQueueKey = collections.NewPrefix("responseQueue/value/")
+
+	// JurorQueueKey is the prefix to retrieve the disputes with alternates by juror deadline
+	JurorQueueKey = collections.NewPrefix("jurorQueue/value/")
+
+	// RevealQueueKey is the prefix to retrieve the open disputes by reveal deadline
+	RevealQueueKey = collections.NewPrefix("revealQueue/value/")
+
+	// AppealQueueKey is the prefix to retrieve the appealable rulings by appeal deadline
+	AppealQueueKey = collections.NewPrefix("appealQueue/value/")
+)
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// ReferralKey is the prefix to retrieve all Referral
+var ReferralKey = collections.NewPrefix("referral/value/")
@@ -9,7 +9,9 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
-	// EscrowAccountName is the name of the escrow module account
+	// EscrowAccountName is the name of the module account holding contract
+	// escrows. Platform fees are held by the ModuleName account until they
+	// are distributed.
 	EscrowAccountName = "marketplace_escrow"
 
 	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
@@ -40,3 +42,19 @@ var (
 	DisputeKey      = collections.NewPrefix("dispute/value/")
 	DisputeCountKey = collections.NewPrefix("dispute/count/")
 )
+
+var (
+	TipKey      = collections.NewPrefix("tip/value/")
+	TipCountKey = collections.NewPrefix("tip/count/")
+)
+
+var (
+	TimeLogKey           = collections.NewPrefix("timeLog/value/")
+	TimeLogCountKey      = collections.NewPrefix("timeLog/count/")
+	TimeLogByContractKey = collections.NewPrefix("timeLog/contract/")
+)
+
+var (
+	DeadlineExtensionKey      = collections.NewPrefix("deadlineExtension/value/")
+	DeadlineExtensionCountKey = collections.NewPrefix("deadlineExtension/count/")
+)
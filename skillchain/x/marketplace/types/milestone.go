@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxMilestones is the maximum number of milestones a contract can be split into.
+const MaxMilestones = 20
+
+// ValidateMilestones checks a milestone plan proposed for a price of totalPrice
+// delivered within totalDays. An empty plan is valid and means the price is
+// paid in a single release.
+func ValidateMilestones(milestones []Milestone, totalPrice math.Int, totalDays uint64) error {
+	if len(milestones) == 0 {
+		return nil
+	}
+	if len(milestones) > MaxMilestones {
+		return fmt.Errorf("a contract can have at most %d milestones, got %d", MaxMilestones, len(milestones))
+	}
+
+	sum := math.ZeroInt()
+	var previousDays uint64
+	for i, milestone := range milestones {
+		if milestone.Title == "" {
+			return fmt.Errorf("milestone %d must have a title", i)
+		}
+		if milestone.Amount.IsNil() || !milestone.Amount.IsPositive() {
+			return fmt.Errorf("milestone %d amount must be greater than zero", i)
+		}
+		if milestone.DeliveryDays == 0 || milestone.DeliveryDays < previousDays {
+			return fmt.Errorf("milestone %d delivery days must be positive and not before the previous milestone", i)
+		}
+		if milestone.DeliveryDays > totalDays {
+			return fmt.Errorf("milestone %d is due after the proposed %d days", i, totalDays)
+		}
+		previousDays = milestone.DeliveryDays
+		sum = sum.Add(milestone.Amount)
+	}
+
+	if !sum.Equal(totalPrice) {
+		return fmt.Errorf("milestone amounts add up to %s but the price is %s", sum, totalPrice)
+	}
+
+	return nil
+}
@@ -4,8 +4,27 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
+// DefaultDenom is the native denom of the chain.
+const DefaultDenom = "skill"
+
+// BasisPoints is the denominator of the basis points shares.
+const BasisPoints = 10000
+
+// MaxAppealDepth and MaxAppealMultiplier bound the appeal params so the jury
+// size and the duration of the last appeal round stay reasonable.
+const (
+	MaxAppealDepth      = 5
+	MaxAppealMultiplier = 10
+)
+
+// BaseFeeTier names the rate applied to freelancers qualifying for no fee
+// tier: the fee of the gig category or platform_fee_percent.
+const BaseFeeTier = "base"
+
 // Default parameter values
 var (
 	DefaultPlatformFeePercent   = uint64(5)        // 5%
@@ -14,17 +33,120 @@ var (
 	DefaultDisputeDuration      = uint64(604800)   // 7 days in seconds
 	DefaultMinArbitersRequired  = uint64(3)        // 3 arbiters
 	DefaultArbiterStakeRequired = uint64(1000)     // 1000 SKILL
+	DefaultAllowedDenoms        = []string{DefaultDenom}
+	DefaultStakeDenom           = DefaultDenom
+	DefaultFeeDistribution      = FeeDistribution{
+		CommunityPoolBps: 5000, // 50%
+		BurnBps:          5000, // 50%
+	}
+	DefaultFeeSettlementEpoch    = ""             // distribute fees as they are charged
+	DefaultReviewPeriod          = uint64(604800) // 7 days in seconds
+	DefaultDeadlineGracePeriod   = uint64(0)      // overdue as soon as the deadline passes
+	DefaultCancellationExpiry    = uint64(259200) // 3 days in seconds
+	DefaultTipFeeEnabled         = false          // tips are paid in full
+	DefaultHourlyBillingEpoch    = "week"
+	DefaultTimeLogContestWindow  = uint64(172800)                    // 2 days in seconds
+	DefaultApplicationBond       = sdk.NewInt64Coin(DefaultDenom, 0) // no bond
+	DefaultMaxDeadlineExtensions = uint64(2)
+	DefaultFeeTiers              []FeeTier      // platform_fee_percent for everyone
+	DefaultReferralFeeShareBps   = uint64(1000) // 10%
+	DefaultReferralMaxContracts  = uint64(5)
+	DefaultEscrowStaking         = EscrowStakingParams{
+		Enabled:             false,
+		MaxStakedBps:        5000, // 50%
+		ReserveBps:          3000, // 30%
+		ClientRewardBps:     4000, // 40%
+		FreelancerRewardBps: 4000, // 40%
+		TreasuryRewardBps:   2000, // 20%
+		Epoch:               "day",
+	}
+	DefaultCategoryRules          []CategoryRule    // global params for every category
+	DefaultArbiterUnbondingPeriod = uint64(1814400) // 21 days in seconds
+	DefaultDisputeFeeBps          = uint64(200)     // 2%
+	DefaultArbiterSlashBps        = uint64(500)     // 5%
+	DefaultJuryAlternates         = uint64(2)
+	DefaultJurorVotePeriod        = uint64(259200) // 3 days in seconds
+	DefaultRevealPeriod           = uint64(86400)  // 1 day in seconds
+	DefaultRulingToleranceBps     = uint64(1000)   // 10%
+
+	DefaultAppealPeriod             = uint64(172800) // 2 days in seconds
+	DefaultAppealBondBps            = uint64(1000)   // 10%
+	DefaultMaxAppealDepth           = uint64(1)
+	DefaultAppealJuryMultiplier     = uint64(2)
+	DefaultAppealDurationMultiplier = uint64(2)
+
+	DefaultDisputeFilingFeeBps   = uint64(0)
+	DefaultDisputeResponsePeriod = uint64(172800) // 2 days in seconds
+	DefaultDisputeFilingFees     sdk.Coins        // no fixed filing fee
 )
 
 // NewParams creates a new Params instance.
-func NewParams(feePercent, minDuration uint64, minPrice math.Int, disputeDuration, minArbitersRequired, arbiterStakeRequired uint64) Params {
+func NewParams(
+	feePercent, minDuration uint64,
+	minPrice math.Int,
+	disputeDuration, minArbitersRequired, arbiterStakeRequired uint64,
+	allowedDenoms []string,
+	stakeDenom string,
+	feeDistribution FeeDistribution,
+	feeSettlementEpoch string,
+	reviewPeriod, deadlineGracePeriod, cancellationExpiry uint64,
+	tipFeeEnabled bool,
+	hourlyBillingEpoch string,
+	timeLogContestWindow uint64,
+	applicationBond sdk.Coin,
+	maxDeadlineExtensions uint64,
+	feeTiers []FeeTier,
+	referralFeeShareBps, referralMaxContracts uint64,
+	escrowStaking EscrowStakingParams,
+	categoryRules []CategoryRule,
+	arbiterUnbondingPeriod uint64,
+	disputeFeeBps, arbiterSlashBps uint64,
+	juryAlternates, jurorVotePeriod, revealPeriod uint64,
+	rulingToleranceBps uint64,
+	appealPeriod, appealBondBps, maxAppealDepth uint64,
+	appealJuryMultiplier, appealDurationMultiplier uint64,
+	disputeFilingFeeBps, disputeResponsePeriod uint64,
+	disputeFilingFees sdk.Coins,
+) Params {
 	return Params{
-		PlatformFeePercent:   feePercent,
-		MinContractDuration:  minDuration,
-		MinGigPrice:          minPrice,
-		DisputeDuration:      disputeDuration,
-		MinArbitersRequired:  minArbitersRequired,
-		ArbiterStakeRequired: arbiterStakeRequired,
+		PlatformFeePercent:       feePercent,
+		MinContractDuration:      minDuration,
+		MinGigPrice:              minPrice,
+		DisputeDuration:          disputeDuration,
+		MinArbitersRequired:      minArbitersRequired,
+		ArbiterStakeRequired:     arbiterStakeRequired,
+		AllowedDenoms:            allowedDenoms,
+		StakeDenom:               stakeDenom,
+		FeeDistribution:          feeDistribution,
+		FeeSettlementEpoch:       feeSettlementEpoch,
+		ReviewPeriod:             reviewPeriod,
+		DeadlineGracePeriod:      deadlineGracePeriod,
+		CancellationExpiry:       cancellationExpiry,
+		TipFeeEnabled:            tipFeeEnabled,
+		HourlyBillingEpoch:       hourlyBillingEpoch,
+		TimeLogContestWindow:     timeLogContestWindow,
+		ApplicationBond:          applicationBond,
+		MaxDeadlineExtensions:    maxDeadlineExtensions,
+		FeeTiers:                 feeTiers,
+		ReferralFeeShareBps:      referralFeeShareBps,
+		ReferralMaxContracts:     referralMaxContracts,
+		EscrowStaking:            escrowStaking,
+		CategoryRules:            categoryRules,
+		ArbiterUnbondingPeriod:   arbiterUnbondingPeriod,
+		DisputeFeeBps:            disputeFeeBps,
+		ArbiterSlashBps:          arbiterSlashBps,
+		JuryAlternates:           juryAlternates,
+		JurorVotePeriod:          jurorVotePeriod,
+		RevealPeriod:             revealPeriod,
+		RulingToleranceBps:       rulingToleranceBps,
+		AppealPeriod:             appealPeriod,
+		AppealBondBps:            appealBondBps,
+		MaxAppealDepth:           maxAppealDepth,
+		AppealJuryMultiplier:     appealJuryMultiplier,
+		AppealDurationMultiplier: appealDurationMultiplier,
+		DisputeFilingFeeBps:      disputeFilingFeeBps,
+		DisputeResponsePeriod:    disputeResponsePeriod,
+		DisputeFilingFees:        disputeFilingFees,
 	}
 }
 
@@ -37,13 +159,45 @@ func DefaultParams() Params {
 		DefaultDisputeDuration,
 		DefaultMinArbitersRequired,
 		DefaultArbiterStakeRequired,
+		DefaultAllowedDenoms,
+		DefaultStakeDenom,
+		DefaultFeeDistribution,
+		DefaultFeeSettlementEpoch,
+		DefaultReviewPeriod,
+		DefaultDeadlineGracePeriod,
+		DefaultCancellationExpiry,
+		DefaultTipFeeEnabled,
+		DefaultHourlyBillingEpoch,
+		DefaultTimeLogContestWindow,
+		DefaultApplicationBond,
+		DefaultMaxDeadlineExtensions,
+		DefaultFeeTiers,
+		DefaultReferralFeeShareBps,
+		DefaultReferralMaxContracts,
+		DefaultEscrowStaking,
+		DefaultCategoryRules,
+		DefaultArbiterUnbondingPeriod,
+		DefaultDisputeFeeBps,
+		DefaultArbiterSlashBps,
+		DefaultJuryAlternates,
+		DefaultJurorVotePeriod,
+		DefaultRevealPeriod,
+		DefaultRulingToleranceBps,
+		DefaultAppealPeriod,
+		DefaultAppealBondBps,
+		DefaultMaxAppealDepth,
+		DefaultAppealJuryMultiplier,
+		DefaultAppealDurationMultiplier,
+		DefaultDisputeFilingFeeBps,
+		DefaultDisputeResponsePeriod,
+		DefaultDisputeFilingFees,
 	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
 	if p.PlatformFeePercent > 100 {
-		return fmt.Errorf("platform fee cannot exceed 100%")
+		return fmt.Errorf("platform fee cannot exceed 100%%")
 	}
 	if p.MinContractDuration == 0 {
 		return fmt.Errorf("min contract duration (in seconds) must be greater than zero")
@@ -60,6 +214,211 @@ func (p Params) Validate() error {
 	if p.DisputeDuration < 86400 {
 		return fmt.Errorf("dispute duration must be at least 1 day")
 	}
+	if p.RevealPeriod < 3600 {
+		return fmt.Errorf("reveal period must be at least 1 hour")
+	}
+	if p.MaxAppealDepth > MaxAppealDepth {
+		return fmt.Errorf("max appeal depth cannot exceed %d", MaxAppealDepth)
+	}
+	if p.AppealJuryMultiplier < 1 || p.AppealJuryMultiplier > MaxAppealMultiplier {
+		return fmt.Errorf("appeal jury multiplier must be between 1 and %d", MaxAppealMultiplier)
+	}
+	if p.AppealDurationMultiplier < 1 || p.AppealDurationMultiplier > MaxAppealMultiplier {
+		return fmt.Errorf("appeal duration multiplier must be between 1 and %d", MaxAppealMultiplier)
+	}
+	if p.AppealPeriod < 3600 {
+		return fmt.Errorf("appeal period must be at least 1 hour")
+	}
+	if p.AppealBondBps > BasisPoints {
+		return fmt.Errorf("appeal bond cannot exceed %d basis points", BasisPoints)
+	}
+	// arbiters must stay slashable until the disputes they voted on close,
+	// including the longest appeal
+	if p.ArbiterUnbondingPeriod < p.RoundDuration(p.DisputeDuration, p.MaxAppealDepth)+p.RevealPeriod {
+		return fmt.Errorf("arbiter unbonding period cannot be shorter than the last appeal round and reveal period")
+	}
+	if p.DisputeFilingFeeBps > BasisPoints {
+		return fmt.Errorf("dispute filing fee cannot exceed %d basis points", BasisPoints)
+	}
+	if err := p.DisputeFilingFees.Validate(); err != nil {
+		return fmt.Errorf("invalid dispute filing fees: %w", err)
+	}
+	for _, fee := range p.DisputeFilingFees {
+		if !p.IsAllowedDenom(fee.Denom) {
+			return fmt.Errorf("dispute filing fee denom %q is not allowed", fee.Denom)
+		}
+	}
+	// the counterparty must be able to contest before the jurors are done
+	if p.DisputeResponsePeriod < 3600 {
+		return fmt.Errorf("dispute response period must be at least 1 hour")
+	}
+	if p.DisputeResponsePeriod > p.DisputeDuration {
+		return fmt.Errorf("dispute response period cannot exceed the dispute duration")
+	}
+	if p.DisputeFeeBps > BasisPoints {
+		return fmt.Errorf("dispute fee cannot exceed %d basis points", BasisPoints)
+	}
+	// every appeal round takes the dispute fee again out of the disputed amount
+	if p.DisputeFeeBps*(p.MaxAppealDepth+1) > BasisPoints {
+		return fmt.Errorf("dispute fee over the first ruling and %d appeals cannot exceed %d basis points", p.MaxAppealDepth, BasisPoints)
+	}
+	if p.ArbiterSlashBps > BasisPoints {
+		return fmt.Errorf("arbiter slash cannot exceed %d basis points", BasisPoints)
+	}
+	if p.RulingToleranceBps > BasisPoints {
+		return fmt.Errorf("ruling tolerance cannot exceed %d basis points", BasisPoints)
+	}
+	if p.JurorVotePeriod < 3600 {
+		return fmt.Errorf("juror vote period must be at least 1 hour")
+	}
+	if p.JurorVotePeriod > p.DisputeDuration {
+		return fmt.Errorf("juror vote period cannot exceed the dispute duration")
+	}
+	if len(p.AllowedDenoms) == 0 {
+		return fmt.Errorf("allowed denoms cannot be empty")
+	}
+	seen := make(map[string]bool, len(p.AllowedDenoms))
+	for _, denom := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom %q: %w", denom, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom %q", denom)
+		}
+		seen[denom] = true
+	}
+	if err := sdk.ValidateDenom(p.StakeDenom); err != nil {
+		return fmt.Errorf("invalid stake denom: %w", err)
+	}
+	if p.ReviewPeriod < 86400 {
+		return fmt.Errorf("review period must be at least 1 day")
+	}
+	if p.HourlyBillingEpoch == "" {
+		return fmt.Errorf("hourly billing epoch cannot be empty")
+	}
+	if p.TimeLogContestWindow < 3600 {
+		return fmt.Errorf("time log contest window must be at least 1 hour")
+	}
+	if err := p.ApplicationBond.Validate(); err != nil {
+		return fmt.Errorf("invalid application bond: %w", err)
+	}
+	if p.CancellationExpiry < 3600 {
+		return fmt.Errorf("cancellation expiry must be at least 1 hour")
+	}
+	if err := p.FeeDistribution.Validate(); err != nil {
+		return fmt.Errorf("invalid fee distribution: %w", err)
+	}
+	tierNames := make(map[string]bool, len(p.FeeTiers))
+	for _, tier := range p.FeeTiers {
+		if err := tier.Validate(); err != nil {
+			return fmt.Errorf("invalid fee tier %q: %w", tier.Name, err)
+		}
+		if tierNames[tier.Name] {
+			return fmt.Errorf("duplicate fee tier %q", tier.Name)
+		}
+		tierNames[tier.Name] = true
+	}
+	// both parties of a contract can have a referrer
+	if p.ReferralFeeShareBps > BasisPoints/2 {
+		return fmt.Errorf("referral fee share cannot exceed %d basis points", BasisPoints/2)
+	}
+	if err := p.EscrowStaking.Validate(); err != nil {
+		return fmt.Errorf("invalid escrow staking: %w", err)
+	}
+	categories := make(map[string]bool, len(p.CategoryRules))
+	for _, rule := range p.CategoryRules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("invalid rule of category %q: %w", rule.Category, err)
+		}
+		if p.RoundDuration(rule.DisputeDuration, p.MaxAppealDepth)+p.RevealPeriod > p.ArbiterUnbondingPeriod {
+			return fmt.Errorf("last appeal round of category %q and reveal period cannot exceed the arbiter unbonding period", rule.Category)
+		}
+		// jurors and alternates get the same voting window in every category,
+		// the reveal period following the dispute deadline
+		if rule.DisputeDurationSet && p.JurorVotePeriod > rule.DisputeDuration {
+			return fmt.Errorf("juror vote period cannot exceed the dispute duration of category %q", rule.Category)
+		}
+		if rule.DisputeDurationSet && p.DisputeResponsePeriod > rule.DisputeDuration {
+			return fmt.Errorf("dispute response period cannot exceed the dispute duration of category %q", rule.Category)
+		}
+		if categories[rule.Category] {
+			return fmt.Errorf("duplicate rule of category %q", rule.Category)
+		}
+		categories[rule.Category] = true
+	}
+
+	return nil
+}
+
+// IsAllowedDenom reports whether gigs can be priced and escrowed in denom.
+func (p Params) IsAllowedDenom(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// JurySize returns the number of jurors of a dispute appealed appeals times:
+// min_arbiters_required, grown by appeal_jury_multiplier on every appeal.
+func (p Params) JurySize(appeals uint64) uint64 {
+	size := p.MinArbitersRequired
+	for range appeals {
+		size *= p.AppealJuryMultiplier
+	}
+	return size
+}
 
+// RoundDuration returns the time in seconds jurors have to vote on a dispute
+// lasting disputeDuration once appealed appeals times, grown by
+// appeal_duration_multiplier on every appeal.
+func (p Params) RoundDuration(disputeDuration, appeals uint64) uint64 {
+	for range appeals {
+		disputeDuration *= p.AppealDurationMultiplier
+	}
+	return disputeDuration
+}
+
+// DisputeFilingFeeFor returns the filing fee of a dispute on a contract of
+// the given price: the fixed dispute_filing_fees in its denom, or
+// dispute_filing_fee_bps of the price for a denom without one.
+func (p Params) DisputeFilingFeeFor(price sdk.Coin) sdk.Coin {
+	if found, fee := p.DisputeFilingFees.Find(price.Denom); found {
+		return fee
+	}
+	return sdk.NewCoin(price.Denom, price.Amount.MulRaw(int64(p.DisputeFilingFeeBps)).QuoRaw(BasisPoints))
+}
+
+// Validate validates the fee distribution shares.
+func (d FeeDistribution) Validate() error {
+	if d.TreasuryBps+d.CommunityPoolBps+d.BurnBps != BasisPoints {
+		return fmt.Errorf("shares must add up to %d basis points", BasisPoints)
+	}
+	if d.TreasuryBps > 0 && d.Treasury == "" {
+		return fmt.Errorf("a treasury address is required for the treasury share")
+	}
+	if d.Treasury != "" {
+		if _, _, err := bech32.DecodeAndConvert(d.Treasury); err != nil {
+			return fmt.Errorf("invalid treasury address: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate validates the fee tier.
+func (t FeeTier) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if t.Name == BaseFeeTier {
+		return fmt.Errorf("name %q is reserved", BaseFeeTier)
+	}
+	if t.FeeBps > BasisPoints {
+		return fmt.Errorf("fee cannot exceed %d basis points", BasisPoints)
+	}
+	if !t.MinStake.IsNil() && t.MinStake.IsNegative() {
+		return fmt.Errorf("min stake cannot be negative")
+	}
 	return nil
 }
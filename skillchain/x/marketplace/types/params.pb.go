@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -40,6 +42,98 @@ type Params struct {
 	MinArbitersRequired uint64 `protobuf:"varint,5,opt,name=min_arbiters_required,json=minArbitersRequired,proto3" json:"min_arbiters_required,omitempty"`
 	// Defines the stake required for arbiters
 	ArbiterStakeRequired uint64 `protobuf:"varint,6,opt,name=arbiter_stake_required,json=arbiterStakeRequired,proto3" json:"arbiter_stake_required,omitempty"`
+	// Defines the denoms gigs can be priced and escrowed in, including IBC
+	// vouchers (ibc/...)
+	AllowedDenoms []string `protobuf:"bytes,7,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// Defines the denom arbiters must hold to meet arbiter_stake_required
+	StakeDenom string `protobuf:"bytes,8,opt,name=stake_denom,json=stakeDenom,proto3" json:"stake_denom,omitempty"`
+	// Defines how the platform fees are split between the treasury, the
+	// community pool and a burn
+	FeeDistribution FeeDistribution `protobuf:"bytes,9,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution"`
+	// Defines the x/epochs identifier at the end of which collected fees are
+	// distributed. When empty fees are distributed as soon as they are charged
+	FeeSettlementEpoch string `protobuf:"bytes,10,opt,name=fee_settlement_epoch,json=feeSettlementEpoch,proto3" json:"fee_settlement_epoch,omitempty"`
+	// Defines the time in seconds a client has to review a delivery before it is
+	// accepted automatically
+	ReviewPeriod uint64 `protobuf:"varint,11,opt,name=review_period,json=reviewPeriod,proto3" json:"review_period,omitempty"`
+	// Defines the time in seconds past the delivery deadline before an
+	// undelivered contract is flagged as overdue
+	DeadlineGracePeriod uint64 `protobuf:"varint,12,opt,name=deadline_grace_period,json=deadlineGracePeriod,proto3" json:"deadline_grace_period,omitempty"`
+	// Defines the time in seconds a cancellation proposal can be accepted
+	// before it expires
+	CancellationExpiry uint64 `protobuf:"varint,13,opt,name=cancellation_expiry,json=cancellationExpiry,proto3" json:"cancellation_expiry,omitempty"`
+	// Defines whether the platform fee is charged on tips
+	TipFeeEnabled bool `protobuf:"varint,14,opt,name=tip_fee_enabled,json=tipFeeEnabled,proto3" json:"tip_fee_enabled,omitempty"`
+	// Defines the x/epochs identifier at the end of which the uncontested hours
+	// of hourly contracts are billed
+	HourlyBillingEpoch string `protobuf:"bytes,15,opt,name=hourly_billing_epoch,json=hourlyBillingEpoch,proto3" json:"hourly_billing_epoch,omitempty"`
+	// Defines the time in seconds a client has to contest a time log
+	TimeLogContestWindow uint64 `protobuf:"varint,16,opt,name=time_log_contest_window,json=timeLogContestWindow,proto3" json:"time_log_contest_window,omitempty"`
+	// Defines the bond locked when applying to a gig. It is refunded unless the
+	// gig owner flags the application as spam. A zero amount disables bonds
+	ApplicationBond types.Coin `protobuf:"bytes,17,opt,name=application_bond,json=applicationBond,proto3" json:"application_bond"`
+	// Defines the maximum number of deadline extensions a contract can be
+	// granted. Zero disables extension requests
+	MaxDeadlineExtensions uint64 `protobuf:"varint,18,opt,name=max_deadline_extensions,json=maxDeadlineExtensions,proto3" json:"max_deadline_extensions,omitempty"`
+	// Defines discounted platform fees for freelancers with a track record or a
+	// bonded stake. The lowest fee of the tiers a freelancer qualifies for
+	// applies, platform_fee_percent when there is none
+	FeeTiers []FeeTier `protobuf:"bytes,19,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	// Defines the share of the platform fee, in basis points, paid to the
+	// referrer of each party of a contract
+	ReferralFeeShareBps uint64 `protobuf:"varint,20,opt,name=referral_fee_share_bps,json=referralFeeShareBps,proto3" json:"referral_fee_share_bps,omitempty"`
+	// Defines the number of contracts of a referred account its referrer earns
+	// fee shares on
+	ReferralMaxContracts uint64 `protobuf:"varint,21,opt,name=referral_max_contracts,json=referralMaxContracts,proto3" json:"referral_max_contracts,omitempty"`
+	// Defines the opt-in delegation of idle escrow to validators
+	EscrowStaking EscrowStakingParams `protobuf:"bytes,22,opt,name=escrow_staking,json=escrowStaking,proto3" json:"escrow_staking"`
+	// Defines the per-category overrides of the minimum price, fee, maximum
+	// delivery days and dispute duration
+	CategoryRules []CategoryRule `protobuf:"bytes,23,rep,name=category_rules,json=categoryRules,proto3" json:"category_rules"`
+	// Defines the time in seconds an arbiter waits for its stake after
+	// unbonding
+	ArbiterUnbondingPeriod uint64 `protobuf:"varint,24,opt,name=arbiter_unbonding_period,json=arbiterUnbondingPeriod,proto3" json:"arbiter_unbonding_period,omitempty"`
+	// Defines the dispute fee, in basis points of the disputed amount, taken
+	// from the escrow and split among the arbiters who voted with the majority
+	DisputeFeeBps uint64 `protobuf:"varint,25,opt,name=dispute_fee_bps,json=disputeFeeBps,proto3" json:"dispute_fee_bps,omitempty"`
+	// Defines the fraction, in basis points, of its stake an arbiter is
+	// slashed for voting with the minority or missing a vote it was assigned
+	ArbiterSlashBps uint64 `protobuf:"varint,26,opt,name=arbiter_slash_bps,json=arbiterSlashBps,proto3" json:"arbiter_slash_bps,omitempty"`
+	// Defines the number of alternates drawn after the min_arbiters_required
+	// jurors of a dispute
+	JuryAlternates uint64 `protobuf:"varint,27,opt,name=jury_alternates,json=juryAlternates,proto3" json:"jury_alternates,omitempty"`
+	// Defines the time in seconds jurors have to vote before the alternates
+	// replace them
+	JurorVotePeriod uint64 `protobuf:"varint,28,opt,name=juror_vote_period,json=jurorVotePeriod,proto3" json:"juror_vote_period,omitempty"`
+	// Defines the time in seconds jurors have to reveal their votes after the
+	// voting period
+	RevealPeriod uint64 `protobuf:"varint,29,opt,name=reveal_period,json=revealPeriod,proto3" json:"reveal_period,omitempty"`
+	// Defines how far, in basis points, a vote can be from the ruling and still
+	// count as voting with the majority
+	RulingToleranceBps uint64 `protobuf:"varint,30,opt,name=ruling_tolerance_bps,json=rulingToleranceBps,proto3" json:"ruling_tolerance_bps,omitempty"`
+	// Defines the time in seconds the losing party has to appeal a ruling, the
+	// escrow being paid out once it passed
+	AppealPeriod uint64 `protobuf:"varint,31,opt,name=appeal_period,json=appealPeriod,proto3" json:"appeal_period,omitempty"`
+	// Defines the bond, in basis points of the disputed amount, posted to
+	// appeal a ruling
+	AppealBondBps uint64 `protobuf:"varint,32,opt,name=appeal_bond_bps,json=appealBondBps,proto3" json:"appeal_bond_bps,omitempty"`
+	// Defines how many times a dispute can be appealed, zero disabling appeals
+	MaxAppealDepth uint64 `protobuf:"varint,33,opt,name=max_appeal_depth,json=maxAppealDepth,proto3" json:"max_appeal_depth,omitempty"`
+	// Defines the factor the jury grows by on every appeal
+	AppealJuryMultiplier uint64 `protobuf:"varint,34,opt,name=appeal_jury_multiplier,json=appealJuryMultiplier,proto3" json:"appeal_jury_multiplier,omitempty"`
+	// Defines the factor the dispute duration grows by on every appeal
+	AppealDurationMultiplier uint64 `protobuf:"varint,35,opt,name=appeal_duration_multiplier,json=appealDurationMultiplier,proto3" json:"appeal_duration_multiplier,omitempty"`
+	// Defines the filing fee in basis points of the contract price, charged on
+	// contracts in a denom without a fixed filing fee. No filing fee is charged
+	// when zero, disputes being contested as soon as they are opened
+	DisputeFilingFeeBps uint64 `protobuf:"varint,37,opt,name=dispute_filing_fee_bps,json=disputeFilingFeeBps,proto3" json:"dispute_filing_fee_bps,omitempty"`
+	// Defines the time in seconds the counterparty has to contest a dispute,
+	// which resolves in favor of the initiator once it passed
+	DisputeResponsePeriod uint64 `protobuf:"varint,38,opt,name=dispute_response_period,json=disputeResponsePeriod,proto3" json:"dispute_response_period,omitempty"`
+	// Defines the fixed fee, one per allowed denom, the initiator of a dispute
+	// pays to open it and the counterparty matches to contest it, on contracts
+	// in that denom
+	DisputeFilingFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,39,rep,name=dispute_filing_fees,json=disputeFilingFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dispute_filing_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -110,6 +204,230 @@ func (m *Params) GetArbiterStakeRequired() uint64 {
 	return 0
 }
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetStakeDenom() string {
+	if m != nil {
+		return m.StakeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeDistribution() FeeDistribution {
+	if m != nil {
+		return m.FeeDistribution
+	}
+	return FeeDistribution{}
+}
+
+func (m *Params) GetFeeSettlementEpoch() string {
+	if m != nil {
+		return m.FeeSettlementEpoch
+	}
+	return ""
+}
+
+func (m *Params) GetReviewPeriod() uint64 {
+	if m != nil {
+		return m.ReviewPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDeadlineGracePeriod() uint64 {
+	if m != nil {
+		return m.DeadlineGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCancellationExpiry() uint64 {
+	if m != nil {
+		return m.CancellationExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetTipFeeEnabled() bool {
+	if m != nil {
+		return m.TipFeeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetHourlyBillingEpoch() string {
+	if m != nil {
+		return m.HourlyBillingEpoch
+	}
+	return ""
+}
+
+func (m *Params) GetTimeLogContestWindow() uint64 {
+	if m != nil {
+		return m.TimeLogContestWindow
+	}
+	return 0
+}
+
+func (m *Params) GetApplicationBond() types.Coin {
+	if m != nil {
+		return m.ApplicationBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxDeadlineExtensions() uint64 {
+	if m != nil {
+		return m.MaxDeadlineExtensions
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetReferralFeeShareBps() uint64 {
+	if m != nil {
+		return m.ReferralFeeShareBps
+	}
+	return 0
+}
+
+func (m *Params) GetReferralMaxContracts() uint64 {
+	if m != nil {
+		return m.ReferralMaxContracts
+	}
+	return 0
+}
+
+func (m *Params) GetEscrowStaking() EscrowStakingParams {
+	if m != nil {
+		return m.EscrowStaking
+	}
+	return EscrowStakingParams{}
+}
+
+func (m *Params) GetCategoryRules() []CategoryRule {
+	if m != nil {
+		return m.CategoryRules
+	}
+	return nil
+}
+
+func (m *Params) GetArbiterUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.ArbiterUnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeFeeBps() uint64 {
+	if m != nil {
+		return m.DisputeFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetArbiterSlashBps() uint64 {
+	if m != nil {
+		return m.ArbiterSlashBps
+	}
+	return 0
+}
+
+func (m *Params) GetJuryAlternates() uint64 {
+	if m != nil {
+		return m.JuryAlternates
+	}
+	return 0
+}
+
+func (m *Params) GetJurorVotePeriod() uint64 {
+	if m != nil {
+		return m.JurorVotePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRevealPeriod() uint64 {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRulingToleranceBps() uint64 {
+	if m != nil {
+		return m.RulingToleranceBps
+	}
+	return 0
+}
+
+func (m *Params) GetAppealPeriod() uint64 {
+	if m != nil {
+		return m.AppealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAppealBondBps() uint64 {
+	if m != nil {
+		return m.AppealBondBps
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAppealDepth() uint64 {
+	if m != nil {
+		return m.MaxAppealDepth
+	}
+	return 0
+}
+
+func (m *Params) GetAppealJuryMultiplier() uint64 {
+	if m != nil {
+		return m.AppealJuryMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetAppealDurationMultiplier() uint64 {
+	if m != nil {
+		return m.AppealDurationMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeFilingFeeBps() uint64 {
+	if m != nil {
+		return m.DisputeFilingFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeResponsePeriod() uint64 {
+	if m != nil {
+		return m.DisputeResponsePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeFilingFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DisputeFilingFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "skillchain.marketplace.v1.Params")
 }
@@ -119,33 +437,84 @@ func init() {
 }
 
 var fileDescriptor_ff49d97364dd9a36 = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0xfe, 0x7f, 0x2a, 0x61, 0x84, 0x80, 0xd0, 0xa2, 0xb4, 0x43, 0x52, 0x31, 0xa0,
-	0x52, 0x89, 0xa4, 0x05, 0x06, 0xc4, 0x46, 0x41, 0xa0, 0x6e, 0x55, 0x61, 0x62, 0xb1, 0xdc, 0xc4,
-	0x4d, 0xad, 0xc4, 0x1f, 0xd8, 0x6e, 0x05, 0x33, 0x1b, 0x13, 0x8f, 0xc0, 0xc8, 0xd8, 0x81, 0x87,
-	0xe8, 0x58, 0x31, 0x21, 0x86, 0x0a, 0xb5, 0x43, 0x79, 0x0c, 0x94, 0xc4, 0xfd, 0x60, 0x60, 0xb1,
-	0x7c, 0xef, 0xef, 0x1c, 0xfb, 0xd8, 0x17, 0xde, 0xd7, 0x19, 0xcd, 0xf3, 0x78, 0x86, 0x29, 0x8f,
-	0x18, 0x56, 0x19, 0x31, 0x32, 0xc7, 0x31, 0x89, 0x16, 0xfd, 0x48, 0x62, 0x85, 0x99, 0x0e, 0xa5,
-	0x12, 0x46, 0xb8, 0xcd, 0x93, 0x2e, 0x3c, 0xd3, 0x85, 0x8b, 0x7e, 0xeb, 0x36, 0x66, 0x94, 0x8b,
-	0xa8, 0x5c, 0x2b, 0x75, 0xab, 0x19, 0x0b, 0xcd, 0x84, 0x46, 0x65, 0x15, 0x55, 0x85, 0x45, 0xf5,
-	0x54, 0xa4, 0xa2, 0xea, 0x17, 0xbb, 0xaa, 0x7b, 0xef, 0xd3, 0x05, 0xac, 0x8d, 0xca, 0xfb, 0xdc,
-	0x1e, 0xac, 0xcb, 0x1c, 0x9b, 0xa9, 0x50, 0x0c, 0x4d, 0x09, 0x41, 0x92, 0xa8, 0x98, 0x70, 0xe3,
-	0x81, 0x36, 0xe8, 0x5c, 0x8e, 0xdd, 0x03, 0x7b, 0x45, 0xc8, 0xa8, 0x22, 0xee, 0x23, 0xd8, 0x60,
-	0x94, 0xa3, 0x58, 0x70, 0xa3, 0x70, 0x6c, 0x50, 0x32, 0x57, 0xd8, 0x50, 0xc1, 0xbd, 0x2b, 0xa5,
-	0xe5, 0x0e, 0xa3, 0xfc, 0x85, 0x65, 0x2f, 0x2d, 0x72, 0xdf, 0xc2, 0x1b, 0x85, 0x27, 0xa5, 0x29,
-	0x92, 0x8a, 0xc6, 0xc4, 0xbb, 0x68, 0x83, 0xce, 0xb5, 0x41, 0x6f, 0xb5, 0x09, 0x9c, 0x5f, 0x9b,
-	0xa0, 0x51, 0x65, 0xd6, 0x49, 0x16, 0x52, 0x11, 0x31, 0x6c, 0x66, 0xe1, 0x90, 0x9b, 0x1f, 0xdf,
-	0x1f, 0x42, 0xfb, 0x98, 0x21, 0x37, 0xdf, 0xf6, 0xcb, 0x2e, 0x18, 0x5f, 0x67, 0x94, 0xbf, 0xa6,
-	0xe9, 0xa8, 0x38, 0xc4, 0x7d, 0x00, 0x6f, 0x25, 0x54, 0xcb, 0xb9, 0x21, 0xa7, 0x10, 0x97, 0x65,
-	0x88, 0x9b, 0xb6, 0x7f, 0x0c, 0x60, 0x43, 0x63, 0x35, 0xa1, 0x86, 0x28, 0x8d, 0x14, 0x79, 0x3f,
-	0xa7, 0x8a, 0x24, 0xde, 0xd5, 0x63, 0xe8, 0xe7, 0x96, 0x8d, 0x2d, 0x72, 0x9f, 0xc0, 0xbb, 0x56,
-	0x8f, 0xb4, 0xc1, 0x19, 0x39, 0x99, 0x6a, 0xa5, 0xa9, 0x6e, 0xe9, 0x9b, 0x02, 0x1e, 0x5c, 0xcf,
-	0x3a, 0x7f, 0xbe, 0x06, 0xe0, 0xf3, 0x7e, 0xd9, 0x0d, 0xce, 0x66, 0xfd, 0xe1, 0x9f, 0x69, 0x57,
-	0x5f, 0x3f, 0x78, 0xba, 0xda, 0xfa, 0x60, 0xbd, 0xf5, 0xc1, 0xef, 0xad, 0x0f, 0xbe, 0xec, 0x7c,
-	0x67, 0xbd, 0xf3, 0x9d, 0x9f, 0x3b, 0xdf, 0x79, 0xe7, 0xff, 0xd7, 0x6a, 0x3e, 0x4a, 0xa2, 0x27,
-	0xb5, 0x72, 0x8c, 0x8f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x18, 0x62, 0x64, 0x82, 0x4f, 0x02,
-	0x00, 0x00,
+	// 1231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x56, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xf6, 0x92, 0x10, 0xec, 0x76, 0xd6, 0x8f, 0xf1, 0xab, 0x6d, 0x60, 0x77, 0x89, 0x89, 0xb3,
+	0x58, 0xca, 0x6c, 0x1e, 0x24, 0x8a, 0x10, 0x97, 0xf8, 0x15, 0x25, 0x22, 0xc2, 0x5a, 0x07, 0x10,
+	0x5c, 0x26, 0xbd, 0x33, 0xb5, 0xbb, 0x1d, 0xf7, 0x74, 0x0f, 0xdd, 0x3d, 0xf6, 0xfa, 0xc6, 0x99,
+	0x13, 0x3f, 0x81, 0x13, 0x42, 0x9c, 0x72, 0xe0, 0x47, 0xe4, 0x18, 0x71, 0x42, 0x1c, 0x02, 0x8a,
+	0x0f, 0xe1, 0x67, 0xa0, 0x7e, 0xcc, 0x78, 0x4c, 0x64, 0x5f, 0xec, 0xdd, 0xfa, 0xbe, 0xaa, 0xa9,
+	0xfa, 0xea, 0xb1, 0x83, 0xd6, 0xd4, 0x3e, 0x65, 0x2c, 0x1e, 0x12, 0xca, 0x3b, 0x29, 0x91, 0xfb,
+	0xa0, 0x33, 0x46, 0x62, 0xe8, 0x1c, 0xdc, 0xec, 0x64, 0x44, 0x92, 0x54, 0x85, 0x99, 0x14, 0x5a,
+	0x04, 0xcb, 0x27, 0xbc, 0xb0, 0xc2, 0x0b, 0x0f, 0x6e, 0xae, 0xcc, 0x92, 0x94, 0x72, 0xd1, 0xb1,
+	0x7f, 0x1d, 0x7b, 0xa5, 0x11, 0x0b, 0x95, 0x0a, 0xd5, 0xe9, 0x11, 0x65, 0x42, 0xf5, 0x40, 0x93,
+	0x9b, 0x9d, 0x58, 0x50, 0xee, 0xf1, 0x65, 0x87, 0x47, 0xf6, 0x5b, 0xc7, 0x7d, 0xf1, 0xd0, 0xfc,
+	0x40, 0x0c, 0x84, 0xb3, 0x9b, 0x4f, 0xde, 0xda, 0x3e, 0x3b, 0xcd, 0x98, 0x68, 0x18, 0x08, 0x79,
+	0xe4, 0x99, 0xe1, 0xd9, 0x4c, 0x50, 0xb1, 0x14, 0x87, 0x91, 0xd2, 0x64, 0x9f, 0xf2, 0x81, 0xe7,
+	0xaf, 0x9e, 0xcd, 0xef, 0x03, 0x38, 0xd2, 0x95, 0x5f, 0x66, 0xd1, 0xa5, 0x5d, 0x2b, 0x47, 0x70,
+	0x03, 0xcd, 0x67, 0x8c, 0xe8, 0xbe, 0x90, 0x69, 0xd4, 0x07, 0x88, 0x32, 0x90, 0x31, 0x70, 0x8d,
+	0x6b, 0xad, 0x5a, 0xfb, 0x62, 0x37, 0x28, 0xb0, 0x1d, 0x80, 0x5d, 0x87, 0x04, 0xb7, 0xd0, 0x42,
+	0x4a, 0x79, 0x14, 0x0b, 0xae, 0x25, 0x89, 0x75, 0x94, 0xe4, 0x92, 0x68, 0x2a, 0x38, 0x7e, 0xc7,
+	0xba, 0xcc, 0xa5, 0x94, 0x6f, 0x7a, 0x6c, 0xcb, 0x43, 0xc1, 0x13, 0x54, 0x37, 0x3e, 0x03, 0x3a,
+	0x88, 0x32, 0x49, 0x63, 0xc0, 0x17, 0x5a, 0xb5, 0xf6, 0xc4, 0xc6, 0x8d, 0x17, 0xaf, 0x9a, 0x63,
+	0x7f, 0xbd, 0x6a, 0x2e, 0x38, 0xc9, 0x54, 0xb2, 0x1f, 0x52, 0xd1, 0x49, 0x89, 0x1e, 0x86, 0x0f,
+	0xb9, 0xfe, 0xe3, 0xf7, 0xeb, 0xc8, 0x6b, 0xf9, 0x90, 0xeb, 0x5f, 0xdf, 0x3c, 0x5f, 0xaf, 0x75,
+	0x27, 0x53, 0xca, 0x1f, 0xd0, 0xc1, 0xae, 0x09, 0x12, 0x7c, 0x82, 0x66, 0x12, 0xaa, 0xb2, 0x5c,
+	0xc3, 0x49, 0x12, 0x17, 0x6d, 0x12, 0xd3, 0xde, 0x5e, 0x26, 0xe0, 0x93, 0x26, 0xb2, 0x47, 0x35,
+	0x48, 0x15, 0x49, 0xf8, 0x3e, 0xa7, 0x12, 0x12, 0xfc, 0x6e, 0x99, 0xf4, 0x7d, 0x8f, 0x75, 0x3d,
+	0x14, 0x7c, 0x8a, 0x16, 0x3d, 0xdf, 0x6a, 0x0c, 0x27, 0x4e, 0x97, 0xac, 0xd3, 0xbc, 0x47, 0xf7,
+	0x0c, 0x58, 0x7a, 0x5d, 0x45, 0x53, 0x84, 0x31, 0x71, 0x08, 0x49, 0x94, 0x00, 0x17, 0xa9, 0xc2,
+	0xef, 0xb5, 0x2e, 0xb4, 0x27, 0xba, 0x75, 0x6f, 0xdd, 0xb2, 0xc6, 0xa0, 0x89, 0x26, 0x5d, 0x50,
+	0x4b, 0xc2, 0xe3, 0x46, 0x8f, 0x2e, 0xb2, 0x26, 0xcb, 0x08, 0x9e, 0xa2, 0x19, 0xd3, 0x8f, 0x84,
+	0x2a, 0x2d, 0x69, 0x2f, 0xb7, 0xc5, 0x4d, 0xb4, 0x6a, 0xed, 0xc9, 0x5b, 0xeb, 0xe1, 0x99, 0xc3,
+	0x1b, 0xee, 0x00, 0x6c, 0x55, 0x3c, 0x36, 0x26, 0x8c, 0xc2, 0x4e, 0xba, 0xe9, 0xfe, 0x69, 0xcc,
+	0xb4, 0xde, 0x3c, 0x41, 0x81, 0xd6, 0x0c, 0x52, 0xe0, 0x3a, 0x82, 0x4c, 0xc4, 0x43, 0x8c, 0x6c,
+	0x2e, 0x41, 0x1f, 0x60, 0xaf, 0x84, 0xb6, 0x0d, 0x12, 0xac, 0xa2, 0xba, 0x84, 0x03, 0x0a, 0x87,
+	0x66, 0x4c, 0xa8, 0x48, 0xf0, 0xa4, 0x15, 0xe2, 0xb2, 0x33, 0xee, 0x5a, 0x9b, 0x91, 0x3a, 0x01,
+	0x92, 0x30, 0xca, 0x21, 0x1a, 0x48, 0x12, 0x43, 0x41, 0xbe, 0xec, 0xa4, 0x2e, 0xc0, 0x07, 0x06,
+	0xf3, 0x3e, 0x1d, 0x34, 0x17, 0x13, 0x1e, 0x03, 0x63, 0xb6, 0x5d, 0x11, 0x8c, 0x32, 0x2a, 0x8f,
+	0x70, 0xdd, 0x0d, 0x61, 0x15, 0xda, 0xb6, 0x48, 0xb0, 0x86, 0xa6, 0x35, 0xcd, 0xec, 0xc4, 0x02,
+	0x27, 0x3d, 0x06, 0x09, 0x9e, 0x6a, 0xd5, 0xda, 0xe3, 0xdd, 0xba, 0xa6, 0xd9, 0x0e, 0xc0, 0xb6,
+	0x33, 0x9a, 0x1a, 0x87, 0x22, 0x97, 0xec, 0x28, 0xea, 0x51, 0xc6, 0x28, 0x1f, 0xf8, 0x1a, 0xa7,
+	0x5d, 0x8d, 0x0e, 0xdb, 0x70, 0x90, 0xab, 0xf1, 0x0e, 0x5a, 0xd2, 0x34, 0x85, 0x88, 0x89, 0x81,
+	0x9d, 0x71, 0x50, 0x3a, 0x3a, 0xa4, 0x3c, 0x11, 0x87, 0x78, 0xc6, 0xb5, 0xdd, 0xc0, 0x5f, 0x88,
+	0xc1, 0xa6, 0x03, 0xbf, 0xb1, 0x58, 0xf0, 0x25, 0x9a, 0x21, 0x59, 0xc6, 0x68, 0xec, 0x0a, 0xe8,
+	0x09, 0x9e, 0xe0, 0x59, 0xdb, 0xae, 0xe5, 0xd0, 0x0f, 0xb1, 0xb9, 0x1e, 0xa1, 0xbf, 0x1e, 0xe1,
+	0xa6, 0xa0, 0xa7, 0xbb, 0x53, 0xf1, 0xde, 0x10, 0x3c, 0x09, 0xee, 0xa2, 0xa5, 0x94, 0x8c, 0xa2,
+	0x52, 0x4a, 0x18, 0x69, 0xe0, 0x8a, 0x0a, 0xae, 0x70, 0x60, 0xf3, 0x58, 0x48, 0xc9, 0x68, 0xcb,
+	0xa3, 0xdb, 0x25, 0x18, 0x3c, 0x42, 0x13, 0x46, 0x15, 0x4d, 0x41, 0x2a, 0x3c, 0xd7, 0xba, 0xd0,
+	0x9e, 0xbc, 0x75, 0xe5, 0xfc, 0x81, 0x79, 0x42, 0x41, 0x56, 0x53, 0x19, 0xef, 0x3b, 0x9b, 0x0a,
+	0x6e, 0xa3, 0x45, 0x09, 0x7d, 0x90, 0x92, 0x30, 0x2b, 0xb5, 0x1a, 0x12, 0x09, 0x51, 0x2f, 0x53,
+	0x78, 0xde, 0xf5, 0xb2, 0x40, 0x77, 0x00, 0xf6, 0x0c, 0xb6, 0x91, 0x29, 0xb3, 0x36, 0xa5, 0x93,
+	0xa9, 0xa0, 0x38, 0x14, 0x0a, 0x2f, 0x38, 0xfd, 0x0a, 0xf4, 0x31, 0x19, 0x15, 0x87, 0x42, 0x05,
+	0x4f, 0xd1, 0xd4, 0xe9, 0x7b, 0x86, 0x17, 0xad, 0x7a, 0xe1, 0x39, 0xb9, 0x6f, 0x5b, 0x87, 0x3d,
+	0xc7, 0x77, 0xf7, 0xac, 0x5a, 0x47, 0x1d, 0xaa, 0x78, 0xf0, 0x2d, 0x9a, 0x2a, 0x6e, 0x6b, 0x24,
+	0x73, 0x06, 0x0a, 0x2f, 0x59, 0x75, 0xae, 0x9d, 0xf3, 0x84, 0x4d, 0xef, 0xd0, 0xcd, 0x19, 0x9c,
+	0x0a, 0x1d, 0x57, 0x00, 0x15, 0xdc, 0x43, 0xb8, 0xb8, 0x14, 0x39, 0x37, 0xad, 0x37, 0x83, 0xe6,
+	0xa7, 0x1e, 0xdb, 0xa2, 0x8b, 0x4b, 0xf2, 0x55, 0x01, 0xfb, 0xc1, 0x5f, 0x43, 0xc5, 0xa9, 0xb2,
+	0x02, 0x1b, 0x69, 0x97, 0xad, 0x43, 0xdd, 0x9b, 0x77, 0xc0, 0x8a, 0xba, 0x8e, 0x66, 0xcb, 0x5b,
+	0xc4, 0x88, 0x1a, 0x5a, 0xe6, 0x8a, 0xbb, 0x75, 0xc5, 0x19, 0x32, 0x76, 0xc3, 0xbd, 0x86, 0xa6,
+	0x9f, 0xe5, 0xf2, 0x28, 0x22, 0x4c, 0x83, 0xe4, 0x44, 0x83, 0xc2, 0xef, 0x5b, 0xe6, 0x94, 0x31,
+	0xdf, 0x2f, 0xad, 0x26, 0xe8, 0xb3, 0x5c, 0x0a, 0x19, 0x1d, 0x08, 0x5d, 0x6e, 0xe9, 0x07, 0x2e,
+	0xa8, 0x05, 0xbe, 0x16, 0xba, 0xd8, 0x50, 0xb7, 0xfa, 0x40, 0x58, 0xc1, 0xfb, 0xb0, 0x5c, 0x7d,
+	0x20, 0xcc, 0x93, 0x6e, 0xa0, 0x79, 0x99, 0xdb, 0x2d, 0xd3, 0x82, 0x81, 0x34, 0x6b, 0x6b, 0x13,
+	0x6d, 0xb8, 0x3d, 0x76, 0xd8, 0x93, 0x02, 0x32, 0xb9, 0xae, 0xa2, 0x3a, 0xc9, 0xb2, 0x4a, 0xd8,
+	0xa6, 0x0b, 0xeb, 0x8c, 0x27, 0x22, 0x79, 0x92, 0x11, 0xcf, 0x46, 0x6c, 0x39, 0x91, 0x9c, 0xd9,
+	0xec, 0x8b, 0x09, 0xd6, 0x46, 0x33, 0x66, 0xe0, 0x3c, 0x37, 0x81, 0x4c, 0x0f, 0xf1, 0x47, 0xae,
+	0xf2, 0x94, 0x8c, 0xee, 0x5b, 0xf3, 0x96, 0xb1, 0xda, 0xd3, 0xee, 0x58, 0x56, 0xa9, 0x34, 0x67,
+	0x9a, 0x66, 0x8c, 0x82, 0xc4, 0x57, 0xfc, 0x69, 0xb7, 0xe8, 0xa3, 0x5c, 0x1e, 0x3d, 0x2e, 0xb1,
+	0xe0, 0x73, 0xb4, 0x52, 0xc4, 0xf6, 0xbf, 0x2b, 0x55, 0xcf, 0x55, 0xeb, 0x89, 0x1d, 0xa3, 0xf8,
+	0xe1, 0xa9, 0x78, 0xdf, 0x46, 0x8b, 0x65, 0xab, 0xa9, 0x15, 0xa9, 0xe8, 0xf8, 0x55, 0x7f, 0x18,
+	0x7d, 0xc7, 0x2d, 0xe8, 0xfb, 0x7e, 0x17, 0x2d, 0x15, 0x4e, 0x12, 0x54, 0x26, 0xb8, 0x2a, 0x1b,
+	0xb5, 0xe6, 0xae, 0x80, 0x87, 0xbb, 0x1e, 0xf5, 0x92, 0xfd, 0x50, 0x43, 0x73, 0x6f, 0x3f, 0x4d,
+	0xe1, 0x6b, 0x76, 0xe4, 0xcf, 0x39, 0x49, 0x77, 0xcc, 0x90, 0xff, 0xf6, 0x77, 0xb3, 0x3d, 0xa0,
+	0x7a, 0x98, 0xf7, 0xc2, 0x58, 0xa4, 0xfe, 0x85, 0xc6, 0xff, 0xbb, 0xae, 0x92, 0xfd, 0x8e, 0x3e,
+	0xca, 0x40, 0x59, 0x07, 0xe5, 0x16, 0x62, 0xf6, 0xff, 0xc9, 0xab, 0xcf, 0xda, 0xff, 0xfe, 0xdc,
+	0xac, 0xfd, 0xf8, 0xe6, 0xf9, 0x7a, 0xb3, 0xf2, 0x4a, 0x32, 0x3a, 0xf5, 0x52, 0xe2, 0x76, 0xf6,
+	0xd1, 0xc5, 0xf1, 0x8f, 0x67, 0xae, 0x76, 0x83, 0xb7, 0xf3, 0xdd, 0xb8, 0xf7, 0xe2, 0x75, 0xa3,
+	0xf6, 0xf2, 0x75, 0xa3, 0xf6, 0xcf, 0xeb, 0x46, 0xed, 0xa7, 0xe3, 0xc6, 0xd8, 0xcb, 0xe3, 0xc6,
+	0xd8, 0x9f, 0xc7, 0x8d, 0xb1, 0xef, 0x1a, 0x67, 0x06, 0xb5, 0xb9, 0xf5, 0x2e, 0xd9, 0x37, 0x9d,
+	0xdb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xae, 0x1a, 0xba, 0xfb, 0x11, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -185,6 +554,122 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ArbiterStakeRequired != that1.ArbiterStakeRequired {
 		return false
 	}
+	if len(this.AllowedDenoms) != len(that1.AllowedDenoms) {
+		return false
+	}
+	for i := range this.AllowedDenoms {
+		if this.AllowedDenoms[i] != that1.AllowedDenoms[i] {
+			return false
+		}
+	}
+	if this.StakeDenom != that1.StakeDenom {
+		return false
+	}
+	if !this.FeeDistribution.Equal(&that1.FeeDistribution) {
+		return false
+	}
+	if this.FeeSettlementEpoch != that1.FeeSettlementEpoch {
+		return false
+	}
+	if this.ReviewPeriod != that1.ReviewPeriod {
+		return false
+	}
+	if this.DeadlineGracePeriod != that1.DeadlineGracePeriod {
+		return false
+	}
+	if this.CancellationExpiry != that1.CancellationExpiry {
+		return false
+	}
+	if this.TipFeeEnabled != that1.TipFeeEnabled {
+		return false
+	}
+	if this.HourlyBillingEpoch != that1.HourlyBillingEpoch {
+		return false
+	}
+	if this.TimeLogContestWindow != that1.TimeLogContestWindow {
+		return false
+	}
+	if !this.ApplicationBond.Equal(&that1.ApplicationBond) {
+		return false
+	}
+	if this.MaxDeadlineExtensions != that1.MaxDeadlineExtensions {
+		return false
+	}
+	if len(this.FeeTiers) != len(that1.FeeTiers) {
+		return false
+	}
+	for i := range this.FeeTiers {
+		if !this.FeeTiers[i].Equal(&that1.FeeTiers[i]) {
+			return false
+		}
+	}
+	if this.ReferralFeeShareBps != that1.ReferralFeeShareBps {
+		return false
+	}
+	if this.ReferralMaxContracts != that1.ReferralMaxContracts {
+		return false
+	}
+	if !this.EscrowStaking.Equal(&that1.EscrowStaking) {
+		return false
+	}
+	if len(this.CategoryRules) != len(that1.CategoryRules) {
+		return false
+	}
+	for i := range this.CategoryRules {
+		if !this.CategoryRules[i].Equal(&that1.CategoryRules[i]) {
+			return false
+		}
+	}
+	if this.ArbiterUnbondingPeriod != that1.ArbiterUnbondingPeriod {
+		return false
+	}
+	if this.DisputeFeeBps != that1.DisputeFeeBps {
+		return false
+	}
+	if this.ArbiterSlashBps != that1.ArbiterSlashBps {
+		return false
+	}
+	if this.JuryAlternates != that1.JuryAlternates {
+		return false
+	}
+	if this.JurorVotePeriod != that1.JurorVotePeriod {
+		return false
+	}
+	if this.RevealPeriod != that1.RevealPeriod {
+		return false
+	}
+	if this.RulingToleranceBps != that1.RulingToleranceBps {
+		return false
+	}
+	if this.AppealPeriod != that1.AppealPeriod {
+		return false
+	}
+	if this.AppealBondBps != that1.AppealBondBps {
+		return false
+	}
+	if this.MaxAppealDepth != that1.MaxAppealDepth {
+		return false
+	}
+	if this.AppealJuryMultiplier != that1.AppealJuryMultiplier {
+		return false
+	}
+	if this.AppealDurationMultiplier != that1.AppealDurationMultiplier {
+		return false
+	}
+	if this.DisputeFilingFeeBps != that1.DisputeFilingFeeBps {
+		return false
+	}
+	if this.DisputeResponsePeriod != that1.DisputeResponsePeriod {
+		return false
+	}
+	if len(this.DisputeFilingFees) != len(that1.DisputeFilingFees) {
+		return false
+	}
+	for i := range this.DisputeFilingFees {
+		if !this.DisputeFilingFees[i].Equal(&that1.DisputeFilingFees[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -207,31 +692,294 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ArbiterStakeRequired != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.ArbiterStakeRequired))
+	if len(m.DisputeFilingFees) > 0 {
+		for iNdEx := len(m.DisputeFilingFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisputeFilingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.DisputeResponsePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeResponsePeriod))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
 	}
-	if m.MinArbitersRequired != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MinArbitersRequired))
+	if m.DisputeFilingFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeFilingFeeBps))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.DisputeDuration != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.DisputeDuration))
+	if m.AppealDurationMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealDurationMultiplier))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
 	}
-	{
-		size := m.MinGigPrice.Size()
-		i -= size
-		if _, err := m.MinGigPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	if m.AppealJuryMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealJuryMultiplier))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
 	}
-	i--
-	dAtA[i] = 0x1a
+	if m.MaxAppealDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAppealDepth))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AppealBondBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealBondBps))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AppealPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AppealPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RulingToleranceBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RulingToleranceBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.RevealPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RevealPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.JurorVotePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JurorVotePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.JuryAlternates != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JuryAlternates))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.ArbiterSlashBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ArbiterSlashBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.DisputeFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeFeeBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ArbiterUnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ArbiterUnbondingPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.CategoryRules) > 0 {
+		for iNdEx := len(m.CategoryRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CategoryRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	{
+		size, err := m.EscrowStaking.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.ReferralMaxContracts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReferralMaxContracts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ReferralFeeShareBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReferralFeeShareBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.MaxDeadlineExtensions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeadlineExtensions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.ApplicationBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.TimeLogContestWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TimeLogContestWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.HourlyBillingEpoch) > 0 {
+		i -= len(m.HourlyBillingEpoch)
+		copy(dAtA[i:], m.HourlyBillingEpoch)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.HourlyBillingEpoch)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.TipFeeEnabled {
+		i--
+		if m.TipFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CancellationExpiry != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CancellationExpiry))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DeadlineGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeadlineGracePeriod))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ReviewPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReviewPeriod))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeeSettlementEpoch) > 0 {
+		i -= len(m.FeeSettlementEpoch)
+		copy(dAtA[i:], m.FeeSettlementEpoch)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeSettlementEpoch)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.StakeDenom) > 0 {
+		i -= len(m.StakeDenom)
+		copy(dAtA[i:], m.StakeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StakeDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ArbiterStakeRequired != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ArbiterStakeRequired))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinArbitersRequired != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinArbitersRequired))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DisputeDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinGigPrice.Size()
+		i -= size
+		if _, err := m.MinGigPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.MinContractDuration != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinContractDuration))
 		i--
@@ -279,6 +1027,114 @@ func (m *Params) Size() (n int) {
 	if m.ArbiterStakeRequired != 0 {
 		n += 1 + sovParams(uint64(m.ArbiterStakeRequired))
 	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.StakeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeSettlementEpoch)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ReviewPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ReviewPeriod))
+	}
+	if m.DeadlineGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.DeadlineGracePeriod))
+	}
+	if m.CancellationExpiry != 0 {
+		n += 1 + sovParams(uint64(m.CancellationExpiry))
+	}
+	if m.TipFeeEnabled {
+		n += 2
+	}
+	l = len(m.HourlyBillingEpoch)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.TimeLogContestWindow != 0 {
+		n += 2 + sovParams(uint64(m.TimeLogContestWindow))
+	}
+	l = m.ApplicationBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxDeadlineExtensions != 0 {
+		n += 2 + sovParams(uint64(m.MaxDeadlineExtensions))
+	}
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ReferralFeeShareBps != 0 {
+		n += 2 + sovParams(uint64(m.ReferralFeeShareBps))
+	}
+	if m.ReferralMaxContracts != 0 {
+		n += 2 + sovParams(uint64(m.ReferralMaxContracts))
+	}
+	l = m.EscrowStaking.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.CategoryRules) > 0 {
+		for _, e := range m.CategoryRules {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ArbiterUnbondingPeriod != 0 {
+		n += 2 + sovParams(uint64(m.ArbiterUnbondingPeriod))
+	}
+	if m.DisputeFeeBps != 0 {
+		n += 2 + sovParams(uint64(m.DisputeFeeBps))
+	}
+	if m.ArbiterSlashBps != 0 {
+		n += 2 + sovParams(uint64(m.ArbiterSlashBps))
+	}
+	if m.JuryAlternates != 0 {
+		n += 2 + sovParams(uint64(m.JuryAlternates))
+	}
+	if m.JurorVotePeriod != 0 {
+		n += 2 + sovParams(uint64(m.JurorVotePeriod))
+	}
+	if m.RevealPeriod != 0 {
+		n += 2 + sovParams(uint64(m.RevealPeriod))
+	}
+	if m.RulingToleranceBps != 0 {
+		n += 2 + sovParams(uint64(m.RulingToleranceBps))
+	}
+	if m.AppealPeriod != 0 {
+		n += 2 + sovParams(uint64(m.AppealPeriod))
+	}
+	if m.AppealBondBps != 0 {
+		n += 2 + sovParams(uint64(m.AppealBondBps))
+	}
+	if m.MaxAppealDepth != 0 {
+		n += 2 + sovParams(uint64(m.MaxAppealDepth))
+	}
+	if m.AppealJuryMultiplier != 0 {
+		n += 2 + sovParams(uint64(m.AppealJuryMultiplier))
+	}
+	if m.AppealDurationMultiplier != 0 {
+		n += 2 + sovParams(uint64(m.AppealDurationMultiplier))
+	}
+	if m.DisputeFilingFeeBps != 0 {
+		n += 2 + sovParams(uint64(m.DisputeFilingFeeBps))
+	}
+	if m.DisputeResponsePeriod != 0 {
+		n += 2 + sovParams(uint64(m.DisputeResponsePeriod))
+	}
+	if len(m.DisputeFilingFees) > 0 {
+		for _, e := range m.DisputeFilingFees {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -307,21 +1163,493 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFeePercent", wireType)
+			}
+			m.PlatformFeePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformFeePercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGigPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGigPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDuration", wireType)
+			}
+			m.DisputeDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinArbitersRequired", wireType)
+			}
+			m.MinArbitersRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinArbitersRequired |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterStakeRequired", wireType)
+			}
+			m.ArbiterStakeRequired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArbiterStakeRequired |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSettlementEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSettlementEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewPeriod", wireType)
+			}
+			m.ReviewPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineGracePeriod", wireType)
+			}
+			m.DeadlineGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationExpiry", wireType)
+			}
+			m.CancellationExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancellationExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TipFeeEnabled = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyBillingEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HourlyBillingEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLogContestWindow", wireType)
+			}
+			m.TimeLogContestWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeLogContestWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeadlineExtensions", wireType)
+			}
+			m.MaxDeadlineExtensions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeadlineExtensions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformFeePercent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFeeShareBps", wireType)
 			}
-			m.PlatformFeePercent = 0
+			m.ReferralFeeShareBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -331,16 +1659,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PlatformFeePercent |= uint64(b&0x7F) << shift
+				m.ReferralFeeShareBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralMaxContracts", wireType)
 			}
-			m.MinContractDuration = 0
+			m.ReferralMaxContracts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -350,16 +1678,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinContractDuration |= uint64(b&0x7F) << shift
+				m.ReferralMaxContracts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGigPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowStaking", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -369,31 +1697,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGigPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EscrowStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryRules = append(m.CategoryRules, CategoryRule{})
+			if err := m.CategoryRules[len(m.CategoryRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterUnbondingPeriod", wireType)
 			}
-			m.DisputeDuration = 0
+			m.ArbiterUnbondingPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -403,16 +1764,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DisputeDuration |= uint64(b&0x7F) << shift
+				m.ArbiterUnbondingPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinArbitersRequired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeFeeBps", wireType)
 			}
-			m.MinArbitersRequired = 0
+			m.DisputeFeeBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -422,16 +1783,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinArbitersRequired |= uint64(b&0x7F) << shift
+				m.DisputeFeeBps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 26:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterStakeRequired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterSlashBps", wireType)
 			}
-			m.ArbiterStakeRequired = 0
+			m.ArbiterSlashBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -441,11 +1802,254 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ArbiterStakeRequired |= uint64(b&0x7F) << shift
+				m.ArbiterSlashBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JuryAlternates", wireType)
+			}
+			m.JuryAlternates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JuryAlternates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JurorVotePeriod", wireType)
+			}
+			m.JurorVotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JurorVotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			m.RevealPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RulingToleranceBps", wireType)
+			}
+			m.RulingToleranceBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RulingToleranceBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealPeriod", wireType)
+			}
+			m.AppealPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealBondBps", wireType)
+			}
+			m.AppealBondBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealBondBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAppealDepth", wireType)
+			}
+			m.MaxAppealDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAppealDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealJuryMultiplier", wireType)
+			}
+			m.AppealJuryMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealJuryMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealDurationMultiplier", wireType)
+			}
+			m.AppealDurationMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppealDurationMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeFilingFeeBps", wireType)
+			}
+			m.DisputeFilingFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeFilingFeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeResponsePeriod", wireType)
+			}
+			m.DisputeResponsePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeResponsePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeFilingFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeFilingFees = append(m.DisputeFilingFees, types.Coin{})
+			if err := m.DisputeFilingFees[len(m.DisputeFilingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
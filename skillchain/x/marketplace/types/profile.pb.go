@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,15 +27,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Profile defines the Profile message.
 type Profile struct {
-	Owner       string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Bio         string   `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
-	Skills      []string `protobuf:"bytes,4,rep,name=skills,proto3" json:"skills,omitempty"`
-	HourlyRate  uint64   `protobuf:"varint,5,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
-	TotalJobs   uint64   `protobuf:"varint,6,opt,name=total_jobs,json=totalJobs,proto3" json:"total_jobs,omitempty"`
-	TotalEarned uint64   `protobuf:"varint,7,opt,name=total_earned,json=totalEarned,proto3" json:"total_earned,omitempty"`
-	RatingSum   uint64   `protobuf:"varint,8,opt,name=rating_sum,json=ratingSum,proto3" json:"rating_sum,omitempty"`
-	RatingCount uint64   `protobuf:"varint,9,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Owner      string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name       string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio        string   `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	Skills     []string `protobuf:"bytes,4,rep,name=skills,proto3" json:"skills,omitempty"`
+	HourlyRate uint64   `protobuf:"varint,5,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	TotalJobs  uint64   `protobuf:"varint,6,opt,name=total_jobs,json=totalJobs,proto3" json:"total_jobs,omitempty"`
+	// Deprecated: replaced by total_earned, only read by the store migration.
+	LegacyTotalEarned uint64                                   `protobuf:"varint,7,opt,name=legacy_total_earned,json=legacyTotalEarned,proto3" json:"legacy_total_earned,omitempty"`
+	RatingSum         uint64                                   `protobuf:"varint,8,opt,name=rating_sum,json=ratingSum,proto3" json:"rating_sum,omitempty"`
+	RatingCount       uint64                                   `protobuf:"varint,9,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	TotalEarned       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=total_earned,json=totalEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_earned"`
+	// Account that referred the owner, if any.
+	Referrer string `protobuf:"bytes,11,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *Profile) Reset()         { *m = Profile{} }
@@ -110,9 +117,9 @@ func (m *Profile) GetTotalJobs() uint64 {
 	return 0
 }
 
-func (m *Profile) GetTotalEarned() uint64 {
+func (m *Profile) GetLegacyTotalEarned() uint64 {
 	if m != nil {
-		return m.TotalEarned
+		return m.LegacyTotalEarned
 	}
 	return 0
 }
@@ -131,6 +138,20 @@ func (m *Profile) GetRatingCount() uint64 {
 	return 0
 }
 
+func (m *Profile) GetTotalEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEarned
+	}
+	return nil
+}
+
+func (m *Profile) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Profile)(nil), "skillchain.marketplace.v1.Profile")
 }
@@ -140,26 +161,33 @@ func init() {
 }
 
 var fileDescriptor_65cc9871d900b00a = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x18, 0x84, 0xeb, 0x26, 0x4d, 0x89, 0xcb, 0x80, 0x2c, 0x84, 0xcc, 0x80, 0x09, 0x2c, 0x64, 0x4a,
-	0x54, 0xb1, 0x30, 0x83, 0x58, 0x98, 0x50, 0xd8, 0x58, 0x22, 0x27, 0x18, 0x1a, 0xea, 0xd8, 0x91,
-	0xed, 0x14, 0xba, 0xf2, 0x04, 0x3c, 0x16, 0x63, 0x47, 0x46, 0x94, 0xbc, 0x08, 0x8a, 0x5d, 0x89,
-	0xb2, 0xfd, 0xf7, 0xdd, 0xe9, 0x7e, 0xe9, 0xe0, 0x85, 0x5e, 0x56, 0x9c, 0x97, 0x0b, 0x5a, 0x89,
-	0xb4, 0xa6, 0x6a, 0xc9, 0x4c, 0xc3, 0x69, 0xc9, 0xd2, 0xd5, 0x3c, 0x6d, 0x94, 0x7c, 0xae, 0x38,
-	0x4b, 0x1a, 0x25, 0x8d, 0x44, 0xc7, 0x7f, 0xc1, 0x64, 0x27, 0x98, 0xac, 0xe6, 0xe7, 0x1f, 0x63,
-	0x38, 0xbd, 0x77, 0x61, 0x74, 0x08, 0x27, 0xf2, 0x4d, 0x30, 0x85, 0x41, 0x04, 0xe2, 0x30, 0x73,
-	0x02, 0x21, 0xe8, 0x0b, 0x5a, 0x33, 0x3c, 0xb6, 0xd0, 0xde, 0xe8, 0x00, 0x7a, 0x45, 0x25, 0xb1,
-	0x67, 0xd1, 0x70, 0xa2, 0x23, 0x18, 0xd8, 0x27, 0x1a, 0xfb, 0x91, 0x17, 0x87, 0xd9, 0x56, 0xa1,
-	0x53, 0x38, 0x5b, 0xc8, 0x56, 0xf1, 0x75, 0xae, 0xa8, 0x61, 0x78, 0x12, 0x81, 0xd8, 0xcf, 0xa0,
-	0x43, 0x19, 0x35, 0x0c, 0x9d, 0x40, 0x68, 0xa4, 0xa1, 0x3c, 0x7f, 0x95, 0x85, 0xc6, 0x81, 0xf5,
-	0x43, 0x4b, 0xee, 0x64, 0xa1, 0xd1, 0x19, 0xdc, 0x77, 0x36, 0xa3, 0x4a, 0xb0, 0x27, 0x3c, 0xb5,
-	0x81, 0x99, 0x65, 0xb7, 0x16, 0x0d, 0x0d, 0x8a, 0x9a, 0x4a, 0xbc, 0xe4, 0xba, 0xad, 0xf1, 0x9e,
-	0x6b, 0x70, 0xe4, 0xa1, 0xad, 0x87, 0x86, 0xad, 0x5d, 0xca, 0x56, 0x18, 0x1c, 0xba, 0x06, 0xc7,
-	0x6e, 0x06, 0x74, 0x7d, 0xf5, 0xd5, 0x11, 0xb0, 0xe9, 0x08, 0xf8, 0xe9, 0x08, 0xf8, 0xec, 0xc9,
-	0x68, 0xd3, 0x93, 0xd1, 0x77, 0x4f, 0x46, 0x8f, 0x64, 0x67, 0xe2, 0xf7, 0x7f, 0x23, 0x9b, 0x75,
-	0xc3, 0x74, 0x11, 0xd8, 0x81, 0x2f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x3c, 0xfb, 0xa4,
-	0x8b, 0x01, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x31, 0x73, 0xd3, 0x30,
+	0x14, 0xc7, 0x63, 0x9c, 0xa6, 0x8d, 0xdc, 0x01, 0x44, 0x8f, 0x53, 0x73, 0x87, 0x12, 0x58, 0xf0,
+	0x82, 0x44, 0x60, 0x61, 0x6e, 0x8f, 0x85, 0x89, 0x33, 0x4c, 0x2c, 0x3e, 0xd9, 0x55, 0x1d, 0x11,
+	0x5b, 0xcf, 0x27, 0xc9, 0x85, 0x7c, 0x0b, 0x76, 0xbe, 0x01, 0x9f, 0xa4, 0x63, 0x47, 0x26, 0xe0,
+	0x92, 0x2f, 0xc2, 0x59, 0xf2, 0xd1, 0x64, 0xd2, 0x7b, 0xbf, 0xff, 0xff, 0xe9, 0xe9, 0x9e, 0x1e,
+	0x7a, 0x61, 0xd7, 0xaa, 0xae, 0xcb, 0x95, 0x50, 0x9a, 0x37, 0xc2, 0xac, 0xa5, 0x6b, 0x6b, 0x51,
+	0x4a, 0x7e, 0xb3, 0xe4, 0xad, 0x81, 0x6b, 0x55, 0x4b, 0xd6, 0x1a, 0x70, 0x80, 0xcf, 0xef, 0x8d,
+	0x6c, 0xcf, 0xc8, 0x6e, 0x96, 0x33, 0x5a, 0x82, 0x6d, 0xc0, 0xf2, 0x42, 0xd8, 0xbe, 0xb0, 0x90,
+	0x4e, 0x2c, 0x79, 0x09, 0x4a, 0x87, 0xd2, 0xd9, 0x59, 0x05, 0x15, 0xf8, 0x90, 0xf7, 0x51, 0xa0,
+	0xcf, 0x7f, 0xc4, 0xe8, 0xf8, 0x43, 0x68, 0x81, 0xcf, 0xd0, 0x11, 0x7c, 0xd5, 0xd2, 0x90, 0x68,
+	0x11, 0xa5, 0xd3, 0x2c, 0x24, 0x18, 0xa3, 0xb1, 0x16, 0x8d, 0x24, 0x0f, 0x3c, 0xf4, 0x31, 0x7e,
+	0x88, 0xe2, 0x42, 0x01, 0x89, 0x3d, 0xea, 0x43, 0xfc, 0x04, 0x4d, 0xfc, 0xd3, 0x2c, 0x19, 0x2f,
+	0xe2, 0x74, 0x9a, 0x0d, 0x19, 0x9e, 0xa3, 0x64, 0x05, 0x9d, 0xa9, 0x37, 0xb9, 0x11, 0x4e, 0x92,
+	0xa3, 0x45, 0x94, 0x8e, 0x33, 0x14, 0x50, 0x26, 0x9c, 0xc4, 0x4f, 0x11, 0x72, 0xe0, 0x44, 0x9d,
+	0x7f, 0x81, 0xc2, 0x92, 0x89, 0xd7, 0xa7, 0x9e, 0xbc, 0x87, 0xc2, 0x62, 0x86, 0x1e, 0xd7, 0xb2,
+	0x12, 0xe5, 0x26, 0x0f, 0x2e, 0x29, 0x8c, 0x96, 0x57, 0xe4, 0xd8, 0xfb, 0x1e, 0x05, 0xe9, 0x53,
+	0xaf, 0xbc, 0xf3, 0x42, 0x7f, 0x9d, 0x11, 0x4e, 0xe9, 0x2a, 0xb7, 0x5d, 0x43, 0x4e, 0xc2, 0x75,
+	0x81, 0x7c, 0xec, 0x1a, 0xfc, 0x0c, 0x9d, 0x0e, 0x72, 0x09, 0x9d, 0x76, 0x64, 0xea, 0x0d, 0x49,
+	0x60, 0x97, 0x3d, 0xc2, 0x1a, 0x9d, 0x1e, 0xb4, 0x42, 0x8b, 0x38, 0x4d, 0x5e, 0x9f, 0xb3, 0x30,
+	0x5e, 0xd6, 0x8f, 0x97, 0x0d, 0xe3, 0x65, 0x97, 0xa0, 0xf4, 0xc5, 0xab, 0xdb, 0xdf, 0xf3, 0xd1,
+	0xcf, 0x3f, 0xf3, 0xb4, 0x52, 0x6e, 0xd5, 0x15, 0xac, 0x84, 0x86, 0x0f, 0x7f, 0x11, 0x8e, 0x97,
+	0xf6, 0x6a, 0xcd, 0xdd, 0xa6, 0x95, 0xd6, 0x17, 0xd8, 0x2c, 0x71, 0x7b, 0x2f, 0x9e, 0xa1, 0x13,
+	0x23, 0xaf, 0xa5, 0x31, 0xd2, 0x90, 0xc4, 0x0f, 0xf4, 0x7f, 0x7e, 0xf1, 0xf6, 0x76, 0x4b, 0xa3,
+	0xbb, 0x2d, 0x8d, 0xfe, 0x6e, 0x69, 0xf4, 0x7d, 0x47, 0x47, 0x77, 0x3b, 0x3a, 0xfa, 0xb5, 0xa3,
+	0xa3, 0xcf, 0x74, 0x6f, 0x63, 0xbe, 0x1d, 0xec, 0x8c, 0x6f, 0x54, 0x4c, 0xfc, 0xf7, 0xbe, 0xf9,
+	0x17, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x01, 0x9d, 0xe6, 0x5a, 0x02, 0x00, 0x00,
 }
 
 func (m *Profile) Marshal() (dAtA []byte, err error) {
@@ -182,6 +210,27 @@ func (m *Profile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintProfile(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TotalEarned) > 0 {
+		for iNdEx := len(m.TotalEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProfile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.RatingCount != 0 {
 		i = encodeVarintProfile(dAtA, i, uint64(m.RatingCount))
 		i--
@@ -192,8 +241,8 @@ func (m *Profile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.TotalEarned != 0 {
-		i = encodeVarintProfile(dAtA, i, uint64(m.TotalEarned))
+	if m.LegacyTotalEarned != 0 {
+		i = encodeVarintProfile(dAtA, i, uint64(m.LegacyTotalEarned))
 		i--
 		dAtA[i] = 0x38
 	}
@@ -281,8 +330,8 @@ func (m *Profile) Size() (n int) {
 	if m.TotalJobs != 0 {
 		n += 1 + sovProfile(uint64(m.TotalJobs))
 	}
-	if m.TotalEarned != 0 {
-		n += 1 + sovProfile(uint64(m.TotalEarned))
+	if m.LegacyTotalEarned != 0 {
+		n += 1 + sovProfile(uint64(m.LegacyTotalEarned))
 	}
 	if m.RatingSum != 0 {
 		n += 1 + sovProfile(uint64(m.RatingSum))
@@ -290,6 +339,16 @@ func (m *Profile) Size() (n int) {
 	if m.RatingCount != 0 {
 		n += 1 + sovProfile(uint64(m.RatingCount))
 	}
+	if len(m.TotalEarned) > 0 {
+		for _, e := range m.TotalEarned {
+			l = e.Size()
+			n += 1 + l + sovProfile(uint64(l))
+		}
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovProfile(uint64(l))
+	}
 	return n
 }
 
@@ -496,9 +555,9 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEarned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTotalEarned", wireType)
 			}
-			m.TotalEarned = 0
+			m.LegacyTotalEarned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProfile
@@ -508,7 +567,7 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalEarned |= uint64(b&0x7F) << shift
+				m.LegacyTotalEarned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -551,6 +610,72 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEarned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEarned = append(m.TotalEarned, types.Coin{})
+			if err := m.TotalEarned[len(m.TotalEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProfile(dAtA[iNdEx:])
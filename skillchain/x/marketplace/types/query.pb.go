@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -396,6 +397,8 @@ func (m *QueryGetGigResponse) GetGig() Gig {
 // QueryAllGigRequest defines the QueryAllGigRequest message.
 type QueryAllGigRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// funded only lists the gigs whose price is held in escrow
+	Funded bool `protobuf:"varint,2,opt,name=funded,proto3" json:"funded,omitempty"`
 }
 
 func (m *QueryAllGigRequest) Reset()         { *m = QueryAllGigRequest{} }
@@ -438,6 +441,13 @@ func (m *QueryAllGigRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllGigRequest) GetFunded() bool {
+	if m != nil {
+		return m.Funded
+	}
+	return false
+}
+
 // QueryAllGigResponse defines the QueryAllGigResponse message.
 type QueryAllGigResponse struct {
 	Gig        []Gig               `protobuf:"bytes,1,rep,name=gig,proto3" json:"gig"`
@@ -1266,7 +1276,10 @@ var xxx_messageInfo_QueryEscrowBalanceRequest proto.InternalMessageInfo
 
 // QueryEscrowBalanceResponse defines the QueryEscrowBalanceResponse message.
 type QueryEscrowBalanceResponse struct {
-	Balance *types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Balances held in escrow, one entry per denom.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// Part of the balances made of retained platform fees.
+	RetainedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=retained_fees,json=retainedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"retained_fees"`
 }
 
 func (m *QueryEscrowBalanceResponse) Reset()         { *m = QueryEscrowBalanceResponse{} }
@@ -1302,9 +1315,214 @@ func (m *QueryEscrowBalanceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEscrowBalanceResponse proto.InternalMessageInfo
 
-func (m *QueryEscrowBalanceResponse) GetBalance() *types.Coin {
+func (m *QueryEscrowBalanceResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QueryEscrowBalanceResponse) GetRetainedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RetainedFees
+	}
+	return nil
+}
+
+// QueryContractEscrowRequest defines the QueryContractEscrowRequest message.
+type QueryContractEscrowRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *QueryContractEscrowRequest) Reset()         { *m = QueryContractEscrowRequest{} }
+func (m *QueryContractEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractEscrowRequest) ProtoMessage()    {}
+func (*QueryContractEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{28}
+}
+func (m *QueryContractEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractEscrowRequest.Merge(m, src)
+}
+func (m *QueryContractEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryContractEscrowRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+// QueryContractEscrowResponse defines the QueryContractEscrowResponse message.
+type QueryContractEscrowResponse struct {
+	Escrow ContractEscrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
+	// Funds still held for the contract.
+	Held github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=held,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"held"`
+}
+
+func (m *QueryContractEscrowResponse) Reset()         { *m = QueryContractEscrowResponse{} }
+func (m *QueryContractEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractEscrowResponse) ProtoMessage()    {}
+func (*QueryContractEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{29}
+}
+func (m *QueryContractEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractEscrowResponse.Merge(m, src)
+}
+func (m *QueryContractEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryContractEscrowResponse) GetEscrow() ContractEscrow {
+	if m != nil {
+		return m.Escrow
+	}
+	return ContractEscrow{}
+}
+
+func (m *QueryContractEscrowResponse) GetHeld() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Held
+	}
+	return nil
+}
+
+// QueryEscrowsByUserRequest defines the QueryEscrowsByUserRequest message.
+type QueryEscrowsByUserRequest struct {
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QueryEscrowsByUserRequest) Reset()         { *m = QueryEscrowsByUserRequest{} }
+func (m *QueryEscrowsByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsByUserRequest) ProtoMessage()    {}
+func (*QueryEscrowsByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{30}
+}
+func (m *QueryEscrowsByUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsByUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsByUserRequest.Merge(m, src)
+}
+func (m *QueryEscrowsByUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsByUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsByUserRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowsByUserRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QueryEscrowsByUserResponse defines the QueryEscrowsByUserResponse message.
+type QueryEscrowsByUserResponse struct {
+	Escrows []ContractEscrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows"`
+	// Funds still held across the contracts of the user.
+	Held github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=held,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"held"`
+}
+
+func (m *QueryEscrowsByUserResponse) Reset()         { *m = QueryEscrowsByUserResponse{} }
+func (m *QueryEscrowsByUserResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsByUserResponse) ProtoMessage()    {}
+func (*QueryEscrowsByUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{31}
+}
+func (m *QueryEscrowsByUserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsByUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsByUserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsByUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsByUserResponse.Merge(m, src)
+}
+func (m *QueryEscrowsByUserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsByUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsByUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsByUserResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowsByUserResponse) GetEscrows() []ContractEscrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *QueryEscrowsByUserResponse) GetHeld() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Balance
+		return m.Held
 	}
 	return nil
 }
@@ -1318,7 +1536,7 @@ func (m *QueryGetDisputeRequest) Reset()         { *m = QueryGetDisputeRequest{}
 func (m *QueryGetDisputeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDisputeRequest) ProtoMessage()    {}
 func (*QueryGetDisputeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{28}
+	return fileDescriptor_0c914ebc0cae4876, []int{32}
 }
 func (m *QueryGetDisputeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,13 +1575,16 @@ func (m *QueryGetDisputeRequest) GetId() uint64 {
 // QueryGetDisputeResponse defines the QueryGetDisputeResponse message.
 type QueryGetDisputeResponse struct {
 	Dispute Dispute `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute"`
+	// Deadline extension history of the disputed contract, given to arbiters
+	// as context.
+	Extensions []DeadlineExtension `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions"`
 }
 
 func (m *QueryGetDisputeResponse) Reset()         { *m = QueryGetDisputeResponse{} }
 func (m *QueryGetDisputeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDisputeResponse) ProtoMessage()    {}
 func (*QueryGetDisputeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{29}
+	return fileDescriptor_0c914ebc0cae4876, []int{33}
 }
 func (m *QueryGetDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1399,6 +1620,13 @@ func (m *QueryGetDisputeResponse) GetDispute() Dispute {
 	return Dispute{}
 }
 
+func (m *QueryGetDisputeResponse) GetExtensions() []DeadlineExtension {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
 // QueryAllDisputeRequest defines the QueryAllDisputeRequest message.
 type QueryAllDisputeRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1408,7 +1636,7 @@ func (m *QueryAllDisputeRequest) Reset()         { *m = QueryAllDisputeRequest{}
 func (m *QueryAllDisputeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllDisputeRequest) ProtoMessage()    {}
 func (*QueryAllDisputeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{30}
+	return fileDescriptor_0c914ebc0cae4876, []int{34}
 }
 func (m *QueryAllDisputeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1682,7 @@ func (m *QueryAllDisputeResponse) Reset()         { *m = QueryAllDisputeResponse
 func (m *QueryAllDisputeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllDisputeResponse) ProtoMessage()    {}
 func (*QueryAllDisputeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{31}
+	return fileDescriptor_0c914ebc0cae4876, []int{35}
 }
 func (m *QueryAllDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1734,7 @@ func (m *QueryGetDisputeVoteRequest) Reset()         { *m = QueryGetDisputeVoteR
 func (m *QueryGetDisputeVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDisputeVoteRequest) ProtoMessage()    {}
 func (*QueryGetDisputeVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{32}
+	return fileDescriptor_0c914ebc0cae4876, []int{36}
 }
 func (m *QueryGetDisputeVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1779,7 @@ func (m *QueryGetDisputeVoteResponse) Reset()         { *m = QueryGetDisputeVote
 func (m *QueryGetDisputeVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetDisputeVoteResponse) ProtoMessage()    {}
 func (*QueryGetDisputeVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{33}
+	return fileDescriptor_0c914ebc0cae4876, []int{37}
 }
 func (m *QueryGetDisputeVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,7 +1824,7 @@ func (m *QueryAllDisputeVoteRequest) Reset()         { *m = QueryAllDisputeVoteR
 func (m *QueryAllDisputeVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllDisputeVoteRequest) ProtoMessage()    {}
 func (*QueryAllDisputeVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{34}
+	return fileDescriptor_0c914ebc0cae4876, []int{38}
 }
 func (m *QueryAllDisputeVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1642,7 +1870,7 @@ func (m *QueryAllDisputeVoteResponse) Reset()         { *m = QueryAllDisputeVote
 func (m *QueryAllDisputeVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllDisputeVoteResponse) ProtoMessage()    {}
 func (*QueryAllDisputeVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c914ebc0cae4876, []int{35}
+	return fileDescriptor_0c914ebc0cae4876, []int{39}
 }
 func (m *QueryAllDisputeVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,2624 +1913,9515 @@ func (m *QueryAllDisputeVoteResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "skillchain.marketplace.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "skillchain.marketplace.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetProfileRequest)(nil), "skillchain.marketplace.v1.QueryGetProfileRequest")
-	proto.RegisterType((*QueryGetProfileResponse)(nil), "skillchain.marketplace.v1.QueryGetProfileResponse")
-	proto.RegisterType((*QueryAllProfileRequest)(nil), "skillchain.marketplace.v1.QueryAllProfileRequest")
-	proto.RegisterType((*QueryAllProfileResponse)(nil), "skillchain.marketplace.v1.QueryAllProfileResponse")
-	proto.RegisterType((*QueryGetGigRequest)(nil), "skillchain.marketplace.v1.QueryGetGigRequest")
-	proto.RegisterType((*QueryGetGigResponse)(nil), "skillchain.marketplace.v1.QueryGetGigResponse")
-	proto.RegisterType((*QueryAllGigRequest)(nil), "skillchain.marketplace.v1.QueryAllGigRequest")
-	proto.RegisterType((*QueryAllGigResponse)(nil), "skillchain.marketplace.v1.QueryAllGigResponse")
-	proto.RegisterType((*QueryGetApplicationRequest)(nil), "skillchain.marketplace.v1.QueryGetApplicationRequest")
-	proto.RegisterType((*QueryGetApplicationResponse)(nil), "skillchain.marketplace.v1.QueryGetApplicationResponse")
-	proto.RegisterType((*QueryAllApplicationRequest)(nil), "skillchain.marketplace.v1.QueryAllApplicationRequest")
-	proto.RegisterType((*QueryAllApplicationResponse)(nil), "skillchain.marketplace.v1.QueryAllApplicationResponse")
-	proto.RegisterType((*QueryGetContractRequest)(nil), "skillchain.marketplace.v1.QueryGetContractRequest")
-	proto.RegisterType((*QueryGetContractResponse)(nil), "skillchain.marketplace.v1.QueryGetContractResponse")
-	proto.RegisterType((*QueryAllContractRequest)(nil), "skillchain.marketplace.v1.QueryAllContractRequest")
-	proto.RegisterType((*QueryAllContractResponse)(nil), "skillchain.marketplace.v1.QueryAllContractResponse")
-	proto.RegisterType((*QueryApplicationsByGigRequest)(nil), "skillchain.marketplace.v1.QueryApplicationsByGigRequest")
-	proto.RegisterType((*QueryApplicationsByGigResponse)(nil), "skillchain.marketplace.v1.QueryApplicationsByGigResponse")
-	proto.RegisterType((*QueryApplicationsByFreelancerRequest)(nil), "skillchain.marketplace.v1.QueryApplicationsByFreelancerRequest")
-	proto.RegisterType((*QueryApplicationsByFreelancerResponse)(nil), "skillchain.marketplace.v1.QueryApplicationsByFreelancerResponse")
-	proto.RegisterType((*QueryContractsByUserRequest)(nil), "skillchain.marketplace.v1.QueryContractsByUserRequest")
-	proto.RegisterType((*QueryContractsByUserResponse)(nil), "skillchain.marketplace.v1.QueryContractsByUserResponse")
-	proto.RegisterType((*QueryContractByGigRequest)(nil), "skillchain.marketplace.v1.QueryContractByGigRequest")
-	proto.RegisterType((*QueryContractByGigResponse)(nil), "skillchain.marketplace.v1.QueryContractByGigResponse")
-	proto.RegisterType((*QueryEscrowBalanceRequest)(nil), "skillchain.marketplace.v1.QueryEscrowBalanceRequest")
-	proto.RegisterType((*QueryEscrowBalanceResponse)(nil), "skillchain.marketplace.v1.QueryEscrowBalanceResponse")
-	proto.RegisterType((*QueryGetDisputeRequest)(nil), "skillchain.marketplace.v1.QueryGetDisputeRequest")
-	proto.RegisterType((*QueryGetDisputeResponse)(nil), "skillchain.marketplace.v1.QueryGetDisputeResponse")
-	proto.RegisterType((*QueryAllDisputeRequest)(nil), "skillchain.marketplace.v1.QueryAllDisputeRequest")
-	proto.RegisterType((*QueryAllDisputeResponse)(nil), "skillchain.marketplace.v1.QueryAllDisputeResponse")
-	proto.RegisterType((*QueryGetDisputeVoteRequest)(nil), "skillchain.marketplace.v1.QueryGetDisputeVoteRequest")
-	proto.RegisterType((*QueryGetDisputeVoteResponse)(nil), "skillchain.marketplace.v1.QueryGetDisputeVoteResponse")
-	proto.RegisterType((*QueryAllDisputeVoteRequest)(nil), "skillchain.marketplace.v1.QueryAllDisputeVoteRequest")
-	proto.RegisterType((*QueryAllDisputeVoteResponse)(nil), "skillchain.marketplace.v1.QueryAllDisputeVoteResponse")
+// QueryFeeStatsRequest defines the QueryFeeStatsRequest message.
+type QueryFeeStatsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("skillchain/marketplace/v1/query.proto", fileDescriptor_0c914ebc0cae4876)
+func (m *QueryFeeStatsRequest) Reset()         { *m = QueryFeeStatsRequest{} }
+func (m *QueryFeeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeStatsRequest) ProtoMessage()    {}
+func (*QueryFeeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{40}
 }
-
-var fileDescriptor_0c914ebc0cae4876 = []byte{
-	// 1472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x99, 0xcb, 0x6f, 0xdc, 0xd4,
-	0x17, 0xc7, 0x73, 0x33, 0x6d, 0xf2, 0xeb, 0x49, 0x1f, 0xea, 0xfd, 0x15, 0x48, 0xa7, 0xc5, 0xb4,
-	0x37, 0x6d, 0x9a, 0x47, 0x6b, 0x33, 0x09, 0x7d, 0x89, 0x45, 0xc9, 0xf4, 0x11, 0x81, 0x78, 0xa4,
-	0x23, 0xc1, 0x02, 0xa8, 0x82, 0x33, 0xe3, 0x1a, 0xab, 0x8e, 0x3d, 0xb5, 0x9d, 0x94, 0x51, 0x34,
-	0x1b, 0xfe, 0x82, 0x0a, 0x10, 0x6b, 0x16, 0x08, 0x2a, 0x36, 0x14, 0x09, 0x81, 0x60, 0xc3, 0xb6,
-	0xec, 0x2a, 0xb1, 0x61, 0x85, 0x50, 0x8b, 0x84, 0xd8, 0xf2, 0x17, 0xa0, 0xb9, 0x3e, 0x1e, 0xbf,
-	0xc7, 0xd7, 0xd3, 0xe9, 0x26, 0x72, 0x3c, 0xe7, 0xdc, 0xfb, 0xf9, 0xde, 0xe7, 0xf9, 0xce, 0xc0,
-	0x49, 0xf7, 0x96, 0x61, 0x9a, 0xcd, 0x0f, 0x55, 0xc3, 0x52, 0x36, 0x55, 0xe7, 0x96, 0xe6, 0xb5,
-	0x4d, 0xb5, 0xa9, 0x29, 0xdb, 0x35, 0xe5, 0xf6, 0x96, 0xe6, 0x74, 0xe4, 0xb6, 0x63, 0x7b, 0x36,
-	0x3d, 0x1c, 0x86, 0xc9, 0x91, 0x30, 0x79, 0xbb, 0x56, 0x3d, 0xa8, 0x6e, 0x1a, 0x96, 0xad, 0xf0,
-	0xbf, 0x7e, 0x74, 0x75, 0xa1, 0x69, 0xbb, 0x9b, 0xb6, 0xab, 0x6c, 0xa8, 0xae, 0xe6, 0x37, 0xa3,
-	0x6c, 0xd7, 0x36, 0x34, 0x4f, 0xad, 0x29, 0x6d, 0x55, 0x37, 0x2c, 0xd5, 0x33, 0x6c, 0x0b, 0x63,
-	0xa5, 0x68, 0x6c, 0x10, 0xd5, 0xb4, 0x8d, 0xe0, 0xf3, 0x43, 0xba, 0xad, 0xdb, 0xfc, 0x51, 0xe9,
-	0x3d, 0xe1, 0xdb, 0xa3, 0xba, 0x6d, 0xeb, 0xa6, 0xa6, 0xa8, 0x6d, 0x43, 0x51, 0x2d, 0xcb, 0xf6,
-	0x78, 0x93, 0x2e, 0x7e, 0xba, 0x98, 0x2f, 0x4a, 0x6d, 0xb7, 0x4d, 0xa3, 0x19, 0x05, 0x98, 0xcb,
-	0x0f, 0x6e, 0xda, 0x96, 0xe7, 0xa8, 0x4d, 0x0f, 0x23, 0x4f, 0xe5, 0x47, 0xb6, 0x0c, 0xb7, 0xbd,
-	0xe5, 0x69, 0x18, 0x78, 0xba, 0x30, 0x70, 0x7d, 0xdb, 0xee, 0x47, 0xcf, 0xe4, 0x47, 0xeb, 0x86,
-	0x8e, 0x41, 0xb3, 0xf9, 0x41, 0x6d, 0xd5, 0x51, 0x37, 0xdd, 0x62, 0xc6, 0xb6, 0x63, 0xdf, 0x34,
-	0x4c, 0xec, 0x95, 0x1d, 0x02, 0x7a, 0xbd, 0x37, 0x33, 0x6b, 0x3c, 0xbb, 0xa1, 0xdd, 0xde, 0xd2,
-	0x5c, 0x8f, 0xbd, 0x07, 0xff, 0x8f, 0xbd, 0x75, 0xdb, 0xb6, 0xe5, 0x6a, 0xf4, 0x0a, 0x4c, 0xf8,
-	0xbd, 0x4c, 0x93, 0x63, 0x64, 0x6e, 0x6a, 0xe9, 0xb8, 0x9c, 0xbb, 0x1e, 0x64, 0x3f, 0xb5, 0xbe,
-	0xe7, 0xc1, 0x1f, 0x2f, 0x8c, 0xdd, 0xfb, 0xfb, 0xfe, 0x02, 0x69, 0x60, 0x2e, 0x93, 0xe1, 0x59,
-	0xde, 0xf8, 0xaa, 0xe6, 0xad, 0xf9, 0x2c, 0xd8, 0x2d, 0x3d, 0x04, 0xbb, 0xed, 0x3b, 0x96, 0xe6,
-	0xf0, 0xe6, 0xf7, 0x34, 0xfc, 0x7f, 0xd8, 0x0d, 0x78, 0x2e, 0x15, 0x8f, 0x40, 0x75, 0x98, 0x44,
-	0x39, 0x48, 0xc4, 0x06, 0x11, 0xf9, 0x91, 0xf5, 0x5d, 0x3d, 0xa4, 0x46, 0x90, 0xc8, 0x3e, 0x40,
-	0x9c, 0x15, 0xd3, 0x4c, 0xe0, 0x5c, 0x03, 0x08, 0xd7, 0x29, 0x76, 0x30, 0x2b, 0xfb, 0x0b, 0x55,
-	0xee, 0x2d, 0x54, 0xd9, 0xdf, 0x1b, 0xb8, 0x5c, 0xe5, 0x35, 0x55, 0x0f, 0x72, 0x1b, 0x91, 0x4c,
-	0xf6, 0x15, 0x41, 0x05, 0xd1, 0x2e, 0xb2, 0x14, 0x54, 0x86, 0x52, 0x40, 0x57, 0x63, 0x9c, 0xe3,
-	0x9c, 0xf3, 0x54, 0x21, 0xa7, 0x0f, 0x10, 0x03, 0x3d, 0x81, 0x8b, 0x61, 0x55, 0xf3, 0x56, 0x0d,
-	0x3d, 0x18, 0x86, 0xfd, 0x30, 0x6e, 0xb4, 0xb8, 0xfc, 0x5d, 0x8d, 0x71, 0xa3, 0xc5, 0xde, 0xc0,
-	0xc5, 0x11, 0x44, 0xa1, 0x92, 0x73, 0x50, 0xd1, 0x0d, 0x1d, 0x87, 0x49, 0x1a, 0xa0, 0x62, 0xd5,
-	0xd0, 0x51, 0x41, 0x2f, 0x81, 0xbd, 0x8f, 0x9d, 0xae, 0x98, 0x66, 0xa4, 0xd3, 0x51, 0x8d, 0xfd,
-	0xe7, 0x04, 0x69, 0x83, 0xe6, 0x93, 0xb4, 0x95, 0x52, 0xb4, 0xa3, 0x1b, 0xeb, 0xd3, 0x50, 0x0d,
-	0x46, 0x71, 0x25, 0x3c, 0x8c, 0xf2, 0xc6, 0x7c, 0x13, 0x8e, 0x64, 0x46, 0xa3, 0x9a, 0x37, 0x61,
-	0x2a, 0x72, 0xa2, 0xf5, 0x87, 0x2b, 0x5f, 0x55, 0xa4, 0x11, 0x54, 0x17, 0x6d, 0x80, 0xb5, 0x10,
-	0x6e, 0xc5, 0x34, 0x33, 0xe0, 0x46, 0x35, 0x37, 0x3f, 0x10, 0x54, 0x95, 0xec, 0x26, 0x4f, 0x55,
-	0xe5, 0x89, 0x54, 0x8d, 0x6e, 0xee, 0xe6, 0xc3, 0x13, 0xe9, 0x32, 0xde, 0x0d, 0x79, 0x13, 0xa7,
-	0xc2, 0x74, 0x3a, 0x14, 0xf5, 0x5d, 0x85, 0xff, 0x05, 0x57, 0x0b, 0x8e, 0xe2, 0xcc, 0x00, 0x71,
-	0x41, 0x3a, 0x2a, 0xeb, 0xa7, 0x32, 0x35, 0x3c, 0x5d, 0x92, 0x34, 0xa3, 0x9a, 0xa9, 0x6f, 0x08,
-	0xca, 0x88, 0xf5, 0x91, 0x29, 0xa3, 0x32, 0xa4, 0x8c, 0xd1, 0xcd, 0xce, 0x39, 0x78, 0xde, 0x67,
-	0x0d, 0xa7, 0xde, 0xad, 0x77, 0x22, 0x67, 0xcb, 0x33, 0x30, 0xa1, 0x1b, 0xfa, 0x7a, 0x7f, 0x9e,
-	0x76, 0xeb, 0x86, 0xfe, 0x6a, 0x8b, 0x39, 0x20, 0xe5, 0xe5, 0xa1, 0xd2, 0x35, 0xd8, 0x1b, 0x59,
-	0x4f, 0xee, 0x50, 0x2b, 0x32, 0xd6, 0x02, 0xbb, 0x06, 0x27, 0x32, 0xfa, 0xbc, 0xe6, 0x68, 0x9a,
-	0xa9, 0x5a, 0x4d, 0xcd, 0x09, 0x90, 0x25, 0x80, 0x9b, 0xfd, 0x97, 0x78, 0x3d, 0x46, 0xde, 0xb0,
-	0x0e, 0x9c, 0x2c, 0x68, 0xe7, 0xa9, 0x49, 0xa8, 0xe1, 0x26, 0x0e, 0x26, 0xd6, 0xad, 0x77, 0xde,
-	0x76, 0x43, 0x72, 0x0a, 0xbb, 0xb6, 0xdc, 0x3e, 0x33, 0x7f, 0x66, 0x3a, 0x1c, 0xcd, 0x4e, 0x41,
-	0xc8, 0x55, 0xd8, 0x13, 0x2c, 0x0b, 0xb7, 0xfc, 0x92, 0x0a, 0x73, 0xd9, 0x12, 0x1c, 0x8e, 0x75,
-	0x24, 0xb2, 0x0c, 0x6e, 0xe0, 0xd9, 0x97, 0xc8, 0x41, 0xb4, 0x4b, 0x43, 0xed, 0xd9, 0xc8, 0x6e,
-	0x3d, 0x82, 0x48, 0x57, 0xdd, 0xa6, 0x63, 0xdf, 0xa9, 0xab, 0x7c, 0x7e, 0x82, 0xba, 0xeb, 0x3a,
-	0xf6, 0x9d, 0xf8, 0x10, 0xfb, 0x5e, 0x86, 0xc9, 0x0d, 0xff, 0x15, 0x76, 0x7d, 0x38, 0xb6, 0x3d,
-	0x82, 0x8d, 0x71, 0xd9, 0x36, 0xac, 0x46, 0x10, 0xc9, 0xe6, 0xc2, 0x6a, 0xeb, 0x8a, 0x5f, 0x74,
-	0xe6, 0x1d, 0x55, 0x91, 0x3a, 0xab, 0x1f, 0x19, 0x56, 0x29, 0x58, 0xb1, 0x0a, 0xd4, 0x59, 0x98,
-	0x1c, 0x54, 0x29, 0x98, 0x18, 0xad, 0xb3, 0x12, 0x20, 0x4f, 0xa3, 0xce, 0x1a, 0xa8, 0xa0, 0x32,
-	0x94, 0x82, 0x51, 0x9e, 0x50, 0xd5, 0xc4, 0x48, 0xbf, 0x63, 0x87, 0xc3, 0x31, 0x0d, 0x93, 0xaa,
-	0xb3, 0x61, 0x78, 0xfd, 0x4d, 0x13, 0xfc, 0xcb, 0xac, 0xb0, 0x0a, 0x88, 0xe5, 0xa1, 0xc6, 0xb7,
-	0x60, 0x6f, 0xd4, 0x57, 0x08, 0x94, 0x01, 0x91, 0x56, 0x82, 0x0b, 0xb3, 0x15, 0xbe, 0x8a, 0x96,
-	0x01, 0x19, 0x9c, 0xa3, 0x9a, 0xb6, 0x1f, 0x23, 0x65, 0x80, 0x98, 0xac, 0xca, 0x13, 0xc9, 0x1a,
-	0xd9, 0x3c, 0x2e, 0xfd, 0x3b, 0x0d, 0xbb, 0x39, 0x39, 0xfd, 0x84, 0xc0, 0x84, 0xef, 0x78, 0xe8,
-	0x99, 0x01, 0x60, 0x69, 0xab, 0x55, 0x95, 0x45, 0xc3, 0xfd, 0xfe, 0xd9, 0xfc, 0xc7, 0xbf, 0xfd,
-	0xf5, 0xe9, 0xf8, 0x0c, 0x3d, 0xae, 0x14, 0x59, 0x41, 0xfa, 0x35, 0x01, 0x08, 0x4d, 0x13, 0xad,
-	0x15, 0xf5, 0x94, 0x32, 0x64, 0xd5, 0xa5, 0x32, 0x29, 0x08, 0xb8, 0xc4, 0x01, 0x4f, 0xd3, 0x05,
-	0xa5, 0xd0, 0x83, 0x2a, 0x3b, 0xdc, 0xe1, 0x75, 0xe9, 0x17, 0x04, 0xa6, 0x5e, 0x37, 0x5c, 0x71,
-	0xd4, 0x94, 0x59, 0x2b, 0x46, 0x4d, 0x9b, 0x2f, 0xb6, 0xc0, 0x51, 0x4f, 0x50, 0x56, 0x8c, 0x4a,
-	0x3f, 0x23, 0x30, 0xe1, 0x3b, 0x9e, 0xe2, 0x19, 0x8e, 0xf9, 0xa7, 0xe2, 0x19, 0x8e, 0x1b, 0x29,
-	0xb6, 0xc8, 0xa9, 0x4e, 0xd2, 0x19, 0x65, 0xe0, 0x37, 0x02, 0xca, 0x8e, 0xd1, 0xea, 0xd2, 0xbb,
-	0x04, 0x26, 0x7b, 0x23, 0x27, 0xc4, 0x15, 0xb3, 0x58, 0xc5, 0x5c, 0x71, 0xcb, 0xc4, 0x66, 0x39,
-	0xd7, 0x31, 0x2a, 0x0d, 0xe6, 0xa2, 0xdf, 0x13, 0xd8, 0x1f, 0xf7, 0x29, 0xf4, 0xac, 0xc0, 0x10,
-	0xa4, 0x8d, 0x46, 0xf5, 0x5c, 0xd9, 0x34, 0x24, 0x5d, 0xe6, 0xa4, 0x67, 0xe8, 0xa2, 0x22, 0xf4,
-	0x0d, 0x90, 0x3f, 0x92, 0xf7, 0x09, 0x1c, 0xe8, 0x8d, 0x64, 0x29, 0xee, 0x4c, 0x83, 0x54, 0xcc,
-	0x9d, 0x6d, 0x78, 0x98, 0xcc, 0xb9, 0xe7, 0xe8, 0xac, 0x18, 0x37, 0xbd, 0x47, 0x60, 0x2a, 0x62,
-	0x2c, 0xa8, 0xc8, 0x76, 0x4d, 0x58, 0x84, 0xea, 0x72, 0xa9, 0x1c, 0x04, 0x7d, 0x91, 0x83, 0x2e,
-	0xd0, 0x39, 0xa5, 0xf8, 0x5b, 0x33, 0x7f, 0x74, 0xbf, 0x24, 0xb0, 0xb7, 0x37, 0xba, 0xe2, 0xac,
-	0x69, 0x3b, 0x53, 0xcc, 0x9a, 0x61, 0x4f, 0x84, 0xb6, 0x53, 0xdf, 0x84, 0xfc, 0x4a, 0xe0, 0x60,
-	0xaa, 0xfe, 0xa7, 0x17, 0x0a, 0xfb, 0xcd, 0xb1, 0x1a, 0xd5, 0x8b, 0x43, 0x64, 0x22, 0xf7, 0x25,
-	0xce, 0x7d, 0x91, 0x9e, 0x17, 0x5b, 0x0c, 0xee, 0xfa, 0x46, 0x67, 0x9d, 0x1f, 0x0b, 0x7e, 0x51,
-	0xdb, 0xa5, 0xff, 0x10, 0x98, 0xce, 0xf3, 0x03, 0xf4, 0x52, 0x39, 0xb0, 0x94, 0x23, 0xa9, 0xbe,
-	0x32, 0x7c, 0x03, 0x28, 0xf0, 0x35, 0x2e, 0xf0, 0x0a, 0xad, 0x97, 0x10, 0x18, 0x5a, 0x1e, 0x65,
-	0x27, 0x7c, 0xee, 0xd2, 0x5f, 0x08, 0x1c, 0x48, 0xb8, 0x09, 0x5a, 0xb8, 0x0b, 0xb3, 0x1d, 0x4b,
-	0xf5, 0x7c, 0xe9, 0x3c, 0x14, 0xf4, 0x32, 0x17, 0x74, 0x96, 0x2e, 0x0b, 0xac, 0x34, 0xae, 0xa6,
-	0x67, 0x86, 0x94, 0x9d, 0xde, 0xdf, 0x2e, 0xfd, 0x89, 0xc0, 0xbe, 0x98, 0xe5, 0xa0, 0x2f, 0x89,
-	0x72, 0xc4, 0x56, 0xdc, 0xd9, 0x92, 0x59, 0x43, 0xb0, 0xa7, 0x56, 0xda, 0xb7, 0x04, 0xf6, 0xc5,
-	0x2c, 0x4b, 0x31, 0x7b, 0x96, 0xfd, 0x29, 0x66, 0xcf, 0xf4, 0x45, 0xac, 0xc6, 0xd9, 0x17, 0xe9,
-	0xfc, 0x00, 0x76, 0x8d, 0x67, 0xae, 0xa3, 0x2b, 0xea, 0x1d, 0x47, 0x10, 0x56, 0xd1, 0x42, 0xa5,
-	0x51, 0xdc, 0xb4, 0x08, 0x95, 0x46, 0x09, 0x13, 0xc2, 0x14, 0x0e, 0x3a, 0x4f, 0x4f, 0x29, 0x85,
-	0xbf, 0x0c, 0xf8, 0xa7, 0x66, 0x50, 0x17, 0x09, 0x73, 0xa6, 0xcc, 0x95, 0x50, 0x5d, 0x94, 0xe4,
-	0x14, 0xa9, 0x8b, 0x02, 0x53, 0xf4, 0xb3, 0x7f, 0xdb, 0x47, 0x4a, 0x6e, 0xa1, 0xdb, 0x3e, 0xed,
-	0x27, 0x84, 0x6e, 0xfb, 0x0c, 0x7f, 0xc0, 0x2e, 0x72, 0xda, 0x65, 0x5a, 0x53, 0xc4, 0x7e, 0x6f,
-	0x51, 0x76, 0xd0, 0x4f, 0x75, 0xe9, 0x77, 0x78, 0xe7, 0x97, 0xa2, 0xcf, 0x74, 0x43, 0x42, 0x77,
-	0x7e, 0x16, 0x7d, 0x89, 0x35, 0xc1, 0xe9, 0xeb, 0x17, 0x1e, 0x3c, 0x92, 0xc8, 0xc3, 0x47, 0x12,
-	0xf9, 0xf3, 0x91, 0x44, 0xee, 0x3e, 0x96, 0xc6, 0x1e, 0x3e, 0x96, 0xc6, 0x7e, 0x7f, 0x2c, 0x8d,
-	0xbd, 0x2b, 0x45, 0x5a, 0xf8, 0x28, 0xd6, 0x86, 0xd7, 0x69, 0x6b, 0xee, 0xc6, 0x04, 0xff, 0xc9,
-	0x67, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0xfe, 0xd0, 0x61, 0xed, 0x1b, 0x00, 0x00,
+func (m *QueryFeeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeStatsRequest.Merge(m, src)
+}
+func (m *QueryFeeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeStatsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFeeStatsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListProfile Queries a list of Profile items.
-	GetProfile(ctx context.Context, in *QueryGetProfileRequest, opts ...grpc.CallOption) (*QueryGetProfileResponse, error)
-	// ListProfile defines the ListProfile RPC.
-	ListProfile(ctx context.Context, in *QueryAllProfileRequest, opts ...grpc.CallOption) (*QueryAllProfileResponse, error)
-	// ListGig Queries a list of Gig items.
-	GetGig(ctx context.Context, in *QueryGetGigRequest, opts ...grpc.CallOption) (*QueryGetGigResponse, error)
-	// ListGig defines the ListGig RPC.
-	ListGig(ctx context.Context, in *QueryAllGigRequest, opts ...grpc.CallOption) (*QueryAllGigResponse, error)
-	// ListApplication Queries a list of Application items.
-	GetApplication(ctx context.Context, in *QueryGetApplicationRequest, opts ...grpc.CallOption) (*QueryGetApplicationResponse, error)
-	// ListApplication defines the ListApplication RPC.
-	ListApplication(ctx context.Context, in *QueryAllApplicationRequest, opts ...grpc.CallOption) (*QueryAllApplicationResponse, error)
-	// ListContract Queries a list of Contract items.
-	GetContract(ctx context.Context, in *QueryGetContractRequest, opts ...grpc.CallOption) (*QueryGetContractResponse, error)
-	// ListContract defines the ListContract RPC.
-	ListContract(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
-	// ApplicationsByGig Queries a list of ApplicationsByGig items.
-	ApplicationsByGig(ctx context.Context, in *QueryApplicationsByGigRequest, opts ...grpc.CallOption) (*QueryApplicationsByGigResponse, error)
-	// ApplicationsByFreelancer Queries a list of ApplicationsByFreelancer items.
-	ApplicationsByFreelancer(ctx context.Context, in *QueryApplicationsByFreelancerRequest, opts ...grpc.CallOption) (*QueryApplicationsByFreelancerResponse, error)
-	// ContractsByUser Queries a list of ContractsByUser items.
-	ContractsByUser(ctx context.Context, in *QueryContractsByUserRequest, opts ...grpc.CallOption) (*QueryContractsByUserResponse, error)
-	// ContractByGig Queries a list of ContractByGig items.
-	ContractByGig(ctx context.Context, in *QueryContractByGigRequest, opts ...grpc.CallOption) (*QueryContractByGigResponse, error)
-	// EscrowBalance Queries a list of EscrowBalance items.
-	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
-	// ListDispute Queries a list of Dispute items.
-	GetDispute(ctx context.Context, in *QueryGetDisputeRequest, opts ...grpc.CallOption) (*QueryGetDisputeResponse, error)
-	// ListDispute defines the ListDispute RPC.
-	ListDispute(ctx context.Context, in *QueryAllDisputeRequest, opts ...grpc.CallOption) (*QueryAllDisputeResponse, error)
-	// ListDisputeVote Queries a list of DisputeVote items.
-	GetDisputeVote(ctx context.Context, in *QueryGetDisputeVoteRequest, opts ...grpc.CallOption) (*QueryGetDisputeVoteResponse, error)
-	// ListDisputeVote defines the ListDisputeVote RPC.
-	ListDisputeVote(ctx context.Context, in *QueryAllDisputeVoteRequest, opts ...grpc.CallOption) (*QueryAllDisputeVoteResponse, error)
+// QueryFeeStatsResponse defines the QueryFeeStatsResponse message.
+type QueryFeeStatsResponse struct {
+	Stats FeeStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// Fees collected but not distributed yet.
+	Pending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeeStatsResponse) Reset()         { *m = QueryFeeStatsResponse{} }
+func (m *QueryFeeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeStatsResponse) ProtoMessage()    {}
+func (*QueryFeeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{41}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeStatsResponse.Merge(m, src)
+}
+func (m *QueryFeeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeStatsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProfile(ctx context.Context, in *QueryGetProfileRequest, opts ...grpc.CallOption) (*QueryGetProfileResponse, error) {
-	out := new(QueryGetProfileResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeStatsResponse) GetStats() FeeStats {
+	if m != nil {
+		return m.Stats
 	}
-	return out, nil
+	return FeeStats{}
 }
 
-func (c *queryClient) ListProfile(ctx context.Context, in *QueryAllProfileRequest, opts ...grpc.CallOption) (*QueryAllProfileResponse, error) {
-	out := new(QueryAllProfileResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeStatsResponse) GetPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pending
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetGig(ctx context.Context, in *QueryGetGigRequest, opts ...grpc.CallOption) (*QueryGetGigResponse, error) {
-	out := new(QueryGetGigResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetGig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCancellationProposalRequest defines the QueryCancellationProposalRequest message.
+type QueryCancellationProposalRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (c *queryClient) ListGig(ctx context.Context, in *QueryAllGigRequest, opts ...grpc.CallOption) (*QueryAllGigResponse, error) {
-	out := new(QueryAllGigResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListGig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCancellationProposalRequest) Reset()         { *m = QueryCancellationProposalRequest{} }
+func (m *QueryCancellationProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCancellationProposalRequest) ProtoMessage()    {}
+func (*QueryCancellationProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{42}
+}
+func (m *QueryCancellationProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancellationProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancellationProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCancellationProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancellationProposalRequest.Merge(m, src)
+}
+func (m *QueryCancellationProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancellationProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancellationProposalRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetApplication(ctx context.Context, in *QueryGetApplicationRequest, opts ...grpc.CallOption) (*QueryGetApplicationResponse, error) {
-	out := new(QueryGetApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCancellationProposalRequest proto.InternalMessageInfo
+
+func (m *QueryCancellationProposalRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ListApplication(ctx context.Context, in *QueryAllApplicationRequest, opts ...grpc.CallOption) (*QueryAllApplicationResponse, error) {
-	out := new(QueryAllApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCancellationProposalResponse defines the QueryCancellationProposalResponse message.
+type QueryCancellationProposalResponse struct {
+	Proposal CancellationProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
 
-func (c *queryClient) GetContract(ctx context.Context, in *QueryGetContractRequest, opts ...grpc.CallOption) (*QueryGetContractResponse, error) {
-	out := new(QueryGetContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCancellationProposalResponse) Reset()         { *m = QueryCancellationProposalResponse{} }
+func (m *QueryCancellationProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCancellationProposalResponse) ProtoMessage()    {}
+func (*QueryCancellationProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{43}
+}
+func (m *QueryCancellationProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancellationProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancellationProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCancellationProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancellationProposalResponse.Merge(m, src)
+}
+func (m *QueryCancellationProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancellationProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancellationProposalResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListContract(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
-	out := new(QueryAllContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCancellationProposalResponse proto.InternalMessageInfo
+
+func (m *QueryCancellationProposalResponse) GetProposal() CancellationProposal {
+	if m != nil {
+		return m.Proposal
 	}
-	return out, nil
+	return CancellationProposal{}
 }
 
-func (c *queryClient) ApplicationsByGig(ctx context.Context, in *QueryApplicationsByGigRequest, opts ...grpc.CallOption) (*QueryApplicationsByGigResponse, error) {
-	out := new(QueryApplicationsByGigResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ApplicationsByGig", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTipsByContractRequest defines the QueryTipsByContractRequest message.
+type QueryTipsByContractRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *QueryTipsByContractRequest) Reset()         { *m = QueryTipsByContractRequest{} }
+func (m *QueryTipsByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTipsByContractRequest) ProtoMessage()    {}
+func (*QueryTipsByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{44}
+}
+func (m *QueryTipsByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTipsByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTipsByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTipsByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTipsByContractRequest.Merge(m, src)
+}
+func (m *QueryTipsByContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTipsByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTipsByContractRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ApplicationsByFreelancer(ctx context.Context, in *QueryApplicationsByFreelancerRequest, opts ...grpc.CallOption) (*QueryApplicationsByFreelancerResponse, error) {
-	out := new(QueryApplicationsByFreelancerResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ApplicationsByFreelancer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTipsByContractRequest proto.InternalMessageInfo
+
+func (m *QueryTipsByContractRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ContractsByUser(ctx context.Context, in *QueryContractsByUserRequest, opts ...grpc.CallOption) (*QueryContractsByUserResponse, error) {
-	out := new(QueryContractsByUserResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ContractsByUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTipsByContractResponse defines the QueryTipsByContractResponse message.
+type QueryTipsByContractResponse struct {
+	Tips []Tip `protobuf:"bytes,1,rep,name=tips,proto3" json:"tips"`
+	// Tips received by the freelancer, net of fees.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryTipsByContractResponse) Reset()         { *m = QueryTipsByContractResponse{} }
+func (m *QueryTipsByContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTipsByContractResponse) ProtoMessage()    {}
+func (*QueryTipsByContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{45}
+}
+func (m *QueryTipsByContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTipsByContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTipsByContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTipsByContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTipsByContractResponse.Merge(m, src)
+}
+func (m *QueryTipsByContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTipsByContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTipsByContractResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractByGig(ctx context.Context, in *QueryContractByGigRequest, opts ...grpc.CallOption) (*QueryContractByGigResponse, error) {
-	out := new(QueryContractByGigResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ContractByGig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTipsByContractResponse proto.InternalMessageInfo
+
+func (m *QueryTipsByContractResponse) GetTips() []Tip {
+	if m != nil {
+		return m.Tips
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
-	out := new(QueryEscrowBalanceResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/EscrowBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTipsByContractResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetDispute(ctx context.Context, in *QueryGetDisputeRequest, opts ...grpc.CallOption) (*QueryGetDisputeResponse, error) {
-	out := new(QueryGetDisputeResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTimeLogsByContractRequest defines the QueryTimeLogsByContractRequest message.
+type QueryTimeLogsByContractRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *QueryTimeLogsByContractRequest) Reset()         { *m = QueryTimeLogsByContractRequest{} }
+func (m *QueryTimeLogsByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeLogsByContractRequest) ProtoMessage()    {}
+func (*QueryTimeLogsByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{46}
+}
+func (m *QueryTimeLogsByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeLogsByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeLogsByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTimeLogsByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeLogsByContractRequest.Merge(m, src)
+}
+func (m *QueryTimeLogsByContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeLogsByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeLogsByContractRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListDispute(ctx context.Context, in *QueryAllDisputeRequest, opts ...grpc.CallOption) (*QueryAllDisputeResponse, error) {
-	out := new(QueryAllDisputeResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTimeLogsByContractRequest proto.InternalMessageInfo
+
+func (m *QueryTimeLogsByContractRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetDisputeVote(ctx context.Context, in *QueryGetDisputeVoteRequest, opts ...grpc.CallOption) (*QueryGetDisputeVoteResponse, error) {
-	out := new(QueryGetDisputeVoteResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetDisputeVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTimeLogsByContractResponse defines the QueryTimeLogsByContractResponse message.
+type QueryTimeLogsByContractResponse struct {
+	TimeLogs []TimeLog `protobuf:"bytes,1,rep,name=time_logs,json=timeLogs,proto3" json:"time_logs"`
+}
+
+func (m *QueryTimeLogsByContractResponse) Reset()         { *m = QueryTimeLogsByContractResponse{} }
+func (m *QueryTimeLogsByContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeLogsByContractResponse) ProtoMessage()    {}
+func (*QueryTimeLogsByContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{47}
+}
+func (m *QueryTimeLogsByContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeLogsByContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeLogsByContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTimeLogsByContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeLogsByContractResponse.Merge(m, src)
+}
+func (m *QueryTimeLogsByContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeLogsByContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeLogsByContractResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListDisputeVote(ctx context.Context, in *QueryAllDisputeVoteRequest, opts ...grpc.CallOption) (*QueryAllDisputeVoteResponse, error) {
-	out := new(QueryAllDisputeVoteResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListDisputeVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTimeLogsByContractResponse proto.InternalMessageInfo
+
+func (m *QueryTimeLogsByContractResponse) GetTimeLogs() []TimeLog {
+	if m != nil {
+		return m.TimeLogs
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListProfile Queries a list of Profile items.
-	GetProfile(context.Context, *QueryGetProfileRequest) (*QueryGetProfileResponse, error)
-	// ListProfile defines the ListProfile RPC.
-	ListProfile(context.Context, *QueryAllProfileRequest) (*QueryAllProfileResponse, error)
-	// ListGig Queries a list of Gig items.
-	GetGig(context.Context, *QueryGetGigRequest) (*QueryGetGigResponse, error)
-	// ListGig defines the ListGig RPC.
-	ListGig(context.Context, *QueryAllGigRequest) (*QueryAllGigResponse, error)
-	// ListApplication Queries a list of Application items.
-	GetApplication(context.Context, *QueryGetApplicationRequest) (*QueryGetApplicationResponse, error)
-	// ListApplication defines the ListApplication RPC.
-	ListApplication(context.Context, *QueryAllApplicationRequest) (*QueryAllApplicationResponse, error)
-	// ListContract Queries a list of Contract items.
-	GetContract(context.Context, *QueryGetContractRequest) (*QueryGetContractResponse, error)
-	// ListContract defines the ListContract RPC.
-	ListContract(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
-	// ApplicationsByGig Queries a list of ApplicationsByGig items.
-	ApplicationsByGig(context.Context, *QueryApplicationsByGigRequest) (*QueryApplicationsByGigResponse, error)
-	// ApplicationsByFreelancer Queries a list of ApplicationsByFreelancer items.
-	ApplicationsByFreelancer(context.Context, *QueryApplicationsByFreelancerRequest) (*QueryApplicationsByFreelancerResponse, error)
-	// ContractsByUser Queries a list of ContractsByUser items.
-	ContractsByUser(context.Context, *QueryContractsByUserRequest) (*QueryContractsByUserResponse, error)
-	// ContractByGig Queries a list of ContractByGig items.
-	ContractByGig(context.Context, *QueryContractByGigRequest) (*QueryContractByGigResponse, error)
-	// EscrowBalance Queries a list of EscrowBalance items.
-	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
-	// ListDispute Queries a list of Dispute items.
-	GetDispute(context.Context, *QueryGetDisputeRequest) (*QueryGetDisputeResponse, error)
-	// ListDispute defines the ListDispute RPC.
-	ListDispute(context.Context, *QueryAllDisputeRequest) (*QueryAllDisputeResponse, error)
-	// ListDisputeVote Queries a list of DisputeVote items.
-	GetDisputeVote(context.Context, *QueryGetDisputeVoteRequest) (*QueryGetDisputeVoteResponse, error)
-	// ListDisputeVote defines the ListDisputeVote RPC.
-	ListDisputeVote(context.Context, *QueryAllDisputeVoteRequest) (*QueryAllDisputeVoteResponse, error)
+// QueryStreamAccrualRequest defines the QueryStreamAccrualRequest message.
+type QueryStreamAccrualRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryStreamAccrualRequest) Reset()         { *m = QueryStreamAccrualRequest{} }
+func (m *QueryStreamAccrualRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamAccrualRequest) ProtoMessage()    {}
+func (*QueryStreamAccrualRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{48}
+}
+func (m *QueryStreamAccrualRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamAccrualRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamAccrualRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamAccrualRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamAccrualRequest.Merge(m, src)
+}
+func (m *QueryStreamAccrualRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamAccrualRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamAccrualRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryStreamAccrualRequest proto.InternalMessageInfo
+
+func (m *QueryStreamAccrualRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetProfile(ctx context.Context, req *QueryGetProfileRequest) (*QueryGetProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+
+// QueryStreamAccrualResponse defines the QueryStreamAccrualResponse message.
+type QueryStreamAccrualResponse struct {
+	// accrued is the part of the price streamed so far, claimed or not
+	Accrued   types.Coin `protobuf:"bytes,1,opt,name=accrued,proto3" json:"accrued"`
+	Claimed   types.Coin `protobuf:"bytes,2,opt,name=claimed,proto3" json:"claimed"`
+	Claimable types.Coin `protobuf:"bytes,3,opt,name=claimable,proto3" json:"claimable"`
 }
-func (*UnimplementedQueryServer) ListProfile(ctx context.Context, req *QueryAllProfileRequest) (*QueryAllProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProfile not implemented")
+
+func (m *QueryStreamAccrualResponse) Reset()         { *m = QueryStreamAccrualResponse{} }
+func (m *QueryStreamAccrualResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamAccrualResponse) ProtoMessage()    {}
+func (*QueryStreamAccrualResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{49}
 }
-func (*UnimplementedQueryServer) GetGig(ctx context.Context, req *QueryGetGigRequest) (*QueryGetGigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGig not implemented")
+func (m *QueryStreamAccrualResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListGig(ctx context.Context, req *QueryAllGigRequest) (*QueryAllGigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGig not implemented")
+func (m *QueryStreamAccrualResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamAccrualResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetApplication(ctx context.Context, req *QueryGetApplicationRequest) (*QueryGetApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+func (m *QueryStreamAccrualResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamAccrualResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListApplication(ctx context.Context, req *QueryAllApplicationRequest) (*QueryAllApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListApplication not implemented")
+func (m *QueryStreamAccrualResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetContract(ctx context.Context, req *QueryGetContractRequest) (*QueryGetContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContract not implemented")
+func (m *QueryStreamAccrualResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamAccrualResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ListContract(ctx context.Context, req *QueryAllContractRequest) (*QueryAllContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListContract not implemented")
+
+var xxx_messageInfo_QueryStreamAccrualResponse proto.InternalMessageInfo
+
+func (m *QueryStreamAccrualResponse) GetAccrued() types.Coin {
+	if m != nil {
+		return m.Accrued
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) ApplicationsByGig(ctx context.Context, req *QueryApplicationsByGigRequest) (*QueryApplicationsByGigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplicationsByGig not implemented")
+
+func (m *QueryStreamAccrualResponse) GetClaimed() types.Coin {
+	if m != nil {
+		return m.Claimed
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) ApplicationsByFreelancer(ctx context.Context, req *QueryApplicationsByFreelancerRequest) (*QueryApplicationsByFreelancerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplicationsByFreelancer not implemented")
+
+func (m *QueryStreamAccrualResponse) GetClaimable() types.Coin {
+	if m != nil {
+		return m.Claimable
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) ContractsByUser(ctx context.Context, req *QueryContractsByUserRequest) (*QueryContractsByUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractsByUser not implemented")
+
+// QueryAmendmentRequest defines the QueryAmendmentRequest message.
+type QueryAmendmentRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
-func (*UnimplementedQueryServer) ContractByGig(ctx context.Context, req *QueryContractByGigRequest) (*QueryContractByGigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractByGig not implemented")
+
+func (m *QueryAmendmentRequest) Reset()         { *m = QueryAmendmentRequest{} }
+func (m *QueryAmendmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAmendmentRequest) ProtoMessage()    {}
+func (*QueryAmendmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{50}
 }
-func (*UnimplementedQueryServer) EscrowBalance(ctx context.Context, req *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+func (m *QueryAmendmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetDispute(ctx context.Context, req *QueryGetDisputeRequest) (*QueryGetDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+func (m *QueryAmendmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmendmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListDispute(ctx context.Context, req *QueryAllDisputeRequest) (*QueryAllDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDispute not implemented")
+func (m *QueryAmendmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmendmentRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetDisputeVote(ctx context.Context, req *QueryGetDisputeVoteRequest) (*QueryGetDisputeVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDisputeVote not implemented")
+func (m *QueryAmendmentRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListDisputeVote(ctx context.Context, req *QueryAllDisputeVoteRequest) (*QueryAllDisputeVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDisputeVote not implemented")
+func (m *QueryAmendmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmendmentRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAmendmentRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAmendmentRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProfileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/GetProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProfile(ctx, req.(*QueryGetProfileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAmendmentResponse defines the QueryAmendmentResponse message.
+type QueryAmendmentResponse struct {
+	Amendment Amendment `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment"`
 }
 
-func _Query_ListProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllProfileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ListProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListProfile(ctx, req.(*QueryAllProfileRequest))
+func (m *QueryAmendmentResponse) Reset()         { *m = QueryAmendmentResponse{} }
+func (m *QueryAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAmendmentResponse) ProtoMessage()    {}
+func (*QueryAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{51}
+}
+func (m *QueryAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmendmentResponse.Merge(m, src)
+}
+func (m *QueryAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmendmentResponse.DiscardUnknown(m)
 }
 
-func _Query_GetGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetGig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/GetGig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetGig(ctx, req.(*QueryGetGigRequest))
+var xxx_messageInfo_QueryAmendmentResponse proto.InternalMessageInfo
+
+func (m *QueryAmendmentResponse) GetAmendment() Amendment {
+	if m != nil {
+		return m.Amendment
 	}
-	return interceptor(ctx, in, info, handler)
+	return Amendment{}
 }
 
-func _Query_ListGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListGig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ListGig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListGig(ctx, req.(*QueryAllGigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryExtensionsByContractRequest defines the QueryExtensionsByContractRequest message.
+type QueryExtensionsByContractRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func _Query_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetApplicationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/GetApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetApplication(ctx, req.(*QueryGetApplicationRequest))
+func (m *QueryExtensionsByContractRequest) Reset()         { *m = QueryExtensionsByContractRequest{} }
+func (m *QueryExtensionsByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExtensionsByContractRequest) ProtoMessage()    {}
+func (*QueryExtensionsByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{52}
+}
+func (m *QueryExtensionsByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExtensionsByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExtensionsByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryExtensionsByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExtensionsByContractRequest.Merge(m, src)
+}
+func (m *QueryExtensionsByContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExtensionsByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExtensionsByContractRequest.DiscardUnknown(m)
 }
 
-func _Query_ListApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllApplicationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListApplication(ctx, in)
+var xxx_messageInfo_QueryExtensionsByContractRequest proto.InternalMessageInfo
+
+func (m *QueryExtensionsByContractRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ListApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListApplication(ctx, req.(*QueryAllApplicationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/GetContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetContract(ctx, req.(*QueryGetContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryExtensionsByContractResponse defines the QueryExtensionsByContractResponse message.
+type QueryExtensionsByContractResponse struct {
+	Extensions []DeadlineExtension `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions"`
 }
 
-func _Query_ListContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ListContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListContract(ctx, req.(*QueryAllContractRequest))
+func (m *QueryExtensionsByContractResponse) Reset()         { *m = QueryExtensionsByContractResponse{} }
+func (m *QueryExtensionsByContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExtensionsByContractResponse) ProtoMessage()    {}
+func (*QueryExtensionsByContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{53}
+}
+func (m *QueryExtensionsByContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExtensionsByContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExtensionsByContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryExtensionsByContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExtensionsByContractResponse.Merge(m, src)
+}
+func (m *QueryExtensionsByContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExtensionsByContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExtensionsByContractResponse.DiscardUnknown(m)
 }
 
-func _Query_ApplicationsByGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryApplicationsByGigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ApplicationsByGig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ApplicationsByGig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ApplicationsByGig(ctx, req.(*QueryApplicationsByGigRequest))
+var xxx_messageInfo_QueryExtensionsByContractResponse proto.InternalMessageInfo
+
+func (m *QueryExtensionsByContractResponse) GetExtensions() []DeadlineExtension {
+	if m != nil {
+		return m.Extensions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ApplicationsByFreelancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryApplicationsByFreelancerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ApplicationsByFreelancer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ApplicationsByFreelancer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ApplicationsByFreelancer(ctx, req.(*QueryApplicationsByFreelancerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFeePreviewRequest defines the QueryFeePreviewRequest message.
+type QueryFeePreviewRequest struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Price   types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// Category of the gig, whose fee replaces platform_fee_percent.
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
 }
 
-func _Query_ContractsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractsByUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractsByUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ContractsByUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractsByUser(ctx, req.(*QueryContractsByUserRequest))
+func (m *QueryFeePreviewRequest) Reset()         { *m = QueryFeePreviewRequest{} }
+func (m *QueryFeePreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePreviewRequest) ProtoMessage()    {}
+func (*QueryFeePreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{54}
+}
+func (m *QueryFeePreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeePreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePreviewRequest.Merge(m, src)
+}
+func (m *QueryFeePreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePreviewRequest.DiscardUnknown(m)
 }
 
-func _Query_ContractByGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractByGigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractByGig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ContractByGig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractByGig(ctx, req.(*QueryContractByGigRequest))
+var xxx_messageInfo_QueryFeePreviewRequest proto.InternalMessageInfo
+
+func (m *QueryFeePreviewRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EscrowBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/EscrowBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
+func (m *QueryFeePreviewRequest) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDisputeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDispute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/GetDispute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDispute(ctx, req.(*QueryGetDisputeRequest))
+func (m *QueryFeePreviewRequest) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDisputeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListDispute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ListDispute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListDispute(ctx, req.(*QueryAllDisputeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFeePreviewResponse defines the QueryFeePreviewResponse message.
+type QueryFeePreviewResponse struct {
+	// Name of the fee tier applied, "base" for platform_fee_percent.
+	Tier   string     `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	FeeBps uint64     `protobuf:"varint,2,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	Fee    types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	Net    types.Coin `protobuf:"bytes,4,opt,name=net,proto3" json:"net"`
 }
 
-func _Query_GetDisputeVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDisputeVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDisputeVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/GetDisputeVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDisputeVote(ctx, req.(*QueryGetDisputeVoteRequest))
+func (m *QueryFeePreviewResponse) Reset()         { *m = QueryFeePreviewResponse{} }
+func (m *QueryFeePreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePreviewResponse) ProtoMessage()    {}
+func (*QueryFeePreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{55}
+}
+func (m *QueryFeePreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeePreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePreviewResponse.Merge(m, src)
+}
+func (m *QueryFeePreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePreviewResponse.DiscardUnknown(m)
 }
 
-func _Query_ListDisputeVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDisputeVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePreviewResponse proto.InternalMessageInfo
+
+func (m *QueryFeePreviewResponse) GetTier() string {
+	if m != nil {
+		return m.Tier
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListDisputeVote(ctx, in)
+	return ""
+}
+
+func (m *QueryFeePreviewResponse) GetFeeBps() uint64 {
+	if m != nil {
+		return m.FeeBps
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Query/ListDisputeVote",
+	return 0
+}
+
+func (m *QueryFeePreviewResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListDisputeVote(ctx, req.(*QueryAllDisputeVoteRequest))
+	return types.Coin{}
+}
+
+func (m *QueryFeePreviewResponse) GetNet() types.Coin {
+	if m != nil {
+		return m.Net
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "skillchain.marketplace.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetProfile",
-			Handler:    _Query_GetProfile_Handler,
-		},
-		{
-			MethodName: "ListProfile",
-			Handler:    _Query_ListProfile_Handler,
-		},
-		{
-			MethodName: "GetGig",
-			Handler:    _Query_GetGig_Handler,
-		},
-		{
-			MethodName: "ListGig",
-			Handler:    _Query_ListGig_Handler,
-		},
-		{
-			MethodName: "GetApplication",
-			Handler:    _Query_GetApplication_Handler,
-		},
-		{
-			MethodName: "ListApplication",
-			Handler:    _Query_ListApplication_Handler,
-		},
-		{
-			MethodName: "GetContract",
-			Handler:    _Query_GetContract_Handler,
-		},
-		{
-			MethodName: "ListContract",
-			Handler:    _Query_ListContract_Handler,
-		},
-		{
-			MethodName: "ApplicationsByGig",
-			Handler:    _Query_ApplicationsByGig_Handler,
-		},
-		{
-			MethodName: "ApplicationsByFreelancer",
-			Handler:    _Query_ApplicationsByFreelancer_Handler,
-		},
-		{
-			MethodName: "ContractsByUser",
-			Handler:    _Query_ContractsByUser_Handler,
-		},
-		{
-			MethodName: "ContractByGig",
-			Handler:    _Query_ContractByGig_Handler,
-		},
-		{
-			MethodName: "EscrowBalance",
-			Handler:    _Query_EscrowBalance_Handler,
-		},
-		{
-			MethodName: "GetDispute",
-			Handler:    _Query_GetDispute_Handler,
-		},
-		{
-			MethodName: "ListDispute",
-			Handler:    _Query_ListDispute_Handler,
-		},
-		{
-			MethodName: "GetDisputeVote",
-			Handler:    _Query_GetDisputeVote_Handler,
-		},
-		{
-			MethodName: "ListDisputeVote",
-			Handler:    _Query_ListDisputeVote_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "skillchain/marketplace/v1/query.proto",
+// QueryReferralEarningsRequest defines the QueryReferralEarningsRequest message.
+type QueryReferralEarningsRequest struct {
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReferralEarningsRequest) Reset()         { *m = QueryReferralEarningsRequest{} }
+func (m *QueryReferralEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralEarningsRequest) ProtoMessage()    {}
+func (*QueryReferralEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{56}
+}
+func (m *QueryReferralEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReferralEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralEarningsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryReferralEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralEarningsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferralEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryReferralEarningsRequest) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReferralEarningsResponse defines the QueryReferralEarningsResponse message.
+type QueryReferralEarningsResponse struct {
+	Earned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=earned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned"`
+	ReferredCount uint64                                   `protobuf:"varint,2,opt,name=referred_count,json=referredCount,proto3" json:"referred_count,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryReferralEarningsResponse) Reset()         { *m = QueryReferralEarningsResponse{} }
+func (m *QueryReferralEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralEarningsResponse) ProtoMessage()    {}
+func (*QueryReferralEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{57}
+}
+func (m *QueryReferralEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProfileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryReferralEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralEarningsResponse.Merge(m, src)
 }
-
-func (m *QueryGetProfileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryReferralEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralEarningsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryReferralEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryReferralEarningsResponse) GetEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earned
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetProfileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryReferralEarningsResponse) GetReferredCount() uint64 {
+	if m != nil {
+		return m.ReferredCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetProfileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReferredAccountsRequest defines the QueryReferredAccountsRequest message.
+type QueryReferredAccountsRequest struct {
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
-func (m *QueryGetProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryReferredAccountsRequest) Reset()         { *m = QueryReferredAccountsRequest{} }
+func (m *QueryReferredAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferredAccountsRequest) ProtoMessage()    {}
+func (*QueryReferredAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{58}
+}
+func (m *QueryReferredAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferredAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferredAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryReferredAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferredAccountsRequest.Merge(m, src)
+}
+func (m *QueryReferredAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferredAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferredAccountsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllProfileRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferredAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryReferredAccountsRequest) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllProfileRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReferredAccountsResponse defines the QueryReferredAccountsResponse message.
+type QueryReferredAccountsResponse struct {
+	Referrals []Referral `protobuf:"bytes,1,rep,name=referrals,proto3" json:"referrals"`
 }
 
-func (m *QueryAllProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryReferredAccountsResponse) Reset()         { *m = QueryReferredAccountsResponse{} }
+func (m *QueryReferredAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferredAccountsResponse) ProtoMessage()    {}
+func (*QueryReferredAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{59}
+}
+func (m *QueryReferredAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferredAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferredAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryReferredAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferredAccountsResponse.Merge(m, src)
+}
+func (m *QueryReferredAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferredAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferredAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllProfileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferredAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryReferredAccountsResponse) GetReferrals() []Referral {
+	if m != nil {
+		return m.Referrals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllProfileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEscrowStakingRequest defines the QueryEscrowStakingRequest message.
+type QueryEscrowStakingRequest struct {
 }
 
-func (m *QueryAllProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Profile) > 0 {
-		for iNdEx := len(m.Profile) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Profile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEscrowStakingRequest) Reset()         { *m = QueryEscrowStakingRequest{} }
+func (m *QueryEscrowStakingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowStakingRequest) ProtoMessage()    {}
+func (*QueryEscrowStakingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{60}
+}
+func (m *QueryEscrowStakingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowStakingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowStakingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEscrowStakingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowStakingRequest.Merge(m, src)
+}
+func (m *QueryEscrowStakingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowStakingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowStakingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetGigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowStakingRequest proto.InternalMessageInfo
+
+// QueryEscrowStakingResponse defines the QueryEscrowStakingResponse message.
+type QueryEscrowStakingResponse struct {
+	Pool EscrowStakingPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+	// Escrow of the contracts opted in to staking.
+	OptedIn types.Coin `protobuf:"bytes,2,opt,name=opted_in,json=optedIn,proto3" json:"opted_in"`
+	// Escrow held liquid by the escrow account.
+	Liquid types.Coin `protobuf:"bytes,3,opt,name=liquid,proto3" json:"liquid"`
+}
+
+func (m *QueryEscrowStakingResponse) Reset()         { *m = QueryEscrowStakingResponse{} }
+func (m *QueryEscrowStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowStakingResponse) ProtoMessage()    {}
+func (*QueryEscrowStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{61}
+}
+func (m *QueryEscrowStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEscrowStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowStakingResponse.Merge(m, src)
+}
+func (m *QueryEscrowStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowStakingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetGigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEscrowStakingResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowStakingResponse) GetPool() EscrowStakingPool {
+	if m != nil {
+		return m.Pool
+	}
+	return EscrowStakingPool{}
 }
 
-func (m *QueryGetGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryEscrowStakingResponse) GetOptedIn() types.Coin {
+	if m != nil {
+		return m.OptedIn
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryGetGigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEscrowStakingResponse) GetLiquid() types.Coin {
+	if m != nil {
+		return m.Liquid
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryGetGigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCategoryRuleRequest defines the QueryCategoryRuleRequest message.
+type QueryCategoryRuleRequest struct {
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 }
 
-func (m *QueryGetGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Gig.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCategoryRuleRequest) Reset()         { *m = QueryCategoryRuleRequest{} }
+func (m *QueryCategoryRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCategoryRuleRequest) ProtoMessage()    {}
+func (*QueryCategoryRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{62}
+}
+func (m *QueryCategoryRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCategoryRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCategoryRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCategoryRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCategoryRuleRequest.Merge(m, src)
+}
+func (m *QueryCategoryRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCategoryRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCategoryRuleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllGigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCategoryRuleRequest proto.InternalMessageInfo
+
+func (m *QueryCategoryRuleRequest) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllGigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCategoryRuleResponse defines the QueryCategoryRuleResponse message.
+type QueryCategoryRuleResponse struct {
+	// Rules applied to the category, the global params filling the fields it
+	// does not override.
+	Rule CategoryRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule"`
+	// Whether governance set rules for the category.
+	Overridden bool `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty"`
 }
 
-func (m *QueryAllGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCategoryRuleResponse) Reset()         { *m = QueryCategoryRuleResponse{} }
+func (m *QueryCategoryRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCategoryRuleResponse) ProtoMessage()    {}
+func (*QueryCategoryRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{63}
+}
+func (m *QueryCategoryRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCategoryRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCategoryRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllGigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCategoryRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCategoryRuleResponse.Merge(m, src)
 }
-
-func (m *QueryAllGigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCategoryRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCategoryRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCategoryRuleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Gig) > 0 {
-		for iNdEx := len(m.Gig) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Gig[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryCategoryRuleResponse proto.InternalMessageInfo
+
+func (m *QueryCategoryRuleResponse) GetRule() CategoryRule {
+	if m != nil {
+		return m.Rule
 	}
-	return len(dAtA) - i, nil
+	return CategoryRule{}
 }
 
-func (m *QueryGetApplicationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCategoryRuleResponse) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryArbiterRequest defines the QueryArbiterRequest message.
+type QueryArbiterRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryGetApplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryArbiterRequest) Reset()         { *m = QueryArbiterRequest{} }
+func (m *QueryArbiterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArbiterRequest) ProtoMessage()    {}
+func (*QueryArbiterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{64}
+}
+func (m *QueryArbiterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArbiterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArbiterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryArbiterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArbiterRequest.Merge(m, src)
+}
+func (m *QueryArbiterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArbiterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArbiterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryArbiterRequest proto.InternalMessageInfo
+
+func (m *QueryArbiterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryArbiterResponse defines the QueryArbiterResponse message.
+type QueryArbiterResponse struct {
+	Arbiter Arbiter `protobuf:"bytes,1,opt,name=arbiter,proto3" json:"arbiter"`
+	// Share of the resolved disputes assigned to the arbiter it voted on with
+	// the majority, in basis points.
+	AccuracyBps uint64 `protobuf:"varint,2,opt,name=accuracy_bps,json=accuracyBps,proto3" json:"accuracy_bps,omitempty"`
 }
 
-func (m *QueryGetApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryArbiterResponse) Reset()         { *m = QueryArbiterResponse{} }
+func (m *QueryArbiterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArbiterResponse) ProtoMessage()    {}
+func (*QueryArbiterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{65}
+}
+func (m *QueryArbiterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArbiterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArbiterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllApplicationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryArbiterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArbiterResponse.Merge(m, src)
 }
-
-func (m *QueryAllApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryArbiterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArbiterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArbiterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllApplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryArbiterResponse proto.InternalMessageInfo
+
+func (m *QueryArbiterResponse) GetArbiter() Arbiter {
+	if m != nil {
+		return m.Arbiter
 	}
-	return len(dAtA) - i, nil
+	return Arbiter{}
 }
 
-func (m *QueryAllApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryArbiterResponse) GetAccuracyBps() uint64 {
+	if m != nil {
+		return m.AccuracyBps
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryActiveArbitersRequest defines the QueryActiveArbitersRequest message.
+type QueryActiveArbitersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Application) > 0 {
-		for iNdEx := len(m.Application) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Application[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryActiveArbitersRequest) Reset()         { *m = QueryActiveArbitersRequest{} }
+func (m *QueryActiveArbitersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveArbitersRequest) ProtoMessage()    {}
+func (*QueryActiveArbitersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{66}
+}
+func (m *QueryActiveArbitersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveArbitersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveArbitersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryActiveArbitersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveArbitersRequest.Merge(m, src)
 }
-
-func (m *QueryGetContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryActiveArbitersRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryActiveArbitersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveArbitersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryActiveArbitersRequest proto.InternalMessageInfo
+
+func (m *QueryActiveArbitersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryActiveArbitersResponse defines the QueryActiveArbitersResponse message.
+type QueryActiveArbitersResponse struct {
+	Arbiters   []Arbiter           `protobuf:"bytes,1,rep,name=arbiters,proto3" json:"arbiters"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Stake of all the active arbiters.
+	TotalStake types.Coin `protobuf:"bytes,3,opt,name=total_stake,json=totalStake,proto3" json:"total_stake"`
 }
 
-func (m *QueryGetContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryActiveArbitersResponse) Reset()         { *m = QueryActiveArbitersResponse{} }
+func (m *QueryActiveArbitersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveArbitersResponse) ProtoMessage()    {}
+func (*QueryActiveArbitersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c914ebc0cae4876, []int{67}
+}
+func (m *QueryActiveArbitersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveArbitersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveArbitersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryActiveArbitersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveArbitersResponse.Merge(m, src)
 }
-
-func (m *QueryAllContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryActiveArbitersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveArbitersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveArbitersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryActiveArbitersResponse proto.InternalMessageInfo
+
+func (m *QueryActiveArbitersResponse) GetArbiters() []Arbiter {
+	if m != nil {
+		return m.Arbiters
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveArbitersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryActiveArbitersResponse) GetTotalStake() types.Coin {
+	if m != nil {
+		return m.TotalStake
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAllContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contract) > 0 {
-		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "skillchain.marketplace.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "skillchain.marketplace.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetProfileRequest)(nil), "skillchain.marketplace.v1.QueryGetProfileRequest")
+	proto.RegisterType((*QueryGetProfileResponse)(nil), "skillchain.marketplace.v1.QueryGetProfileResponse")
+	proto.RegisterType((*QueryAllProfileRequest)(nil), "skillchain.marketplace.v1.QueryAllProfileRequest")
+	proto.RegisterType((*QueryAllProfileResponse)(nil), "skillchain.marketplace.v1.QueryAllProfileResponse")
+	proto.RegisterType((*QueryGetGigRequest)(nil), "skillchain.marketplace.v1.QueryGetGigRequest")
+	proto.RegisterType((*QueryGetGigResponse)(nil), "skillchain.marketplace.v1.QueryGetGigResponse")
+	proto.RegisterType((*QueryAllGigRequest)(nil), "skillchain.marketplace.v1.QueryAllGigRequest")
+	proto.RegisterType((*QueryAllGigResponse)(nil), "skillchain.marketplace.v1.QueryAllGigResponse")
+	proto.RegisterType((*QueryGetApplicationRequest)(nil), "skillchain.marketplace.v1.QueryGetApplicationRequest")
+	proto.RegisterType((*QueryGetApplicationResponse)(nil), "skillchain.marketplace.v1.QueryGetApplicationResponse")
+	proto.RegisterType((*QueryAllApplicationRequest)(nil), "skillchain.marketplace.v1.QueryAllApplicationRequest")
+	proto.RegisterType((*QueryAllApplicationResponse)(nil), "skillchain.marketplace.v1.QueryAllApplicationResponse")
+	proto.RegisterType((*QueryGetContractRequest)(nil), "skillchain.marketplace.v1.QueryGetContractRequest")
+	proto.RegisterType((*QueryGetContractResponse)(nil), "skillchain.marketplace.v1.QueryGetContractResponse")
+	proto.RegisterType((*QueryAllContractRequest)(nil), "skillchain.marketplace.v1.QueryAllContractRequest")
+	proto.RegisterType((*QueryAllContractResponse)(nil), "skillchain.marketplace.v1.QueryAllContractResponse")
+	proto.RegisterType((*QueryApplicationsByGigRequest)(nil), "skillchain.marketplace.v1.QueryApplicationsByGigRequest")
+	proto.RegisterType((*QueryApplicationsByGigResponse)(nil), "skillchain.marketplace.v1.QueryApplicationsByGigResponse")
+	proto.RegisterType((*QueryApplicationsByFreelancerRequest)(nil), "skillchain.marketplace.v1.QueryApplicationsByFreelancerRequest")
+	proto.RegisterType((*QueryApplicationsByFreelancerResponse)(nil), "skillchain.marketplace.v1.QueryApplicationsByFreelancerResponse")
+	proto.RegisterType((*QueryContractsByUserRequest)(nil), "skillchain.marketplace.v1.QueryContractsByUserRequest")
+	proto.RegisterType((*QueryContractsByUserResponse)(nil), "skillchain.marketplace.v1.QueryContractsByUserResponse")
+	proto.RegisterType((*QueryContractByGigRequest)(nil), "skillchain.marketplace.v1.QueryContractByGigRequest")
+	proto.RegisterType((*QueryContractByGigResponse)(nil), "skillchain.marketplace.v1.QueryContractByGigResponse")
+	proto.RegisterType((*QueryEscrowBalanceRequest)(nil), "skillchain.marketplace.v1.QueryEscrowBalanceRequest")
+	proto.RegisterType((*QueryEscrowBalanceResponse)(nil), "skillchain.marketplace.v1.QueryEscrowBalanceResponse")
+	proto.RegisterType((*QueryContractEscrowRequest)(nil), "skillchain.marketplace.v1.QueryContractEscrowRequest")
+	proto.RegisterType((*QueryContractEscrowResponse)(nil), "skillchain.marketplace.v1.QueryContractEscrowResponse")
+	proto.RegisterType((*QueryEscrowsByUserRequest)(nil), "skillchain.marketplace.v1.QueryEscrowsByUserRequest")
+	proto.RegisterType((*QueryEscrowsByUserResponse)(nil), "skillchain.marketplace.v1.QueryEscrowsByUserResponse")
+	proto.RegisterType((*QueryGetDisputeRequest)(nil), "skillchain.marketplace.v1.QueryGetDisputeRequest")
+	proto.RegisterType((*QueryGetDisputeResponse)(nil), "skillchain.marketplace.v1.QueryGetDisputeResponse")
+	proto.RegisterType((*QueryAllDisputeRequest)(nil), "skillchain.marketplace.v1.QueryAllDisputeRequest")
+	proto.RegisterType((*QueryAllDisputeResponse)(nil), "skillchain.marketplace.v1.QueryAllDisputeResponse")
+	proto.RegisterType((*QueryGetDisputeVoteRequest)(nil), "skillchain.marketplace.v1.QueryGetDisputeVoteRequest")
+	proto.RegisterType((*QueryGetDisputeVoteResponse)(nil), "skillchain.marketplace.v1.QueryGetDisputeVoteResponse")
+	proto.RegisterType((*QueryAllDisputeVoteRequest)(nil), "skillchain.marketplace.v1.QueryAllDisputeVoteRequest")
+	proto.RegisterType((*QueryAllDisputeVoteResponse)(nil), "skillchain.marketplace.v1.QueryAllDisputeVoteResponse")
+	proto.RegisterType((*QueryFeeStatsRequest)(nil), "skillchain.marketplace.v1.QueryFeeStatsRequest")
+	proto.RegisterType((*QueryFeeStatsResponse)(nil), "skillchain.marketplace.v1.QueryFeeStatsResponse")
+	proto.RegisterType((*QueryCancellationProposalRequest)(nil), "skillchain.marketplace.v1.QueryCancellationProposalRequest")
+	proto.RegisterType((*QueryCancellationProposalResponse)(nil), "skillchain.marketplace.v1.QueryCancellationProposalResponse")
+	proto.RegisterType((*QueryTipsByContractRequest)(nil), "skillchain.marketplace.v1.QueryTipsByContractRequest")
+	proto.RegisterType((*QueryTipsByContractResponse)(nil), "skillchain.marketplace.v1.QueryTipsByContractResponse")
+	proto.RegisterType((*QueryTimeLogsByContractRequest)(nil), "skillchain.marketplace.v1.QueryTimeLogsByContractRequest")
+	proto.RegisterType((*QueryTimeLogsByContractResponse)(nil), "skillchain.marketplace.v1.QueryTimeLogsByContractResponse")
+	proto.RegisterType((*QueryStreamAccrualRequest)(nil), "skillchain.marketplace.v1.QueryStreamAccrualRequest")
+	proto.RegisterType((*QueryStreamAccrualResponse)(nil), "skillchain.marketplace.v1.QueryStreamAccrualResponse")
+	proto.RegisterType((*QueryAmendmentRequest)(nil), "skillchain.marketplace.v1.QueryAmendmentRequest")
+	proto.RegisterType((*QueryAmendmentResponse)(nil), "skillchain.marketplace.v1.QueryAmendmentResponse")
+	proto.RegisterType((*QueryExtensionsByContractRequest)(nil), "skillchain.marketplace.v1.QueryExtensionsByContractRequest")
+	proto.RegisterType((*QueryExtensionsByContractResponse)(nil), "skillchain.marketplace.v1.QueryExtensionsByContractResponse")
+	proto.RegisterType((*QueryFeePreviewRequest)(nil), "skillchain.marketplace.v1.QueryFeePreviewRequest")
+	proto.RegisterType((*QueryFeePreviewResponse)(nil), "skillchain.marketplace.v1.QueryFeePreviewResponse")
+	proto.RegisterType((*QueryReferralEarningsRequest)(nil), "skillchain.marketplace.v1.QueryReferralEarningsRequest")
+	proto.RegisterType((*QueryReferralEarningsResponse)(nil), "skillchain.marketplace.v1.QueryReferralEarningsResponse")
+	proto.RegisterType((*QueryReferredAccountsRequest)(nil), "skillchain.marketplace.v1.QueryReferredAccountsRequest")
+	proto.RegisterType((*QueryReferredAccountsResponse)(nil), "skillchain.marketplace.v1.QueryReferredAccountsResponse")
+	proto.RegisterType((*QueryEscrowStakingRequest)(nil), "skillchain.marketplace.v1.QueryEscrowStakingRequest")
+	proto.RegisterType((*QueryEscrowStakingResponse)(nil), "skillchain.marketplace.v1.QueryEscrowStakingResponse")
+	proto.RegisterType((*QueryCategoryRuleRequest)(nil), "skillchain.marketplace.v1.QueryCategoryRuleRequest")
+	proto.RegisterType((*QueryCategoryRuleResponse)(nil), "skillchain.marketplace.v1.QueryCategoryRuleResponse")
+	proto.RegisterType((*QueryArbiterRequest)(nil), "skillchain.marketplace.v1.QueryArbiterRequest")
+	proto.RegisterType((*QueryArbiterResponse)(nil), "skillchain.marketplace.v1.QueryArbiterResponse")
+	proto.RegisterType((*QueryActiveArbitersRequest)(nil), "skillchain.marketplace.v1.QueryActiveArbitersRequest")
+	proto.RegisterType((*QueryActiveArbitersResponse)(nil), "skillchain.marketplace.v1.QueryActiveArbitersResponse")
 }
 
-func (m *QueryApplicationsByGigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/query.proto", fileDescriptor_0c914ebc0cae4876)
 }
 
-func (m *QueryApplicationsByGigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_0c914ebc0cae4876 = []byte{
+	// 2934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xdd, 0x8f, 0xdc, 0x56,
+	0x15, 0x8f, 0xb3, 0x9b, 0xfd, 0x38, 0x9b, 0xa4, 0xed, 0x25, 0x6d, 0xb7, 0xd3, 0x32, 0x69, 0x9c,
+	0xef, 0x8f, 0x8e, 0xb3, 0x9b, 0x8f, 0x6e, 0xd2, 0x96, 0x64, 0x37, 0xcd, 0x2e, 0xa9, 0x0a, 0x6c,
+	0xa7, 0x85, 0x07, 0x50, 0x35, 0x78, 0xc7, 0x77, 0x1d, 0x2b, 0x1e, 0xdb, 0xb5, 0x3d, 0x9b, 0xae,
+	0x56, 0xcb, 0x03, 0x12, 0xef, 0x15, 0x20, 0x5e, 0x78, 0xe1, 0xa1, 0x82, 0xaa, 0x14, 0xd1, 0x0a,
+	0xd4, 0x8a, 0x4a, 0xa8, 0x82, 0x07, 0x28, 0x6f, 0x45, 0x7d, 0x80, 0xf2, 0x00, 0xa8, 0x45, 0x42,
+	0xbc, 0xf0, 0x1f, 0x20, 0x21, 0x5f, 0x9f, 0xeb, 0xaf, 0xf1, 0xf8, 0x5e, 0x4f, 0x27, 0x2f, 0xc9,
+	0x8c, 0xf7, 0x9c, 0x7b, 0x7f, 0xbf, 0x73, 0xcf, 0xfd, 0xfa, 0x1d, 0x0f, 0x1c, 0x0f, 0xee, 0x58,
+	0xb6, 0xdd, 0xbd, 0xad, 0x5b, 0x8e, 0xd6, 0xd3, 0xfd, 0x3b, 0x34, 0xf4, 0x6c, 0xbd, 0x4b, 0xb5,
+	0xad, 0x05, 0xed, 0x95, 0x3e, 0xf5, 0xb7, 0x5b, 0x9e, 0xef, 0x86, 0x2e, 0x79, 0x24, 0x35, 0x6b,
+	0x65, 0xcc, 0x5a, 0x5b, 0x0b, 0x8d, 0x07, 0xf4, 0x9e, 0xe5, 0xb8, 0x1a, 0xfb, 0x37, 0xb6, 0x6e,
+	0x9c, 0xe9, 0xba, 0x41, 0xcf, 0x0d, 0xb4, 0x0d, 0x3d, 0xa0, 0x71, 0x33, 0xda, 0xd6, 0xc2, 0x06,
+	0x0d, 0xf5, 0x05, 0xcd, 0xd3, 0x4d, 0xcb, 0xd1, 0x43, 0xcb, 0x75, 0xd0, 0xb6, 0x99, 0xb5, 0xe5,
+	0x56, 0x5d, 0xd7, 0xe2, 0x7f, 0x3f, 0x64, 0xba, 0xa6, 0xcb, 0x3e, 0x6a, 0xd1, 0x27, 0x7c, 0xfa,
+	0x98, 0xe9, 0xba, 0xa6, 0x4d, 0x35, 0xdd, 0xb3, 0x34, 0xdd, 0x71, 0xdc, 0x90, 0x35, 0x19, 0xe0,
+	0x5f, 0x4f, 0x0f, 0x27, 0xa5, 0xf7, 0xa8, 0x63, 0xf4, 0xa8, 0x13, 0xa2, 0xe9, 0xd9, 0x0a, 0x53,
+	0xcf, 0xb3, 0xad, 0x6e, 0x16, 0xeb, 0xc9, 0x0a, 0x63, 0x7f, 0xc3, 0x0a, 0xa9, 0x8f, 0x86, 0xa7,
+	0x86, 0x1b, 0x76, 0xf5, 0x90, 0x9a, 0x2e, 0x0f, 0x6c, 0xe3, 0x5c, 0x95, 0xa5, 0xd3, 0xa5, 0xb6,
+	0x9d, 0x05, 0x50, 0xd5, 0xae, 0xeb, 0x84, 0xbe, 0xde, 0x0d, 0xc5, 0x50, 0x0d, 0x2b, 0xf0, 0xfa,
+	0x21, 0x15, 0x03, 0x40, 0xc3, 0xce, 0x96, 0x9b, 0x58, 0x9f, 0x18, 0x6e, 0x4d, 0x83, 0xae, 0xef,
+	0xde, 0x45, 0xbb, 0x96, 0xc8, 0xae, 0x13, 0x84, 0xfa, 0x1d, 0xcb, 0x31, 0xc5, 0x23, 0x46, 0x5f,
+	0x0d, 0xa9, 0x13, 0xa4, 0x31, 0x38, 0x3a, 0xdc, 0x74, 0x93, 0x52, 0xb1, 0x91, 0x69, 0x99, 0x62,
+	0x32, 0x9e, 0xee, 0xeb, 0xbd, 0x40, 0x1c, 0x4b, 0xcf, 0x77, 0x37, 0x2d, 0x9b, 0x8a, 0x87, 0xc7,
+	0xa7, 0x9b, 0xd4, 0xf7, 0x75, 0x5b, 0x6c, 0x19, 0x5a, 0x3d, 0xda, 0xb1, 0x5d, 0x53, 0xcc, 0x24,
+	0xb4, 0xbc, 0xd8, 0x48, 0x3d, 0x04, 0xe4, 0x85, 0x68, 0x9a, 0xad, 0x33, 0xd8, 0x6d, 0xfa, 0x4a,
+	0x9f, 0x06, 0xa1, 0xfa, 0x2d, 0xf8, 0x42, 0xee, 0x69, 0xe0, 0xb9, 0x4e, 0x40, 0xc9, 0xb3, 0x30,
+	0x15, 0xd3, 0x9b, 0x57, 0x1e, 0x57, 0x4e, 0xcd, 0x2d, 0x1e, 0x69, 0x0d, 0x9d, 0xdc, 0xad, 0xd8,
+	0x75, 0x65, 0xf6, 0xc3, 0xbf, 0x1f, 0xde, 0xf3, 0xc6, 0xbf, 0xdf, 0x3e, 0xa3, 0xb4, 0xd1, 0x57,
+	0x6d, 0xc1, 0x43, 0xac, 0xf1, 0x35, 0x1a, 0xae, 0xc7, 0x41, 0xc0, 0x6e, 0xc9, 0x21, 0xd8, 0xe7,
+	0xde, 0x75, 0xa8, 0xcf, 0x9a, 0x9f, 0x6d, 0xc7, 0x5f, 0xd4, 0x97, 0xe1, 0xe1, 0x01, 0x7b, 0x04,
+	0xb4, 0x02, 0xd3, 0x18, 0x47, 0x44, 0xa4, 0x56, 0x21, 0x8a, 0x2d, 0x57, 0x26, 0x23, 0x48, 0x6d,
+	0xee, 0xa8, 0x7e, 0x1b, 0xe1, 0x2c, 0xdb, 0x76, 0x01, 0xce, 0x2a, 0x40, 0xba, 0xe8, 0x60, 0x07,
+	0x27, 0x5a, 0xf1, 0xaa, 0xd3, 0x8a, 0x56, 0x9d, 0x56, 0xbc, 0xd0, 0xe1, 0xda, 0xd3, 0x5a, 0xd7,
+	0x4d, 0xee, 0xdb, 0xce, 0x78, 0xaa, 0x3f, 0x55, 0x90, 0x41, 0xb6, 0x8b, 0x32, 0x06, 0x13, 0x23,
+	0x31, 0x20, 0x6b, 0x39, 0x9c, 0x7b, 0x19, 0xce, 0x93, 0x42, 0x9c, 0x31, 0x80, 0x1c, 0xd0, 0x63,
+	0x98, 0x0c, 0x6b, 0x34, 0x5c, 0xb3, 0x4c, 0x1e, 0x86, 0x83, 0xb0, 0xd7, 0x32, 0x18, 0xfd, 0xc9,
+	0xf6, 0x5e, 0xcb, 0x50, 0xbf, 0x82, 0xc9, 0xc1, 0xad, 0x90, 0xc9, 0x65, 0x98, 0x30, 0x2d, 0x13,
+	0xc3, 0xd4, 0xac, 0x60, 0xb1, 0x66, 0x99, 0xc8, 0x20, 0x72, 0x50, 0x43, 0xec, 0x74, 0xd9, 0xb6,
+	0x33, 0x9d, 0x8e, 0x29, 0xf6, 0xe4, 0x21, 0x98, 0xda, 0xec, 0x3b, 0x06, 0x35, 0x58, 0x5c, 0x66,
+	0xda, 0xf8, 0x4d, 0xfd, 0x91, 0x82, 0x2c, 0x78, 0xb7, 0x45, 0x16, 0x13, 0xb5, 0x58, 0x8c, 0x6f,
+	0x0c, 0xce, 0x41, 0x83, 0x47, 0x77, 0x39, 0xdd, 0x46, 0x86, 0x8d, 0x45, 0x0f, 0x1e, 0x2d, 0xb5,
+	0x46, 0x36, 0x5f, 0x85, 0xb9, 0xcc, 0x5e, 0x94, 0x84, 0x71, 0x38, 0xab, 0x4c, 0x23, 0xc8, 0x2e,
+	0xdb, 0x80, 0x6a, 0x20, 0xb8, 0x65, 0xdb, 0x2e, 0x01, 0x37, 0xae, 0xf9, 0xf2, 0xae, 0x82, 0xac,
+	0x8a, 0xdd, 0x0c, 0x63, 0x35, 0xf1, 0xb9, 0x58, 0x8d, 0x6f, 0xec, 0x4e, 0xa7, 0x2b, 0xd5, 0x0d,
+	0xdc, 0x54, 0x87, 0x0d, 0x9c, 0x0e, 0xf3, 0x83, 0xa6, 0xc8, 0xef, 0x26, 0xcc, 0xf0, 0x3d, 0x19,
+	0xa3, 0x78, 0xb4, 0x82, 0x1c, 0x77, 0x47, 0x66, 0x89, 0xab, 0xaa, 0xa7, 0xab, 0x4e, 0x11, 0xcd,
+	0xb8, 0x46, 0xea, 0x4d, 0x05, 0x69, 0xe4, 0xfa, 0x28, 0xa5, 0x31, 0x31, 0x22, 0x8d, 0xf1, 0x8d,
+	0xce, 0x65, 0xf8, 0x62, 0x8c, 0x35, 0x1d, 0xfa, 0x60, 0x65, 0x3b, 0xb3, 0xe6, 0x3c, 0x08, 0x53,
+	0xa6, 0x65, 0x76, 0x92, 0x71, 0xda, 0x67, 0x5a, 0xe6, 0x2d, 0x43, 0xf5, 0xa1, 0x39, 0xcc, 0x0f,
+	0x99, 0xae, 0xc3, 0xfe, 0x4c, 0x3e, 0x05, 0x23, 0x65, 0x64, 0xae, 0x05, 0x75, 0x15, 0x8e, 0x95,
+	0xf4, 0xb9, 0xea, 0x53, 0x6a, 0x47, 0x67, 0x3b, 0x9f, 0x43, 0x6e, 0x02, 0x6c, 0x26, 0x0f, 0x71,
+	0xdb, 0xcc, 0x3c, 0x51, 0xb7, 0xe1, 0xb8, 0xa0, 0x9d, 0x7b, 0x46, 0x61, 0x01, 0x27, 0x31, 0x1f,
+	0xd8, 0x60, 0x65, 0xfb, 0xeb, 0x41, 0x8a, 0x9c, 0xc0, 0x64, 0x3f, 0x48, 0x30, 0xb3, 0xcf, 0xaa,
+	0x09, 0x8f, 0x95, 0xbb, 0x20, 0xc8, 0x35, 0x98, 0xe5, 0x69, 0x11, 0xd4, 0x4f, 0xa9, 0xd4, 0x57,
+	0x5d, 0x84, 0x47, 0x72, 0x1d, 0xc9, 0xa4, 0xc1, 0xcb, 0xb8, 0xf6, 0x15, 0x7c, 0x10, 0xda, 0xb5,
+	0x91, 0xe6, 0x6c, 0x66, 0xb6, 0x3e, 0x8a, 0x90, 0x6e, 0xb2, 0x43, 0xee, 0x8a, 0xce, 0xc6, 0x87,
+	0x9f, 0xc7, 0xfe, 0xa7, 0x60, 0xe7, 0x85, 0xbf, 0x62, 0xe7, 0x26, 0xcc, 0x6c, 0xc4, 0x8f, 0x82,
+	0xf9, 0xbd, 0x2c, 0x2c, 0x8f, 0xe4, 0x26, 0x08, 0x9f, 0x1a, 0x37, 0x5c, 0xcb, 0x59, 0x39, 0x1f,
+	0x05, 0xe3, 0xcd, 0x7f, 0x1c, 0x3e, 0x65, 0x5a, 0xe1, 0xed, 0xfe, 0x46, 0xab, 0xeb, 0xf6, 0x34,
+	0xbc, 0x49, 0xc5, 0xff, 0x3d, 0x11, 0x18, 0x77, 0xb4, 0x70, 0xdb, 0xa3, 0x01, 0x73, 0x08, 0xda,
+	0x49, 0xe3, 0xc4, 0x83, 0x03, 0x3e, 0x0d, 0x75, 0xcb, 0xa1, 0x46, 0x67, 0x93, 0xd2, 0x60, 0x7e,
+	0x62, 0xfc, 0xbd, 0xed, 0xe7, 0x3d, 0xac, 0x52, 0x1a, 0x3c, 0x37, 0x39, 0xa3, 0xdc, 0xbf, 0x57,
+	0x7d, 0xa6, 0x10, 0xfb, 0x38, 0x0c, 0x7c, 0xc0, 0x0e, 0xc3, 0x1c, 0x0f, 0x63, 0x3a, 0x6a, 0xc0,
+	0x1f, 0xdd, 0x32, 0xd4, 0x3f, 0x2a, 0x85, 0x5c, 0xe4, 0xfe, 0x49, 0x5e, 0x4d, 0xc5, 0x77, 0x0b,
+	0x1c, 0xba, 0xd3, 0x12, 0x43, 0x87, 0x23, 0x11, 0xa7, 0x16, 0xba, 0x93, 0x0e, 0x4c, 0xde, 0xa6,
+	0xb6, 0x71, 0x2f, 0x06, 0x81, 0x35, 0xac, 0x6a, 0xb9, 0x2c, 0x91, 0x98, 0x52, 0x1f, 0xe6, 0x33,
+	0xa7, 0x38, 0xa3, 0x6e, 0xc1, 0x74, 0x0c, 0x9d, 0xcf, 0xa7, 0xda, 0xd4, 0xb9, 0xff, 0xbd, 0xe7,
+	0x7e, 0x2a, 0xbd, 0x37, 0x3c, 0x1b, 0xdf, 0x2f, 0x87, 0x6d, 0xae, 0xef, 0x28, 0xe9, 0x46, 0x9c,
+	0x98, 0xa6, 0x07, 0x6e, 0xbc, 0x9d, 0x4a, 0x5c, 0x19, 0xd0, 0x99, 0x53, 0x45, 0x47, 0xd2, 0x06,
+	0x48, 0xee, 0x96, 0x7c, 0xc6, 0x9d, 0xab, 0x6a, 0x86, 0xea, 0x86, 0x6d, 0x39, 0xf4, 0x26, 0x77,
+	0xc2, 0x06, 0x33, 0xad, 0x64, 0xaf, 0x21, 0x05, 0x76, 0xf7, 0xe2, 0x1a, 0x52, 0x19, 0x95, 0x89,
+	0xd1, 0xa2, 0x32, 0xc6, 0x8d, 0xba, 0x51, 0x18, 0xbd, 0x6f, 0xb8, 0x69, 0x38, 0xe6, 0x61, 0x1a,
+	0x25, 0x13, 0x4c, 0x74, 0xfe, 0x55, 0x75, 0xd2, 0xc3, 0x70, 0xce, 0x0f, 0x39, 0x7e, 0x0d, 0xf6,
+	0x67, 0x75, 0x09, 0x89, 0xd3, 0x70, 0xa6, 0x15, 0x7e, 0x6e, 0x34, 0xd2, 0x47, 0xd9, 0xd3, 0x70,
+	0x09, 0xce, 0x71, 0x0d, 0xdb, 0x7b, 0x99, 0xd3, 0xb0, 0x1c, 0xad, 0x89, 0xcf, 0x45, 0x6b, 0x7c,
+	0xe3, 0xf8, 0x10, 0x1c, 0x62, 0xc0, 0x57, 0x29, 0x7d, 0x31, 0xd4, 0xc3, 0x44, 0x5d, 0xf8, 0x40,
+	0x81, 0x07, 0x0b, 0x7f, 0x48, 0x76, 0xd1, 0x7d, 0x41, 0xf4, 0x40, 0x62, 0x0b, 0xe5, 0xbe, 0xc8,
+	0x20, 0xf6, 0x23, 0x14, 0xa6, 0x3d, 0xea, 0x18, 0x96, 0x63, 0xde, 0x8b, 0x75, 0x88, 0xb7, 0xad,
+	0xde, 0x80, 0xc7, 0xe3, 0xfd, 0x24, 0x23, 0xb4, 0xad, 0xfb, 0xae, 0xe7, 0x06, 0xba, 0x2d, 0xbd,
+	0x2b, 0x6d, 0xc1, 0x91, 0x8a, 0x46, 0x30, 0x22, 0x2f, 0xc0, 0x8c, 0x87, 0xcf, 0x30, 0x28, 0x5a,
+	0xd5, 0x0a, 0x5d, 0xd2, 0x14, 0x3f, 0x50, 0xf3, 0x66, 0x92, 0xcd, 0xf4, 0x25, 0xcb, 0x0b, 0x56,
+	0xb6, 0x8b, 0x57, 0x03, 0x21, 0xec, 0xf7, 0x79, 0x3e, 0x16, 0xfd, 0x11, 0xf1, 0x12, 0x4c, 0x86,
+	0x96, 0x17, 0x48, 0x5c, 0xa1, 0x5f, 0xb2, 0x3c, 0x04, 0xc7, 0x3c, 0x88, 0x0e, 0xfb, 0x42, 0x37,
+	0xd4, 0xed, 0x7b, 0x31, 0x74, 0x71, 0xcb, 0xea, 0x32, 0x9e, 0xe5, 0x5f, 0xb2, 0x7a, 0xf4, 0x79,
+	0xd7, 0x1c, 0x85, 0xff, 0x6d, 0x38, 0x3c, 0xb4, 0x89, 0xe4, 0xe6, 0x33, 0xcb, 0xb5, 0xb8, 0x40,
+	0x62, 0x3d, 0xc5, 0x96, 0xf8, 0x40, 0x85, 0xd8, 0xb0, 0xfa, 0x34, 0x6e, 0xf6, 0x2f, 0x86, 0x3e,
+	0xd5, 0x7b, 0xcb, 0xdd, 0xae, 0xdf, 0xaf, 0x91, 0x5e, 0x7f, 0xe6, 0x3b, 0x7f, 0xc1, 0x1d, 0x31,
+	0x5e, 0x81, 0x69, 0x3d, 0x7a, 0x44, 0x0d, 0xcc, 0xab, 0x8a, 0x70, 0xe3, 0x42, 0x8f, 0xf6, 0x91,
+	0x6b, 0xd7, 0xd6, 0xad, 0x1e, 0x8a, 0x2a, 0x32, 0xae, 0x68, 0x4f, 0x9e, 0x81, 0x59, 0xf6, 0x51,
+	0xdf, 0xb0, 0xe9, 0xfc, 0x84, 0x9c, 0x73, 0xea, 0xa1, 0x2e, 0xe1, 0xc2, 0xb1, 0xcc, 0xb5, 0x78,
+	0xe9, 0x68, 0x6c, 0xf0, 0xed, 0x35, 0xf5, 0xc4, 0x40, 0x7c, 0x19, 0x66, 0x13, 0x69, 0x1f, 0x43,
+	0x71, 0xac, 0xea, 0xda, 0xc3, 0x6d, 0x39, 0xba, 0xc4, 0x39, 0x59, 0x15, 0x92, 0x6d, 0x7e, 0x94,
+	0xf4, 0xba, 0x8b, 0xab, 0x42, 0x79, 0x23, 0x88, 0x39, 0x7f, 0x00, 0x51, 0xc6, 0x72, 0x00, 0xf9,
+	0x9e, 0x82, 0x21, 0x5a, 0xa5, 0x74, 0xdd, 0xa7, 0x5b, 0x16, 0xbd, 0x9b, 0xdd, 0x72, 0x0d, 0xc3,
+	0xa7, 0x41, 0x90, 0x6c, 0xb9, 0xf1, 0x57, 0x72, 0x09, 0xf6, 0x79, 0xbe, 0xd5, 0xa5, 0xb2, 0x89,
+	0x10, 0x5b, 0x93, 0x06, 0xcc, 0xf0, 0x6a, 0x06, 0xcb, 0x82, 0xd9, 0x76, 0xf2, 0x5d, 0x7d, 0x8b,
+	0x1f, 0x53, 0xb2, 0x38, 0x90, 0x37, 0x89, 0xd6, 0x96, 0xf4, 0x84, 0x1b, 0x7d, 0x26, 0x0f, 0xc3,
+	0xf4, 0x26, 0xa5, 0x9d, 0x0d, 0x2f, 0x60, 0x20, 0x26, 0xdb, 0x53, 0x9b, 0x94, 0xae, 0x78, 0x01,
+	0x59, 0x80, 0x89, 0x4d, 0x2a, 0x9d, 0x65, 0x91, 0x6d, 0xe4, 0xe2, 0xd0, 0x70, 0x7e, 0x52, 0xd2,
+	0xc5, 0xa1, 0xa1, 0x7a, 0x15, 0xef, 0xac, 0x6d, 0x94, 0xe9, 0x6f, 0xea, 0xbe, 0x63, 0x39, 0x26,
+	0xdf, 0xec, 0x22, 0xaa, 0xb1, 0x82, 0x9f, 0xc0, 0x4e, 0xbe, 0xab, 0x3f, 0x57, 0x50, 0x92, 0x18,
+	0x74, 0x46, 0xc2, 0x5d, 0x98, 0xa2, 0xba, 0xef, 0xb0, 0x49, 0x3a, 0xf6, 0x35, 0x11, 0x9b, 0x26,
+	0xc7, 0xe1, 0x20, 0x42, 0x32, 0x3a, 0x5d, 0xb7, 0xef, 0x84, 0x18, 0xc8, 0x03, 0xfc, 0xe9, 0x8d,
+	0xe8, 0x61, 0x81, 0x29, 0x35, 0x96, 0xbb, 0xcc, 0x58, 0x8a, 0xe9, 0xed, 0x1c, 0xd1, 0xac, 0x6f,
+	0x7a, 0xb5, 0xe7, 0x85, 0x0e, 0x99, 0xab, 0x3d, 0x0f, 0x18, 0x9f, 0x84, 0x89, 0x6f, 0xe1, 0x1e,
+	0xfd, 0x62, 0x5c, 0x2b, 0xe2, 0x27, 0x8f, 0x4f, 0xf2, 0xb7, 0xa1, 0xe4, 0xaf, 0x08, 0x62, 0x15,
+	0x26, 0x3d, 0xd7, 0xe5, 0x1b, 0x6d, 0xd5, 0x84, 0xca, 0xf9, 0xaf, 0xbb, 0x2e, 0x07, 0xc2, 0xfc,
+	0xc9, 0x55, 0x98, 0x71, 0xbd, 0x90, 0x1a, 0x1d, 0xcb, 0x91, 0x5e, 0x21, 0x99, 0xc3, 0x2d, 0x87,
+	0x3c, 0x09, 0x53, 0xb6, 0xf5, 0x4a, 0xdf, 0x32, 0x64, 0x13, 0x17, 0xcd, 0xd5, 0xcb, 0x28, 0xc5,
+	0xdd, 0xc0, 0x89, 0xd4, 0xee, 0xa7, 0x95, 0x8c, 0xec, 0x7c, 0x53, 0x0a, 0xf3, 0xed, 0x3b, 0x5c,
+	0x0b, 0xc9, 0xf9, 0x61, 0x44, 0x96, 0x61, 0xd2, 0xef, 0x27, 0xd5, 0x95, 0x93, 0x95, 0x47, 0x8f,
+	0xd4, 0x9d, 0x07, 0x23, 0x72, 0x25, 0x4d, 0x00, 0x77, 0x8b, 0xfa, 0xbe, 0x65, 0x18, 0xd4, 0x41,
+	0x15, 0x3e, 0xf3, 0x44, 0xd5, 0xb8, 0x10, 0x1f, 0x9f, 0xe2, 0x85, 0x6b, 0x8e, 0xba, 0x8b, 0xc7,
+	0xca, 0xc4, 0x21, 0xbd, 0xc3, 0x64, 0x2f, 0x06, 0xd5, 0x7b, 0x2e, 0x3a, 0x27, 0x5b, 0x5b, 0xfc,
+	0x95, 0x1c, 0x81, 0xfd, 0x7a, 0xb7, 0xdb, 0xf7, 0xf5, 0xee, 0x76, 0x66, 0x45, 0x99, 0xe3, 0xcf,
+	0x56, 0xbc, 0x20, 0x3d, 0xf5, 0x77, 0x43, 0x6b, 0x8b, 0x62, 0x3b, 0xc1, 0xb8, 0x4f, 0xfd, 0xff,
+	0x4d, 0x4e, 0xfd, 0x85, 0x6e, 0x92, 0x52, 0xdc, 0x0c, 0x62, 0x96, 0x39, 0x61, 0xe4, 0xd9, 0x26,
+	0x9e, 0x63, 0x3b, 0xea, 0x93, 0xeb, 0x30, 0xc7, 0x0e, 0x58, 0xac, 0x38, 0x2b, 0xbd, 0xe6, 0x02,
+	0xf3, 0x89, 0x66, 0x11, 0x5d, 0xfc, 0xcb, 0x79, 0xd8, 0xc7, 0x08, 0x93, 0xef, 0x2b, 0x30, 0x15,
+	0x57, 0x0f, 0xc9, 0x13, 0x15, 0x9c, 0x06, 0xcb, 0x96, 0x8d, 0x96, 0xac, 0x79, 0xcc, 0x40, 0x3d,
+	0xfd, 0xdd, 0x8f, 0xff, 0xf5, 0x83, 0xbd, 0x47, 0xc9, 0x11, 0x4d, 0x54, 0xcf, 0x25, 0x3f, 0x53,
+	0x00, 0xd2, 0x02, 0x24, 0x59, 0x10, 0xf5, 0x34, 0x50, 0xdc, 0x6c, 0x2c, 0xd6, 0x71, 0x41, 0x80,
+	0x8b, 0x0c, 0xe0, 0x39, 0x72, 0x46, 0x13, 0x16, 0x92, 0xb5, 0x1d, 0x56, 0x2d, 0xdd, 0x25, 0x3f,
+	0x51, 0x60, 0xee, 0x79, 0x2b, 0x90, 0x87, 0x3a, 0x50, 0xf8, 0x14, 0x43, 0x1d, 0x2c, 0x64, 0xaa,
+	0x67, 0x18, 0xd4, 0x63, 0x44, 0x15, 0x43, 0x25, 0x3f, 0x54, 0x60, 0x2a, 0xae, 0x1e, 0x8a, 0x47,
+	0x38, 0x57, 0x8b, 0x14, 0x8f, 0x70, 0xbe, 0x28, 0xa9, 0x9e, 0x65, 0xa8, 0x8e, 0x93, 0xa3, 0x5a,
+	0x65, 0x59, 0x5f, 0xdb, 0xb1, 0x8c, 0x5d, 0xf2, 0x9a, 0x02, 0xd3, 0x51, 0xe4, 0xa4, 0x70, 0xe5,
+	0xca, 0x95, 0x62, 0x5c, 0xf9, 0x32, 0xa3, 0x7a, 0x82, 0xe1, 0x7a, 0x9c, 0x34, 0xab, 0x71, 0x91,
+	0x5f, 0x2b, 0x70, 0x30, 0x5f, 0xdb, 0x23, 0x97, 0x24, 0x42, 0x30, 0x58, 0x9c, 0x6b, 0x5c, 0xae,
+	0xeb, 0x86, 0x48, 0x2f, 0x30, 0xa4, 0x4f, 0x90, 0xb3, 0x9a, 0xd4, 0xfb, 0x2e, 0x71, 0x24, 0xdf,
+	0x56, 0xe0, 0xbe, 0x28, 0x92, 0xb5, 0x70, 0x97, 0x16, 0x15, 0xc5, 0xb8, 0xcb, 0x8b, 0x84, 0x6a,
+	0x8b, 0xe1, 0x3e, 0x45, 0x4e, 0xc8, 0xe1, 0x26, 0x6f, 0x28, 0x30, 0x97, 0x29, 0xc6, 0x11, 0x99,
+	0xe9, 0x5a, 0x38, 0xdc, 0x37, 0x2e, 0xd4, 0xf2, 0x41, 0xa0, 0xe7, 0x19, 0xd0, 0x33, 0xe4, 0x94,
+	0x26, 0x7e, 0x45, 0x27, 0x8e, 0xee, 0xeb, 0x0a, 0xec, 0x8f, 0xa2, 0x2b, 0x8f, 0x75, 0xb0, 0x04,
+	0x28, 0xc6, 0x5a, 0x52, 0xd2, 0x93, 0x9a, 0x4e, 0x49, 0xe1, 0xee, 0x4f, 0x0a, 0x3c, 0x30, 0x50,
+	0x33, 0x23, 0x4b, 0xc2, 0x7e, 0x87, 0x94, 0xe7, 0x1a, 0x57, 0x46, 0xf0, 0x44, 0xdc, 0xd7, 0x18,
+	0xee, 0x2b, 0xe4, 0x49, 0xb9, 0x64, 0x08, 0x3a, 0x1b, 0xdb, 0x1d, 0xb6, 0x2c, 0xc4, 0x85, 0xa0,
+	0x5d, 0xf2, 0x1f, 0x05, 0xe6, 0x87, 0xd5, 0xd0, 0xc8, 0xb5, 0x7a, 0xc0, 0x06, 0xaa, 0x78, 0x8d,
+	0xeb, 0xa3, 0x37, 0x80, 0x04, 0x9f, 0x63, 0x04, 0x9f, 0x25, 0x2b, 0x35, 0x08, 0xa6, 0x65, 0x42,
+	0x6d, 0x27, 0xfd, 0xbc, 0x4b, 0x3e, 0x50, 0xe0, 0xbe, 0x42, 0x05, 0x8e, 0x08, 0x67, 0x61, 0x79,
+	0x95, 0xaf, 0xf1, 0x64, 0x6d, 0x3f, 0x24, 0xf4, 0x14, 0x23, 0x74, 0x89, 0x5c, 0x90, 0xc8, 0x34,
+	0xc6, 0xa6, 0x1f, 0x44, 0x3c, 0xa2, 0x7f, 0x77, 0xc9, 0x6f, 0x14, 0x38, 0x90, 0x2b, 0xd3, 0x91,
+	0x8b, 0xb2, 0x38, 0x72, 0x19, 0x77, 0xa9, 0xa6, 0xd7, 0x08, 0xd8, 0x07, 0x32, 0xed, 0x97, 0x0a,
+	0x1c, 0xc8, 0x55, 0xf9, 0xc4, 0xd8, 0xcb, 0x4a, 0x86, 0x62, 0xec, 0xa5, 0xa5, 0x44, 0x75, 0x81,
+	0x61, 0x3f, 0x4b, 0x4e, 0x6b, 0xc2, 0xf7, 0xf0, 0xb0, 0x2a, 0x48, 0x7e, 0xa7, 0xc0, 0xc1, 0x7c,
+	0x69, 0x88, 0x48, 0x07, 0x2e, 0x57, 0xc8, 0x6b, 0x5c, 0xae, 0xeb, 0x86, 0xa0, 0xaf, 0x33, 0xd0,
+	0x57, 0xc9, 0x92, 0x4c, 0xc0, 0x63, 0xf4, 0xda, 0x4e, 0x46, 0x86, 0xd9, 0x25, 0xef, 0x25, 0x51,
+	0xe7, 0x19, 0x2f, 0x19, 0xf5, 0x42, 0xbe, 0x5f, 0xaa, 0xe9, 0x85, 0x04, 0xae, 0x30, 0x02, 0x17,
+	0xc8, 0x82, 0x30, 0xea, 0x03, 0xb9, 0xfe, 0x63, 0x05, 0x66, 0xb8, 0x16, 0x4e, 0x34, 0x51, 0xf7,
+	0x05, 0x29, 0xbe, 0x71, 0x5e, 0xde, 0x01, 0xa1, 0x9e, 0x63, 0x50, 0x4f, 0x90, 0x63, 0x5a, 0xe5,
+	0xdb, 0x94, 0x9d, 0x58, 0x8f, 0xff, 0x44, 0x81, 0x43, 0x65, 0xa2, 0x34, 0x79, 0x4a, 0x38, 0xd4,
+	0xc3, 0xa5, 0xf5, 0xc6, 0xd3, 0xa3, 0x39, 0x23, 0x83, 0x55, 0xc6, 0xe0, 0x3a, 0xf9, 0x92, 0x26,
+	0xf7, 0x06, 0x6d, 0x87, 0x2b, 0xe7, 0x85, 0x9c, 0xf9, 0xbd, 0x02, 0x07, 0xf3, 0x1a, 0xb8, 0x38,
+	0xef, 0x4b, 0x35, 0x77, 0x71, 0xde, 0x97, 0x4b, 0xed, 0xea, 0x32, 0x63, 0xf2, 0x14, 0xb9, 0xa2,
+	0x55, 0xbe, 0xea, 0xc9, 0x72, 0x26, 0x3d, 0x42, 0xe4, 0x48, 0x7c, 0xac, 0x00, 0x19, 0x54, 0xb2,
+	0xc9, 0x15, 0x31, 0xa2, 0x21, 0x02, 0x7a, 0xe3, 0xea, 0x28, 0xae, 0x35, 0x86, 0x26, 0x51, 0xd6,
+	0x2b, 0x58, 0xfd, 0x56, 0x81, 0x03, 0x39, 0xd9, 0x5b, 0x3c, 0x9d, 0xcb, 0x44, 0x76, 0xf1, 0x74,
+	0x2e, 0xd5, 0xd6, 0xa5, 0x8e, 0x1b, 0x01, 0xf3, 0xec, 0xe8, 0xb1, 0x6b, 0x01, 0xff, 0x5b, 0x0a,
+	0xcc, 0x26, 0x42, 0x33, 0x11, 0x4e, 0xd2, 0xa2, 0x1c, 0xde, 0x58, 0xa8, 0xe1, 0x81, 0x98, 0xaf,
+	0x32, 0xcc, 0x17, 0xc9, 0xa2, 0x26, 0xf1, 0x0a, 0x7c, 0x01, 0xee, 0x2f, 0x14, 0x80, 0x54, 0xad,
+	0x15, 0xdf, 0x38, 0x07, 0x14, 0x66, 0xf1, 0x8d, 0x73, 0x50, 0x0c, 0x56, 0x97, 0x18, 0xe2, 0x45,
+	0x72, 0x5e, 0xb0, 0x12, 0x79, 0xb1, 0x9f, 0xb6, 0x83, 0x02, 0xd2, 0x2e, 0xf9, 0x83, 0x02, 0xf7,
+	0x17, 0x25, 0x57, 0x22, 0x3c, 0xaa, 0x0c, 0x51, 0x78, 0x1b, 0x4b, 0xf5, 0x1d, 0x6b, 0xe4, 0x09,
+	0x57, 0x36, 0x3b, 0x14, 0xbd, 0xb5, 0x1d, 0xae, 0xaa, 0x66, 0x89, 0xa4, 0x92, 0xaa, 0x2c, 0x91,
+	0x01, 0x01, 0x57, 0x96, 0xc8, 0xa0, 0x7a, 0x5b, 0x83, 0x08, 0x35, 0xa2, 0x94, 0x67, 0xde, 0x59,
+	0x22, 0xe9, 0xa9, 0x07, 0x35, 0x55, 0xd9, 0xfd, 0x37, 0x2f, 0xf0, 0xca, 0xee, 0xbf, 0x05, 0xe1,
+	0xb7, 0xce, 0xa9, 0x07, 0x7f, 0x7d, 0x40, 0xde, 0x55, 0x60, 0x7f, 0x56, 0xf3, 0x24, 0x17, 0xc4,
+	0x9b, 0xd2, 0x80, 0x30, 0xdb, 0xb8, 0x58, 0xcf, 0xa9, 0xce, 0x01, 0x13, 0x1d, 0x3b, 0x7e, 0xdf,
+	0xa6, 0xda, 0x0e, 0xff, 0xca, 0xf4, 0xa1, 0x69, 0xd4, 0x03, 0x89, 0x58, 0xb6, 0xc8, 0x89, 0xb2,
+	0x0d, 0x4d, 0xda, 0x1e, 0x91, 0x5e, 0x64, 0x48, 0x5b, 0xe4, 0x9c, 0x26, 0xfc, 0x01, 0x4c, 0x66,
+	0x7e, 0xfe, 0x4a, 0x81, 0x83, 0x79, 0xdd, 0x53, 0x42, 0x3d, 0x28, 0x93, 0x63, 0x25, 0xd4, 0x83,
+	0x52, 0x79, 0x55, 0x4a, 0x78, 0xd3, 0x99, 0x6b, 0x27, 0x11, 0x53, 0xff, 0xa6, 0xc0, 0xa1, 0xb2,
+	0xaa, 0x9d, 0xf8, 0xac, 0x53, 0x51, 0x30, 0x14, 0x9f, 0x75, 0xaa, 0x0a, 0x85, 0xea, 0x1a, 0xe3,
+	0xb1, 0x4c, 0xae, 0x69, 0x12, 0x3f, 0x93, 0xa9, 0xda, 0x51, 0x5f, 0x8f, 0xf5, 0x4f, 0x7c, 0x75,
+	0x44, 0x4a, 0xff, 0xcc, 0xbf, 0xc6, 0x24, 0xa5, 0x7f, 0x16, 0x5e, 0x4b, 0x52, 0x35, 0x06, 0xff,
+	0x34, 0x39, 0xa9, 0x09, 0x7f, 0x6b, 0x14, 0x4b, 0x23, 0x5c, 0xfc, 0x94, 0xc6, 0x39, 0xf0, 0xba,
+	0x95, 0x94, 0xf8, 0x59, 0xc4, 0x29, 0x23, 0x7e, 0xf2, 0xd7, 0xa4, 0xde, 0x8f, 0x25, 0xbd, 0xcc,
+	0x4b, 0x38, 0x52, 0x92, 0xde, 0xe0, 0x1b, 0x46, 0x52, 0x92, 0x5e, 0xc9, 0x1b, 0x43, 0x52, 0xb7,
+	0x8d, 0xec, 0x2b, 0x45, 0xda, 0x0e, 0xe6, 0xf8, 0x2e, 0x79, 0x07, 0x85, 0xbd, 0x5a, 0xe8, 0x4b,
+	0xdf, 0x8f, 0x92, 0x12, 0xf6, 0xca, 0xd0, 0xd7, 0xc8, 0x09, 0x86, 0x7e, 0x65, 0xe9, 0xc3, 0x4f,
+	0x9b, 0xca, 0x47, 0x9f, 0x36, 0x95, 0x7f, 0x7e, 0xda, 0x54, 0x5e, 0xfb, 0xac, 0xb9, 0xe7, 0xa3,
+	0xcf, 0x9a, 0x7b, 0xfe, 0xfa, 0x59, 0x73, 0xcf, 0x37, 0x9b, 0x99, 0x16, 0x5e, 0xcd, 0xb5, 0xc1,
+	0xca, 0xa4, 0x1b, 0x53, 0xec, 0x37, 0x52, 0x17, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x24, 0xb4,
+	0x73, 0x8a, 0xeb, 0x38, 0x00, 0x00,
 }
 
-func (m *QueryApplicationsByGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GigId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListProfile Queries a list of Profile items.
+	GetProfile(ctx context.Context, in *QueryGetProfileRequest, opts ...grpc.CallOption) (*QueryGetProfileResponse, error)
+	// ListProfile defines the ListProfile RPC.
+	ListProfile(ctx context.Context, in *QueryAllProfileRequest, opts ...grpc.CallOption) (*QueryAllProfileResponse, error)
+	// ListGig Queries a list of Gig items.
+	GetGig(ctx context.Context, in *QueryGetGigRequest, opts ...grpc.CallOption) (*QueryGetGigResponse, error)
+	// ListGig defines the ListGig RPC.
+	ListGig(ctx context.Context, in *QueryAllGigRequest, opts ...grpc.CallOption) (*QueryAllGigResponse, error)
+	// ListApplication Queries a list of Application items.
+	GetApplication(ctx context.Context, in *QueryGetApplicationRequest, opts ...grpc.CallOption) (*QueryGetApplicationResponse, error)
+	// ListApplication defines the ListApplication RPC.
+	ListApplication(ctx context.Context, in *QueryAllApplicationRequest, opts ...grpc.CallOption) (*QueryAllApplicationResponse, error)
+	// ListContract Queries a list of Contract items.
+	GetContract(ctx context.Context, in *QueryGetContractRequest, opts ...grpc.CallOption) (*QueryGetContractResponse, error)
+	// ListContract defines the ListContract RPC.
+	ListContract(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
+	// ApplicationsByGig Queries a list of ApplicationsByGig items.
+	ApplicationsByGig(ctx context.Context, in *QueryApplicationsByGigRequest, opts ...grpc.CallOption) (*QueryApplicationsByGigResponse, error)
+	// ApplicationsByFreelancer Queries a list of ApplicationsByFreelancer items.
+	ApplicationsByFreelancer(ctx context.Context, in *QueryApplicationsByFreelancerRequest, opts ...grpc.CallOption) (*QueryApplicationsByFreelancerResponse, error)
+	// ContractsByUser Queries a list of ContractsByUser items.
+	ContractsByUser(ctx context.Context, in *QueryContractsByUserRequest, opts ...grpc.CallOption) (*QueryContractsByUserResponse, error)
+	// ContractByGig Queries a list of ContractByGig items.
+	ContractByGig(ctx context.Context, in *QueryContractByGigRequest, opts ...grpc.CallOption) (*QueryContractByGigResponse, error)
+	// EscrowBalance Queries a list of EscrowBalance items.
+	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
+	// ContractEscrow Queries the escrow ledger of a contract.
+	ContractEscrow(ctx context.Context, in *QueryContractEscrowRequest, opts ...grpc.CallOption) (*QueryContractEscrowResponse, error)
+	// EscrowsByUser Queries the escrow ledgers of the contracts of a user.
+	EscrowsByUser(ctx context.Context, in *QueryEscrowsByUserRequest, opts ...grpc.CallOption) (*QueryEscrowsByUserResponse, error)
+	// FeeStats Queries the platform fees collected and distributed.
+	FeeStats(ctx context.Context, in *QueryFeeStatsRequest, opts ...grpc.CallOption) (*QueryFeeStatsResponse, error)
+	// CancellationProposal Queries the pending cancellation proposal of a contract.
+	CancellationProposal(ctx context.Context, in *QueryCancellationProposalRequest, opts ...grpc.CallOption) (*QueryCancellationProposalResponse, error)
+	// TipsByContract Queries the tips paid on a contract.
+	TipsByContract(ctx context.Context, in *QueryTipsByContractRequest, opts ...grpc.CallOption) (*QueryTipsByContractResponse, error)
+	// TimeLogsByContract Queries the time logs of an hourly contract.
+	TimeLogsByContract(ctx context.Context, in *QueryTimeLogsByContractRequest, opts ...grpc.CallOption) (*QueryTimeLogsByContractResponse, error)
+	// StreamAccrual Queries the amount accrued by a streaming contract.
+	StreamAccrual(ctx context.Context, in *QueryStreamAccrualRequest, opts ...grpc.CallOption) (*QueryStreamAccrualResponse, error)
+	// Amendment Queries the pending amendment of a contract.
+	Amendment(ctx context.Context, in *QueryAmendmentRequest, opts ...grpc.CallOption) (*QueryAmendmentResponse, error)
+	// FeePreview Queries the platform fee and net payout of a freelancer for a
+	// price.
+	FeePreview(ctx context.Context, in *QueryFeePreviewRequest, opts ...grpc.CallOption) (*QueryFeePreviewResponse, error)
+	// ReferralEarnings Queries the fee shares earned by a referrer.
+	ReferralEarnings(ctx context.Context, in *QueryReferralEarningsRequest, opts ...grpc.CallOption) (*QueryReferralEarningsResponse, error)
+	// ReferredAccounts Queries the accounts referred by a referrer.
+	ReferredAccounts(ctx context.Context, in *QueryReferredAccountsRequest, opts ...grpc.CallOption) (*QueryReferredAccountsResponse, error)
+	// EscrowStaking Queries the escrow delegated to validators.
+	EscrowStaking(ctx context.Context, in *QueryEscrowStakingRequest, opts ...grpc.CallOption) (*QueryEscrowStakingResponse, error)
+	// CategoryRule Queries the rules applied to the gigs of a category.
+	CategoryRule(ctx context.Context, in *QueryCategoryRuleRequest, opts ...grpc.CallOption) (*QueryCategoryRuleResponse, error)
+	// Arbiter Queries an arbiter by address.
+	Arbiter(ctx context.Context, in *QueryArbiterRequest, opts ...grpc.CallOption) (*QueryArbiterResponse, error)
+	// ActiveArbiters Queries the arbiters assigned to new disputes and their
+	// total stake.
+	ActiveArbiters(ctx context.Context, in *QueryActiveArbitersRequest, opts ...grpc.CallOption) (*QueryActiveArbitersResponse, error)
+	// ExtensionsByContract Queries the deadline extension requests of a contract.
+	ExtensionsByContract(ctx context.Context, in *QueryExtensionsByContractRequest, opts ...grpc.CallOption) (*QueryExtensionsByContractResponse, error)
+	// ListDispute Queries a list of Dispute items.
+	GetDispute(ctx context.Context, in *QueryGetDisputeRequest, opts ...grpc.CallOption) (*QueryGetDisputeResponse, error)
+	// ListDispute defines the ListDispute RPC.
+	ListDispute(ctx context.Context, in *QueryAllDisputeRequest, opts ...grpc.CallOption) (*QueryAllDisputeResponse, error)
+	// ListDisputeVote Queries a list of DisputeVote items.
+	GetDisputeVote(ctx context.Context, in *QueryGetDisputeVoteRequest, opts ...grpc.CallOption) (*QueryGetDisputeVoteResponse, error)
+	// ListDisputeVote defines the ListDisputeVote RPC.
+	ListDisputeVote(ctx context.Context, in *QueryAllDisputeVoteRequest, opts ...grpc.CallOption) (*QueryAllDisputeVoteResponse, error)
 }
 
-func (m *QueryApplicationsByGigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryApplicationsByGigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProfile(ctx context.Context, in *QueryGetProfileRequest, opts ...grpc.CallOption) (*QueryGetProfileResponse, error) {
+	out := new(QueryGetProfileResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryApplicationsByGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Applications) > 0 {
-		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ListProfile(ctx context.Context, in *QueryAllProfileRequest, opts ...grpc.CallOption) (*QueryAllProfileResponse, error) {
+	out := new(QueryAllProfileResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryApplicationsByFreelancerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetGig(ctx context.Context, in *QueryGetGigRequest, opts ...grpc.CallOption) (*QueryGetGigResponse, error) {
+	out := new(QueryGetGigResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetGig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryApplicationsByFreelancerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListGig(ctx context.Context, in *QueryAllGigRequest, opts ...grpc.CallOption) (*QueryAllGigResponse, error) {
+	out := new(QueryAllGigResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListGig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryApplicationsByFreelancerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Freelancer) > 0 {
-		i -= len(m.Freelancer)
-		copy(dAtA[i:], m.Freelancer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Freelancer)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetApplication(ctx context.Context, in *QueryGetApplicationRequest, opts ...grpc.CallOption) (*QueryGetApplicationResponse, error) {
+	out := new(QueryGetApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryApplicationsByFreelancerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListApplication(ctx context.Context, in *QueryAllApplicationRequest, opts ...grpc.CallOption) (*QueryAllApplicationResponse, error) {
+	out := new(QueryAllApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListApplication", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryApplicationsByFreelancerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetContract(ctx context.Context, in *QueryGetContractRequest, opts ...grpc.CallOption) (*QueryGetContractResponse, error) {
+	out := new(QueryGetContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryApplicationsByFreelancerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Applications) > 0 {
-		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ListContract(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
+	out := new(QueryAllContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractsByUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ApplicationsByGig(ctx context.Context, in *QueryApplicationsByGigRequest, opts ...grpc.CallOption) (*QueryApplicationsByGigResponse, error) {
+	out := new(QueryApplicationsByGigResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ApplicationsByGig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractsByUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ApplicationsByFreelancer(ctx context.Context, in *QueryApplicationsByFreelancerRequest, opts ...grpc.CallOption) (*QueryApplicationsByFreelancerResponse, error) {
+	out := new(QueryApplicationsByFreelancerResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ApplicationsByFreelancer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractsByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ContractsByUser(ctx context.Context, in *QueryContractsByUserRequest, opts ...grpc.CallOption) (*QueryContractsByUserResponse, error) {
+	out := new(QueryContractsByUserResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ContractsByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractsByUserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractByGig(ctx context.Context, in *QueryContractByGigRequest, opts ...grpc.CallOption) (*QueryContractByGigResponse, error) {
+	out := new(QueryContractByGigResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ContractByGig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractsByUserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
+	out := new(QueryEscrowBalanceResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/EscrowBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractsByUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ContractEscrow(ctx context.Context, in *QueryContractEscrowRequest, opts ...grpc.CallOption) (*QueryContractEscrowResponse, error) {
+	out := new(QueryContractEscrowResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ContractEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractByGigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EscrowsByUser(ctx context.Context, in *QueryEscrowsByUserRequest, opts ...grpc.CallOption) (*QueryEscrowsByUserResponse, error) {
+	out := new(QueryEscrowsByUserResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/EscrowsByUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractByGigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FeeStats(ctx context.Context, in *QueryFeeStatsRequest, opts ...grpc.CallOption) (*QueryFeeStatsResponse, error) {
+	out := new(QueryFeeStatsResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/FeeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractByGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GigId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) CancellationProposal(ctx context.Context, in *QueryCancellationProposalRequest, opts ...grpc.CallOption) (*QueryCancellationProposalResponse, error) {
+	out := new(QueryCancellationProposalResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/CancellationProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractByGigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TipsByContract(ctx context.Context, in *QueryTipsByContractRequest, opts ...grpc.CallOption) (*QueryTipsByContractResponse, error) {
+	out := new(QueryTipsByContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/TipsByContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractByGigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TimeLogsByContract(ctx context.Context, in *QueryTimeLogsByContractRequest, opts ...grpc.CallOption) (*QueryTimeLogsByContractResponse, error) {
+	out := new(QueryTimeLogsByContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/TimeLogsByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractByGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Contract != nil {
-		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) StreamAccrual(ctx context.Context, in *QueryStreamAccrualRequest, opts ...grpc.CallOption) (*QueryStreamAccrualResponse, error) {
+	out := new(QueryStreamAccrualResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/StreamAccrual", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Amendment(ctx context.Context, in *QueryAmendmentRequest, opts ...grpc.CallOption) (*QueryAmendmentResponse, error) {
+	out := new(QueryAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/Amendment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FeePreview(ctx context.Context, in *QueryFeePreviewRequest, opts ...grpc.CallOption) (*QueryFeePreviewResponse, error) {
+	out := new(QueryFeePreviewResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/FeePreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) ReferralEarnings(ctx context.Context, in *QueryReferralEarningsRequest, opts ...grpc.CallOption) (*QueryReferralEarningsResponse, error) {
+	out := new(QueryReferralEarningsResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ReferralEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ReferredAccounts(ctx context.Context, in *QueryReferredAccountsRequest, opts ...grpc.CallOption) (*QueryReferredAccountsResponse, error) {
+	out := new(QueryReferredAccountsResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ReferredAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EscrowStaking(ctx context.Context, in *QueryEscrowStakingRequest, opts ...grpc.CallOption) (*QueryEscrowStakingResponse, error) {
+	out := new(QueryEscrowStakingResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/EscrowStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Balance != nil {
-		{
-			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) CategoryRule(ctx context.Context, in *QueryCategoryRuleRequest, opts ...grpc.CallOption) (*QueryCategoryRuleResponse, error) {
+	out := new(QueryCategoryRuleResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/CategoryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetDisputeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Arbiter(ctx context.Context, in *QueryArbiterRequest, opts ...grpc.CallOption) (*QueryArbiterResponse, error) {
+	out := new(QueryArbiterResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/Arbiter", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ActiveArbiters(ctx context.Context, in *QueryActiveArbitersRequest, opts ...grpc.CallOption) (*QueryActiveArbitersResponse, error) {
+	out := new(QueryActiveArbitersResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ActiveArbiters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ExtensionsByContract(ctx context.Context, in *QueryExtensionsByContractRequest, opts ...grpc.CallOption) (*QueryExtensionsByContractResponse, error) {
+	out := new(QueryExtensionsByContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ExtensionsByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDispute(ctx context.Context, in *QueryGetDisputeRequest, opts ...grpc.CallOption) (*QueryGetDisputeResponse, error) {
+	out := new(QueryGetDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetDispute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListDispute(ctx context.Context, in *QueryAllDisputeRequest, opts ...grpc.CallOption) (*QueryAllDisputeResponse, error) {
+	out := new(QueryAllDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetDisputeVote(ctx context.Context, in *QueryGetDisputeVoteRequest, opts ...grpc.CallOption) (*QueryGetDisputeVoteResponse, error) {
+	out := new(QueryGetDisputeVoteResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/GetDisputeVote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllDisputeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListDisputeVote(ctx context.Context, in *QueryAllDisputeVoteRequest, opts ...grpc.CallOption) (*QueryAllDisputeVoteResponse, error) {
+	out := new(QueryAllDisputeVoteResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Query/ListDisputeVote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListProfile Queries a list of Profile items.
+	GetProfile(context.Context, *QueryGetProfileRequest) (*QueryGetProfileResponse, error)
+	// ListProfile defines the ListProfile RPC.
+	ListProfile(context.Context, *QueryAllProfileRequest) (*QueryAllProfileResponse, error)
+	// ListGig Queries a list of Gig items.
+	GetGig(context.Context, *QueryGetGigRequest) (*QueryGetGigResponse, error)
+	// ListGig defines the ListGig RPC.
+	ListGig(context.Context, *QueryAllGigRequest) (*QueryAllGigResponse, error)
+	// ListApplication Queries a list of Application items.
+	GetApplication(context.Context, *QueryGetApplicationRequest) (*QueryGetApplicationResponse, error)
+	// ListApplication defines the ListApplication RPC.
+	ListApplication(context.Context, *QueryAllApplicationRequest) (*QueryAllApplicationResponse, error)
+	// ListContract Queries a list of Contract items.
+	GetContract(context.Context, *QueryGetContractRequest) (*QueryGetContractResponse, error)
+	// ListContract defines the ListContract RPC.
+	ListContract(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
+	// ApplicationsByGig Queries a list of ApplicationsByGig items.
+	ApplicationsByGig(context.Context, *QueryApplicationsByGigRequest) (*QueryApplicationsByGigResponse, error)
+	// ApplicationsByFreelancer Queries a list of ApplicationsByFreelancer items.
+	ApplicationsByFreelancer(context.Context, *QueryApplicationsByFreelancerRequest) (*QueryApplicationsByFreelancerResponse, error)
+	// ContractsByUser Queries a list of ContractsByUser items.
+	ContractsByUser(context.Context, *QueryContractsByUserRequest) (*QueryContractsByUserResponse, error)
+	// ContractByGig Queries a list of ContractByGig items.
+	ContractByGig(context.Context, *QueryContractByGigRequest) (*QueryContractByGigResponse, error)
+	// EscrowBalance Queries a list of EscrowBalance items.
+	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
+	// ContractEscrow Queries the escrow ledger of a contract.
+	ContractEscrow(context.Context, *QueryContractEscrowRequest) (*QueryContractEscrowResponse, error)
+	// EscrowsByUser Queries the escrow ledgers of the contracts of a user.
+	EscrowsByUser(context.Context, *QueryEscrowsByUserRequest) (*QueryEscrowsByUserResponse, error)
+	// FeeStats Queries the platform fees collected and distributed.
+	FeeStats(context.Context, *QueryFeeStatsRequest) (*QueryFeeStatsResponse, error)
+	// CancellationProposal Queries the pending cancellation proposal of a contract.
+	CancellationProposal(context.Context, *QueryCancellationProposalRequest) (*QueryCancellationProposalResponse, error)
+	// TipsByContract Queries the tips paid on a contract.
+	TipsByContract(context.Context, *QueryTipsByContractRequest) (*QueryTipsByContractResponse, error)
+	// TimeLogsByContract Queries the time logs of an hourly contract.
+	TimeLogsByContract(context.Context, *QueryTimeLogsByContractRequest) (*QueryTimeLogsByContractResponse, error)
+	// StreamAccrual Queries the amount accrued by a streaming contract.
+	StreamAccrual(context.Context, *QueryStreamAccrualRequest) (*QueryStreamAccrualResponse, error)
+	// Amendment Queries the pending amendment of a contract.
+	Amendment(context.Context, *QueryAmendmentRequest) (*QueryAmendmentResponse, error)
+	// FeePreview Queries the platform fee and net payout of a freelancer for a
+	// price.
+	FeePreview(context.Context, *QueryFeePreviewRequest) (*QueryFeePreviewResponse, error)
+	// ReferralEarnings Queries the fee shares earned by a referrer.
+	ReferralEarnings(context.Context, *QueryReferralEarningsRequest) (*QueryReferralEarningsResponse, error)
+	// ReferredAccounts Queries the accounts referred by a referrer.
+	ReferredAccounts(context.Context, *QueryReferredAccountsRequest) (*QueryReferredAccountsResponse, error)
+	// EscrowStaking Queries the escrow delegated to validators.
+	EscrowStaking(context.Context, *QueryEscrowStakingRequest) (*QueryEscrowStakingResponse, error)
+	// CategoryRule Queries the rules applied to the gigs of a category.
+	CategoryRule(context.Context, *QueryCategoryRuleRequest) (*QueryCategoryRuleResponse, error)
+	// Arbiter Queries an arbiter by address.
+	Arbiter(context.Context, *QueryArbiterRequest) (*QueryArbiterResponse, error)
+	// ActiveArbiters Queries the arbiters assigned to new disputes and their
+	// total stake.
+	ActiveArbiters(context.Context, *QueryActiveArbitersRequest) (*QueryActiveArbitersResponse, error)
+	// ExtensionsByContract Queries the deadline extension requests of a contract.
+	ExtensionsByContract(context.Context, *QueryExtensionsByContractRequest) (*QueryExtensionsByContractResponse, error)
+	// ListDispute Queries a list of Dispute items.
+	GetDispute(context.Context, *QueryGetDisputeRequest) (*QueryGetDisputeResponse, error)
+	// ListDispute defines the ListDispute RPC.
+	ListDispute(context.Context, *QueryAllDisputeRequest) (*QueryAllDisputeResponse, error)
+	// ListDisputeVote Queries a list of DisputeVote items.
+	GetDisputeVote(context.Context, *QueryGetDisputeVoteRequest) (*QueryGetDisputeVoteResponse, error)
+	// ListDisputeVote defines the ListDisputeVote RPC.
+	ListDisputeVote(context.Context, *QueryAllDisputeVoteRequest) (*QueryAllDisputeVoteResponse, error)
 }
 
-func (m *QueryAllDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetProfile(ctx context.Context, req *QueryGetProfileRequest) (*QueryGetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (*UnimplementedQueryServer) ListProfile(ctx context.Context, req *QueryAllProfileRequest) (*QueryAllProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfile not implemented")
+}
+func (*UnimplementedQueryServer) GetGig(ctx context.Context, req *QueryGetGigRequest) (*QueryGetGigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGig not implemented")
+}
+func (*UnimplementedQueryServer) ListGig(ctx context.Context, req *QueryAllGigRequest) (*QueryAllGigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGig not implemented")
+}
+func (*UnimplementedQueryServer) GetApplication(ctx context.Context, req *QueryGetApplicationRequest) (*QueryGetApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (*UnimplementedQueryServer) ListApplication(ctx context.Context, req *QueryAllApplicationRequest) (*QueryAllApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplication not implemented")
+}
+func (*UnimplementedQueryServer) GetContract(ctx context.Context, req *QueryGetContractRequest) (*QueryGetContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContract not implemented")
+}
+func (*UnimplementedQueryServer) ListContract(ctx context.Context, req *QueryAllContractRequest) (*QueryAllContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContract not implemented")
+}
+func (*UnimplementedQueryServer) ApplicationsByGig(ctx context.Context, req *QueryApplicationsByGigRequest) (*QueryApplicationsByGigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplicationsByGig not implemented")
+}
+func (*UnimplementedQueryServer) ApplicationsByFreelancer(ctx context.Context, req *QueryApplicationsByFreelancerRequest) (*QueryApplicationsByFreelancerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplicationsByFreelancer not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByUser(ctx context.Context, req *QueryContractsByUserRequest) (*QueryContractsByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByUser not implemented")
+}
+func (*UnimplementedQueryServer) ContractByGig(ctx context.Context, req *QueryContractByGigRequest) (*QueryContractByGigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractByGig not implemented")
+}
+func (*UnimplementedQueryServer) EscrowBalance(ctx context.Context, req *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+}
+func (*UnimplementedQueryServer) ContractEscrow(ctx context.Context, req *QueryContractEscrowRequest) (*QueryContractEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractEscrow not implemented")
+}
+func (*UnimplementedQueryServer) EscrowsByUser(ctx context.Context, req *QueryEscrowsByUserRequest) (*QueryEscrowsByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsByUser not implemented")
+}
+func (*UnimplementedQueryServer) FeeStats(ctx context.Context, req *QueryFeeStatsRequest) (*QueryFeeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeStats not implemented")
+}
+func (*UnimplementedQueryServer) CancellationProposal(ctx context.Context, req *QueryCancellationProposalRequest) (*QueryCancellationProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancellationProposal not implemented")
+}
+func (*UnimplementedQueryServer) TipsByContract(ctx context.Context, req *QueryTipsByContractRequest) (*QueryTipsByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TipsByContract not implemented")
+}
+func (*UnimplementedQueryServer) TimeLogsByContract(ctx context.Context, req *QueryTimeLogsByContractRequest) (*QueryTimeLogsByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeLogsByContract not implemented")
+}
+func (*UnimplementedQueryServer) StreamAccrual(ctx context.Context, req *QueryStreamAccrualRequest) (*QueryStreamAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamAccrual not implemented")
+}
+func (*UnimplementedQueryServer) Amendment(ctx context.Context, req *QueryAmendmentRequest) (*QueryAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Amendment not implemented")
+}
+func (*UnimplementedQueryServer) FeePreview(ctx context.Context, req *QueryFeePreviewRequest) (*QueryFeePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePreview not implemented")
+}
+func (*UnimplementedQueryServer) ReferralEarnings(ctx context.Context, req *QueryReferralEarningsRequest) (*QueryReferralEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferralEarnings not implemented")
+}
+func (*UnimplementedQueryServer) ReferredAccounts(ctx context.Context, req *QueryReferredAccountsRequest) (*QueryReferredAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferredAccounts not implemented")
+}
+func (*UnimplementedQueryServer) EscrowStaking(ctx context.Context, req *QueryEscrowStakingRequest) (*QueryEscrowStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowStaking not implemented")
+}
+func (*UnimplementedQueryServer) CategoryRule(ctx context.Context, req *QueryCategoryRuleRequest) (*QueryCategoryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryRule not implemented")
+}
+func (*UnimplementedQueryServer) Arbiter(ctx context.Context, req *QueryArbiterRequest) (*QueryArbiterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Arbiter not implemented")
+}
+func (*UnimplementedQueryServer) ActiveArbiters(ctx context.Context, req *QueryActiveArbitersRequest) (*QueryActiveArbitersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveArbiters not implemented")
+}
+func (*UnimplementedQueryServer) ExtensionsByContract(ctx context.Context, req *QueryExtensionsByContractRequest) (*QueryExtensionsByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtensionsByContract not implemented")
+}
+func (*UnimplementedQueryServer) GetDispute(ctx context.Context, req *QueryGetDisputeRequest) (*QueryGetDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (*UnimplementedQueryServer) ListDispute(ctx context.Context, req *QueryAllDisputeRequest) (*QueryAllDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDispute not implemented")
+}
+func (*UnimplementedQueryServer) GetDisputeVote(ctx context.Context, req *QueryGetDisputeVoteRequest) (*QueryGetDisputeVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDisputeVote not implemented")
+}
+func (*UnimplementedQueryServer) ListDisputeVote(ctx context.Context, req *QueryAllDisputeVoteRequest) (*QueryAllDisputeVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisputeVote not implemented")
 }
 
-func (m *QueryAllDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Dispute) > 0 {
-		for iNdEx := len(m.Dispute) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dispute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetDisputeVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetDisputeVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Arbiter) > 0 {
-		i -= len(m.Arbiter)
-		copy(dAtA[i:], m.Arbiter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Arbiter)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetProfile(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProfile(ctx, req.(*QueryGetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetDisputeVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ListProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ListProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListProfile(ctx, req.(*QueryAllProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DisputeVote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_GetGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetGig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/GetGig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetGig(ctx, req.(*QueryGetGigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDisputeVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllDisputeVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllDisputeVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ListGig(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ListGig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListGig(ctx, req.(*QueryAllGigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDisputeVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetApplicationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllDisputeVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllDisputeVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetApplication(ctx, in)
 	}
-	if len(m.DisputeVote) > 0 {
-		for iNdEx := len(m.DisputeVote) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DisputeVote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/GetApplication",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetApplication(ctx, req.(*QueryGetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_ListApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).ListApplication(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ListApplication",
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListApplication(ctx, req.(*QueryAllApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProfileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetContract(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetProfileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/GetContract",
 	}
-	var l int
-	_ = l
-	l = m.Profile.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetContract(ctx, req.(*QueryGetContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllProfileRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ListContract(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ListContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListContract(ctx, req.(*QueryAllContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllProfileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ApplicationsByGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApplicationsByGigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Profile) > 0 {
-		for _, e := range m.Profile {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ApplicationsByGig(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ApplicationsByGig",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApplicationsByGig(ctx, req.(*QueryApplicationsByGigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ApplicationsByFreelancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryApplicationsByFreelancerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).ApplicationsByFreelancer(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetGigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ApplicationsByFreelancer",
 	}
-	var l int
-	_ = l
-	l = m.Gig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ApplicationsByFreelancer(ctx, req.(*QueryApplicationsByFreelancerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByUser(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ContractsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByUser(ctx, req.(*QueryContractsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractByGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractByGigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Gig) > 0 {
-		for _, e := range m.Gig {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractByGig(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ContractByGig",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractByGig(ctx, req.(*QueryContractByGigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetApplicationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowBalance(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/EscrowBalance",
 	}
-	var l int
-	_ = l
-	l = m.Application.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllApplicationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractEscrow(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ContractEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractEscrow(ctx, req.(*QueryContractEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EscrowsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Application) > 0 {
-		for _, e := range m.Application {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsByUser(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/EscrowsByUser",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsByUser(ctx, req.(*QueryEscrowsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FeeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).FeeStats(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/FeeStats",
 	}
-	var l int
-	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeStats(ctx, req.(*QueryFeeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CancellationProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCancellationProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CancellationProposal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/CancellationProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CancellationProposal(ctx, req.(*QueryCancellationProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TipsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTipsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		for _, e := range m.Contract {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TipsByContract(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/TipsByContract",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TipsByContract(ctx, req.(*QueryTipsByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryApplicationsByGigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TimeLogsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeLogsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GigId != 0 {
-		n += 1 + sovQuery(uint64(m.GigId))
+	if interceptor == nil {
+		return srv.(QueryServer).TimeLogsByContract(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryApplicationsByGigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/TimeLogsByContract",
 	}
-	var l int
-	_ = l
-	if len(m.Applications) > 0 {
-		for _, e := range m.Applications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeLogsByContract(ctx, req.(*QueryTimeLogsByContractRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryApplicationsByFreelancerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StreamAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Freelancer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).StreamAccrual(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/StreamAccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamAccrual(ctx, req.(*QueryStreamAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryApplicationsByFreelancerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Amendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Applications) > 0 {
-		for _, e := range m.Applications {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Amendment(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryContractsByUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/Amendment",
 	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Amendment(ctx, req.(*QueryAmendmentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryContractsByUserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FeePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Contracts) > 0 {
-		for _, e := range m.Contracts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePreview(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryContractByGigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/FeePreview",
 	}
-	var l int
-	_ = l
-	if m.GigId != 0 {
-		n += 1 + sovQuery(uint64(m.GigId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePreview(ctx, req.(*QueryFeePreviewRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryContractByGigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ReferralEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Contract != nil {
-		l = m.Contract.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ReferralEarnings(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ReferralEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferralEarnings(ctx, req.(*QueryReferralEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEscrowBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ReferredAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferredAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ReferredAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ReferredAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReferredAccounts(ctx, req.(*QueryReferredAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEscrowBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EscrowStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowStakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowStaking(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/EscrowStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowStaking(ctx, req.(*QueryEscrowStakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CategoryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCategoryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).CategoryRule(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/CategoryRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CategoryRule(ctx, req.(*QueryCategoryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Arbiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArbiterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Dispute.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Arbiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/Arbiter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Arbiter(ctx, req.(*QueryArbiterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDisputeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ActiveArbiters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveArbitersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveArbiters(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ActiveArbiters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveArbiters(ctx, req.(*QueryActiveArbitersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ExtensionsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExtensionsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Dispute) > 0 {
-		for _, e := range m.Dispute {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ExtensionsByContract(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ExtensionsByContract",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExtensionsByContract(ctx, req.(*QueryExtensionsByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Arbiter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDispute(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/GetDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDispute(ctx, req.(*QueryGetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetDisputeVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.DisputeVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ListDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ListDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDispute(ctx, req.(*QueryAllDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDisputeVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetDisputeVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDisputeVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GetDisputeVote(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/GetDisputeVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDisputeVote(ctx, req.(*QueryGetDisputeVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllDisputeVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListDisputeVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDisputeVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DisputeVote) > 0 {
-		for _, e := range m.DisputeVote {
-			l = e.Size()
+	if interceptor == nil {
+		return srv.(QueryServer).ListDisputeVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Query/ListDisputeVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDisputeVote(ctx, req.(*QueryAllDisputeVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "skillchain.marketplace.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _Query_GetProfile_Handler,
+		},
+		{
+			MethodName: "ListProfile",
+			Handler:    _Query_ListProfile_Handler,
+		},
+		{
+			MethodName: "GetGig",
+			Handler:    _Query_GetGig_Handler,
+		},
+		{
+			MethodName: "ListGig",
+			Handler:    _Query_ListGig_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _Query_GetApplication_Handler,
+		},
+		{
+			MethodName: "ListApplication",
+			Handler:    _Query_ListApplication_Handler,
+		},
+		{
+			MethodName: "GetContract",
+			Handler:    _Query_GetContract_Handler,
+		},
+		{
+			MethodName: "ListContract",
+			Handler:    _Query_ListContract_Handler,
+		},
+		{
+			MethodName: "ApplicationsByGig",
+			Handler:    _Query_ApplicationsByGig_Handler,
+		},
+		{
+			MethodName: "ApplicationsByFreelancer",
+			Handler:    _Query_ApplicationsByFreelancer_Handler,
+		},
+		{
+			MethodName: "ContractsByUser",
+			Handler:    _Query_ContractsByUser_Handler,
+		},
+		{
+			MethodName: "ContractByGig",
+			Handler:    _Query_ContractByGig_Handler,
+		},
+		{
+			MethodName: "EscrowBalance",
+			Handler:    _Query_EscrowBalance_Handler,
+		},
+		{
+			MethodName: "ContractEscrow",
+			Handler:    _Query_ContractEscrow_Handler,
+		},
+		{
+			MethodName: "EscrowsByUser",
+			Handler:    _Query_EscrowsByUser_Handler,
+		},
+		{
+			MethodName: "FeeStats",
+			Handler:    _Query_FeeStats_Handler,
+		},
+		{
+			MethodName: "CancellationProposal",
+			Handler:    _Query_CancellationProposal_Handler,
+		},
+		{
+			MethodName: "TipsByContract",
+			Handler:    _Query_TipsByContract_Handler,
+		},
+		{
+			MethodName: "TimeLogsByContract",
+			Handler:    _Query_TimeLogsByContract_Handler,
+		},
+		{
+			MethodName: "StreamAccrual",
+			Handler:    _Query_StreamAccrual_Handler,
+		},
+		{
+			MethodName: "Amendment",
+			Handler:    _Query_Amendment_Handler,
+		},
+		{
+			MethodName: "FeePreview",
+			Handler:    _Query_FeePreview_Handler,
+		},
+		{
+			MethodName: "ReferralEarnings",
+			Handler:    _Query_ReferralEarnings_Handler,
+		},
+		{
+			MethodName: "ReferredAccounts",
+			Handler:    _Query_ReferredAccounts_Handler,
+		},
+		{
+			MethodName: "EscrowStaking",
+			Handler:    _Query_EscrowStaking_Handler,
+		},
+		{
+			MethodName: "CategoryRule",
+			Handler:    _Query_CategoryRule_Handler,
+		},
+		{
+			MethodName: "Arbiter",
+			Handler:    _Query_Arbiter_Handler,
+		},
+		{
+			MethodName: "ActiveArbiters",
+			Handler:    _Query_ActiveArbiters_Handler,
+		},
+		{
+			MethodName: "ExtensionsByContract",
+			Handler:    _Query_ExtensionsByContract_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _Query_GetDispute_Handler,
+		},
+		{
+			MethodName: "ListDispute",
+			Handler:    _Query_ListDispute_Handler,
+		},
+		{
+			MethodName: "GetDisputeVote",
+			Handler:    _Query_GetDisputeVote_Handler,
+		},
+		{
+			MethodName: "ListDisputeVote",
+			Handler:    _Query_ListDisputeVote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skillchain/marketplace/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Profile) > 0 {
+		for iNdEx := len(m.Profile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Gig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Funded {
+		i--
+		if m.Funded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Gig) > 0 {
+		for iNdEx := len(m.Gig) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gig[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetApplicationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetApplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllApplicationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllApplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Application) > 0 {
+		for iNdEx := len(m.Application) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Application[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApplicationsByGigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApplicationsByGigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApplicationsByGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GigId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GigId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApplicationsByGigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApplicationsByGigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApplicationsByGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApplicationsByFreelancerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApplicationsByFreelancerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApplicationsByFreelancerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryApplicationsByFreelancerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryApplicationsByFreelancerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryApplicationsByFreelancerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractByGigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractByGigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractByGigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GigId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GigId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractByGigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractByGigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractByGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetainedFees) > 0 {
+		for iNdEx := len(m.RetainedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetainedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Held) > 0 {
+		for iNdEx := len(m.Held) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Held[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsByUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsByUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsByUserResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsByUserResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsByUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Held) > 0 {
+		for iNdEx := len(m.Held) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Held[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dispute) > 0 {
+		for iNdEx := len(m.Dispute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dispute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDisputeVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDisputeVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDisputeVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDisputeVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDisputeVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDisputeVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DisputeVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDisputeVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDisputeVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDisputeVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDisputeVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDisputeVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDisputeVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DisputeVote) > 0 {
+		for iNdEx := len(m.DisputeVote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisputeVote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancellationProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancellationProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancellationProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancellationProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancellationProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancellationProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTipsByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTipsByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTipsByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTipsByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTipsByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTipsByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tips) > 0 {
+		for iNdEx := len(m.Tips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeLogsByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeLogsByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeLogsByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeLogsByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeLogsByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeLogsByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeLogs) > 0 {
+		for iNdEx := len(m.TimeLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamAccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamAccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamAccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamAccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamAccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamAccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claimable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Claimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Accrued.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmendmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmendmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmendmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amendment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExtensionsByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExtensionsByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExtensionsByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExtensionsByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExtensionsByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExtensionsByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		for iNdEx := len(m.Extensions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Extensions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Net.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferredCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReferredCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Earned) > 0 {
+		for iNdEx := len(m.Earned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferredAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferredAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferredAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferredAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferredAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferredAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowStakingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowStakingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowStakingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Liquid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.OptedIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCategoryRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCategoryRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCategoryRuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCategoryRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCategoryRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCategoryRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArbiterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArbiterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArbiterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArbiterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArbiterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArbiterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccuracyBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccuracyBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Arbiter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveArbitersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveArbitersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveArbitersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveArbitersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveArbitersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveArbitersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Arbiters) > 0 {
+		for iNdEx := len(m.Arbiters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Arbiters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Profile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profile) > 0 {
+		for _, e := range m.Profile {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetGigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Funded {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllGigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gig) > 0 {
+		for _, e := range m.Gig {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetApplicationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Application.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllApplicationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Application) > 0 {
+		for _, e := range m.Application {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		for _, e := range m.Contract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApplicationsByGigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GigId != 0 {
+		n += 1 + sovQuery(uint64(m.GigId))
+	}
+	return n
+}
+
+func (m *QueryApplicationsByGigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryApplicationsByFreelancerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryApplicationsByFreelancerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractsByUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractByGigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GigId != 0 {
+		n += 1 + sovQuery(uint64(m.GigId))
+	}
+	return n
+}
+
+func (m *QueryContractByGigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contract != nil {
+		l = m.Contract.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEscrowBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RetainedFees) > 0 {
+		for _, e := range m.RetainedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryContractEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Held) > 0 {
+		for _, e := range m.Held {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEscrowsByUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowsByUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Held) > 0 {
+		for _, e := range m.Held {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Dispute.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dispute) > 0 {
+		for _, e := range m.Dispute {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDisputeVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDisputeVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DisputeVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDisputeVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDisputeVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisputeVote) > 0 {
+		for _, e := range m.DisputeVote {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCancellationProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryCancellationProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTipsByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryTipsByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tips) > 0 {
+		for _, e := range m.Tips {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTimeLogsByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryTimeLogsByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TimeLogs) > 0 {
+		for _, e := range m.TimeLogs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStreamAccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryStreamAccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accrued.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAmendmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amendment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExtensionsByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryExtensionsByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		for _, e := range m.Extensions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeePreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.FeeBps))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Net.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReferralEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReferralEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Earned) > 0 {
+		for _, e := range m.Earned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ReferredCount != 0 {
+		n += 1 + sovQuery(uint64(m.ReferredCount))
+	}
+	return n
+}
+
+func (m *QueryReferredAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReferredAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEscrowStakingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEscrowStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OptedIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liquid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCategoryRuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCategoryRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Overridden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryArbiterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArbiterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Arbiter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AccuracyBps != 0 {
+		n += 1 + sovQuery(uint64(m.AccuracyBps))
+	}
+	return n
+}
+
+func (m *QueryActiveArbitersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveArbitersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Arbiters) > 0 {
+		for _, e := range m.Arbiters {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = append(m.Profile, Profile{})
+			if err := m.Profile[len(m.Profile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Funded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllGigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllGigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gig = append(m.Gig, Gig{})
+			if err := m.Gig[len(m.Gig)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetApplicationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetApplicationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllApplicationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllApplicationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = append(m.Application, Application{})
+			if err := m.Application[len(m.Application)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = append(m.Contract, Contract{})
+			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApplicationsByGigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApplicationsByGigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApplicationsByGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			}
+			m.GigId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GigId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApplicationsByGigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApplicationsByGigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApplicationsByGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, Application{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApplicationsByFreelancerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApplicationsByFreelancerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApplicationsByFreelancerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryApplicationsByFreelancerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryApplicationsByFreelancerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryApplicationsByFreelancerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, Application{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByUserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByUserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, Contract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractByGigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractByGigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractByGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			}
+			m.GigId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GigId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractByGigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractByGigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractByGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contract == nil {
+				m.Contract = &Contract{}
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetainedFees = append(m.RetainedFees, types.Coin{})
+			if err := m.RetainedFees[len(m.RetainedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Held = append(m.Held, types.Coin{})
+			if err := m.Held[len(m.Held)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowsByUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsByUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowsByUserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsByUserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsByUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, ContractEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Held = append(m.Held, types.Coin{})
+			if err := m.Held[len(m.Held)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extensions = append(m.Extensions, DeadlineExtension{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4325,12 +11444,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dispute = append(m.Dispute, Dispute{})
+			if err := m.Dispute[len(m.Dispute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4352,7 +11541,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4375,17 +11564,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDisputeVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDisputeVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4395,24 +11584,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4435,7 +11623,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProfileRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4458,17 +11646,17 @@ func (m *QueryGetProfileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProfileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDisputeVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDisputeVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeVote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4478,23 +11666,24 @@ func (m *QueryGetProfileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.DisputeVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4517,7 +11706,7 @@ func (m *QueryGetProfileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProfileResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4540,15 +11729,15 @@ func (m *QueryGetProfileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProfileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDisputeVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDisputeVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4575,7 +11764,10 @@ func (m *QueryGetProfileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4600,7 +11792,7 @@ func (m *QueryGetProfileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProfileRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4623,13 +11815,47 @@ func (m *QueryAllProfileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProfileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllDisputeVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllDisputeVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeVote = append(m.DisputeVote, DisputeVote{})
+			if err := m.DisputeVote[len(m.DisputeVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4658,13 +11884,63 @@ func (m *QueryAllProfileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4686,7 +11962,7 @@ func (m *QueryAllProfileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProfileResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4709,15 +11985,15 @@ func (m *QueryAllProfileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProfileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4744,14 +12020,13 @@ func (m *QueryAllProfileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = append(m.Profile, Profile{})
-			if err := m.Profile[len(m.Profile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4778,10 +12053,8 @@ func (m *QueryAllProfileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pending = append(m.Pending, types.Coin{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4806,7 +12079,7 @@ func (m *QueryAllProfileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCancellationProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4829,17 +12102,17 @@ func (m *QueryGetGigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCancellationProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCancellationProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4849,7 +12122,7 @@ func (m *QueryGetGigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4875,7 +12148,7 @@ func (m *QueryGetGigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCancellationProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4898,15 +12171,15 @@ func (m *QueryGetGigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCancellationProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCancellationProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4933,7 +12206,7 @@ func (m *QueryGetGigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Gig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4958,7 +12231,7 @@ func (m *QueryGetGigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTipsByContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4981,17 +12254,17 @@ func (m *QueryAllGigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTipsByContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTipsByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5001,28 +12274,11 @@ func (m *QueryAllGigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5044,7 +12300,7 @@ func (m *QueryAllGigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTipsByContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,15 +12323,15 @@ func (m *QueryAllGigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTipsByContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTipsByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tips", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5102,14 +12358,14 @@ func (m *QueryAllGigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gig = append(m.Gig, Gig{})
-			if err := m.Gig[len(m.Gig)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tips = append(m.Tips, Tip{})
+			if err := m.Tips[len(m.Tips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5136,10 +12392,8 @@ func (m *QueryAllGigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5164,7 +12418,7 @@ func (m *QueryAllGigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetApplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeLogsByContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5187,17 +12441,17 @@ func (m *QueryGetApplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetApplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeLogsByContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeLogsByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5207,7 +12461,7 @@ func (m *QueryGetApplicationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5233,7 +12487,7 @@ func (m *QueryGetApplicationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeLogsByContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5256,15 +12510,15 @@ func (m *QueryGetApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeLogsByContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeLogsByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLogs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5291,7 +12545,8 @@ func (m *QueryGetApplicationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TimeLogs = append(m.TimeLogs, TimeLog{})
+			if err := m.TimeLogs[len(m.TimeLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5316,7 +12571,7 @@ func (m *QueryGetApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllApplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamAccrualRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5339,17 +12594,17 @@ func (m *QueryAllApplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllApplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamAccrualRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamAccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5359,28 +12614,11 @@ func (m *QueryAllApplicationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5402,7 +12640,7 @@ func (m *QueryAllApplicationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamAccrualResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5425,15 +12663,15 @@ func (m *QueryAllApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamAccrualResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamAccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5460,14 +12698,13 @@ func (m *QueryAllApplicationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = append(m.Application, Application{})
-			if err := m.Application[len(m.Application)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5494,135 +12731,13 @@ func (m *QueryAllApplicationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetContractRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetContractRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5649,7 +12764,7 @@ func (m *QueryGetContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5674,7 +12789,7 @@ func (m *QueryGetContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAmendmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5697,17 +12812,17 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmendmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmendmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5717,28 +12832,11 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5760,7 +12858,7 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAmendmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5783,49 +12881,15 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmendmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = append(m.Contract, Contract{})
-			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5852,10 +12916,7 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amendment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5880,7 +12941,7 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryApplicationsByGigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExtensionsByContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5903,17 +12964,17 @@ func (m *QueryApplicationsByGigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryApplicationsByGigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExtensionsByContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryApplicationsByGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExtensionsByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.GigId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5923,7 +12984,7 @@ func (m *QueryApplicationsByGigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5949,7 +13010,7 @@ func (m *QueryApplicationsByGigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryApplicationsByGigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExtensionsByContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5972,15 +13033,15 @@ func (m *QueryApplicationsByGigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryApplicationsByGigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExtensionsByContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryApplicationsByGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExtensionsByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6007,8 +13068,8 @@ func (m *QueryApplicationsByGigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Applications = append(m.Applications, Application{})
-			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Extensions = append(m.Extensions, DeadlineExtension{})
+			if err := m.Extensions[len(m.Extensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6033,7 +13094,7 @@ func (m *QueryApplicationsByGigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryApplicationsByFreelancerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6056,15 +13117,15 @@ func (m *QueryApplicationsByFreelancerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryApplicationsByFreelancerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryApplicationsByFreelancerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6092,7 +13153,72 @@ func (m *QueryApplicationsByFreelancerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6115,7 +13241,7 @@ func (m *QueryApplicationsByFreelancerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryApplicationsByFreelancerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6138,15 +13264,66 @@ func (m *QueryApplicationsByFreelancerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryApplicationsByFreelancerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryApplicationsByFreelancerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6173,8 +13350,40 @@ func (m *QueryApplicationsByFreelancerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Applications = append(m.Applications, Application{})
-			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Net.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6199,7 +13408,7 @@ func (m *QueryApplicationsByFreelancerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractsByUserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReferralEarningsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6222,15 +13431,15 @@ func (m *QueryContractsByUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReferralEarningsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReferralEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6258,7 +13467,7 @@ func (m *QueryContractsByUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6281,7 +13490,7 @@ func (m *QueryContractsByUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractsByUserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReferralEarningsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6304,15 +13513,15 @@ func (m *QueryContractsByUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReferralEarningsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReferralEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6339,11 +13548,30 @@ func (m *QueryContractsByUserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, Contract{})
-			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Earned = append(m.Earned, types.Coin{})
+			if err := m.Earned[len(m.Earned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferredCount", wireType)
+			}
+			m.ReferredCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferredCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6365,7 +13593,7 @@ func (m *QueryContractsByUserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractByGigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReferredAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6388,17 +13616,17 @@ func (m *QueryContractByGigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractByGigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReferredAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractByGigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReferredAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
-			m.GigId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6408,11 +13636,24 @@ func (m *QueryContractByGigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6434,7 +13675,7 @@ func (m *QueryContractByGigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractByGigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReferredAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6457,15 +13698,15 @@ func (m *QueryContractByGigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractByGigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReferredAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractByGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReferredAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6492,10 +13733,8 @@ func (m *QueryContractByGigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contract == nil {
-				m.Contract = &Contract{}
-			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Referrals = append(m.Referrals, Referral{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6520,7 +13759,7 @@ func (m *QueryContractByGigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowStakingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6543,10 +13782,10 @@ func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowStakingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowStakingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6570,7 +13809,7 @@ func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEscrowStakingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6593,15 +13832,15 @@ func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEscrowStakingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEscrowStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6628,68 +13867,15 @@ func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balance == nil {
-				m.Balance = &types.Coin{}
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetDisputeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDisputeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedIn", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6699,64 +13885,28 @@ func (m *QueryGetDisputeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetDisputeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.OptedIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDisputeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6783,7 +13933,7 @@ func (m *QueryGetDisputeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liquid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6808,7 +13958,7 @@ func (m *QueryGetDisputeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDisputeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCategoryRuleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6831,17 +13981,17 @@ func (m *QueryAllDisputeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDisputeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCategoryRuleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCategoryRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6851,27 +14001,23 @@ func (m *QueryAllDisputeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6894,7 +14040,7 @@ func (m *QueryAllDisputeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCategoryRuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6917,15 +14063,15 @@ func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDisputeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCategoryRuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCategoryRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6952,16 +14098,15 @@ func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dispute = append(m.Dispute, Dispute{})
-			if err := m.Dispute[len(m.Dispute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6971,28 +14116,12 @@ func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Overridden = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7014,7 +14143,7 @@ func (m *QueryAllDisputeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDisputeVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryArbiterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7037,15 +14166,15 @@ func (m *QueryGetDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDisputeVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArbiterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDisputeVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArbiterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7073,7 +14202,7 @@ func (m *QueryGetDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7096,7 +14225,7 @@ func (m *QueryGetDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDisputeVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryArbiterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7119,15 +14248,15 @@ func (m *QueryGetDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDisputeVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArbiterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDisputeVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArbiterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7154,10 +14283,29 @@ func (m *QueryGetDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DisputeVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arbiter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccuracyBps", wireType)
+			}
+			m.AccuracyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccuracyBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7179,7 +14327,7 @@ func (m *QueryGetDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDisputeVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveArbitersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7202,10 +14350,10 @@ func (m *QueryAllDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDisputeVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveArbitersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDisputeVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveArbitersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7265,7 +14413,7 @@ func (m *QueryAllDisputeVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDisputeVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveArbitersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7288,15 +14436,15 @@ func (m *QueryAllDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDisputeVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveArbitersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDisputeVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveArbitersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7323,8 +14471,8 @@ func (m *QueryAllDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisputeVote = append(m.DisputeVote, DisputeVote{})
-			if err := m.DisputeVote[len(m.DisputeVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Arbiters = append(m.Arbiters, Arbiter{})
+			if err := m.Arbiters[len(m.Arbiters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7364,6 +14512,39 @@ func (m *QueryAllDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
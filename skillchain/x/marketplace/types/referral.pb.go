@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/referral.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Referral links an account to the account that referred it. The referrer
+// earns a share of the platform fees charged on the first contracts of the
+// referred account.
+type Referral struct {
+	Referred string `protobuf:"bytes,1,opt,name=referred,proto3" json:"referred,omitempty"`
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	// Number of contracts of the referred account the referrer earned fee
+	// shares on. A contract counts from its first fee share.
+	RewardedContracts uint64                                   `protobuf:"varint,3,opt,name=rewarded_contracts,json=rewardedContracts,proto3" json:"rewarded_contracts,omitempty"`
+	Earned            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=earned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned"`
+	CreatedAt         int64                                    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Contracts counted towards rewarded_contracts, which keep paying fee
+	// shares until they close.
+	ContractIds []uint64 `protobuf:"varint,6,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+}
+
+func (m *Referral) Reset()         { *m = Referral{} }
+func (m *Referral) String() string { return proto.CompactTextString(m) }
+func (*Referral) ProtoMessage()    {}
+func (*Referral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13520f0159998ca0, []int{0}
+}
+func (m *Referral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Referral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Referral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Referral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Referral.Merge(m, src)
+}
+func (m *Referral) XXX_Size() int {
+	return m.Size()
+}
+func (m *Referral) XXX_DiscardUnknown() {
+	xxx_messageInfo_Referral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Referral proto.InternalMessageInfo
+
+func (m *Referral) GetReferred() string {
+	if m != nil {
+		return m.Referred
+	}
+	return ""
+}
+
+func (m *Referral) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *Referral) GetRewardedContracts() uint64 {
+	if m != nil {
+		return m.RewardedContracts
+	}
+	return 0
+}
+
+func (m *Referral) GetEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earned
+	}
+	return nil
+}
+
+func (m *Referral) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Referral) GetContractIds() []uint64 {
+	if m != nil {
+		return m.ContractIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Referral)(nil), "skillchain.marketplace.v1.Referral")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/referral.proto", fileDescriptor_13520f0159998ca0)
+}
+
+var fileDescriptor_13520f0159998ca0 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0xc6, 0xe3, 0xa6, 0xb7, 0x6a, 0xdd, 0xbb, 0x34, 0xba, 0x43, 0x5a, 0xe9, 0xba, 0x81, 0x29,
+	0x42, 0xaa, 0xad, 0x82, 0x90, 0x58, 0x69, 0x27, 0xd6, 0x8c, 0x2c, 0x95, 0x63, 0x9b, 0xd6, 0x6a,
+	0x12, 0x57, 0xb6, 0x29, 0xf0, 0x16, 0xbc, 0x00, 0x3b, 0x62, 0xe2, 0x31, 0x3a, 0x76, 0x64, 0x02,
+	0xd4, 0x0e, 0xbc, 0x06, 0x6a, 0xfe, 0x40, 0xbb, 0x24, 0xc7, 0xdf, 0xf7, 0xf9, 0xf8, 0x67, 0x1f,
+	0x18, 0x9a, 0xb9, 0x4c, 0x12, 0x36, 0xa3, 0x32, 0x23, 0x29, 0xd5, 0x73, 0x61, 0x17, 0x09, 0x65,
+	0x82, 0x2c, 0x87, 0x44, 0x8b, 0x1b, 0xa1, 0x35, 0x4d, 0xf0, 0x42, 0x2b, 0xab, 0xbc, 0xee, 0x6f,
+	0x12, 0xef, 0x25, 0xf1, 0x72, 0xd8, 0xeb, 0xd0, 0x54, 0x66, 0x8a, 0xe4, 0xdf, 0x22, 0xdd, 0x43,
+	0x4c, 0x99, 0x54, 0x19, 0x12, 0x53, 0xb3, 0x6b, 0x16, 0x0b, 0x4b, 0x87, 0x84, 0x29, 0x99, 0x95,
+	0xfe, 0xbf, 0xa9, 0x9a, 0xaa, 0xbc, 0x24, 0xbb, 0xaa, 0x50, 0x8f, 0x9f, 0x6a, 0xb0, 0x19, 0x95,
+	0xc7, 0x7a, 0x3d, 0xd8, 0x2c, 0x10, 0x04, 0xf7, 0x41, 0x00, 0xc2, 0x56, 0xf4, 0xb3, 0xde, 0xf3,
+	0xb4, 0x5f, 0x3b, 0xf0, 0xb4, 0x37, 0x80, 0x9e, 0x16, 0x77, 0x54, 0x73, 0xc1, 0x27, 0x4c, 0x65,
+	0x56, 0x53, 0x66, 0x8d, 0xef, 0x06, 0x20, 0xac, 0x47, 0x9d, 0xca, 0x19, 0x57, 0x86, 0x37, 0x83,
+	0x0d, 0x41, 0x75, 0x26, 0xb8, 0x5f, 0x0f, 0xdc, 0xb0, 0x7d, 0xda, 0xc5, 0x05, 0x3a, 0xde, 0xa1,
+	0xe3, 0x12, 0x1d, 0x8f, 0x95, 0xcc, 0x46, 0xe7, 0xab, 0xf7, 0xbe, 0xf3, 0xf2, 0xd1, 0x0f, 0xa7,
+	0xd2, 0xce, 0x6e, 0x63, 0xcc, 0x54, 0x4a, 0xca, 0x7b, 0x16, 0xbf, 0x81, 0xe1, 0x73, 0x62, 0x1f,
+	0x16, 0xc2, 0xe4, 0x1b, 0xcc, 0xf3, 0xd7, 0xeb, 0x09, 0x88, 0xca, 0xfe, 0xde, 0x7f, 0x08, 0x99,
+	0x16, 0xd4, 0x0a, 0x3e, 0xa1, 0xd6, 0xff, 0x13, 0x80, 0xd0, 0x8d, 0x5a, 0xa5, 0x72, 0x69, 0xbd,
+	0x23, 0xf8, 0xb7, 0xc2, 0x9d, 0x48, 0x6e, 0xfc, 0x46, 0xe0, 0x86, 0xf5, 0xa8, 0x5d, 0x69, 0x57,
+	0xdc, 0x8c, 0x2e, 0x56, 0x1b, 0x04, 0xd6, 0x1b, 0x04, 0x3e, 0x37, 0x08, 0x3c, 0x6e, 0x91, 0xb3,
+	0xde, 0x22, 0xe7, 0x6d, 0x8b, 0x9c, 0x6b, 0xb4, 0x37, 0xc7, 0xfb, 0x83, 0x49, 0xe6, 0x38, 0x71,
+	0x23, 0x7f, 0xe0, 0xb3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xb9, 0x91, 0xb3, 0xf0, 0x01,
+	0x00, 0x00,
+}
+
+func (m *Referral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Referral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Referral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractIds) > 0 {
+		dAtA2 := make([]byte, len(m.ContractIds)*10)
+		var j1 int
+		for _, num := range m.ContractIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintReferral(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintReferral(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Earned) > 0 {
+		for iNdEx := len(m.Earned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReferral(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RewardedContracts != 0 {
+		i = encodeVarintReferral(dAtA, i, uint64(m.RewardedContracts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintReferral(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Referred) > 0 {
+		i -= len(m.Referred)
+		copy(dAtA[i:], m.Referred)
+		i = encodeVarintReferral(dAtA, i, uint64(len(m.Referred)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReferral(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReferral(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Referral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referred)
+	if l > 0 {
+		n += 1 + l + sovReferral(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovReferral(uint64(l))
+	}
+	if m.RewardedContracts != 0 {
+		n += 1 + sovReferral(uint64(m.RewardedContracts))
+	}
+	if len(m.Earned) > 0 {
+		for _, e := range m.Earned {
+			l = e.Size()
+			n += 1 + l + sovReferral(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovReferral(uint64(m.CreatedAt))
+	}
+	if len(m.ContractIds) > 0 {
+		l = 0
+		for _, e := range m.ContractIds {
+			l += sovReferral(uint64(e))
+		}
+		n += 1 + sovReferral(uint64(l)) + l
+	}
+	return n
+}
+
+func sovReferral(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReferral(x uint64) (n int) {
+	return sovReferral(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Referral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Referral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Referral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referred", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referred = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardedContracts", wireType)
+			}
+			m.RewardedContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardedContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earned = append(m.Earned, types.Coin{})
+			if err := m.Earned[len(m.Earned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReferral
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ContractIds = append(m.ContractIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReferral
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthReferral
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthReferral
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ContractIds) == 0 {
+					m.ContractIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReferral
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractIds = append(m.ContractIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReferral(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReferral(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReferral
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReferral
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReferral
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReferral        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReferral          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReferral = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// IsStreaming reports whether the contract price accrues linearly to the
+// freelancer over time instead of being released on delivery.
+func (c Contract) IsStreaming() bool {
+	return c.StreamEnd > 0
+}
+
+// StreamedAmount returns the part of the price accrued to the freelancer at
+// time now, claimed or not.
+func (c Contract) StreamedAmount(now int64) math.Int {
+	if now >= c.StreamEnd {
+		return c.Price.Amount
+	}
+	if now <= c.StreamStart {
+		return math.ZeroInt()
+	}
+	return c.Price.Amount.MulRaw(now - c.StreamStart).QuoRaw(c.StreamEnd - c.StreamStart)
+}
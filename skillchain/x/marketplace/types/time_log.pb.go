@@ -0,0 +1,695 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/time_log.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TimeLog is an entry of hours worked on an hourly contract. Pending entries
+// are billed at the first billing epoch after their contest window, unless the
+// client contested them.
+type TimeLog struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Freelancer string `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	Hours      uint64 `protobuf:"varint,4,opt,name=hours,proto3" json:"hours,omitempty"`
+	// Hash of the off-chain description of the work done.
+	DescriptionHash string `protobuf:"bytes,5,opt,name=description_hash,json=descriptionHash,proto3" json:"description_hash,omitempty"`
+	LoggedAt        int64  `protobuf:"varint,6,opt,name=logged_at,json=loggedAt,proto3" json:"logged_at,omitempty"`
+	ContestDeadline int64  `protobuf:"varint,7,opt,name=contest_deadline,json=contestDeadline,proto3" json:"contest_deadline,omitempty"`
+	// pending, contested or billed.
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ContestReason string `protobuf:"bytes,9,opt,name=contest_reason,json=contestReason,proto3" json:"contest_reason,omitempty"`
+	BilledAt      int64  `protobuf:"varint,10,opt,name=billed_at,json=billedAt,proto3" json:"billed_at,omitempty"`
+}
+
+func (m *TimeLog) Reset()         { *m = TimeLog{} }
+func (m *TimeLog) String() string { return proto.CompactTextString(m) }
+func (*TimeLog) ProtoMessage()    {}
+func (*TimeLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5df234003f4d2a8, []int{0}
+}
+func (m *TimeLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLog.Merge(m, src)
+}
+func (m *TimeLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeLog proto.InternalMessageInfo
+
+func (m *TimeLog) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TimeLog) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *TimeLog) GetFreelancer() string {
+	if m != nil {
+		return m.Freelancer
+	}
+	return ""
+}
+
+func (m *TimeLog) GetHours() uint64 {
+	if m != nil {
+		return m.Hours
+	}
+	return 0
+}
+
+func (m *TimeLog) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
+}
+
+func (m *TimeLog) GetLoggedAt() int64 {
+	if m != nil {
+		return m.LoggedAt
+	}
+	return 0
+}
+
+func (m *TimeLog) GetContestDeadline() int64 {
+	if m != nil {
+		return m.ContestDeadline
+	}
+	return 0
+}
+
+func (m *TimeLog) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TimeLog) GetContestReason() string {
+	if m != nil {
+		return m.ContestReason
+	}
+	return ""
+}
+
+func (m *TimeLog) GetBilledAt() int64 {
+	if m != nil {
+		return m.BilledAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TimeLog)(nil), "skillchain.marketplace.v1.TimeLog")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/time_log.proto", fileDescriptor_b5df234003f4d2a8)
+}
+
+var fileDescriptor_b5df234003f4d2a8 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x9b, 0xe9, 0xff, 0x7c, 0x7c, 0xad, 0x04, 0x91, 0x88, 0x10, 0x8b, 0x20, 0x8c, 0x9b,
+	0x96, 0xe2, 0xc6, 0x6d, 0xc5, 0x85, 0x82, 0xab, 0xc1, 0x95, 0x9b, 0x21, 0x9d, 0x5c, 0x67, 0x42,
+	0xd3, 0xc9, 0x90, 0xa4, 0x45, 0xdf, 0xc2, 0x37, 0x72, 0xeb, 0xb2, 0x4b, 0x97, 0xd2, 0xbe, 0x88,
+	0x34, 0x33, 0xc5, 0x71, 0x79, 0x7f, 0xf7, 0xdc, 0x73, 0x0f, 0x1c, 0x1c, 0xda, 0x85, 0x54, 0x2a,
+	0xc9, 0xb8, 0xcc, 0x27, 0x4b, 0x6e, 0x16, 0xe0, 0x0a, 0xc5, 0x13, 0x98, 0xac, 0xa7, 0x13, 0x27,
+	0x97, 0x10, 0x2b, 0x9d, 0x8e, 0x0b, 0xa3, 0x9d, 0x26, 0xa7, 0xbf, 0xca, 0x71, 0x4d, 0x39, 0x5e,
+	0x4f, 0x2f, 0x3e, 0x02, 0xdc, 0x7d, 0x92, 0x4b, 0x78, 0xd4, 0x29, 0x19, 0xe0, 0x40, 0x0a, 0x8a,
+	0x46, 0x28, 0x6c, 0x45, 0x81, 0x14, 0xe4, 0x1c, 0xff, 0x4b, 0x74, 0xee, 0x0c, 0x4f, 0x5c, 0x2c,
+	0x05, 0x0d, 0xfc, 0x02, 0x1f, 0xd0, 0x83, 0x20, 0x0c, 0xe3, 0x17, 0x03, 0xa0, 0x78, 0x9e, 0x80,
+	0xa1, 0xcd, 0x11, 0x0a, 0xfb, 0x51, 0x8d, 0x90, 0x63, 0xdc, 0xce, 0xf4, 0xca, 0x58, 0xda, 0xf2,
+	0xa7, 0xe5, 0x40, 0xae, 0xf0, 0x91, 0x00, 0x9b, 0x18, 0x59, 0x38, 0xa9, 0xf3, 0x38, 0xe3, 0x36,
+	0xa3, 0x6d, 0x7f, 0x3b, 0xac, 0xf1, 0x7b, 0x6e, 0x33, 0x72, 0x86, 0xfb, 0x4a, 0xa7, 0x29, 0x88,
+	0x98, 0x3b, 0xda, 0x19, 0xa1, 0xb0, 0x19, 0xf5, 0x4a, 0x30, 0x73, 0x7b, 0x9f, 0x7d, 0x16, 0xb0,
+	0x2e, 0x16, 0xc0, 0x85, 0x92, 0x39, 0xd0, 0xae, 0xd7, 0x0c, 0x2b, 0x7e, 0x57, 0x61, 0x72, 0x82,
+	0x3b, 0xd6, 0x71, 0xb7, 0xb2, 0xb4, 0xe7, 0x1f, 0x55, 0x13, 0xb9, 0xc4, 0x83, 0x83, 0x85, 0x01,
+	0x6e, 0x75, 0x4e, 0xfb, 0x7e, 0xff, 0xbf, 0xa2, 0x91, 0x87, 0xfb, 0x18, 0x73, 0xa9, 0x54, 0x19,
+	0x03, 0x97, 0x31, 0x4a, 0x30, 0x73, 0xb7, 0x37, 0x9f, 0x5b, 0x86, 0x36, 0x5b, 0x86, 0xbe, 0xb7,
+	0x0c, 0xbd, 0xef, 0x58, 0x63, 0xb3, 0x63, 0x8d, 0xaf, 0x1d, 0x6b, 0x3c, 0xb3, 0x5a, 0x41, 0xaf,
+	0x7f, 0x2a, 0x72, 0x6f, 0x05, 0xd8, 0x79, 0xc7, 0xb7, 0x73, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff,
+	0xa1, 0xab, 0xaa, 0xa9, 0xc9, 0x01, 0x00, 0x00,
+}
+
+func (m *TimeLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BilledAt != 0 {
+		i = encodeVarintTimeLog(dAtA, i, uint64(m.BilledAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContestReason) > 0 {
+		i -= len(m.ContestReason)
+		copy(dAtA[i:], m.ContestReason)
+		i = encodeVarintTimeLog(dAtA, i, uint64(len(m.ContestReason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTimeLog(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ContestDeadline != 0 {
+		i = encodeVarintTimeLog(dAtA, i, uint64(m.ContestDeadline))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LoggedAt != 0 {
+		i = encodeVarintTimeLog(dAtA, i, uint64(m.LoggedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DescriptionHash) > 0 {
+		i -= len(m.DescriptionHash)
+		copy(dAtA[i:], m.DescriptionHash)
+		i = encodeVarintTimeLog(dAtA, i, uint64(len(m.DescriptionHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Hours != 0 {
+		i = encodeVarintTimeLog(dAtA, i, uint64(m.Hours))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintTimeLog(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTimeLog(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTimeLog(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimeLog(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimeLog(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TimeLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTimeLog(uint64(m.Id))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTimeLog(uint64(m.ContractId))
+	}
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovTimeLog(uint64(l))
+	}
+	if m.Hours != 0 {
+		n += 1 + sovTimeLog(uint64(m.Hours))
+	}
+	l = len(m.DescriptionHash)
+	if l > 0 {
+		n += 1 + l + sovTimeLog(uint64(l))
+	}
+	if m.LoggedAt != 0 {
+		n += 1 + sovTimeLog(uint64(m.LoggedAt))
+	}
+	if m.ContestDeadline != 0 {
+		n += 1 + sovTimeLog(uint64(m.ContestDeadline))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTimeLog(uint64(l))
+	}
+	l = len(m.ContestReason)
+	if l > 0 {
+		n += 1 + l + sovTimeLog(uint64(l))
+	}
+	if m.BilledAt != 0 {
+		n += 1 + sovTimeLog(uint64(m.BilledAt))
+	}
+	return n
+}
+
+func sovTimeLog(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimeLog(x uint64) (n int) {
+	return sovTimeLog(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TimeLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimeLog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
+			}
+			m.Hours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoggedAt", wireType)
+			}
+			m.LoggedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoggedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContestDeadline", wireType)
+			}
+			m.ContestDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContestDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContestReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContestReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BilledAt", wireType)
+			}
+			m.BilledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BilledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimeLog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimeLog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimeLog(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimeLog
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimeLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimeLog
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimeLog
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimeLog
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimeLog        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimeLog          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimeLog = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,644 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: skillchain/marketplace/v1/tip.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Tip is a bonus paid by the client to the freelancer of a completed contract.
+type Tip struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Client     string `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Freelancer string `protobuf:"bytes,4,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	// Amount received by the freelancer, net of the platform fee.
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// Platform fee charged on the tip, if any.
+	Fee       types.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	Note      string     `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt int64      `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *Tip) Reset()         { *m = Tip{} }
+func (m *Tip) String() string { return proto.CompactTextString(m) }
+func (*Tip) ProtoMessage()    {}
+func (*Tip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d97049f3695bae7, []int{0}
+}
+func (m *Tip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tip.Merge(m, src)
+}
+func (m *Tip) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tip) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tip proto.InternalMessageInfo
+
+func (m *Tip) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Tip) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *Tip) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *Tip) GetFreelancer() string {
+	if m != nil {
+		return m.Freelancer
+	}
+	return ""
+}
+
+func (m *Tip) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Tip) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *Tip) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *Tip) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Tip)(nil), "skillchain.marketplace.v1.Tip")
+}
+
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/tip.proto", fileDescriptor_5d97049f3695bae7)
+}
+
+var fileDescriptor_5d97049f3695bae7 = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
+	0x18, 0x84, 0xe3, 0x24, 0x04, 0xea, 0x4a, 0x0c, 0x16, 0x42, 0x6e, 0x25, 0xdc, 0x08, 0x96, 0x4c,
+	0x8e, 0x02, 0x03, 0xac, 0x94, 0x89, 0x35, 0x62, 0x62, 0xa9, 0x5c, 0xc7, 0x2d, 0x56, 0x53, 0x3b,
+	0x4a, 0x7e, 0x2a, 0x78, 0x08, 0x24, 0x1e, 0xab, 0x63, 0x47, 0x26, 0x84, 0xda, 0x17, 0x41, 0x49,
+	0x83, 0x08, 0x1b, 0xdb, 0xf9, 0xfe, 0x3b, 0xe9, 0x93, 0x0f, 0x5f, 0x54, 0x0b, 0x9d, 0xe7, 0xf2,
+	0x49, 0x68, 0x13, 0x2f, 0x45, 0xb9, 0x50, 0x50, 0xe4, 0x42, 0xaa, 0x78, 0x95, 0xc4, 0xa0, 0x0b,
+	0x5e, 0x94, 0x16, 0x2c, 0x19, 0xfc, 0x86, 0x78, 0x27, 0xc4, 0x57, 0xc9, 0x90, 0x49, 0x5b, 0x2d,
+	0x6d, 0x15, 0x4f, 0x45, 0x55, 0x97, 0xa6, 0x0a, 0x44, 0x12, 0x4b, 0xab, 0xcd, 0xbe, 0x3a, 0x3c,
+	0x99, 0xdb, 0xb9, 0x6d, 0x64, 0x5c, 0xab, 0xbd, 0x7b, 0xfe, 0xe6, 0x62, 0xef, 0x41, 0x17, 0xe4,
+	0x18, 0xbb, 0x3a, 0xa3, 0x28, 0x44, 0x91, 0x9f, 0xba, 0x3a, 0x23, 0x23, 0xdc, 0x97, 0xd6, 0x40,
+	0x29, 0x24, 0x4c, 0x74, 0x46, 0xdd, 0xe6, 0x80, 0x7f, 0xac, 0xfb, 0x8c, 0x9c, 0xe2, 0x40, 0xe6,
+	0x5a, 0x19, 0xa0, 0x5e, 0x88, 0xa2, 0x5e, 0xda, 0xbe, 0x08, 0xc3, 0x78, 0x56, 0x2a, 0x95, 0x0b,
+	0x23, 0x55, 0x49, 0xfd, 0xe6, 0xd6, 0x71, 0xc8, 0x35, 0x0e, 0xc4, 0xd2, 0x3e, 0x1b, 0xa0, 0x07,
+	0x21, 0x8a, 0xfa, 0x97, 0x03, 0xbe, 0xe7, 0xe6, 0x35, 0x37, 0x6f, 0xb9, 0xf9, 0x9d, 0xd5, 0x66,
+	0xec, 0xaf, 0x3f, 0x47, 0x4e, 0xda, 0xc6, 0x49, 0x82, 0xbd, 0x99, 0x52, 0x34, 0xf8, 0x5f, 0xab,
+	0xce, 0x12, 0x82, 0x7d, 0x63, 0x41, 0xd1, 0xc3, 0x86, 0xa2, 0xd1, 0xe4, 0x0c, 0x63, 0x59, 0x2a,
+	0x01, 0x2a, 0x9b, 0x08, 0xa0, 0x47, 0x21, 0x8a, 0xbc, 0xb4, 0xd7, 0x3a, 0xb7, 0x30, 0xbe, 0x59,
+	0x6f, 0x19, 0xda, 0x6c, 0x19, 0xfa, 0xda, 0x32, 0xf4, 0xbe, 0x63, 0xce, 0x66, 0xc7, 0x9c, 0x8f,
+	0x1d, 0x73, 0x1e, 0x59, 0x67, 0x9f, 0x97, 0x3f, 0x0b, 0xc1, 0x6b, 0xa1, 0xaa, 0x69, 0xd0, 0x7c,
+	0xe8, 0xd5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x76, 0x4e, 0x08, 0xc8, 0x01, 0x00, 0x00,
+}
+
+func (m *Tip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintTip(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTip(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTip(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTip(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintTip(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTip(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTip(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTip(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTip(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTip(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Tip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTip(uint64(m.Id))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTip(uint64(m.ContractId))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTip(uint64(l))
+	}
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovTip(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTip(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTip(uint64(l))
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTip(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTip(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func sovTip(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTip(x uint64) (n int) {
+	return sovTip(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTip
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTip
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTip
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTip
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTip
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTip
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTip
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTip
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTip
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTip
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTip
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTip
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTip        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTip          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTip = fmt.Errorf("proto: unexpected end of group")
+)
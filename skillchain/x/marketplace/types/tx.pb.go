@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -131,6 +134,8 @@ type MsgCreateProfile struct {
 	Bio        string   `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
 	Skills     []string `protobuf:"bytes,4,rep,name=skills,proto3" json:"skills,omitempty"`
 	HourlyRate uint64   `protobuf:"varint,5,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	// optional account that referred the creator
+	Referrer string `protobuf:"bytes,6,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
 func (m *MsgCreateProfile) Reset()         { *m = MsgCreateProfile{} }
@@ -201,6 +206,13 @@ func (m *MsgCreateProfile) GetHourlyRate() uint64 {
 	return 0
 }
 
+func (m *MsgCreateProfile) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
 // MsgCreateProfileResponse defines the MsgCreateProfileResponse message.
 type MsgCreateProfileResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -362,12 +374,14 @@ var xxx_messageInfo_MsgUpdateProfileResponse proto.InternalMessageInfo
 
 // MsgCreateGig defines the MsgCreateGig message.
 type MsgCreateGig struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Title        string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description  string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price        uint64 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Category     string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	DeliveryDays uint64 `protobuf:"varint,6,opt,name=delivery_days,json=deliveryDays,proto3" json:"delivery_days,omitempty"`
+	Creator      string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title        string     `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category     string     `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	DeliveryDays uint64     `protobuf:"varint,6,opt,name=delivery_days,json=deliveryDays,proto3" json:"delivery_days,omitempty"`
+	Price        types.Coin `protobuf:"bytes,7,opt,name=price,proto3" json:"price"`
+	// funded escrows the price when the gig is posted
+	Funded bool `protobuf:"varint,8,opt,name=funded,proto3" json:"funded,omitempty"`
 }
 
 func (m *MsgCreateGig) Reset()         { *m = MsgCreateGig{} }
@@ -424,13 +438,6 @@ func (m *MsgCreateGig) GetDescription() string {
 	return ""
 }
 
-func (m *MsgCreateGig) GetPrice() uint64 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
-}
-
 func (m *MsgCreateGig) GetCategory() string {
 	if m != nil {
 		return m.Category
@@ -445,6 +452,20 @@ func (m *MsgCreateGig) GetDeliveryDays() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGig) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCreateGig) GetFunded() bool {
+	if m != nil {
+		return m.Funded
+	}
+	return false
+}
+
 // MsgCreateGigResponse defines the MsgCreateGigResponse message.
 type MsgCreateGigResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -590,14 +611,14 @@ var xxx_messageInfo_MsgUpdateGigStatusResponse proto.InternalMessageInfo
 
 // MsgCreateApplication defines the MsgCreateApplication message.
 type MsgCreateApplication struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	GigId         uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	Freelancer    string `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
-	CoverLetter   string `protobuf:"bytes,4,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	ProposedPrice uint64 `protobuf:"varint,5,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price,omitempty"`
-	ProposedDays  uint64 `protobuf:"varint,6,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
-	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Creator       string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GigId         uint64     `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
+	Freelancer    string     `protobuf:"bytes,3,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	CoverLetter   string     `protobuf:"bytes,4,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	ProposedDays  uint64     `protobuf:"varint,6,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
+	Status        string     `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64      `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProposedPrice types.Coin `protobuf:"bytes,9,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price"`
 }
 
 func (m *MsgCreateApplication) Reset()         { *m = MsgCreateApplication{} }
@@ -661,13 +682,6 @@ func (m *MsgCreateApplication) GetCoverLetter() string {
 	return ""
 }
 
-func (m *MsgCreateApplication) GetProposedPrice() uint64 {
-	if m != nil {
-		return m.ProposedPrice
-	}
-	return 0
-}
-
 func (m *MsgCreateApplication) GetProposedDays() uint64 {
 	if m != nil {
 		return m.ProposedDays
@@ -689,6 +703,13 @@ func (m *MsgCreateApplication) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *MsgCreateApplication) GetProposedPrice() types.Coin {
+	if m != nil {
+		return m.ProposedPrice
+	}
+	return types.Coin{}
+}
+
 // MsgCreateApplicationResponse defines the MsgCreateApplicationResponse message.
 type MsgCreateApplicationResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -736,15 +757,15 @@ func (m *MsgCreateApplicationResponse) GetId() uint64 {
 
 // MsgUpdateApplication defines the MsgUpdateApplication message.
 type MsgUpdateApplication struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id            uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	GigId         uint64 `protobuf:"varint,3,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	Freelancer    string `protobuf:"bytes,4,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
-	CoverLetter   string `protobuf:"bytes,5,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	ProposedPrice uint64 `protobuf:"varint,6,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price,omitempty"`
-	ProposedDays  uint64 `protobuf:"varint,7,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
-	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Creator       string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id            uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	GigId         uint64     `protobuf:"varint,3,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
+	Freelancer    string     `protobuf:"bytes,4,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
+	CoverLetter   string     `protobuf:"bytes,5,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	ProposedDays  uint64     `protobuf:"varint,7,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
+	Status        string     `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     int64      `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProposedPrice types.Coin `protobuf:"bytes,10,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price"`
 }
 
 func (m *MsgUpdateApplication) Reset()         { *m = MsgUpdateApplication{} }
@@ -815,13 +836,6 @@ func (m *MsgUpdateApplication) GetCoverLetter() string {
 	return ""
 }
 
-func (m *MsgUpdateApplication) GetProposedPrice() uint64 {
-	if m != nil {
-		return m.ProposedPrice
-	}
-	return 0
-}
-
 func (m *MsgUpdateApplication) GetProposedDays() uint64 {
 	if m != nil {
 		return m.ProposedDays
@@ -843,6 +857,13 @@ func (m *MsgUpdateApplication) GetCreatedAt() int64 {
 	return 0
 }
 
+func (m *MsgUpdateApplication) GetProposedPrice() types.Coin {
+	if m != nil {
+		return m.ProposedPrice
+	}
+	return types.Coin{}
+}
+
 // MsgUpdateApplicationResponse defines the MsgUpdateApplicationResponse message.
 type MsgUpdateApplicationResponse struct {
 }
@@ -970,32 +991,34 @@ func (m *MsgDeleteApplicationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteApplicationResponse proto.InternalMessageInfo
 
-// MsgCreateContract defines the MsgCreateContract message.
-type MsgCreateContract struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	GigId            uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	ApplicationId    uint64 `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Client           string `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	Freelancer       string `protobuf:"bytes,5,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
-	Price            uint64 `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	DeliveryDeadline int64  `protobuf:"varint,7,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
-	Status           string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt        int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt      int64  `protobuf:"varint,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-}
-
-func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
-func (m *MsgCreateContract) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateContract) ProtoMessage()    {}
-func (*MsgCreateContract) Descriptor() ([]byte, []int) {
+// MsgApplyToGig defines the MsgApplyToGig message.
+type MsgApplyToGig struct {
+	Creator       string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GigId         uint64      `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
+	CoverLetter   string      `protobuf:"bytes,3,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	ProposedDays  uint64      `protobuf:"varint,5,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
+	Milestones    []Milestone `protobuf:"bytes,6,rep,name=milestones,proto3" json:"milestones"`
+	ProposedPrice types.Coin  `protobuf:"bytes,7,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price"`
+	// weekly_hours_cap makes the application hourly, hourly_rate defaults to the
+	// profile hourly rate
+	WeeklyHoursCap uint64                `protobuf:"varint,8,opt,name=weekly_hours_cap,json=weeklyHoursCap,proto3" json:"weekly_hours_cap,omitempty"`
+	HourlyRate     cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=hourly_rate,json=hourlyRate,proto3,customtype=cosmossdk.io/math.Int" json:"hourly_rate"`
+	// streaming releases the proposed price linearly over the proposed days
+	Streaming bool `protobuf:"varint,10,opt,name=streaming,proto3" json:"streaming,omitempty"`
+}
+
+func (m *MsgApplyToGig) Reset()         { *m = MsgApplyToGig{} }
+func (m *MsgApplyToGig) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyToGig) ProtoMessage()    {}
+func (*MsgApplyToGig) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{16}
 }
-func (m *MsgCreateContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgApplyToGig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApplyToGig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApplyToGig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1005,105 +1028,91 @@ func (m *MsgCreateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateContract.Merge(m, src)
+func (m *MsgApplyToGig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyToGig.Merge(m, src)
 }
-func (m *MsgCreateContract) XXX_Size() int {
+func (m *MsgApplyToGig) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateContract.DiscardUnknown(m)
+func (m *MsgApplyToGig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyToGig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgApplyToGig proto.InternalMessageInfo
 
-func (m *MsgCreateContract) GetCreator() string {
+func (m *MsgApplyToGig) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateContract) GetGigId() uint64 {
+func (m *MsgApplyToGig) GetGigId() uint64 {
 	if m != nil {
 		return m.GigId
 	}
 	return 0
 }
 
-func (m *MsgCreateContract) GetApplicationId() uint64 {
-	if m != nil {
-		return m.ApplicationId
-	}
-	return 0
-}
-
-func (m *MsgCreateContract) GetClient() string {
-	if m != nil {
-		return m.Client
-	}
-	return ""
-}
-
-func (m *MsgCreateContract) GetFreelancer() string {
+func (m *MsgApplyToGig) GetCoverLetter() string {
 	if m != nil {
-		return m.Freelancer
+		return m.CoverLetter
 	}
 	return ""
 }
 
-func (m *MsgCreateContract) GetPrice() uint64 {
+func (m *MsgApplyToGig) GetProposedDays() uint64 {
 	if m != nil {
-		return m.Price
+		return m.ProposedDays
 	}
 	return 0
 }
 
-func (m *MsgCreateContract) GetDeliveryDeadline() int64 {
+func (m *MsgApplyToGig) GetMilestones() []Milestone {
 	if m != nil {
-		return m.DeliveryDeadline
+		return m.Milestones
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgCreateContract) GetStatus() string {
+func (m *MsgApplyToGig) GetProposedPrice() types.Coin {
 	if m != nil {
-		return m.Status
+		return m.ProposedPrice
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgCreateContract) GetCreatedAt() int64 {
+func (m *MsgApplyToGig) GetWeeklyHoursCap() uint64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.WeeklyHoursCap
 	}
 	return 0
 }
 
-func (m *MsgCreateContract) GetCompletedAt() int64 {
+func (m *MsgApplyToGig) GetStreaming() bool {
 	if m != nil {
-		return m.CompletedAt
+		return m.Streaming
 	}
-	return 0
+	return false
 }
 
-// MsgCreateContractResponse defines the MsgCreateContractResponse message.
-type MsgCreateContractResponse struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+// MsgApplyToGigResponse defines the MsgApplyToGigResponse message.
+type MsgApplyToGigResponse struct {
+	ApplicationId uint64 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 }
 
-func (m *MsgCreateContractResponse) Reset()         { *m = MsgCreateContractResponse{} }
-func (m *MsgCreateContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateContractResponse) ProtoMessage()    {}
-func (*MsgCreateContractResponse) Descriptor() ([]byte, []int) {
+func (m *MsgApplyToGigResponse) Reset()         { *m = MsgApplyToGigResponse{} }
+func (m *MsgApplyToGigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApplyToGigResponse) ProtoMessage()    {}
+func (*MsgApplyToGigResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{17}
 }
-func (m *MsgCreateContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgApplyToGigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApplyToGigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApplyToGigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1113,52 +1122,43 @@ func (m *MsgCreateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateContractResponse.Merge(m, src)
+func (m *MsgApplyToGigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApplyToGigResponse.Merge(m, src)
 }
-func (m *MsgCreateContractResponse) XXX_Size() int {
+func (m *MsgApplyToGigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateContractResponse.DiscardUnknown(m)
+func (m *MsgApplyToGigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApplyToGigResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgApplyToGigResponse proto.InternalMessageInfo
 
-func (m *MsgCreateContractResponse) GetId() uint64 {
+func (m *MsgApplyToGigResponse) GetApplicationId() uint64 {
 	if m != nil {
-		return m.Id
+		return m.ApplicationId
 	}
 	return 0
 }
 
-// MsgUpdateContract defines the MsgUpdateContract message.
-type MsgUpdateContract struct {
-	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id               uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	GigId            uint64 `protobuf:"varint,3,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	ApplicationId    uint64 `protobuf:"varint,4,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Client           string `protobuf:"bytes,5,opt,name=client,proto3" json:"client,omitempty"`
-	Freelancer       string `protobuf:"bytes,6,opt,name=freelancer,proto3" json:"freelancer,omitempty"`
-	Price            uint64 `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
-	DeliveryDeadline int64  `protobuf:"varint,8,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
-	Status           string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt        int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CompletedAt      int64  `protobuf:"varint,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-}
-
-func (m *MsgUpdateContract) Reset()         { *m = MsgUpdateContract{} }
-func (m *MsgUpdateContract) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateContract) ProtoMessage()    {}
-func (*MsgUpdateContract) Descriptor() ([]byte, []int) {
+// MsgWithdrawApplication defines the MsgWithdrawApplication message.
+type MsgWithdrawApplication struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+}
+
+func (m *MsgWithdrawApplication) Reset()         { *m = MsgWithdrawApplication{} }
+func (m *MsgWithdrawApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawApplication) ProtoMessage()    {}
+func (*MsgWithdrawApplication) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{18}
 }
-func (m *MsgUpdateContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawApplication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawApplication.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1168,111 +1168,48 @@ func (m *MsgUpdateContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateContract.Merge(m, src)
+func (m *MsgWithdrawApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawApplication.Merge(m, src)
 }
-func (m *MsgUpdateContract) XXX_Size() int {
+func (m *MsgWithdrawApplication) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateContract.DiscardUnknown(m)
+func (m *MsgWithdrawApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawApplication.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawApplication proto.InternalMessageInfo
 
-func (m *MsgUpdateContract) GetCreator() string {
+func (m *MsgWithdrawApplication) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateContract) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func (m *MsgUpdateContract) GetGigId() uint64 {
-	if m != nil {
-		return m.GigId
-	}
-	return 0
-}
-
-func (m *MsgUpdateContract) GetApplicationId() uint64 {
+func (m *MsgWithdrawApplication) GetApplicationId() uint64 {
 	if m != nil {
 		return m.ApplicationId
 	}
 	return 0
 }
 
-func (m *MsgUpdateContract) GetClient() string {
-	if m != nil {
-		return m.Client
-	}
-	return ""
-}
-
-func (m *MsgUpdateContract) GetFreelancer() string {
-	if m != nil {
-		return m.Freelancer
-	}
-	return ""
-}
-
-func (m *MsgUpdateContract) GetPrice() uint64 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
-}
-
-func (m *MsgUpdateContract) GetDeliveryDeadline() int64 {
-	if m != nil {
-		return m.DeliveryDeadline
-	}
-	return 0
-}
-
-func (m *MsgUpdateContract) GetStatus() string {
-	if m != nil {
-		return m.Status
-	}
-	return ""
-}
-
-func (m *MsgUpdateContract) GetCreatedAt() int64 {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return 0
-}
-
-func (m *MsgUpdateContract) GetCompletedAt() int64 {
-	if m != nil {
-		return m.CompletedAt
-	}
-	return 0
-}
-
-// MsgUpdateContractResponse defines the MsgUpdateContractResponse message.
-type MsgUpdateContractResponse struct {
+// MsgWithdrawApplicationResponse defines the MsgWithdrawApplicationResponse message.
+type MsgWithdrawApplicationResponse struct {
 }
 
-func (m *MsgUpdateContractResponse) Reset()         { *m = MsgUpdateContractResponse{} }
-func (m *MsgUpdateContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateContractResponse) ProtoMessage()    {}
-func (*MsgUpdateContractResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawApplicationResponse) Reset()         { *m = MsgWithdrawApplicationResponse{} }
+func (m *MsgWithdrawApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawApplicationResponse) ProtoMessage()    {}
+func (*MsgWithdrawApplicationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{19}
 }
-func (m *MsgUpdateContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawApplicationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1282,36 +1219,36 @@ func (m *MsgUpdateContractResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateContractResponse.Merge(m, src)
+func (m *MsgWithdrawApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawApplicationResponse.Merge(m, src)
 }
-func (m *MsgUpdateContractResponse) XXX_Size() int {
+func (m *MsgWithdrawApplicationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateContractResponse.DiscardUnknown(m)
+func (m *MsgWithdrawApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawApplicationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawApplicationResponse proto.InternalMessageInfo
 
-// MsgDeleteContract defines the MsgDeleteContract message.
-type MsgDeleteContract struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+// MsgAcceptApplication defines the MsgAcceptApplication message.
+type MsgAcceptApplication struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 }
 
-func (m *MsgDeleteContract) Reset()         { *m = MsgDeleteContract{} }
-func (m *MsgDeleteContract) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteContract) ProtoMessage()    {}
-func (*MsgDeleteContract) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptApplication) Reset()         { *m = MsgAcceptApplication{} }
+func (m *MsgAcceptApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptApplication) ProtoMessage()    {}
+func (*MsgAcceptApplication) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{20}
 }
-func (m *MsgDeleteContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptApplication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptApplication.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1321,48 +1258,49 @@ func (m *MsgDeleteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContract.Merge(m, src)
+func (m *MsgAcceptApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptApplication.Merge(m, src)
 }
-func (m *MsgDeleteContract) XXX_Size() int {
+func (m *MsgAcceptApplication) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContract.DiscardUnknown(m)
+func (m *MsgAcceptApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptApplication.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptApplication proto.InternalMessageInfo
 
-func (m *MsgDeleteContract) GetCreator() string {
+func (m *MsgAcceptApplication) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgDeleteContract) GetId() uint64 {
+func (m *MsgAcceptApplication) GetApplicationId() uint64 {
 	if m != nil {
-		return m.Id
+		return m.ApplicationId
 	}
 	return 0
 }
 
-// MsgDeleteContractResponse defines the MsgDeleteContractResponse message.
-type MsgDeleteContractResponse struct {
+// MsgAcceptApplicationResponse defines the MsgAcceptApplicationResponse message.
+type MsgAcceptApplicationResponse struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (m *MsgDeleteContractResponse) Reset()         { *m = MsgDeleteContractResponse{} }
-func (m *MsgDeleteContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteContractResponse) ProtoMessage()    {}
-func (*MsgDeleteContractResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptApplicationResponse) Reset()         { *m = MsgAcceptApplicationResponse{} }
+func (m *MsgAcceptApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptApplicationResponse) ProtoMessage()    {}
+func (*MsgAcceptApplicationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{21}
 }
-func (m *MsgDeleteContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptApplicationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1372,39 +1310,43 @@ func (m *MsgDeleteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteContractResponse.Merge(m, src)
+func (m *MsgAcceptApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptApplicationResponse.Merge(m, src)
 }
-func (m *MsgDeleteContractResponse) XXX_Size() int {
+func (m *MsgAcceptApplicationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteContractResponse.DiscardUnknown(m)
+func (m *MsgAcceptApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptApplicationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptApplicationResponse proto.InternalMessageInfo
 
-// MsgApplyToGig defines the MsgApplyToGig message.
-type MsgApplyToGig struct {
+func (m *MsgAcceptApplicationResponse) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+// MsgRejectApplication defines the MsgRejectApplication message.
+type MsgRejectApplication struct {
 	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	GigId         uint64 `protobuf:"varint,2,opt,name=gig_id,json=gigId,proto3" json:"gig_id,omitempty"`
-	CoverLetter   string `protobuf:"bytes,3,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	ProposedPrice uint64 `protobuf:"varint,4,opt,name=proposed_price,json=proposedPrice,proto3" json:"proposed_price,omitempty"`
-	ProposedDays  uint64 `protobuf:"varint,5,opt,name=proposed_days,json=proposedDays,proto3" json:"proposed_days,omitempty"`
+	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 }
 
-func (m *MsgApplyToGig) Reset()         { *m = MsgApplyToGig{} }
-func (m *MsgApplyToGig) String() string { return proto.CompactTextString(m) }
-func (*MsgApplyToGig) ProtoMessage()    {}
-func (*MsgApplyToGig) Descriptor() ([]byte, []int) {
+func (m *MsgRejectApplication) Reset()         { *m = MsgRejectApplication{} }
+func (m *MsgRejectApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectApplication) ProtoMessage()    {}
+func (*MsgRejectApplication) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{22}
 }
-func (m *MsgApplyToGig) XXX_Unmarshal(b []byte) error {
+func (m *MsgRejectApplication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgApplyToGig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRejectApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgApplyToGig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRejectApplication.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1414,70 +1356,48 @@ func (m *MsgApplyToGig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgApplyToGig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgApplyToGig.Merge(m, src)
+func (m *MsgRejectApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectApplication.Merge(m, src)
 }
-func (m *MsgApplyToGig) XXX_Size() int {
+func (m *MsgRejectApplication) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgApplyToGig) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgApplyToGig.DiscardUnknown(m)
+func (m *MsgRejectApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectApplication.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgApplyToGig proto.InternalMessageInfo
+var xxx_messageInfo_MsgRejectApplication proto.InternalMessageInfo
 
-func (m *MsgApplyToGig) GetCreator() string {
+func (m *MsgRejectApplication) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgApplyToGig) GetGigId() uint64 {
-	if m != nil {
-		return m.GigId
-	}
-	return 0
-}
-
-func (m *MsgApplyToGig) GetCoverLetter() string {
-	if m != nil {
-		return m.CoverLetter
-	}
-	return ""
-}
-
-func (m *MsgApplyToGig) GetProposedPrice() uint64 {
-	if m != nil {
-		return m.ProposedPrice
-	}
-	return 0
-}
-
-func (m *MsgApplyToGig) GetProposedDays() uint64 {
+func (m *MsgRejectApplication) GetApplicationId() uint64 {
 	if m != nil {
-		return m.ProposedDays
+		return m.ApplicationId
 	}
 	return 0
 }
 
-// MsgApplyToGigResponse defines the MsgApplyToGigResponse message.
-type MsgApplyToGigResponse struct {
-	ApplicationId uint64 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+// MsgRejectApplicationResponse defines the MsgRejectApplicationResponse message.
+type MsgRejectApplicationResponse struct {
 }
 
-func (m *MsgApplyToGigResponse) Reset()         { *m = MsgApplyToGigResponse{} }
-func (m *MsgApplyToGigResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgApplyToGigResponse) ProtoMessage()    {}
-func (*MsgApplyToGigResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRejectApplicationResponse) Reset()         { *m = MsgRejectApplicationResponse{} }
+func (m *MsgRejectApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectApplicationResponse) ProtoMessage()    {}
+func (*MsgRejectApplicationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{23}
 }
-func (m *MsgApplyToGigResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRejectApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgApplyToGigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRejectApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgApplyToGigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRejectApplicationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1487,43 +1407,37 @@ func (m *MsgApplyToGigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgApplyToGigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgApplyToGigResponse.Merge(m, src)
+func (m *MsgRejectApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectApplicationResponse.Merge(m, src)
 }
-func (m *MsgApplyToGigResponse) XXX_Size() int {
+func (m *MsgRejectApplicationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgApplyToGigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgApplyToGigResponse.DiscardUnknown(m)
+func (m *MsgRejectApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectApplicationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgApplyToGigResponse proto.InternalMessageInfo
-
-func (m *MsgApplyToGigResponse) GetApplicationId() uint64 {
-	if m != nil {
-		return m.ApplicationId
-	}
-	return 0
-}
+var xxx_messageInfo_MsgRejectApplicationResponse proto.InternalMessageInfo
 
-// MsgWithdrawApplication defines the MsgWithdrawApplication message.
-type MsgWithdrawApplication struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+// MsgDeliverContract defines the MsgDeliverContract message.
+type MsgDeliverContract struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId   uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	DeliveryNote string `protobuf:"bytes,3,opt,name=delivery_note,json=deliveryNote,proto3" json:"delivery_note,omitempty"`
 }
 
-func (m *MsgWithdrawApplication) Reset()         { *m = MsgWithdrawApplication{} }
-func (m *MsgWithdrawApplication) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawApplication) ProtoMessage()    {}
-func (*MsgWithdrawApplication) Descriptor() ([]byte, []int) {
+func (m *MsgDeliverContract) Reset()         { *m = MsgDeliverContract{} }
+func (m *MsgDeliverContract) String() string { return proto.CompactTextString(m) }
+func (*MsgDeliverContract) ProtoMessage()    {}
+func (*MsgDeliverContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{24}
 }
-func (m *MsgWithdrawApplication) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeliverContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeliverContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawApplication.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeliverContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1533,48 +1447,55 @@ func (m *MsgWithdrawApplication) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawApplication) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawApplication.Merge(m, src)
+func (m *MsgDeliverContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeliverContract.Merge(m, src)
 }
-func (m *MsgWithdrawApplication) XXX_Size() int {
+func (m *MsgDeliverContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawApplication) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawApplication.DiscardUnknown(m)
+func (m *MsgDeliverContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeliverContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawApplication proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeliverContract proto.InternalMessageInfo
 
-func (m *MsgWithdrawApplication) GetCreator() string {
+func (m *MsgDeliverContract) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgWithdrawApplication) GetApplicationId() uint64 {
+func (m *MsgDeliverContract) GetContractId() uint64 {
 	if m != nil {
-		return m.ApplicationId
+		return m.ContractId
 	}
 	return 0
 }
 
-// MsgWithdrawApplicationResponse defines the MsgWithdrawApplicationResponse message.
-type MsgWithdrawApplicationResponse struct {
+func (m *MsgDeliverContract) GetDeliveryNote() string {
+	if m != nil {
+		return m.DeliveryNote
+	}
+	return ""
 }
 
-func (m *MsgWithdrawApplicationResponse) Reset()         { *m = MsgWithdrawApplicationResponse{} }
-func (m *MsgWithdrawApplicationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawApplicationResponse) ProtoMessage()    {}
-func (*MsgWithdrawApplicationResponse) Descriptor() ([]byte, []int) {
+// MsgDeliverContractResponse defines the MsgDeliverContractResponse message.
+type MsgDeliverContractResponse struct {
+}
+
+func (m *MsgDeliverContractResponse) Reset()         { *m = MsgDeliverContractResponse{} }
+func (m *MsgDeliverContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeliverContractResponse) ProtoMessage()    {}
+func (*MsgDeliverContractResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{25}
 }
-func (m *MsgWithdrawApplicationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeliverContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeliverContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawApplicationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeliverContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1584,36 +1505,36 @@ func (m *MsgWithdrawApplicationResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawApplicationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawApplicationResponse.Merge(m, src)
+func (m *MsgDeliverContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeliverContractResponse.Merge(m, src)
 }
-func (m *MsgWithdrawApplicationResponse) XXX_Size() int {
+func (m *MsgDeliverContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawApplicationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawApplicationResponse.DiscardUnknown(m)
+func (m *MsgDeliverContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeliverContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawApplicationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeliverContractResponse proto.InternalMessageInfo
 
-// MsgAcceptApplication defines the MsgAcceptApplication message.
-type MsgAcceptApplication struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+// MsgCompleteContract defines the MsgCompleteContract message.
+type MsgCompleteContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (m *MsgAcceptApplication) Reset()         { *m = MsgAcceptApplication{} }
-func (m *MsgAcceptApplication) String() string { return proto.CompactTextString(m) }
-func (*MsgAcceptApplication) ProtoMessage()    {}
-func (*MsgAcceptApplication) Descriptor() ([]byte, []int) {
+func (m *MsgCompleteContract) Reset()         { *m = MsgCompleteContract{} }
+func (m *MsgCompleteContract) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteContract) ProtoMessage()    {}
+func (*MsgCompleteContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{26}
 }
-func (m *MsgAcceptApplication) XXX_Unmarshal(b []byte) error {
+func (m *MsgCompleteContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAcceptApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCompleteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAcceptApplication.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCompleteContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1623,49 +1544,48 @@ func (m *MsgAcceptApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgAcceptApplication) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAcceptApplication.Merge(m, src)
+func (m *MsgCompleteContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteContract.Merge(m, src)
 }
-func (m *MsgAcceptApplication) XXX_Size() int {
+func (m *MsgCompleteContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAcceptApplication) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAcceptApplication.DiscardUnknown(m)
+func (m *MsgCompleteContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAcceptApplication proto.InternalMessageInfo
+var xxx_messageInfo_MsgCompleteContract proto.InternalMessageInfo
 
-func (m *MsgAcceptApplication) GetCreator() string {
+func (m *MsgCompleteContract) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgAcceptApplication) GetApplicationId() uint64 {
+func (m *MsgCompleteContract) GetContractId() uint64 {
 	if m != nil {
-		return m.ApplicationId
+		return m.ContractId
 	}
 	return 0
 }
 
-// MsgAcceptApplicationResponse defines the MsgAcceptApplicationResponse message.
-type MsgAcceptApplicationResponse struct {
-	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+// MsgCompleteContractResponse defines the MsgCompleteContractResponse message.
+type MsgCompleteContractResponse struct {
 }
 
-func (m *MsgAcceptApplicationResponse) Reset()         { *m = MsgAcceptApplicationResponse{} }
-func (m *MsgAcceptApplicationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAcceptApplicationResponse) ProtoMessage()    {}
-func (*MsgAcceptApplicationResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCompleteContractResponse) Reset()         { *m = MsgCompleteContractResponse{} }
+func (m *MsgCompleteContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompleteContractResponse) ProtoMessage()    {}
+func (*MsgCompleteContractResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{27}
 }
-func (m *MsgAcceptApplicationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCompleteContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAcceptApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCompleteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAcceptApplicationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCompleteContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1675,43 +1595,37 @@ func (m *MsgAcceptApplicationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgAcceptApplicationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAcceptApplicationResponse.Merge(m, src)
+func (m *MsgCompleteContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompleteContractResponse.Merge(m, src)
 }
-func (m *MsgAcceptApplicationResponse) XXX_Size() int {
+func (m *MsgCompleteContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAcceptApplicationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAcceptApplicationResponse.DiscardUnknown(m)
+func (m *MsgCompleteContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompleteContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAcceptApplicationResponse proto.InternalMessageInfo
-
-func (m *MsgAcceptApplicationResponse) GetContractId() uint64 {
-	if m != nil {
-		return m.ContractId
-	}
-	return 0
-}
+var xxx_messageInfo_MsgCompleteContractResponse proto.InternalMessageInfo
 
-// MsgRejectApplication defines the MsgRejectApplication message.
-type MsgRejectApplication struct {
-	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+// MsgDisputeContract defines the MsgDisputeContract message.
+type MsgDisputeContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgRejectApplication) Reset()         { *m = MsgRejectApplication{} }
-func (m *MsgRejectApplication) String() string { return proto.CompactTextString(m) }
-func (*MsgRejectApplication) ProtoMessage()    {}
-func (*MsgRejectApplication) Descriptor() ([]byte, []int) {
+func (m *MsgDisputeContract) Reset()         { *m = MsgDisputeContract{} }
+func (m *MsgDisputeContract) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeContract) ProtoMessage()    {}
+func (*MsgDisputeContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{28}
 }
-func (m *MsgRejectApplication) XXX_Unmarshal(b []byte) error {
+func (m *MsgDisputeContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRejectApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDisputeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRejectApplication.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDisputeContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1721,48 +1635,55 @@ func (m *MsgRejectApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgRejectApplication) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRejectApplication.Merge(m, src)
+func (m *MsgDisputeContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeContract.Merge(m, src)
 }
-func (m *MsgRejectApplication) XXX_Size() int {
+func (m *MsgDisputeContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRejectApplication) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRejectApplication.DiscardUnknown(m)
+func (m *MsgDisputeContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRejectApplication proto.InternalMessageInfo
+var xxx_messageInfo_MsgDisputeContract proto.InternalMessageInfo
 
-func (m *MsgRejectApplication) GetCreator() string {
+func (m *MsgDisputeContract) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRejectApplication) GetApplicationId() uint64 {
+func (m *MsgDisputeContract) GetContractId() uint64 {
 	if m != nil {
-		return m.ApplicationId
+		return m.ContractId
 	}
 	return 0
 }
 
-// MsgRejectApplicationResponse defines the MsgRejectApplicationResponse message.
-type MsgRejectApplicationResponse struct {
+func (m *MsgDisputeContract) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgRejectApplicationResponse) Reset()         { *m = MsgRejectApplicationResponse{} }
-func (m *MsgRejectApplicationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRejectApplicationResponse) ProtoMessage()    {}
-func (*MsgRejectApplicationResponse) Descriptor() ([]byte, []int) {
+// MsgDisputeContractResponse defines the MsgDisputeContractResponse message.
+type MsgDisputeContractResponse struct {
+}
+
+func (m *MsgDisputeContractResponse) Reset()         { *m = MsgDisputeContractResponse{} }
+func (m *MsgDisputeContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeContractResponse) ProtoMessage()    {}
+func (*MsgDisputeContractResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{29}
 }
-func (m *MsgRejectApplicationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDisputeContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRejectApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDisputeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRejectApplicationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDisputeContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1772,37 +1693,38 @@ func (m *MsgRejectApplicationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRejectApplicationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRejectApplicationResponse.Merge(m, src)
+func (m *MsgDisputeContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeContractResponse.Merge(m, src)
 }
-func (m *MsgRejectApplicationResponse) XXX_Size() int {
+func (m *MsgDisputeContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRejectApplicationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRejectApplicationResponse.DiscardUnknown(m)
+func (m *MsgDisputeContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRejectApplicationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDisputeContractResponse proto.InternalMessageInfo
 
-// MsgDeliverContract defines the MsgDeliverContract message.
-type MsgDeliverContract struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ContractId   uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	DeliveryNote string `protobuf:"bytes,3,opt,name=delivery_note,json=deliveryNote,proto3" json:"delivery_note,omitempty"`
+// MsgOpenDispute defines the MsgOpenDispute message.
+type MsgOpenDispute struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Evidence   string `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
 }
 
-func (m *MsgDeliverContract) Reset()         { *m = MsgDeliverContract{} }
-func (m *MsgDeliverContract) String() string { return proto.CompactTextString(m) }
-func (*MsgDeliverContract) ProtoMessage()    {}
-func (*MsgDeliverContract) Descriptor() ([]byte, []int) {
+func (m *MsgOpenDispute) Reset()         { *m = MsgOpenDispute{} }
+func (m *MsgOpenDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenDispute) ProtoMessage()    {}
+func (*MsgOpenDispute) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{30}
 }
-func (m *MsgDeliverContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgOpenDispute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeliverContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOpenDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeliverContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOpenDispute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1812,55 +1734,63 @@ func (m *MsgDeliverContract) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDeliverContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeliverContract.Merge(m, src)
+func (m *MsgOpenDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenDispute.Merge(m, src)
 }
-func (m *MsgDeliverContract) XXX_Size() int {
+func (m *MsgOpenDispute) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeliverContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeliverContract.DiscardUnknown(m)
+func (m *MsgOpenDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenDispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeliverContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgOpenDispute proto.InternalMessageInfo
 
-func (m *MsgDeliverContract) GetCreator() string {
+func (m *MsgOpenDispute) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgDeliverContract) GetContractId() uint64 {
+func (m *MsgOpenDispute) GetContractId() uint64 {
 	if m != nil {
 		return m.ContractId
 	}
 	return 0
 }
 
-func (m *MsgDeliverContract) GetDeliveryNote() string {
+func (m *MsgOpenDispute) GetReason() string {
 	if m != nil {
-		return m.DeliveryNote
+		return m.Reason
 	}
 	return ""
 }
 
-// MsgDeliverContractResponse defines the MsgDeliverContractResponse message.
-type MsgDeliverContractResponse struct {
+func (m *MsgOpenDispute) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
 }
 
-func (m *MsgDeliverContractResponse) Reset()         { *m = MsgDeliverContractResponse{} }
-func (m *MsgDeliverContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeliverContractResponse) ProtoMessage()    {}
-func (*MsgDeliverContractResponse) Descriptor() ([]byte, []int) {
+// MsgOpenDisputeResponse defines the MsgOpenDisputeResponse message.
+type MsgOpenDisputeResponse struct {
+	DisputeId uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+}
+
+func (m *MsgOpenDisputeResponse) Reset()         { *m = MsgOpenDisputeResponse{} }
+func (m *MsgOpenDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenDisputeResponse) ProtoMessage()    {}
+func (*MsgOpenDisputeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{31}
 }
-func (m *MsgDeliverContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgOpenDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeliverContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgOpenDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeliverContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgOpenDisputeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1870,36 +1800,44 @@ func (m *MsgDeliverContractResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDeliverContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeliverContractResponse.Merge(m, src)
+func (m *MsgOpenDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenDisputeResponse.Merge(m, src)
 }
-func (m *MsgDeliverContractResponse) XXX_Size() int {
+func (m *MsgOpenDisputeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeliverContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeliverContractResponse.DiscardUnknown(m)
+func (m *MsgOpenDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenDisputeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeliverContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgOpenDisputeResponse proto.InternalMessageInfo
 
-// MsgCompleteContract defines the MsgCompleteContract message.
-type MsgCompleteContract struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+func (m *MsgOpenDisputeResponse) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
+	}
+	return 0
 }
 
-func (m *MsgCompleteContract) Reset()         { *m = MsgCompleteContract{} }
-func (m *MsgCompleteContract) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteContract) ProtoMessage()    {}
-func (*MsgCompleteContract) Descriptor() ([]byte, []int) {
+// MsgSubmitEvidence defines the MsgSubmitEvidence message.
+type MsgSubmitEvidence struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	Evidence  string `protobuf:"bytes,3,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *MsgSubmitEvidence) Reset()         { *m = MsgSubmitEvidence{} }
+func (m *MsgSubmitEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEvidence) ProtoMessage()    {}
+func (*MsgSubmitEvidence) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{32}
 }
-func (m *MsgCompleteContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitEvidence.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1909,48 +1847,55 @@ func (m *MsgCompleteContract) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteContract.Merge(m, src)
+func (m *MsgSubmitEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEvidence.Merge(m, src)
 }
-func (m *MsgCompleteContract) XXX_Size() int {
+func (m *MsgSubmitEvidence) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteContract.DiscardUnknown(m)
+func (m *MsgSubmitEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEvidence.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitEvidence proto.InternalMessageInfo
 
-func (m *MsgCompleteContract) GetCreator() string {
+func (m *MsgSubmitEvidence) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCompleteContract) GetContractId() uint64 {
+func (m *MsgSubmitEvidence) GetDisputeId() uint64 {
 	if m != nil {
-		return m.ContractId
+		return m.DisputeId
 	}
 	return 0
 }
 
-// MsgCompleteContractResponse defines the MsgCompleteContractResponse message.
-type MsgCompleteContractResponse struct {
+func (m *MsgSubmitEvidence) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
 }
 
-func (m *MsgCompleteContractResponse) Reset()         { *m = MsgCompleteContractResponse{} }
-func (m *MsgCompleteContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCompleteContractResponse) ProtoMessage()    {}
-func (*MsgCompleteContractResponse) Descriptor() ([]byte, []int) {
+// MsgSubmitEvidenceResponse defines the MsgSubmitEvidenceResponse message.
+type MsgSubmitEvidenceResponse struct {
+}
+
+func (m *MsgSubmitEvidenceResponse) Reset()         { *m = MsgSubmitEvidenceResponse{} }
+func (m *MsgSubmitEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitEvidenceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{33}
 }
-func (m *MsgCompleteContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCompleteContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCompleteContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitEvidenceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1960,37 +1905,40 @@ func (m *MsgCompleteContractResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCompleteContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCompleteContractResponse.Merge(m, src)
+func (m *MsgSubmitEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEvidenceResponse.Merge(m, src)
 }
-func (m *MsgCompleteContractResponse) XXX_Size() int {
+func (m *MsgSubmitEvidenceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCompleteContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCompleteContractResponse.DiscardUnknown(m)
+func (m *MsgSubmitEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEvidenceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCompleteContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitEvidenceResponse proto.InternalMessageInfo
 
-// MsgDisputeContract defines the MsgDisputeContract message.
-type MsgDisputeContract struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+// MsgVoteDispute defines the MsgVoteDispute message.
+// Jurors commit to their vote during the voting period and reveal it with
+// MsgRevealDisputeVote once the voting period closed.
+type MsgVoteDispute struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	// Hex encoded sha256 of "<dispute id>:<juror>:<freelancer payout bps>:<salt>".
+	Commitment string `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (m *MsgDisputeContract) Reset()         { *m = MsgDisputeContract{} }
-func (m *MsgDisputeContract) String() string { return proto.CompactTextString(m) }
-func (*MsgDisputeContract) ProtoMessage()    {}
-func (*MsgDisputeContract) Descriptor() ([]byte, []int) {
+func (m *MsgVoteDispute) Reset()         { *m = MsgVoteDispute{} }
+func (m *MsgVoteDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteDispute) ProtoMessage()    {}
+func (*MsgVoteDispute) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{34}
 }
-func (m *MsgDisputeContract) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteDispute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDisputeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDisputeContract.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteDispute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2000,55 +1948,55 @@ func (m *MsgDisputeContract) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgDisputeContract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDisputeContract.Merge(m, src)
+func (m *MsgVoteDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteDispute.Merge(m, src)
 }
-func (m *MsgDisputeContract) XXX_Size() int {
+func (m *MsgVoteDispute) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDisputeContract) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDisputeContract.DiscardUnknown(m)
+func (m *MsgVoteDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteDispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDisputeContract proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteDispute proto.InternalMessageInfo
 
-func (m *MsgDisputeContract) GetCreator() string {
+func (m *MsgVoteDispute) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgDisputeContract) GetContractId() uint64 {
+func (m *MsgVoteDispute) GetDisputeId() uint64 {
 	if m != nil {
-		return m.ContractId
+		return m.DisputeId
 	}
 	return 0
 }
 
-func (m *MsgDisputeContract) GetReason() string {
+func (m *MsgVoteDispute) GetCommitment() string {
 	if m != nil {
-		return m.Reason
+		return m.Commitment
 	}
 	return ""
 }
 
-// MsgDisputeContractResponse defines the MsgDisputeContractResponse message.
-type MsgDisputeContractResponse struct {
+// MsgVoteDisputeResponse defines the MsgVoteDisputeResponse message.
+type MsgVoteDisputeResponse struct {
 }
 
-func (m *MsgDisputeContractResponse) Reset()         { *m = MsgDisputeContractResponse{} }
-func (m *MsgDisputeContractResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDisputeContractResponse) ProtoMessage()    {}
-func (*MsgDisputeContractResponse) Descriptor() ([]byte, []int) {
+func (m *MsgVoteDisputeResponse) Reset()         { *m = MsgVoteDisputeResponse{} }
+func (m *MsgVoteDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteDisputeResponse) ProtoMessage()    {}
+func (*MsgVoteDisputeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{35}
 }
-func (m *MsgDisputeContractResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDisputeContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDisputeContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteDisputeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2058,38 +2006,37 @@ func (m *MsgDisputeContractResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgDisputeContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDisputeContractResponse.Merge(m, src)
+func (m *MsgVoteDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteDisputeResponse.Merge(m, src)
 }
-func (m *MsgDisputeContractResponse) XXX_Size() int {
+func (m *MsgVoteDisputeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDisputeContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDisputeContractResponse.DiscardUnknown(m)
+func (m *MsgVoteDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteDisputeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDisputeContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteDisputeResponse proto.InternalMessageInfo
 
-// MsgOpenDispute defines the MsgOpenDispute message.
-type MsgOpenDispute struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Evidence   string `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+// MsgResolveDispute defines the MsgResolveDispute message.
+type MsgResolveDispute struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	Winner    string `protobuf:"bytes,3,opt,name=winner,proto3" json:"winner,omitempty"`
 }
 
-func (m *MsgOpenDispute) Reset()         { *m = MsgOpenDispute{} }
-func (m *MsgOpenDispute) String() string { return proto.CompactTextString(m) }
-func (*MsgOpenDispute) ProtoMessage()    {}
-func (*MsgOpenDispute) Descriptor() ([]byte, []int) {
+func (m *MsgResolveDispute) Reset()         { *m = MsgResolveDispute{} }
+func (m *MsgResolveDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDispute) ProtoMessage()    {}
+func (*MsgResolveDispute) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{36}
 }
-func (m *MsgOpenDispute) XXX_Unmarshal(b []byte) error {
+func (m *MsgResolveDispute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOpenDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOpenDispute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgResolveDispute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2099,63 +2046,55 @@ func (m *MsgOpenDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgOpenDispute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOpenDispute.Merge(m, src)
+func (m *MsgResolveDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDispute.Merge(m, src)
 }
-func (m *MsgOpenDispute) XXX_Size() int {
+func (m *MsgResolveDispute) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOpenDispute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOpenDispute.DiscardUnknown(m)
+func (m *MsgResolveDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOpenDispute proto.InternalMessageInfo
+var xxx_messageInfo_MsgResolveDispute proto.InternalMessageInfo
 
-func (m *MsgOpenDispute) GetCreator() string {
+func (m *MsgResolveDispute) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgOpenDispute) GetContractId() uint64 {
+func (m *MsgResolveDispute) GetDisputeId() uint64 {
 	if m != nil {
-		return m.ContractId
+		return m.DisputeId
 	}
 	return 0
 }
 
-func (m *MsgOpenDispute) GetReason() string {
-	if m != nil {
-		return m.Reason
-	}
-	return ""
-}
-
-func (m *MsgOpenDispute) GetEvidence() string {
+func (m *MsgResolveDispute) GetWinner() string {
 	if m != nil {
-		return m.Evidence
+		return m.Winner
 	}
 	return ""
 }
 
-// MsgOpenDisputeResponse defines the MsgOpenDisputeResponse message.
-type MsgOpenDisputeResponse struct {
-	DisputeId uint64 `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+// MsgResolveDisputeResponse defines the MsgResolveDisputeResponse message.
+type MsgResolveDisputeResponse struct {
 }
 
-func (m *MsgOpenDisputeResponse) Reset()         { *m = MsgOpenDisputeResponse{} }
-func (m *MsgOpenDisputeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgOpenDisputeResponse) ProtoMessage()    {}
-func (*MsgOpenDisputeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgResolveDisputeResponse) Reset()         { *m = MsgResolveDisputeResponse{} }
+func (m *MsgResolveDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDisputeResponse) ProtoMessage()    {}
+func (*MsgResolveDisputeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{37}
 }
-func (m *MsgOpenDisputeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgResolveDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgOpenDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgOpenDisputeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgResolveDisputeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2165,44 +2104,38 @@ func (m *MsgOpenDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgOpenDisputeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgOpenDisputeResponse.Merge(m, src)
+func (m *MsgResolveDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDisputeResponse.Merge(m, src)
 }
-func (m *MsgOpenDisputeResponse) XXX_Size() int {
+func (m *MsgResolveDisputeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgOpenDisputeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgOpenDisputeResponse.DiscardUnknown(m)
+func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDisputeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgOpenDisputeResponse proto.InternalMessageInfo
-
-func (m *MsgOpenDisputeResponse) GetDisputeId() uint64 {
-	if m != nil {
-		return m.DisputeId
-	}
-	return 0
-}
+var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
 
-// MsgSubmitEvidence defines the MsgSubmitEvidence message.
-type MsgSubmitEvidence struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
-	Evidence  string `protobuf:"bytes,3,opt,name=evidence,proto3" json:"evidence,omitempty"`
+// MsgDeliverMilestone defines the MsgDeliverMilestone message.
+type MsgDeliverMilestone struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId     uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	MilestoneIndex uint64 `protobuf:"varint,3,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+	DeliveryNote   string `protobuf:"bytes,4,opt,name=delivery_note,json=deliveryNote,proto3" json:"delivery_note,omitempty"`
 }
 
-func (m *MsgSubmitEvidence) Reset()         { *m = MsgSubmitEvidence{} }
-func (m *MsgSubmitEvidence) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitEvidence) ProtoMessage()    {}
-func (*MsgSubmitEvidence) Descriptor() ([]byte, []int) {
+func (m *MsgDeliverMilestone) Reset()         { *m = MsgDeliverMilestone{} }
+func (m *MsgDeliverMilestone) String() string { return proto.CompactTextString(m) }
+func (*MsgDeliverMilestone) ProtoMessage()    {}
+func (*MsgDeliverMilestone) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{38}
 }
-func (m *MsgSubmitEvidence) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeliverMilestone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeliverMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitEvidence.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeliverMilestone.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2212,55 +2145,62 @@ func (m *MsgSubmitEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitEvidence) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitEvidence.Merge(m, src)
+func (m *MsgDeliverMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeliverMilestone.Merge(m, src)
 }
-func (m *MsgSubmitEvidence) XXX_Size() int {
+func (m *MsgDeliverMilestone) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitEvidence) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitEvidence.DiscardUnknown(m)
+func (m *MsgDeliverMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeliverMilestone.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitEvidence proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeliverMilestone proto.InternalMessageInfo
 
-func (m *MsgSubmitEvidence) GetCreator() string {
+func (m *MsgDeliverMilestone) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSubmitEvidence) GetDisputeId() uint64 {
+func (m *MsgDeliverMilestone) GetContractId() uint64 {
 	if m != nil {
-		return m.DisputeId
+		return m.ContractId
 	}
 	return 0
 }
 
-func (m *MsgSubmitEvidence) GetEvidence() string {
+func (m *MsgDeliverMilestone) GetMilestoneIndex() uint64 {
 	if m != nil {
-		return m.Evidence
+		return m.MilestoneIndex
+	}
+	return 0
+}
+
+func (m *MsgDeliverMilestone) GetDeliveryNote() string {
+	if m != nil {
+		return m.DeliveryNote
 	}
 	return ""
 }
 
-// MsgSubmitEvidenceResponse defines the MsgSubmitEvidenceResponse message.
-type MsgSubmitEvidenceResponse struct {
+// MsgDeliverMilestoneResponse defines the MsgDeliverMilestoneResponse message.
+type MsgDeliverMilestoneResponse struct {
 }
 
-func (m *MsgSubmitEvidenceResponse) Reset()         { *m = MsgSubmitEvidenceResponse{} }
-func (m *MsgSubmitEvidenceResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitEvidenceResponse) ProtoMessage()    {}
-func (*MsgSubmitEvidenceResponse) Descriptor() ([]byte, []int) {
+func (m *MsgDeliverMilestoneResponse) Reset()         { *m = MsgDeliverMilestoneResponse{} }
+func (m *MsgDeliverMilestoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeliverMilestoneResponse) ProtoMessage()    {}
+func (*MsgDeliverMilestoneResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{39}
 }
-func (m *MsgSubmitEvidenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeliverMilestoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeliverMilestoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitEvidenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeliverMilestoneResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2270,37 +2210,37 @@ func (m *MsgSubmitEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitEvidenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitEvidenceResponse.Merge(m, src)
+func (m *MsgDeliverMilestoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeliverMilestoneResponse.Merge(m, src)
 }
-func (m *MsgSubmitEvidenceResponse) XXX_Size() int {
+func (m *MsgDeliverMilestoneResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitEvidenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitEvidenceResponse.DiscardUnknown(m)
+func (m *MsgDeliverMilestoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeliverMilestoneResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitEvidenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeliverMilestoneResponse proto.InternalMessageInfo
 
-// MsgVoteDispute defines the MsgVoteDispute message.
-type MsgVoteDispute struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
-	Vote      string `protobuf:"bytes,3,opt,name=vote,proto3" json:"vote,omitempty"`
+// MsgApproveMilestone defines the MsgApproveMilestone message.
+type MsgApproveMilestone struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId     uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	MilestoneIndex uint64 `protobuf:"varint,3,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
 }
 
-func (m *MsgVoteDispute) Reset()         { *m = MsgVoteDispute{} }
-func (m *MsgVoteDispute) String() string { return proto.CompactTextString(m) }
-func (*MsgVoteDispute) ProtoMessage()    {}
-func (*MsgVoteDispute) Descriptor() ([]byte, []int) {
+func (m *MsgApproveMilestone) Reset()         { *m = MsgApproveMilestone{} }
+func (m *MsgApproveMilestone) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMilestone) ProtoMessage()    {}
+func (*MsgApproveMilestone) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{40}
 }
-func (m *MsgVoteDispute) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveMilestone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgVoteDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgVoteDispute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveMilestone.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2310,55 +2250,55 @@ func (m *MsgVoteDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgVoteDispute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgVoteDispute.Merge(m, src)
+func (m *MsgApproveMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMilestone.Merge(m, src)
 }
-func (m *MsgVoteDispute) XXX_Size() int {
+func (m *MsgApproveMilestone) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgVoteDispute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgVoteDispute.DiscardUnknown(m)
+func (m *MsgApproveMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMilestone.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgVoteDispute proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveMilestone proto.InternalMessageInfo
 
-func (m *MsgVoteDispute) GetCreator() string {
+func (m *MsgApproveMilestone) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgVoteDispute) GetDisputeId() uint64 {
+func (m *MsgApproveMilestone) GetContractId() uint64 {
 	if m != nil {
-		return m.DisputeId
+		return m.ContractId
 	}
 	return 0
 }
 
-func (m *MsgVoteDispute) GetVote() string {
+func (m *MsgApproveMilestone) GetMilestoneIndex() uint64 {
 	if m != nil {
-		return m.Vote
+		return m.MilestoneIndex
 	}
-	return ""
+	return 0
 }
 
-// MsgVoteDisputeResponse defines the MsgVoteDisputeResponse message.
-type MsgVoteDisputeResponse struct {
+// MsgApproveMilestoneResponse defines the MsgApproveMilestoneResponse message.
+type MsgApproveMilestoneResponse struct {
 }
 
-func (m *MsgVoteDisputeResponse) Reset()         { *m = MsgVoteDisputeResponse{} }
-func (m *MsgVoteDisputeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgVoteDisputeResponse) ProtoMessage()    {}
-func (*MsgVoteDisputeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgApproveMilestoneResponse) Reset()         { *m = MsgApproveMilestoneResponse{} }
+func (m *MsgApproveMilestoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMilestoneResponse) ProtoMessage()    {}
+func (*MsgApproveMilestoneResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{41}
 }
-func (m *MsgVoteDisputeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveMilestoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgVoteDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveMilestoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgVoteDisputeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveMilestoneResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2368,37 +2308,39 @@ func (m *MsgVoteDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgVoteDisputeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgVoteDisputeResponse.Merge(m, src)
+func (m *MsgApproveMilestoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMilestoneResponse.Merge(m, src)
 }
-func (m *MsgVoteDisputeResponse) XXX_Size() int {
+func (m *MsgApproveMilestoneResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgVoteDisputeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgVoteDisputeResponse.DiscardUnknown(m)
+func (m *MsgApproveMilestoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMilestoneResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgVoteDisputeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveMilestoneResponse proto.InternalMessageInfo
 
-// MsgResolveDispute defines the MsgResolveDispute message.
-type MsgResolveDispute struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
-	Winner    string `protobuf:"bytes,3,opt,name=winner,proto3" json:"winner,omitempty"`
+// MsgCancelOverdueContract defines the MsgCancelOverdueContract message.
+type MsgCancelOverdueContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// reopen_gig puts the gig back to open so it accepts new applications,
+	// otherwise the gig is closed
+	ReopenGig bool `protobuf:"varint,3,opt,name=reopen_gig,json=reopenGig,proto3" json:"reopen_gig,omitempty"`
 }
 
-func (m *MsgResolveDispute) Reset()         { *m = MsgResolveDispute{} }
-func (m *MsgResolveDispute) String() string { return proto.CompactTextString(m) }
-func (*MsgResolveDispute) ProtoMessage()    {}
-func (*MsgResolveDispute) Descriptor() ([]byte, []int) {
+func (m *MsgCancelOverdueContract) Reset()         { *m = MsgCancelOverdueContract{} }
+func (m *MsgCancelOverdueContract) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOverdueContract) ProtoMessage()    {}
+func (*MsgCancelOverdueContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{42}
 }
-func (m *MsgResolveDispute) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelOverdueContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelOverdueContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResolveDispute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelOverdueContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2408,55 +2350,56 @@ func (m *MsgResolveDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgResolveDispute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResolveDispute.Merge(m, src)
+func (m *MsgCancelOverdueContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOverdueContract.Merge(m, src)
 }
-func (m *MsgResolveDispute) XXX_Size() int {
+func (m *MsgCancelOverdueContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResolveDispute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResolveDispute.DiscardUnknown(m)
+func (m *MsgCancelOverdueContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOverdueContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResolveDispute proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelOverdueContract proto.InternalMessageInfo
 
-func (m *MsgResolveDispute) GetCreator() string {
+func (m *MsgCancelOverdueContract) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgResolveDispute) GetDisputeId() uint64 {
+func (m *MsgCancelOverdueContract) GetContractId() uint64 {
 	if m != nil {
-		return m.DisputeId
+		return m.ContractId
 	}
 	return 0
 }
 
-func (m *MsgResolveDispute) GetWinner() string {
+func (m *MsgCancelOverdueContract) GetReopenGig() bool {
 	if m != nil {
-		return m.Winner
+		return m.ReopenGig
 	}
-	return ""
+	return false
 }
 
-// MsgResolveDisputeResponse defines the MsgResolveDisputeResponse message.
-type MsgResolveDisputeResponse struct {
+// MsgCancelOverdueContractResponse defines the MsgCancelOverdueContractResponse message.
+type MsgCancelOverdueContractResponse struct {
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
 }
 
-func (m *MsgResolveDisputeResponse) Reset()         { *m = MsgResolveDisputeResponse{} }
-func (m *MsgResolveDisputeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgResolveDisputeResponse) ProtoMessage()    {}
-func (*MsgResolveDisputeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelOverdueContractResponse) Reset()         { *m = MsgCancelOverdueContractResponse{} }
+func (m *MsgCancelOverdueContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOverdueContractResponse) ProtoMessage()    {}
+func (*MsgCancelOverdueContractResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9b0e8ad05870c9a3, []int{43}
 }
-func (m *MsgResolveDisputeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelOverdueContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelOverdueContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResolveDisputeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelOverdueContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2466,3469 +2409,12298 @@ func (m *MsgResolveDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgResolveDisputeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResolveDisputeResponse.Merge(m, src)
+func (m *MsgCancelOverdueContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOverdueContractResponse.Merge(m, src)
 }
-func (m *MsgResolveDisputeResponse) XXX_Size() int {
+func (m *MsgCancelOverdueContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResolveDisputeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResolveDisputeResponse.DiscardUnknown(m)
+func (m *MsgCancelOverdueContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOverdueContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResolveDisputeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelOverdueContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "skillchain.marketplace.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "skillchain.marketplace.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateProfile)(nil), "skillchain.marketplace.v1.MsgCreateProfile")
-	proto.RegisterType((*MsgCreateProfileResponse)(nil), "skillchain.marketplace.v1.MsgCreateProfileResponse")
-	proto.RegisterType((*MsgUpdateProfile)(nil), "skillchain.marketplace.v1.MsgUpdateProfile")
-	proto.RegisterType((*MsgUpdateProfileResponse)(nil), "skillchain.marketplace.v1.MsgUpdateProfileResponse")
-	proto.RegisterType((*MsgCreateGig)(nil), "skillchain.marketplace.v1.MsgCreateGig")
-	proto.RegisterType((*MsgCreateGigResponse)(nil), "skillchain.marketplace.v1.MsgCreateGigResponse")
-	proto.RegisterType((*MsgUpdateGigStatus)(nil), "skillchain.marketplace.v1.MsgUpdateGigStatus")
-	proto.RegisterType((*MsgUpdateGigStatusResponse)(nil), "skillchain.marketplace.v1.MsgUpdateGigStatusResponse")
-	proto.RegisterType((*MsgCreateApplication)(nil), "skillchain.marketplace.v1.MsgCreateApplication")
-	proto.RegisterType((*MsgCreateApplicationResponse)(nil), "skillchain.marketplace.v1.MsgCreateApplicationResponse")
-	proto.RegisterType((*MsgUpdateApplication)(nil), "skillchain.marketplace.v1.MsgUpdateApplication")
-	proto.RegisterType((*MsgUpdateApplicationResponse)(nil), "skillchain.marketplace.v1.MsgUpdateApplicationResponse")
-	proto.RegisterType((*MsgDeleteApplication)(nil), "skillchain.marketplace.v1.MsgDeleteApplication")
-	proto.RegisterType((*MsgDeleteApplicationResponse)(nil), "skillchain.marketplace.v1.MsgDeleteApplicationResponse")
-	proto.RegisterType((*MsgCreateContract)(nil), "skillchain.marketplace.v1.MsgCreateContract")
-	proto.RegisterType((*MsgCreateContractResponse)(nil), "skillchain.marketplace.v1.MsgCreateContractResponse")
-	proto.RegisterType((*MsgUpdateContract)(nil), "skillchain.marketplace.v1.MsgUpdateContract")
-	proto.RegisterType((*MsgUpdateContractResponse)(nil), "skillchain.marketplace.v1.MsgUpdateContractResponse")
-	proto.RegisterType((*MsgDeleteContract)(nil), "skillchain.marketplace.v1.MsgDeleteContract")
-	proto.RegisterType((*MsgDeleteContractResponse)(nil), "skillchain.marketplace.v1.MsgDeleteContractResponse")
-	proto.RegisterType((*MsgApplyToGig)(nil), "skillchain.marketplace.v1.MsgApplyToGig")
-	proto.RegisterType((*MsgApplyToGigResponse)(nil), "skillchain.marketplace.v1.MsgApplyToGigResponse")
-	proto.RegisterType((*MsgWithdrawApplication)(nil), "skillchain.marketplace.v1.MsgWithdrawApplication")
-	proto.RegisterType((*MsgWithdrawApplicationResponse)(nil), "skillchain.marketplace.v1.MsgWithdrawApplicationResponse")
-	proto.RegisterType((*MsgAcceptApplication)(nil), "skillchain.marketplace.v1.MsgAcceptApplication")
-	proto.RegisterType((*MsgAcceptApplicationResponse)(nil), "skillchain.marketplace.v1.MsgAcceptApplicationResponse")
-	proto.RegisterType((*MsgRejectApplication)(nil), "skillchain.marketplace.v1.MsgRejectApplication")
-	proto.RegisterType((*MsgRejectApplicationResponse)(nil), "skillchain.marketplace.v1.MsgRejectApplicationResponse")
-	proto.RegisterType((*MsgDeliverContract)(nil), "skillchain.marketplace.v1.MsgDeliverContract")
-	proto.RegisterType((*MsgDeliverContractResponse)(nil), "skillchain.marketplace.v1.MsgDeliverContractResponse")
-	proto.RegisterType((*MsgCompleteContract)(nil), "skillchain.marketplace.v1.MsgCompleteContract")
-	proto.RegisterType((*MsgCompleteContractResponse)(nil), "skillchain.marketplace.v1.MsgCompleteContractResponse")
-	proto.RegisterType((*MsgDisputeContract)(nil), "skillchain.marketplace.v1.MsgDisputeContract")
-	proto.RegisterType((*MsgDisputeContractResponse)(nil), "skillchain.marketplace.v1.MsgDisputeContractResponse")
-	proto.RegisterType((*MsgOpenDispute)(nil), "skillchain.marketplace.v1.MsgOpenDispute")
-	proto.RegisterType((*MsgOpenDisputeResponse)(nil), "skillchain.marketplace.v1.MsgOpenDisputeResponse")
-	proto.RegisterType((*MsgSubmitEvidence)(nil), "skillchain.marketplace.v1.MsgSubmitEvidence")
-	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "skillchain.marketplace.v1.MsgSubmitEvidenceResponse")
-	proto.RegisterType((*MsgVoteDispute)(nil), "skillchain.marketplace.v1.MsgVoteDispute")
-	proto.RegisterType((*MsgVoteDisputeResponse)(nil), "skillchain.marketplace.v1.MsgVoteDisputeResponse")
-	proto.RegisterType((*MsgResolveDispute)(nil), "skillchain.marketplace.v1.MsgResolveDispute")
-	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "skillchain.marketplace.v1.MsgResolveDisputeResponse")
+func (m *MsgCancelOverdueContractResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("skillchain/marketplace/v1/tx.proto", fileDescriptor_9b0e8ad05870c9a3)
+// MsgProposeCancellation defines the MsgProposeCancellation message.
+type MsgProposeCancellation struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// freelancer_payout is the part of the escrow paid to the freelancer, the
+	// rest is refunded to the client
+	FreelancerPayout cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=freelancer_payout,json=freelancerPayout,proto3,customtype=cosmossdk.io/math.Int" json:"freelancer_payout"`
+	Reason           string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-var fileDescriptor_9b0e8ad05870c9a3 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6f, 0xdc, 0x54,
-	0x17, 0x8e, 0xe7, 0x2b, 0x99, 0x33, 0x49, 0x9a, 0xb8, 0x69, 0x3b, 0x71, 0xdb, 0xe9, 0x74, 0xaa,
-	0xf6, 0x9d, 0x37, 0x49, 0x67, 0xde, 0xa4, 0x2f, 0x2d, 0x14, 0x09, 0x94, 0x36, 0xa8, 0xaa, 0x44,
-	0xa0, 0x72, 0xf9, 0x90, 0xd8, 0x8c, 0x5c, 0xfb, 0xd6, 0xb9, 0xd4, 0x63, 0x1b, 0xfb, 0x66, 0xda,
-	0x41, 0xaa, 0xf8, 0x52, 0x37, 0x08, 0x89, 0xfe, 0x00, 0xf6, 0xb0, 0x8c, 0x04, 0xfc, 0x02, 0x84,
-	0xd4, 0x05, 0x8b, 0x8a, 0x0d, 0xac, 0x00, 0xb5, 0x8b, 0xf0, 0x13, 0x58, 0x22, 0x5f, 0x7b, 0xee,
-	0xf8, 0x73, 0xec, 0x49, 0x52, 0x2a, 0x36, 0xd1, 0xdc, 0xe3, 0x73, 0xef, 0x79, 0x9e, 0xe7, 0xdc,
-	0x7b, 0x7c, 0xae, 0x03, 0x0d, 0xfb, 0x0e, 0xd6, 0x34, 0x79, 0x4b, 0xc2, 0x7a, 0xbb, 0x2b, 0x59,
-	0x77, 0x10, 0x31, 0x35, 0x49, 0x46, 0xed, 0xde, 0x6a, 0x9b, 0xdc, 0x6b, 0x99, 0x96, 0x41, 0x0c,
-	0x7e, 0x71, 0xe8, 0xd3, 0xf2, 0xf9, 0xb4, 0x7a, 0xab, 0xc2, 0xbc, 0xd4, 0xc5, 0xba, 0xd1, 0xa6,
-	0x7f, 0x5d, 0x6f, 0xe1, 0x98, 0x6c, 0xd8, 0x5d, 0xc3, 0x6e, 0x77, 0x6d, 0xd5, 0x59, 0xa5, 0x6b,
-	0xab, 0xde, 0x83, 0x45, 0xf7, 0x41, 0x87, 0x8e, 0xda, 0xee, 0xc0, 0x7b, 0xb4, 0xa0, 0x1a, 0xaa,
-	0xe1, 0xda, 0x9d, 0x5f, 0x9e, 0xf5, 0x5c, 0x32, 0x36, 0x53, 0xb2, 0xa4, 0xae, 0x37, 0xbb, 0xf1,
-	0x13, 0x07, 0x87, 0x36, 0x6d, 0xf5, 0x6d, 0x53, 0x91, 0x08, 0xba, 0x41, 0x9f, 0xf0, 0x17, 0xa1,
-	0x2c, 0x6d, 0x93, 0x2d, 0xc3, 0xc2, 0xa4, 0x5f, 0xe5, 0xea, 0x5c, 0xb3, 0x7c, 0xa5, 0xfa, 0xf3,
-	0x77, 0xe7, 0x17, 0xbc, 0xb0, 0xeb, 0x8a, 0x62, 0x21, 0xdb, 0xbe, 0x49, 0x2c, 0xac, 0xab, 0xe2,
-	0xd0, 0x95, 0xdf, 0x80, 0x92, 0xbb, 0x76, 0x35, 0x57, 0xe7, 0x9a, 0x95, 0xb5, 0xd3, 0xad, 0x44,
-	0xf2, 0x2d, 0x37, 0xd4, 0x95, 0xf2, 0xa3, 0xdf, 0x4e, 0x4d, 0x7c, 0xb3, 0xbb, 0xb3, 0xc4, 0x89,
-	0xde, 0xdc, 0xcb, 0x2f, 0x7f, 0xba, 0xbb, 0xb3, 0x34, 0x5c, 0xf5, 0xf3, 0xdd, 0x9d, 0xa5, 0xa6,
-	0x8f, 0xcc, 0xbd, 0x00, 0x9d, 0x10, 0xf4, 0xc6, 0x22, 0x1c, 0x0b, 0x99, 0x44, 0x64, 0x9b, 0x86,
-	0x6e, 0xa3, 0xc6, 0xb7, 0x1c, 0xcc, 0x6d, 0xda, 0xea, 0x55, 0x0b, 0x39, 0xcf, 0x2c, 0xe3, 0x36,
-	0xd6, 0x10, 0xbf, 0x06, 0x93, 0xb2, 0x63, 0x30, 0xac, 0x54, 0xa2, 0x03, 0x47, 0x9e, 0x87, 0x82,
-	0x2e, 0x75, 0x11, 0x25, 0x59, 0x16, 0xe9, 0x6f, 0x7e, 0x0e, 0xf2, 0xb7, 0xb0, 0x51, 0xcd, 0x53,
-	0x93, 0xf3, 0x93, 0x3f, 0x0a, 0x25, 0x8a, 0xda, 0xae, 0x16, 0xea, 0xf9, 0x66, 0x59, 0xf4, 0x46,
-	0xfc, 0x29, 0xa8, 0x6c, 0x19, 0xdb, 0x96, 0xd6, 0xef, 0x58, 0x12, 0x41, 0xd5, 0x62, 0x9d, 0x6b,
-	0x16, 0x44, 0x70, 0x4d, 0xa2, 0x44, 0xd0, 0xe5, 0x69, 0x87, 0xff, 0x20, 0x58, 0x63, 0x09, 0xaa,
-	0x61, 0xd0, 0x03, 0x46, 0xfc, 0x2c, 0xe4, 0xb0, 0x42, 0x71, 0x17, 0xc4, 0x1c, 0x56, 0x06, 0x0c,
-	0x3d, 0xf6, 0xff, 0x16, 0x86, 0x02, 0x65, 0x18, 0x00, 0xcd, 0x72, 0xf6, 0x3b, 0x07, 0xd3, 0x8c,
-	0xfe, 0x35, 0xac, 0xee, 0x89, 0xcd, 0x02, 0x14, 0x09, 0x26, 0xda, 0x80, 0x8e, 0x3b, 0xe0, 0xeb,
-	0x50, 0x51, 0x90, 0x2d, 0x5b, 0xd8, 0x24, 0xd8, 0xd0, 0x3d, 0x5e, 0x7e, 0x93, 0x33, 0xcf, 0xb4,
-	0xb0, 0x8c, 0xaa, 0x05, 0xca, 0xc0, 0x1d, 0xf0, 0x02, 0x4c, 0xc9, 0x12, 0x41, 0xaa, 0x61, 0xf5,
-	0x29, 0xb5, 0xb2, 0xc8, 0xc6, 0xfc, 0x19, 0x98, 0x51, 0x90, 0x86, 0x7b, 0xc8, 0xea, 0x77, 0x14,
-	0xa9, 0x6f, 0x57, 0x4b, 0x74, 0xe6, 0xf4, 0xc0, 0xb8, 0x21, 0xf5, 0xed, 0x10, 0xfb, 0x73, 0xb0,
-	0xe0, 0x27, 0x98, 0x98, 0xdb, 0x07, 0x1c, 0xf0, 0x4c, 0xa6, 0x6b, 0x58, 0xbd, 0x49, 0x24, 0xb2,
-	0x6d, 0xef, 0x49, 0x8f, 0x23, 0x50, 0x52, 0xb1, 0xda, 0xc1, 0x0a, 0x15, 0xa4, 0x20, 0x16, 0x55,
-	0xac, 0x5e, 0x57, 0x68, 0x3a, 0xe9, 0xa2, 0x9e, 0x16, 0xde, 0x28, 0x84, 0xf7, 0x04, 0x08, 0x51,
-	0x18, 0x2c, 0x5f, 0xdf, 0xe7, 0x7c, 0x74, 0xd6, 0x4d, 0x53, 0xc3, 0xb2, 0x44, 0xb5, 0x3c, 0x40,
-	0x9c, 0x35, 0x80, 0xdb, 0x16, 0x42, 0x9a, 0xa4, 0xcb, 0xc8, 0xf2, 0xb0, 0xfa, 0x2c, 0xfc, 0x69,
-	0x98, 0x96, 0x8d, 0x1e, 0xb2, 0x3a, 0x1a, 0x22, 0x04, 0x59, 0x34, 0x7b, 0x65, 0xb1, 0x42, 0x6d,
-	0xaf, 0x53, 0x13, 0x7f, 0x16, 0x66, 0x4d, 0xcb, 0x30, 0x0d, 0x1b, 0x29, 0x1d, 0x37, 0xc5, 0xee,
-	0x26, 0x9d, 0x19, 0x58, 0x6f, 0xd0, 0x54, 0x9f, 0x01, 0x66, 0x08, 0xa4, 0x73, 0x60, 0x74, 0xd2,
-	0xe9, 0x93, 0x6d, 0xd2, 0x2f, 0x1b, 0x7f, 0x12, 0x80, 0x12, 0x41, 0x4a, 0x47, 0x22, 0xd5, 0xa9,
-	0x3a, 0xd7, 0xcc, 0x8b, 0x65, 0xcf, 0xb2, 0x4e, 0x42, 0xaa, 0xb6, 0xe0, 0x44, 0x9c, 0x6c, 0x89,
-	0xbb, 0xe1, 0x47, 0x57, 0x67, 0x37, 0x0d, 0xfb, 0xd5, 0xd9, 0x5d, 0x3c, 0x37, 0x58, 0xdc, 0xa7,
-	0x7b, 0x3e, 0x59, 0xf7, 0x42, 0xaa, 0xee, 0xc5, 0x2c, 0xba, 0x97, 0x32, 0xe9, 0x3e, 0x39, 0x52,
-	0xf7, 0xa9, 0x11, 0xba, 0x97, 0x47, 0xeb, 0x5e, 0xa3, 0xba, 0x47, 0x64, 0x64, 0xfb, 0x79, 0x8b,
-	0xca, 0xbc, 0x81, 0x34, 0x74, 0xe0, 0x32, 0xc7, 0x22, 0x89, 0x44, 0x62, 0x48, 0xfe, 0xcc, 0xc1,
-	0x3c, 0xdb, 0x22, 0x57, 0x0d, 0x9d, 0x58, 0x92, 0x4c, 0x0e, 0xf2, 0x58, 0x9d, 0x85, 0x59, 0x69,
-	0x18, 0x77, 0x98, 0xfd, 0x19, 0x9f, 0xd5, 0xad, 0x12, 0xb2, 0x86, 0x91, 0x4e, 0xbc, 0x1d, 0xe0,
-	0x8d, 0x42, 0xbb, 0xa3, 0x18, 0xd9, 0x1d, 0xac, 0x98, 0x96, 0xfc, 0xc5, 0x74, 0x19, 0xe6, 0x87,
-	0x05, 0x13, 0x49, 0x8a, 0x86, 0x75, 0x44, 0xb3, 0x9d, 0x17, 0xe7, 0x58, 0xd1, 0xf4, 0xec, 0x7b,
-	0xcc, 0xb8, 0xbb, 0x2f, 0xbb, 0xa6, 0x23, 0x2c, 0x75, 0x00, 0xea, 0x50, 0x61, 0xb6, 0xc8, 0xa6,
-	0x58, 0x86, 0xc5, 0x88, 0xd2, 0x89, 0x27, 0xf1, 0x2f, 0x37, 0x2f, 0xee, 0x16, 0xda, 0x57, 0x5e,
-	0x32, 0x1e, 0xc3, 0x68, 0x9e, 0x0a, 0xa3, 0xf3, 0x54, 0x1c, 0x91, 0xa7, 0x52, 0x72, 0x9e, 0x26,
-	0x53, 0xf3, 0x34, 0x95, 0x9a, 0xa7, 0xf2, 0x88, 0x3c, 0x41, 0x5a, 0x9e, 0x2a, 0x69, 0x79, 0x3a,
-	0x4e, 0xf3, 0x14, 0x54, 0x9e, 0x9d, 0x17, 0x44, 0xd3, 0xe2, 0x9e, 0xa7, 0x83, 0x4c, 0x4b, 0x2c,
-	0x86, 0x60, 0x18, 0x86, 0xe1, 0x17, 0x0e, 0x66, 0x36, 0x6d, 0xd5, 0x39, 0xce, 0xfd, 0xb7, 0x8c,
-	0xbd, 0xb6, 0x2f, 0x09, 0xe7, 0x35, 0x5c, 0x6e, 0xf3, 0x59, 0xca, 0x6d, 0x21, 0x53, 0xb9, 0x2d,
-	0x46, 0xcb, 0x6d, 0x88, 0xf6, 0x2b, 0x70, 0x24, 0x40, 0x8c, 0x1d, 0x8f, 0xe8, 0xee, 0xe4, 0x62,
-	0x76, 0x67, 0xe3, 0x13, 0x0e, 0x8e, 0x6e, 0xda, 0xea, 0xbb, 0x98, 0x6c, 0x29, 0x96, 0x74, 0x77,
-	0xbf, 0xa5, 0x35, 0x1a, 0x35, 0x17, 0x13, 0x35, 0xc4, 0xa1, 0x0e, 0xb5, 0x78, 0x08, 0x2c, 0x7f,
-	0x1f, 0xd1, 0xea, 0xbf, 0x2e, 0xcb, 0xc8, 0x24, 0xcf, 0x05, 0xe2, 0xab, 0xf4, 0xa5, 0x10, 0x01,
-	0xc0, 0xd4, 0x3e, 0x05, 0x15, 0xd9, 0xdb, 0x74, 0x43, 0xa9, 0x61, 0x60, 0xba, 0xae, 0x78, 0x0c,
-	0x44, 0xf4, 0x3e, 0x92, 0x9f, 0x0f, 0x03, 0xf7, 0xb5, 0x16, 0x01, 0xc0, 0x24, 0xfe, 0xca, 0x6d,
-	0x6b, 0x37, 0xdc, 0x1a, 0xb2, 0xaf, 0x83, 0x1a, 0x12, 0x23, 0x17, 0x16, 0x23, 0xd0, 0x9d, 0xeb,
-	0x06, 0x41, 0xde, 0x91, 0x61, 0xdd, 0xf9, 0x1b, 0x46, 0xe4, 0x6e, 0xe2, 0x76, 0xbb, 0x21, 0x74,
-	0x0c, 0xfc, 0x3d, 0x38, 0xec, 0xbc, 0x28, 0xbc, 0x02, 0xf5, 0x4c, 0xc1, 0x87, 0x70, 0x9d, 0x84,
-	0xe3, 0x31, 0x91, 0x19, 0xb0, 0x2f, 0x3d, 0x55, 0xb1, 0x6d, 0x6e, 0x3f, 0x63, 0x60, 0x4e, 0xb5,
-	0xb7, 0x90, 0x64, 0xb3, 0x2b, 0x94, 0x37, 0x8a, 0x17, 0x32, 0x08, 0x88, 0xe1, 0xfd, 0x9a, 0x83,
-	0xd9, 0x4d, 0x5b, 0x7d, 0xd3, 0x44, 0xba, 0xe7, 0xf2, 0x8f, 0x62, 0x75, 0xee, 0x74, 0xa8, 0x87,
-	0x15, 0xa4, 0x7b, 0x25, 0xb2, 0x2c, 0xb2, 0x71, 0x88, 0xc7, 0x25, 0x5a, 0xb7, 0x7c, 0x40, 0xd9,
-	0x59, 0x3c, 0x09, 0xa0, 0xb8, 0xa6, 0xe1, 0x51, 0x2c, 0x7b, 0x96, 0xeb, 0x4a, 0xe3, 0x21, 0x47,
-	0x5f, 0x48, 0x37, 0xb7, 0x6f, 0x75, 0x31, 0x79, 0xcd, 0x5b, 0x7c, 0x4f, 0x2c, 0x83, 0x81, 0x72,
-	0xa1, 0x40, 0x01, 0x2e, 0xf9, 0x91, 0x5c, 0xdc, 0x77, 0x57, 0x10, 0x11, 0x4b, 0xc9, 0x03, 0x37,
-	0x25, 0xef, 0x18, 0x04, 0xed, 0x27, 0x25, 0x29, 0x60, 0x79, 0x28, 0xf4, 0x86, 0x27, 0x91, 0xfe,
-	0x0e, 0x81, 0xac, 0x52, 0xc1, 0x7d, 0x30, 0x18, 0xc2, 0x2f, 0x5c, 0x45, 0x45, 0x64, 0x1b, 0x5a,
-	0xef, 0x59, 0x82, 0x3c, 0x0a, 0xa5, 0xbb, 0x58, 0xd7, 0xd9, 0x3b, 0xd6, 0x1b, 0xc5, 0xaa, 0x19,
-	0x44, 0x33, 0xc0, 0xba, 0xf6, 0xc3, 0x02, 0xe4, 0x37, 0x6d, 0x95, 0xd7, 0x61, 0x3a, 0xf0, 0xa5,
-	0x6d, 0x69, 0xc4, 0x17, 0xb2, 0xd0, 0x77, 0x2c, 0x61, 0x2d, 0xbb, 0x2f, 0xdb, 0x94, 0x1f, 0xc0,
-	0x4c, 0xf0, 0x7b, 0xd7, 0xf2, 0xe8, 0x45, 0x02, 0xce, 0xc2, 0x85, 0x31, 0x9c, 0xfd, 0x21, 0x83,
-	0x1f, 0xa0, 0x96, 0x33, 0xe1, 0xce, 0x16, 0x32, 0xf6, 0x2b, 0x11, 0x8f, 0xa0, 0x3c, 0xfc, 0x42,
-	0xf4, 0x9f, 0x2c, 0xa0, 0xaf, 0x61, 0x55, 0x68, 0x67, 0x74, 0x64, 0x61, 0xee, 0xc2, 0xa1, 0xf0,
-	0xe7, 0x97, 0xf3, 0x59, 0xe0, 0x32, 0x77, 0xe1, 0x85, 0xb1, 0xdc, 0x59, 0xe0, 0xfb, 0x30, 0x1f,
-	0xfd, 0xa2, 0x92, 0x09, 0xbe, 0x6f, 0x82, 0x70, 0x69, 0xcc, 0x09, 0xfe, 0xf0, 0xd1, 0x0f, 0x0d,
-	0xed, 0x2c, 0x54, 0xc6, 0x08, 0x9f, 0x78, 0x07, 0x77, 0xc2, 0x47, 0x2f, 0xe0, 0x29, 0xe1, 0x23,
-	0x13, 0xd2, 0xc2, 0x27, 0x5e, 0xbc, 0x79, 0x02, 0xb3, 0xa1, 0x4b, 0xf7, 0x4a, 0x16, 0x21, 0x07,
-	0xde, 0xc2, 0xff, 0xc7, 0xf1, 0xf6, 0x47, 0x0d, 0x5d, 0x29, 0x57, 0xb2, 0xe8, 0x97, 0x35, 0x6a,
-	0xfc, 0xa5, 0xc9, 0x89, 0x1a, 0xba, 0x31, 0xad, 0x64, 0x91, 0x2d, 0x6b, 0xd4, 0xf8, 0x6b, 0x12,
-	0xbf, 0x05, 0xe0, 0xbb, 0x22, 0x35, 0x47, 0xaf, 0x31, 0xf4, 0x14, 0xfe, 0x97, 0xd5, 0x93, 0x45,
-	0xfa, 0x8c, 0x83, 0xc3, 0x71, 0x77, 0x8e, 0xd5, 0xd1, 0x2b, 0xc5, 0x4c, 0x11, 0x5e, 0x1a, 0x7b,
-	0x8a, 0x7f, 0x43, 0x47, 0xef, 0x14, 0x29, 0x1b, 0x3a, 0x32, 0x21, 0x6d, 0x43, 0x27, 0x5f, 0x1a,
-	0xee, 0xc3, 0x7c, 0xf4, 0x42, 0x90, 0x12, 0x3e, 0x32, 0x21, 0x2d, 0x7c, 0x62, 0xc7, 0xef, 0x54,
-	0xd1, 0x70, 0xb7, 0x7f, 0x3e, 0x75, 0xdb, 0xf8, 0xdd, 0xd3, 0xaa, 0x68, 0x42, 0xb7, 0xce, 0x7f,
-	0x08, 0x73, 0x91, 0x56, 0xbd, 0x95, 0x72, 0x38, 0x43, 0xfe, 0xc2, 0xc5, 0xf1, 0xfc, 0x03, 0xa4,
-	0x43, 0xcd, 0x78, 0x1a, 0xe9, 0xa0, 0x7b, 0x2a, 0xe9, 0xf8, 0xce, 0x9a, 0xbf, 0x03, 0x15, 0x7f,
-	0x57, 0xfd, 0xdf, 0xd1, 0xab, 0xf8, 0x5c, 0x85, 0xd5, 0xcc, 0xae, 0xfe, 0xf2, 0x11, 0xea, 0x6f,
-	0x53, 0xca, 0x47, 0xd0, 0x3b, 0xad, 0x7c, 0xc4, 0x77, 0xaa, 0x0e, 0x45, 0x7f, 0x97, 0x9a, 0x42,
-	0xd1, 0xe7, 0x9a, 0x46, 0x31, 0xa6, 0xe9, 0x74, 0x28, 0x86, 0x1a, 0xce, 0x95, 0xb4, 0x83, 0xe0,
-	0xf7, 0x4e, 0xa3, 0x18, 0xdf, 0x3e, 0x0a, 0xc5, 0x8f, 0x77, 0x77, 0x96, 0xb8, 0x2b, 0x2f, 0x3e,
-	0x7a, 0x52, 0xe3, 0x1e, 0x3f, 0xa9, 0x71, 0x7f, 0x3c, 0xa9, 0x71, 0x0f, 0x9f, 0xd6, 0x26, 0x1e,
-	0x3f, 0xad, 0x4d, 0xfc, 0xfa, 0xb4, 0x36, 0xf1, 0x5e, 0x2d, 0xf1, 0x1f, 0xa4, 0xa4, 0x6f, 0x22,
-	0xfb, 0x56, 0x89, 0xfe, 0xb3, 0xf7, 0xc2, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x92, 0x1e, 0x59,
-	0xd0, 0xb2, 0x1e, 0x00, 0x00,
+func (m *MsgProposeCancellation) Reset()         { *m = MsgProposeCancellation{} }
+func (m *MsgProposeCancellation) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeCancellation) ProtoMessage()    {}
+func (*MsgProposeCancellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{44}
+}
+func (m *MsgProposeCancellation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeCancellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeCancellation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeCancellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeCancellation.Merge(m, src)
+}
+func (m *MsgProposeCancellation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeCancellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeCancellation.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgProposeCancellation proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgProposeCancellation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateProfile defines the CreateProfile RPC.
-	CreateProfile(ctx context.Context, in *MsgCreateProfile, opts ...grpc.CallOption) (*MsgCreateProfileResponse, error)
-	// UpdateProfile defines the UpdateProfile RPC.
-	UpdateProfile(ctx context.Context, in *MsgUpdateProfile, opts ...grpc.CallOption) (*MsgUpdateProfileResponse, error)
-	// CreateGig defines the CreateGig RPC.
-	CreateGig(ctx context.Context, in *MsgCreateGig, opts ...grpc.CallOption) (*MsgCreateGigResponse, error)
-	// UpdateGigStatus defines the UpdateGigStatus RPC.
-	UpdateGigStatus(ctx context.Context, in *MsgUpdateGigStatus, opts ...grpc.CallOption) (*MsgUpdateGigStatusResponse, error)
-	// CreateApplication defines the CreateApplication RPC.
-	CreateApplication(ctx context.Context, in *MsgCreateApplication, opts ...grpc.CallOption) (*MsgCreateApplicationResponse, error)
-	// UpdateApplication defines the UpdateApplication RPC.
-	UpdateApplication(ctx context.Context, in *MsgUpdateApplication, opts ...grpc.CallOption) (*MsgUpdateApplicationResponse, error)
-	// DeleteApplication defines the DeleteApplication RPC.
-	DeleteApplication(ctx context.Context, in *MsgDeleteApplication, opts ...grpc.CallOption) (*MsgDeleteApplicationResponse, error)
-	// CreateContract defines the CreateContract RPC.
-	CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error)
-	// UpdateContract defines the UpdateContract RPC.
-	UpdateContract(ctx context.Context, in *MsgUpdateContract, opts ...grpc.CallOption) (*MsgUpdateContractResponse, error)
-	// DeleteContract defines the DeleteContract RPC.
-	DeleteContract(ctx context.Context, in *MsgDeleteContract, opts ...grpc.CallOption) (*MsgDeleteContractResponse, error)
-	// ApplyToGig defines the ApplyToGig RPC.
-	ApplyToGig(ctx context.Context, in *MsgApplyToGig, opts ...grpc.CallOption) (*MsgApplyToGigResponse, error)
-	// WithdrawApplication defines the WithdrawApplication RPC.
-	WithdrawApplication(ctx context.Context, in *MsgWithdrawApplication, opts ...grpc.CallOption) (*MsgWithdrawApplicationResponse, error)
-	// AcceptApplication defines the AcceptApplication RPC.
-	AcceptApplication(ctx context.Context, in *MsgAcceptApplication, opts ...grpc.CallOption) (*MsgAcceptApplicationResponse, error)
-	// RejectApplication defines the RejectApplication RPC.
-	RejectApplication(ctx context.Context, in *MsgRejectApplication, opts ...grpc.CallOption) (*MsgRejectApplicationResponse, error)
-	// DeliverContract defines the DeliverContract RPC.
-	DeliverContract(ctx context.Context, in *MsgDeliverContract, opts ...grpc.CallOption) (*MsgDeliverContractResponse, error)
-	// CompleteContract defines the CompleteContract RPC.
-	CompleteContract(ctx context.Context, in *MsgCompleteContract, opts ...grpc.CallOption) (*MsgCompleteContractResponse, error)
-	// DisputeContract defines the DisputeContract RPC.
-	DisputeContract(ctx context.Context, in *MsgDisputeContract, opts ...grpc.CallOption) (*MsgDisputeContractResponse, error)
-	// OpenDispute defines the OpenDispute RPC.
-	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
-	// SubmitEvidence defines the SubmitEvidence RPC.
-	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
-	// VoteDispute defines the VoteDispute RPC.
-	VoteDispute(ctx context.Context, in *MsgVoteDispute, opts ...grpc.CallOption) (*MsgVoteDisputeResponse, error)
-	// ResolveDispute defines the ResolveDispute RPC.
-	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+func (m *MsgProposeCancellation) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProposeCancellation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgProposeCancellationResponse defines the MsgProposeCancellationResponse message.
+type MsgProposeCancellationResponse struct {
+	ExpiresAt int64 `protobuf:"varint,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeCancellationResponse) Reset()         { *m = MsgProposeCancellationResponse{} }
+func (m *MsgProposeCancellationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeCancellationResponse) ProtoMessage()    {}
+func (*MsgProposeCancellationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{45}
+}
+func (m *MsgProposeCancellationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeCancellationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeCancellationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProposeCancellationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeCancellationResponse.Merge(m, src)
+}
+func (m *MsgProposeCancellationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeCancellationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeCancellationResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateProfile(ctx context.Context, in *MsgCreateProfile, opts ...grpc.CallOption) (*MsgCreateProfileResponse, error) {
-	out := new(MsgCreateProfileResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposeCancellationResponse proto.InternalMessageInfo
+
+func (m *MsgProposeCancellationResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateProfile(ctx context.Context, in *MsgUpdateProfile, opts ...grpc.CallOption) (*MsgUpdateProfileResponse, error) {
-	out := new(MsgUpdateProfileResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateProfile", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAcceptCancellation defines the MsgAcceptCancellation message.
+type MsgAcceptCancellation struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (c *msgClient) CreateGig(ctx context.Context, in *MsgCreateGig, opts ...grpc.CallOption) (*MsgCreateGigResponse, error) {
-	out := new(MsgCreateGigResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateGig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptCancellation) Reset()         { *m = MsgAcceptCancellation{} }
+func (m *MsgAcceptCancellation) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptCancellation) ProtoMessage()    {}
+func (*MsgAcceptCancellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{46}
+}
+func (m *MsgAcceptCancellation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptCancellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptCancellation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptCancellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptCancellation.Merge(m, src)
+}
+func (m *MsgAcceptCancellation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptCancellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptCancellation.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateGigStatus(ctx context.Context, in *MsgUpdateGigStatus, opts ...grpc.CallOption) (*MsgUpdateGigStatusResponse, error) {
-	out := new(MsgUpdateGigStatusResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateGigStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptCancellation proto.InternalMessageInfo
+
+func (m *MsgAcceptCancellation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateApplication(ctx context.Context, in *MsgCreateApplication, opts ...grpc.CallOption) (*MsgCreateApplicationResponse, error) {
-	out := new(MsgCreateApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptCancellation) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateApplication(ctx context.Context, in *MsgUpdateApplication, opts ...grpc.CallOption) (*MsgUpdateApplicationResponse, error) {
-	out := new(MsgUpdateApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAcceptCancellationResponse defines the MsgAcceptCancellationResponse message.
+type MsgAcceptCancellationResponse struct {
+	Payout github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=payout,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payout"`
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
 }
 
-func (c *msgClient) DeleteApplication(ctx context.Context, in *MsgDeleteApplication, opts ...grpc.CallOption) (*MsgDeleteApplicationResponse, error) {
-	out := new(MsgDeleteApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeleteApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptCancellationResponse) Reset()         { *m = MsgAcceptCancellationResponse{} }
+func (m *MsgAcceptCancellationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptCancellationResponse) ProtoMessage()    {}
+func (*MsgAcceptCancellationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{47}
+}
+func (m *MsgAcceptCancellationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptCancellationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptCancellationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptCancellationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptCancellationResponse.Merge(m, src)
+}
+func (m *MsgAcceptCancellationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptCancellationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptCancellationResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateContract(ctx context.Context, in *MsgCreateContract, opts ...grpc.CallOption) (*MsgCreateContractResponse, error) {
-	out := new(MsgCreateContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptCancellationResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptCancellationResponse) GetPayout() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Payout
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateContract(ctx context.Context, in *MsgUpdateContract, opts ...grpc.CallOption) (*MsgUpdateContractResponse, error) {
-	out := new(MsgUpdateContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptCancellationResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteContract(ctx context.Context, in *MsgDeleteContract, opts ...grpc.CallOption) (*MsgDeleteContractResponse, error) {
-	out := new(MsgDeleteContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeleteContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectCancellation defines the MsgRejectCancellation message.
+type MsgRejectCancellation struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (c *msgClient) ApplyToGig(ctx context.Context, in *MsgApplyToGig, opts ...grpc.CallOption) (*MsgApplyToGigResponse, error) {
-	out := new(MsgApplyToGigResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ApplyToGig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectCancellation) Reset()         { *m = MsgRejectCancellation{} }
+func (m *MsgRejectCancellation) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectCancellation) ProtoMessage()    {}
+func (*MsgRejectCancellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{48}
+}
+func (m *MsgRejectCancellation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectCancellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectCancellation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectCancellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectCancellation.Merge(m, src)
+}
+func (m *MsgRejectCancellation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectCancellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectCancellation.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawApplication(ctx context.Context, in *MsgWithdrawApplication, opts ...grpc.CallOption) (*MsgWithdrawApplicationResponse, error) {
-	out := new(MsgWithdrawApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/WithdrawApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRejectCancellation proto.InternalMessageInfo
+
+func (m *MsgRejectCancellation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AcceptApplication(ctx context.Context, in *MsgAcceptApplication, opts ...grpc.CallOption) (*MsgAcceptApplicationResponse, error) {
-	out := new(MsgAcceptApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/AcceptApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectCancellation) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RejectApplication(ctx context.Context, in *MsgRejectApplication, opts ...grpc.CallOption) (*MsgRejectApplicationResponse, error) {
-	out := new(MsgRejectApplicationResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/RejectApplication", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectCancellationResponse defines the MsgRejectCancellationResponse message.
+type MsgRejectCancellationResponse struct {
 }
 
-func (c *msgClient) DeliverContract(ctx context.Context, in *MsgDeliverContract, opts ...grpc.CallOption) (*MsgDeliverContractResponse, error) {
-	out := new(MsgDeliverContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeliverContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectCancellationResponse) Reset()         { *m = MsgRejectCancellationResponse{} }
+func (m *MsgRejectCancellationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectCancellationResponse) ProtoMessage()    {}
+func (*MsgRejectCancellationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{49}
+}
+func (m *MsgRejectCancellationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectCancellationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectCancellationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectCancellationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectCancellationResponse.Merge(m, src)
+}
+func (m *MsgRejectCancellationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectCancellationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectCancellationResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CompleteContract(ctx context.Context, in *MsgCompleteContract, opts ...grpc.CallOption) (*MsgCompleteContractResponse, error) {
-	out := new(MsgCompleteContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CompleteContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRejectCancellationResponse proto.InternalMessageInfo
+
+// MsgTipFreelancer defines the MsgTipFreelancer message.
+type MsgTipFreelancer struct {
+	Creator    string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64     `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Note       string     `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (c *msgClient) DisputeContract(ctx context.Context, in *MsgDisputeContract, opts ...grpc.CallOption) (*MsgDisputeContractResponse, error) {
-	out := new(MsgDisputeContractResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DisputeContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTipFreelancer) Reset()         { *m = MsgTipFreelancer{} }
+func (m *MsgTipFreelancer) String() string { return proto.CompactTextString(m) }
+func (*MsgTipFreelancer) ProtoMessage()    {}
+func (*MsgTipFreelancer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{50}
+}
+func (m *MsgTipFreelancer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTipFreelancer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTipFreelancer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTipFreelancer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTipFreelancer.Merge(m, src)
+}
+func (m *MsgTipFreelancer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTipFreelancer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTipFreelancer.DiscardUnknown(m)
 }
 
-func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
-	out := new(MsgOpenDisputeResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/OpenDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTipFreelancer proto.InternalMessageInfo
+
+func (m *MsgTipFreelancer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error) {
-	out := new(MsgSubmitEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/SubmitEvidence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTipFreelancer) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) VoteDispute(ctx context.Context, in *MsgVoteDispute, opts ...grpc.CallOption) (*MsgVoteDisputeResponse, error) {
-	out := new(MsgVoteDisputeResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/VoteDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTipFreelancer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
-	out := new(MsgResolveDisputeResponse)
-	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ResolveDispute", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTipFreelancer) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateProfile defines the CreateProfile RPC.
-	CreateProfile(context.Context, *MsgCreateProfile) (*MsgCreateProfileResponse, error)
-	// UpdateProfile defines the UpdateProfile RPC.
-	UpdateProfile(context.Context, *MsgUpdateProfile) (*MsgUpdateProfileResponse, error)
-	// CreateGig defines the CreateGig RPC.
-	CreateGig(context.Context, *MsgCreateGig) (*MsgCreateGigResponse, error)
-	// UpdateGigStatus defines the UpdateGigStatus RPC.
-	UpdateGigStatus(context.Context, *MsgUpdateGigStatus) (*MsgUpdateGigStatusResponse, error)
-	// CreateApplication defines the CreateApplication RPC.
-	CreateApplication(context.Context, *MsgCreateApplication) (*MsgCreateApplicationResponse, error)
-	// UpdateApplication defines the UpdateApplication RPC.
-	UpdateApplication(context.Context, *MsgUpdateApplication) (*MsgUpdateApplicationResponse, error)
-	// DeleteApplication defines the DeleteApplication RPC.
-	DeleteApplication(context.Context, *MsgDeleteApplication) (*MsgDeleteApplicationResponse, error)
-	// CreateContract defines the CreateContract RPC.
-	CreateContract(context.Context, *MsgCreateContract) (*MsgCreateContractResponse, error)
-	// UpdateContract defines the UpdateContract RPC.
-	UpdateContract(context.Context, *MsgUpdateContract) (*MsgUpdateContractResponse, error)
-	// DeleteContract defines the DeleteContract RPC.
-	DeleteContract(context.Context, *MsgDeleteContract) (*MsgDeleteContractResponse, error)
-	// ApplyToGig defines the ApplyToGig RPC.
-	ApplyToGig(context.Context, *MsgApplyToGig) (*MsgApplyToGigResponse, error)
-	// WithdrawApplication defines the WithdrawApplication RPC.
-	WithdrawApplication(context.Context, *MsgWithdrawApplication) (*MsgWithdrawApplicationResponse, error)
-	// AcceptApplication defines the AcceptApplication RPC.
-	AcceptApplication(context.Context, *MsgAcceptApplication) (*MsgAcceptApplicationResponse, error)
-	// RejectApplication defines the RejectApplication RPC.
-	RejectApplication(context.Context, *MsgRejectApplication) (*MsgRejectApplicationResponse, error)
-	// DeliverContract defines the DeliverContract RPC.
-	DeliverContract(context.Context, *MsgDeliverContract) (*MsgDeliverContractResponse, error)
-	// CompleteContract defines the CompleteContract RPC.
-	CompleteContract(context.Context, *MsgCompleteContract) (*MsgCompleteContractResponse, error)
-	// DisputeContract defines the DisputeContract RPC.
-	DisputeContract(context.Context, *MsgDisputeContract) (*MsgDisputeContractResponse, error)
-	// OpenDispute defines the OpenDispute RPC.
-	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
-	// SubmitEvidence defines the SubmitEvidence RPC.
-	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
-	// VoteDispute defines the VoteDispute RPC.
-	VoteDispute(context.Context, *MsgVoteDispute) (*MsgVoteDisputeResponse, error)
-	// ResolveDispute defines the ResolveDispute RPC.
-	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+// MsgTipFreelancerResponse defines the MsgTipFreelancerResponse message.
+type MsgTipFreelancerResponse struct {
+	TipId uint64 `protobuf:"varint,1,opt,name=tip_id,json=tipId,proto3" json:"tip_id,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgTipFreelancerResponse) Reset()         { *m = MsgTipFreelancerResponse{} }
+func (m *MsgTipFreelancerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTipFreelancerResponse) ProtoMessage()    {}
+func (*MsgTipFreelancerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{51}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgTipFreelancerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateProfile(ctx context.Context, req *MsgCreateProfile) (*MsgCreateProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+func (m *MsgTipFreelancerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTipFreelancerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateProfile(ctx context.Context, req *MsgUpdateProfile) (*MsgUpdateProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+func (m *MsgTipFreelancerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTipFreelancerResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateGig(ctx context.Context, req *MsgCreateGig) (*MsgCreateGigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGig not implemented")
+func (m *MsgTipFreelancerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateGigStatus(ctx context.Context, req *MsgUpdateGigStatus) (*MsgUpdateGigStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGigStatus not implemented")
+func (m *MsgTipFreelancerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTipFreelancerResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CreateApplication(ctx context.Context, req *MsgCreateApplication) (*MsgCreateApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateApplication not implemented")
+
+var xxx_messageInfo_MsgTipFreelancerResponse proto.InternalMessageInfo
+
+func (m *MsgTipFreelancerResponse) GetTipId() uint64 {
+	if m != nil {
+		return m.TipId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) UpdateApplication(ctx context.Context, req *MsgUpdateApplication) (*MsgUpdateApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplication not implemented")
+
+// MsgLogTime defines the MsgLogTime message.
+type MsgLogTime struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId      uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Hours           uint64 `protobuf:"varint,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	DescriptionHash string `protobuf:"bytes,4,opt,name=description_hash,json=descriptionHash,proto3" json:"description_hash,omitempty"`
 }
-func (*UnimplementedMsgServer) DeleteApplication(ctx context.Context, req *MsgDeleteApplication) (*MsgDeleteApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplication not implemented")
+
+func (m *MsgLogTime) Reset()         { *m = MsgLogTime{} }
+func (m *MsgLogTime) String() string { return proto.CompactTextString(m) }
+func (*MsgLogTime) ProtoMessage()    {}
+func (*MsgLogTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{52}
 }
-func (*UnimplementedMsgServer) CreateContract(ctx context.Context, req *MsgCreateContract) (*MsgCreateContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateContract not implemented")
+func (m *MsgLogTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateContract(ctx context.Context, req *MsgUpdateContract) (*MsgUpdateContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateContract not implemented")
+func (m *MsgLogTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLogTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteContract(ctx context.Context, req *MsgDeleteContract) (*MsgDeleteContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteContract not implemented")
+func (m *MsgLogTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLogTime.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ApplyToGig(ctx context.Context, req *MsgApplyToGig) (*MsgApplyToGigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplyToGig not implemented")
+func (m *MsgLogTime) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) WithdrawApplication(ctx context.Context, req *MsgWithdrawApplication) (*MsgWithdrawApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawApplication not implemented")
+func (m *MsgLogTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLogTime.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AcceptApplication(ctx context.Context, req *MsgAcceptApplication) (*MsgAcceptApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptApplication not implemented")
+
+var xxx_messageInfo_MsgLogTime proto.InternalMessageInfo
+
+func (m *MsgLogTime) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RejectApplication(ctx context.Context, req *MsgRejectApplication) (*MsgRejectApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectApplication not implemented")
+
+func (m *MsgLogTime) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) DeliverContract(ctx context.Context, req *MsgDeliverContract) (*MsgDeliverContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeliverContract not implemented")
+
+func (m *MsgLogTime) GetHours() uint64 {
+	if m != nil {
+		return m.Hours
+	}
+	return 0
 }
-func (*UnimplementedMsgServer) CompleteContract(ctx context.Context, req *MsgCompleteContract) (*MsgCompleteContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompleteContract not implemented")
+
+func (m *MsgLogTime) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) DisputeContract(ctx context.Context, req *MsgDisputeContract) (*MsgDisputeContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisputeContract not implemented")
+
+// MsgLogTimeResponse defines the MsgLogTimeResponse message.
+type MsgLogTimeResponse struct {
+	TimeLogId       uint64 `protobuf:"varint,1,opt,name=time_log_id,json=timeLogId,proto3" json:"time_log_id,omitempty"`
+	ContestDeadline int64  `protobuf:"varint,2,opt,name=contest_deadline,json=contestDeadline,proto3" json:"contest_deadline,omitempty"`
 }
-func (*UnimplementedMsgServer) OpenDispute(ctx context.Context, req *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+
+func (m *MsgLogTimeResponse) Reset()         { *m = MsgLogTimeResponse{} }
+func (m *MsgLogTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLogTimeResponse) ProtoMessage()    {}
+func (*MsgLogTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{53}
 }
-func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
+func (m *MsgLogTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) VoteDispute(ctx context.Context, req *MsgVoteDispute) (*MsgVoteDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteDispute not implemented")
+func (m *MsgLogTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLogTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+func (m *MsgLogTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLogTimeResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgLogTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLogTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLogTimeResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgLogTimeResponse proto.InternalMessageInfo
+
+func (m *MsgLogTimeResponse) GetTimeLogId() uint64 {
+	if m != nil {
+		return m.TimeLogId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateProfile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/CreateProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateProfile(ctx, req.(*MsgCreateProfile))
+func (m *MsgLogTimeResponse) GetContestDeadline() int64 {
+	if m != nil {
+		return m.ContestDeadline
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateProfile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateProfile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateProfile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateProfile(ctx, req.(*MsgUpdateProfile))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgContestTimeLog defines the MsgContestTimeLog message.
+type MsgContestTimeLog struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TimeLogId uint64 `protobuf:"varint,2,opt,name=time_log_id,json=timeLogId,proto3" json:"time_log_id,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Msg_CreateGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateGig)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateGig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/CreateGig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateGig(ctx, req.(*MsgCreateGig))
+func (m *MsgContestTimeLog) Reset()         { *m = MsgContestTimeLog{} }
+func (m *MsgContestTimeLog) String() string { return proto.CompactTextString(m) }
+func (*MsgContestTimeLog) ProtoMessage()    {}
+func (*MsgContestTimeLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{54}
+}
+func (m *MsgContestTimeLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgContestTimeLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgContestTimeLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgContestTimeLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgContestTimeLog.Merge(m, src)
+}
+func (m *MsgContestTimeLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgContestTimeLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgContestTimeLog.DiscardUnknown(m)
 }
 
-func _Msg_UpdateGigStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateGigStatus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateGigStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateGigStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateGigStatus(ctx, req.(*MsgUpdateGigStatus))
+var xxx_messageInfo_MsgContestTimeLog proto.InternalMessageInfo
+
+func (m *MsgContestTimeLog) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateApplication)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/CreateApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateApplication(ctx, req.(*MsgCreateApplication))
+func (m *MsgContestTimeLog) GetTimeLogId() uint64 {
+	if m != nil {
+		return m.TimeLogId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateApplication)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateApplication(ctx, req.(*MsgUpdateApplication))
+func (m *MsgContestTimeLog) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteApplication)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/DeleteApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteApplication(ctx, req.(*MsgDeleteApplication))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgContestTimeLogResponse defines the MsgContestTimeLogResponse message.
+type MsgContestTimeLogResponse struct {
 }
 
-func _Msg_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/CreateContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateContract(ctx, req.(*MsgCreateContract))
+func (m *MsgContestTimeLogResponse) Reset()         { *m = MsgContestTimeLogResponse{} }
+func (m *MsgContestTimeLogResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgContestTimeLogResponse) ProtoMessage()    {}
+func (*MsgContestTimeLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{55}
+}
+func (m *MsgContestTimeLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgContestTimeLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgContestTimeLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgContestTimeLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgContestTimeLogResponse.Merge(m, src)
+}
+func (m *MsgContestTimeLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgContestTimeLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgContestTimeLogResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateContract(ctx, req.(*MsgUpdateContract))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgContestTimeLogResponse proto.InternalMessageInfo
+
+// MsgFundHourlyContract defines the MsgFundHourlyContract message.
+type MsgFundHourlyContract struct {
+	Creator    string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64     `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func _Msg_DeleteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/DeleteContract",
+func (m *MsgFundHourlyContract) Reset()         { *m = MsgFundHourlyContract{} }
+func (m *MsgFundHourlyContract) String() string { return proto.CompactTextString(m) }
+func (*MsgFundHourlyContract) ProtoMessage()    {}
+func (*MsgFundHourlyContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{56}
+}
+func (m *MsgFundHourlyContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundHourlyContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundHourlyContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteContract(ctx, req.(*MsgDeleteContract))
+}
+func (m *MsgFundHourlyContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundHourlyContract.Merge(m, src)
+}
+func (m *MsgFundHourlyContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundHourlyContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundHourlyContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundHourlyContract proto.InternalMessageInfo
+
+func (m *MsgFundHourlyContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ApplyToGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgApplyToGig)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgFundHourlyContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ApplyToGig(ctx, in)
+	return 0
+}
+
+func (m *MsgFundHourlyContract) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/ApplyToGig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ApplyToGig(ctx, req.(*MsgApplyToGig))
-	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_WithdrawApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawApplication)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/WithdrawApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawApplication(ctx, req.(*MsgWithdrawApplication))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgFundHourlyContractResponse defines the MsgFundHourlyContractResponse message.
+type MsgFundHourlyContractResponse struct {
 }
 
-func _Msg_AcceptApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptApplication)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcceptApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/AcceptApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptApplication(ctx, req.(*MsgAcceptApplication))
+func (m *MsgFundHourlyContractResponse) Reset()         { *m = MsgFundHourlyContractResponse{} }
+func (m *MsgFundHourlyContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundHourlyContractResponse) ProtoMessage()    {}
+func (*MsgFundHourlyContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{57}
+}
+func (m *MsgFundHourlyContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundHourlyContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundHourlyContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFundHourlyContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundHourlyContractResponse.Merge(m, src)
+}
+func (m *MsgFundHourlyContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundHourlyContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundHourlyContractResponse.DiscardUnknown(m)
 }
 
-func _Msg_RejectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectApplication)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectApplication(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/RejectApplication",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectApplication(ctx, req.(*MsgRejectApplication))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgFundHourlyContractResponse proto.InternalMessageInfo
+
+// MsgClaimStream defines the MsgClaimStream message.
+type MsgClaimStream struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func _Msg_DeliverContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeliverContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeliverContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/DeliverContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeliverContract(ctx, req.(*MsgDeliverContract))
+func (m *MsgClaimStream) Reset()         { *m = MsgClaimStream{} }
+func (m *MsgClaimStream) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimStream) ProtoMessage()    {}
+func (*MsgClaimStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{58}
+}
+func (m *MsgClaimStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimStream.Merge(m, src)
+}
+func (m *MsgClaimStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimStream.DiscardUnknown(m)
 }
 
-func _Msg_CompleteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCompleteContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CompleteContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/CompleteContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CompleteContract(ctx, req.(*MsgCompleteContract))
+var xxx_messageInfo_MsgClaimStream proto.InternalMessageInfo
+
+func (m *MsgClaimStream) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DisputeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisputeContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisputeContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/DisputeContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisputeContract(ctx, req.(*MsgDisputeContract))
+func (m *MsgClaimStream) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenDispute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenDispute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/OpenDispute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgClaimStreamResponse defines the MsgClaimStreamResponse message.
+type MsgClaimStreamResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitEvidence)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitEvidence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/SubmitEvidence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitEvidence(ctx, req.(*MsgSubmitEvidence))
+func (m *MsgClaimStreamResponse) Reset()         { *m = MsgClaimStreamResponse{} }
+func (m *MsgClaimStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimStreamResponse) ProtoMessage()    {}
+func (*MsgClaimStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{59}
+}
+func (m *MsgClaimStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimStreamResponse.Merge(m, src)
+}
+func (m *MsgClaimStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimStreamResponse.DiscardUnknown(m)
 }
 
-func _Msg_VoteDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVoteDispute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).VoteDispute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/VoteDispute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).VoteDispute(ctx, req.(*MsgVoteDispute))
+var xxx_messageInfo_MsgClaimStreamResponse proto.InternalMessageInfo
+
+func (m *MsgClaimStreamResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResolveDispute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResolveDispute(ctx, in)
+// MsgStopStream defines the MsgStopStream message.
+type MsgStopStream struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *MsgStopStream) Reset()         { *m = MsgStopStream{} }
+func (m *MsgStopStream) String() string { return proto.CompactTextString(m) }
+func (*MsgStopStream) ProtoMessage()    {}
+func (*MsgStopStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{60}
+}
+func (m *MsgStopStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStopStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStopStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/skillchain.marketplace.v1.Msg/ResolveDispute",
+}
+func (m *MsgStopStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStopStream.Merge(m, src)
+}
+func (m *MsgStopStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStopStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStopStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStopStream proto.InternalMessageInfo
+
+func (m *MsgStopStream) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	return ""
+}
+
+func (m *MsgStopStream) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "skillchain.marketplace.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateProfile",
-			Handler:    _Msg_CreateProfile_Handler,
-		},
-		{
-			MethodName: "UpdateProfile",
-			Handler:    _Msg_UpdateProfile_Handler,
-		},
-		{
-			MethodName: "CreateGig",
-			Handler:    _Msg_CreateGig_Handler,
-		},
-		{
-			MethodName: "UpdateGigStatus",
-			Handler:    _Msg_UpdateGigStatus_Handler,
-		},
-		{
-			MethodName: "CreateApplication",
-			Handler:    _Msg_CreateApplication_Handler,
-		},
-		{
-			MethodName: "UpdateApplication",
-			Handler:    _Msg_UpdateApplication_Handler,
-		},
-		{
-			MethodName: "DeleteApplication",
-			Handler:    _Msg_DeleteApplication_Handler,
-		},
-		{
-			MethodName: "CreateContract",
-			Handler:    _Msg_CreateContract_Handler,
-		},
-		{
-			MethodName: "UpdateContract",
-			Handler:    _Msg_UpdateContract_Handler,
-		},
-		{
-			MethodName: "DeleteContract",
-			Handler:    _Msg_DeleteContract_Handler,
-		},
-		{
-			MethodName: "ApplyToGig",
-			Handler:    _Msg_ApplyToGig_Handler,
-		},
-		{
-			MethodName: "WithdrawApplication",
-			Handler:    _Msg_WithdrawApplication_Handler,
-		},
-		{
-			MethodName: "AcceptApplication",
-			Handler:    _Msg_AcceptApplication_Handler,
-		},
-		{
-			MethodName: "RejectApplication",
-			Handler:    _Msg_RejectApplication_Handler,
-		},
-		{
-			MethodName: "DeliverContract",
-			Handler:    _Msg_DeliverContract_Handler,
-		},
-		{
-			MethodName: "CompleteContract",
-			Handler:    _Msg_CompleteContract_Handler,
-		},
-		{
-			MethodName: "DisputeContract",
-			Handler:    _Msg_DisputeContract_Handler,
-		},
-		{
-			MethodName: "OpenDispute",
-			Handler:    _Msg_OpenDispute_Handler,
-		},
-		{
-			MethodName: "SubmitEvidence",
-			Handler:    _Msg_SubmitEvidence_Handler,
-		},
-		{
-			MethodName: "VoteDispute",
-			Handler:    _Msg_VoteDispute_Handler,
-		},
-		{
-			MethodName: "ResolveDispute",
-			Handler:    _Msg_ResolveDispute_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "skillchain/marketplace/v1/tx.proto",
+// MsgStopStreamResponse defines the MsgStopStreamResponse message.
+type MsgStopStreamResponse struct {
+	Paid   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgStopStreamResponse) Reset()         { *m = MsgStopStreamResponse{} }
+func (m *MsgStopStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStopStreamResponse) ProtoMessage()    {}
+func (*MsgStopStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{61}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgStopStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgStopStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStopStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgStopStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStopStreamResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgStopStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStopStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStopStreamResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgStopStreamResponse proto.InternalMessageInfo
+
+func (m *MsgStopStreamResponse) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
 }
 
-func (m *MsgCreateProfile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStopStreamResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateProfile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFlagApplicationSpam defines the MsgFlagApplicationSpam message.
+type MsgFlagApplicationSpam struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
 }
 
-func (m *MsgCreateProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HourlyRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.HourlyRate))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Skills) > 0 {
-		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Skills[iNdEx])
-			copy(dAtA[i:], m.Skills[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Skills[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgFlagApplicationSpam) Reset()         { *m = MsgFlagApplicationSpam{} }
+func (m *MsgFlagApplicationSpam) String() string { return proto.CompactTextString(m) }
+func (*MsgFlagApplicationSpam) ProtoMessage()    {}
+func (*MsgFlagApplicationSpam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{62}
+}
+func (m *MsgFlagApplicationSpam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlagApplicationSpam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlagApplicationSpam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Bio) > 0 {
-		i -= len(m.Bio)
-		copy(dAtA[i:], m.Bio)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bio)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateProfileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFlagApplicationSpam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlagApplicationSpam.Merge(m, src)
 }
-
-func (m *MsgCreateProfileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFlagApplicationSpam) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlagApplicationSpam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlagApplicationSpam.DiscardUnknown(m)
 }
 
-func (m *MsgCreateProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgFlagApplicationSpam proto.InternalMessageInfo
+
+func (m *MsgFlagApplicationSpam) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateProfile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFlagApplicationSpam) GetApplicationId() uint64 {
+	if m != nil {
+		return m.ApplicationId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateProfile) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFlagApplicationSpamResponse defines the MsgFlagApplicationSpamResponse message.
+type MsgFlagApplicationSpamResponse struct {
+	Forfeited github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=forfeited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"forfeited"`
 }
 
-func (m *MsgUpdateProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HourlyRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.HourlyRate))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Skills) > 0 {
-		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Skills[iNdEx])
-			copy(dAtA[i:], m.Skills[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Skills[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *MsgFlagApplicationSpamResponse) Reset()         { *m = MsgFlagApplicationSpamResponse{} }
+func (m *MsgFlagApplicationSpamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlagApplicationSpamResponse) ProtoMessage()    {}
+func (*MsgFlagApplicationSpamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{63}
+}
+func (m *MsgFlagApplicationSpamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlagApplicationSpamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlagApplicationSpamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Bio) > 0 {
-		i -= len(m.Bio)
-		copy(dAtA[i:], m.Bio)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bio)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateProfileResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFlagApplicationSpamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlagApplicationSpamResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateProfileResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFlagApplicationSpamResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFlagApplicationSpamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlagApplicationSpamResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFlagApplicationSpamResponse proto.InternalMessageInfo
+
+func (m *MsgFlagApplicationSpamResponse) GetForfeited() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Forfeited
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateGig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgProposeAmendment defines the MsgProposeAmendment message.
+type MsgProposeAmendment struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// new terms, a zero price, deadline or empty description hash keeps the
+	// current one
+	Price            types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	DeliveryDeadline int64      `protobuf:"varint,4,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	DescriptionHash  string     `protobuf:"bytes,5,opt,name=description_hash,json=descriptionHash,proto3" json:"description_hash,omitempty"`
+	Reason           string     `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgCreateGig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DeliveryDays != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DeliveryDays))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgProposeAmendment) Reset()         { *m = MsgProposeAmendment{} }
+func (m *MsgProposeAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAmendment) ProtoMessage()    {}
+func (*MsgProposeAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{64}
 }
-
-func (m *MsgCreateGigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateGigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAmendment.Merge(m, src)
+}
+func (m *MsgProposeAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAmendment.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgProposeAmendment proto.InternalMessageInfo
+
+func (m *MsgProposeAmendment) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateGigStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAmendment) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateGigStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeAmendment) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateGigStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GigId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgProposeAmendment) GetDeliveryDeadline() int64 {
+	if m != nil {
+		return m.DeliveryDeadline
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateGigStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAmendment) GetDescriptionHash() string {
+	if m != nil {
+		return m.DescriptionHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateGigStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeAmendment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgUpdateGigStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgProposeAmendmentResponse defines the MsgProposeAmendmentResponse message.
+type MsgProposeAmendmentResponse struct {
 }
 
-func (m *MsgCreateApplication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeAmendmentResponse) Reset()         { *m = MsgProposeAmendmentResponse{} }
+func (m *MsgProposeAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAmendmentResponse) ProtoMessage()    {}
+func (*MsgProposeAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{65}
+}
+func (m *MsgProposeAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgProposeAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAmendmentResponse.Merge(m, src)
+}
+func (m *MsgProposeAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAmendmentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateApplication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgProposeAmendmentResponse proto.InternalMessageInfo
+
+// MsgAcceptAmendment defines the MsgAcceptAmendment message.
+type MsgAcceptAmendment struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func (m *MsgCreateApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ProposedDays != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposedDays))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ProposedPrice != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposedPrice))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.CoverLetter) > 0 {
-		i -= len(m.CoverLetter)
-		copy(dAtA[i:], m.CoverLetter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CoverLetter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Freelancer) > 0 {
-		i -= len(m.Freelancer)
-		copy(dAtA[i:], m.Freelancer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Freelancer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GigId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAcceptAmendment) Reset()         { *m = MsgAcceptAmendment{} }
+func (m *MsgAcceptAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAmendment) ProtoMessage()    {}
+func (*MsgAcceptAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{66}
+}
+func (m *MsgAcceptAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAmendment.Merge(m, src)
+}
+func (m *MsgAcceptAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAmendment.DiscardUnknown(m)
 }
 
-func (m *MsgCreateApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptAmendment proto.InternalMessageInfo
+
+func (m *MsgAcceptAmendment) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptAmendment) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (m *MsgCreateApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgAcceptAmendmentResponse defines the MsgAcceptAmendmentResponse message.
+type MsgAcceptAmendmentResponse struct {
 }
 
-func (m *MsgUpdateApplication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptAmendmentResponse) Reset()         { *m = MsgAcceptAmendmentResponse{} }
+func (m *MsgAcceptAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAmendmentResponse) ProtoMessage()    {}
+func (*MsgAcceptAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{67}
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAmendmentResponse.Merge(m, src)
+}
+func (m *MsgAcceptAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAmendmentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateApplication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcceptAmendmentResponse proto.InternalMessageInfo
+
+// MsgRequestDeadlineExtension defines the MsgRequestDeadlineExtension message.
+type MsgRequestDeadlineExtension struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId  uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	NewDeadline int64  `protobuf:"varint,3,opt,name=new_deadline,json=newDeadline,proto3" json:"new_deadline,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgUpdateApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.ProposedDays != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposedDays))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ProposedPrice != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposedPrice))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.CoverLetter) > 0 {
-		i -= len(m.CoverLetter)
-		copy(dAtA[i:], m.CoverLetter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CoverLetter)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Freelancer) > 0 {
-		i -= len(m.Freelancer)
-		copy(dAtA[i:], m.Freelancer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Freelancer)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgRequestDeadlineExtension) Reset()         { *m = MsgRequestDeadlineExtension{} }
+func (m *MsgRequestDeadlineExtension) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestDeadlineExtension) ProtoMessage()    {}
+func (*MsgRequestDeadlineExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{68}
+}
+func (m *MsgRequestDeadlineExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestDeadlineExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestDeadlineExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GigId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *MsgRequestDeadlineExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestDeadlineExtension.Merge(m, src)
+}
+func (m *MsgRequestDeadlineExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestDeadlineExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestDeadlineExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestDeadlineExtension proto.InternalMessageInfo
+
+func (m *MsgRequestDeadlineExtension) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *MsgRequestDeadlineExtension) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgRequestDeadlineExtension) GetNewDeadline() int64 {
+	if m != nil {
+		return m.NewDeadline
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRequestDeadlineExtension) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRequestDeadlineExtensionResponse defines the MsgRequestDeadlineExtensionResponse message.
+type MsgRequestDeadlineExtensionResponse struct {
+	ExtensionId uint64 `protobuf:"varint,1,opt,name=extension_id,json=extensionId,proto3" json:"extension_id,omitempty"`
 }
 
-func (m *MsgUpdateApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRequestDeadlineExtensionResponse) Reset()         { *m = MsgRequestDeadlineExtensionResponse{} }
+func (m *MsgRequestDeadlineExtensionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestDeadlineExtensionResponse) ProtoMessage()    {}
+func (*MsgRequestDeadlineExtensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{69}
+}
+func (m *MsgRequestDeadlineExtensionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestDeadlineExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestDeadlineExtensionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestDeadlineExtensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestDeadlineExtensionResponse.Merge(m, src)
+}
+func (m *MsgRequestDeadlineExtensionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestDeadlineExtensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestDeadlineExtensionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteApplication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestDeadlineExtensionResponse proto.InternalMessageInfo
+
+func (m *MsgRequestDeadlineExtensionResponse) GetExtensionId() uint64 {
+	if m != nil {
+		return m.ExtensionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteApplication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgApproveDeadlineExtension defines the MsgApproveDeadlineExtension message.
+type MsgApproveDeadlineExtension struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ExtensionId uint64 `protobuf:"varint,2,opt,name=extension_id,json=extensionId,proto3" json:"extension_id,omitempty"`
 }
 
-func (m *MsgDeleteApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgApproveDeadlineExtension) Reset()         { *m = MsgApproveDeadlineExtension{} }
+func (m *MsgApproveDeadlineExtension) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveDeadlineExtension) ProtoMessage()    {}
+func (*MsgApproveDeadlineExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{70}
+}
+func (m *MsgApproveDeadlineExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveDeadlineExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveDeadlineExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgApproveDeadlineExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveDeadlineExtension.Merge(m, src)
+}
+func (m *MsgApproveDeadlineExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveDeadlineExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveDeadlineExtension.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApproveDeadlineExtension proto.InternalMessageInfo
+
+func (m *MsgApproveDeadlineExtension) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApproveDeadlineExtension) GetExtensionId() uint64 {
+	if m != nil {
+		return m.ExtensionId
+	}
+	return 0
 }
 
-func (m *MsgDeleteApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgApproveDeadlineExtensionResponse defines the MsgApproveDeadlineExtensionResponse message.
+type MsgApproveDeadlineExtensionResponse struct {
 }
 
-func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApproveDeadlineExtensionResponse) Reset()         { *m = MsgApproveDeadlineExtensionResponse{} }
+func (m *MsgApproveDeadlineExtensionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveDeadlineExtensionResponse) ProtoMessage()    {}
+func (*MsgApproveDeadlineExtensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{71}
+}
+func (m *MsgApproveDeadlineExtensionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveDeadlineExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveDeadlineExtensionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgApproveDeadlineExtensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveDeadlineExtensionResponse.Merge(m, src)
+}
+func (m *MsgApproveDeadlineExtensionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveDeadlineExtensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveDeadlineExtensionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgApproveDeadlineExtensionResponse proto.InternalMessageInfo
+
+// MsgDeclineDeadlineExtension defines the MsgDeclineDeadlineExtension message.
+type MsgDeclineDeadlineExtension struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ExtensionId uint64 `protobuf:"varint,2,opt,name=extension_id,json=extensionId,proto3" json:"extension_id,omitempty"`
 }
 
-func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompletedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompletedAt))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.DeliveryDeadline != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DeliveryDeadline))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Freelancer) > 0 {
-		i -= len(m.Freelancer)
-		copy(dAtA[i:], m.Freelancer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Freelancer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ApplicationId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.GigId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgDeclineDeadlineExtension) Reset()         { *m = MsgDeclineDeadlineExtension{} }
+func (m *MsgDeclineDeadlineExtension) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclineDeadlineExtension) ProtoMessage()    {}
+func (*MsgDeclineDeadlineExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{72}
+}
+func (m *MsgDeclineDeadlineExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclineDeadlineExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclineDeadlineExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDeclineDeadlineExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclineDeadlineExtension.Merge(m, src)
+}
+func (m *MsgDeclineDeadlineExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclineDeadlineExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclineDeadlineExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeclineDeadlineExtension proto.InternalMessageInfo
+
+func (m *MsgDeclineDeadlineExtension) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeclineDeadlineExtension) GetExtensionId() uint64 {
+	if m != nil {
+		return m.ExtensionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDeclineDeadlineExtensionResponse defines the MsgDeclineDeadlineExtensionResponse message.
+type MsgDeclineDeadlineExtensionResponse struct {
 }
 
-func (m *MsgCreateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgDeclineDeadlineExtensionResponse) Reset()         { *m = MsgDeclineDeadlineExtensionResponse{} }
+func (m *MsgDeclineDeadlineExtensionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeclineDeadlineExtensionResponse) ProtoMessage()    {}
+func (*MsgDeclineDeadlineExtensionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{73}
+}
+func (m *MsgDeclineDeadlineExtensionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeclineDeadlineExtensionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeclineDeadlineExtensionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDeclineDeadlineExtensionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeclineDeadlineExtensionResponse.Merge(m, src)
+}
+func (m *MsgDeclineDeadlineExtensionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeclineDeadlineExtensionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeclineDeadlineExtensionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgDeclineDeadlineExtensionResponse proto.InternalMessageInfo
+
+// MsgSetEscrowStaking defines the MsgSetEscrowStaking message.
+type MsgSetEscrowStaking struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Enabled    bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgUpdateContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetEscrowStaking) Reset()         { *m = MsgSetEscrowStaking{} }
+func (m *MsgSetEscrowStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEscrowStaking) ProtoMessage()    {}
+func (*MsgSetEscrowStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{74}
+}
+func (m *MsgSetEscrowStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEscrowStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEscrowStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEscrowStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEscrowStaking.Merge(m, src)
+}
+func (m *MsgSetEscrowStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEscrowStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEscrowStaking.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompletedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompletedAt))
-		i--
-		dAtA[i] = 0x58
+var xxx_messageInfo_MsgSetEscrowStaking proto.InternalMessageInfo
+
+func (m *MsgSetEscrowStaking) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *MsgSetEscrowStaking) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+func (m *MsgSetEscrowStaking) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if m.DeliveryDeadline != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DeliveryDeadline))
-		i--
-		dAtA[i] = 0x40
+	return false
+}
+
+// MsgSetEscrowStakingResponse defines the MsgSetEscrowStakingResponse message.
+type MsgSetEscrowStakingResponse struct {
+}
+
+func (m *MsgSetEscrowStakingResponse) Reset()         { *m = MsgSetEscrowStakingResponse{} }
+func (m *MsgSetEscrowStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEscrowStakingResponse) ProtoMessage()    {}
+func (*MsgSetEscrowStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{75}
+}
+func (m *MsgSetEscrowStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEscrowStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEscrowStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Freelancer) > 0 {
-		i -= len(m.Freelancer)
-		copy(dAtA[i:], m.Freelancer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Freelancer)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ApplicationId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.GigId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetEscrowStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEscrowStakingResponse.Merge(m, src)
+}
+func (m *MsgSetEscrowStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEscrowStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEscrowStakingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEscrowStakingResponse proto.InternalMessageInfo
+
+// MsgRegisterArbiter defines the MsgRegisterArbiter message.
+type MsgRegisterArbiter struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Stake locked, added to the stake of a bonded arbiter.
+	Stake types.Coin `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake"`
+}
+
+func (m *MsgRegisterArbiter) Reset()         { *m = MsgRegisterArbiter{} }
+func (m *MsgRegisterArbiter) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterArbiter) ProtoMessage()    {}
+func (*MsgRegisterArbiter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{76}
+}
+func (m *MsgRegisterArbiter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterArbiter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterArbiter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRegisterArbiter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterArbiter.Merge(m, src)
+}
+func (m *MsgRegisterArbiter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterArbiter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterArbiter.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterArbiter proto.InternalMessageInfo
+
+func (m *MsgRegisterArbiter) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterArbiter) GetStake() types.Coin {
+	if m != nil {
+		return m.Stake
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRegisterArbiterResponse defines the MsgRegisterArbiterResponse message.
+type MsgRegisterArbiterResponse struct {
 }
 
-func (m *MsgDeleteContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterArbiterResponse) Reset()         { *m = MsgRegisterArbiterResponse{} }
+func (m *MsgRegisterArbiterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterArbiterResponse) ProtoMessage()    {}
+func (*MsgRegisterArbiterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{77}
+}
+func (m *MsgRegisterArbiterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterArbiterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterArbiterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterArbiterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterArbiterResponse.Merge(m, src)
+}
+func (m *MsgRegisterArbiterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterArbiterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterArbiterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterArbiterResponse proto.InternalMessageInfo
+
+// MsgUnbondArbiter defines the MsgUnbondArbiter message.
+type MsgUnbondArbiter struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *MsgDeleteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnbondArbiter) Reset()         { *m = MsgUnbondArbiter{} }
+func (m *MsgUnbondArbiter) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondArbiter) ProtoMessage()    {}
+func (*MsgUnbondArbiter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{78}
+}
+func (m *MsgUnbondArbiter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondArbiter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondArbiter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnbondArbiter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondArbiter.Merge(m, src)
+}
+func (m *MsgUnbondArbiter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondArbiter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondArbiter.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnbondArbiter proto.InternalMessageInfo
+
+func (m *MsgUnbondArbiter) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUnbondArbiterResponse defines the MsgUnbondArbiterResponse message.
+type MsgUnbondArbiterResponse struct {
+	// Time the stake is released at.
+	UnbondingEndsAt int64 `protobuf:"varint,1,opt,name=unbonding_ends_at,json=unbondingEndsAt,proto3" json:"unbonding_ends_at,omitempty"`
 }
 
-func (m *MsgDeleteContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUnbondArbiterResponse) Reset()         { *m = MsgUnbondArbiterResponse{} }
+func (m *MsgUnbondArbiterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondArbiterResponse) ProtoMessage()    {}
+func (*MsgUnbondArbiterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{79}
+}
+func (m *MsgUnbondArbiterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondArbiterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondArbiterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondArbiterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondArbiterResponse.Merge(m, src)
+}
+func (m *MsgUnbondArbiterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondArbiterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondArbiterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgApplyToGig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnbondArbiterResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondArbiterResponse) GetUnbondingEndsAt() int64 {
+	if m != nil {
+		return m.UnbondingEndsAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgApplyToGig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevealDisputeVote defines the MsgRevealDisputeVote message.
+type MsgRevealDisputeVote struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	// Salt the commitment was made with.
+	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// Share of the disputed amount the freelancer should be paid, in basis
+	// points.
+	FreelancerPayoutBps uint64 `protobuf:"varint,5,opt,name=freelancer_payout_bps,json=freelancerPayoutBps,proto3" json:"freelancer_payout_bps,omitempty"`
 }
 
-func (m *MsgApplyToGig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposedDays != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposedDays))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ProposedPrice != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposedPrice))
-		i--
-		dAtA[i] = 0x20
+func (m *MsgRevealDisputeVote) Reset()         { *m = MsgRevealDisputeVote{} }
+func (m *MsgRevealDisputeVote) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealDisputeVote) ProtoMessage()    {}
+func (*MsgRevealDisputeVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{80}
+}
+func (m *MsgRevealDisputeVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealDisputeVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealDisputeVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CoverLetter) > 0 {
-		i -= len(m.CoverLetter)
-		copy(dAtA[i:], m.CoverLetter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CoverLetter)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgRevealDisputeVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealDisputeVote.Merge(m, src)
+}
+func (m *MsgRevealDisputeVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealDisputeVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealDisputeVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealDisputeVote proto.InternalMessageInfo
+
+func (m *MsgRevealDisputeVote) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.GigId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *MsgRevealDisputeVote) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgRevealDisputeVote) GetSalt() string {
+	if m != nil {
+		return m.Salt
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgApplyToGigResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealDisputeVote) GetFreelancerPayoutBps() uint64 {
+	if m != nil {
+		return m.FreelancerPayoutBps
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgApplyToGigResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevealDisputeVoteResponse defines the MsgRevealDisputeVoteResponse message.
+type MsgRevealDisputeVoteResponse struct {
 }
 
-func (m *MsgApplyToGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplicationId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevealDisputeVoteResponse) Reset()         { *m = MsgRevealDisputeVoteResponse{} }
+func (m *MsgRevealDisputeVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealDisputeVoteResponse) ProtoMessage()    {}
+func (*MsgRevealDisputeVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{81}
 }
-
-func (m *MsgWithdrawApplication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealDisputeVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealDisputeVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealDisputeVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevealDisputeVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealDisputeVoteResponse.Merge(m, src)
+}
+func (m *MsgRevealDisputeVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealDisputeVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealDisputeVoteResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawApplication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevealDisputeVoteResponse proto.InternalMessageInfo
+
+// MsgAppealDispute defines the MsgAppealDispute message.
+type MsgAppealDispute struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgWithdrawApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplicationId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgAppealDispute) Reset()         { *m = MsgAppealDispute{} }
+func (m *MsgAppealDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealDispute) ProtoMessage()    {}
+func (*MsgAppealDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{82}
+}
+func (m *MsgAppealDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAppealDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealDispute.Merge(m, src)
+}
+func (m *MsgAppealDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealDispute proto.InternalMessageInfo
+
+func (m *MsgAppealDispute) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAppealDispute) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAppealDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgWithdrawApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgAppealDisputeResponse defines the MsgAppealDisputeResponse message.
+type MsgAppealDisputeResponse struct {
+	// Bond posted with the appeal.
+	Bond types.Coin `protobuf:"bytes,1,opt,name=bond,proto3" json:"bond"`
+	// Time the new jury must vote by.
+	Deadline int64 `protobuf:"varint,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *MsgAcceptApplication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAppealDisputeResponse) Reset()         { *m = MsgAppealDisputeResponse{} }
+func (m *MsgAppealDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealDisputeResponse) ProtoMessage()    {}
+func (*MsgAppealDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{83}
+}
+func (m *MsgAppealDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAcceptApplication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAppealDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealDisputeResponse.Merge(m, src)
+}
+func (m *MsgAppealDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplicationId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgAppealDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgAppealDisputeResponse) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgAcceptApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAppealDisputeResponse) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgAcceptApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgContestDispute defines the MsgContestDispute message.
+type MsgContestDispute struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DisputeId uint64 `protobuf:"varint,2,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
 }
 
-func (m *MsgAcceptApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *MsgContestDispute) Reset()         { *m = MsgContestDispute{} }
+func (m *MsgContestDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgContestDispute) ProtoMessage()    {}
+func (*MsgContestDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{84}
 }
-
-func (m *MsgRejectApplication) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgContestDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgContestDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgContestDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRejectApplication) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgContestDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgContestDispute.Merge(m, src)
+}
+func (m *MsgContestDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgContestDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgContestDispute.DiscardUnknown(m)
 }
 
-func (m *MsgRejectApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApplicationId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgContestDispute proto.InternalMessageInfo
+
+func (m *MsgContestDispute) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRejectApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgContestDispute) GetDisputeId() uint64 {
+	if m != nil {
+		return m.DisputeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRejectApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgContestDisputeResponse defines the MsgContestDisputeResponse message.
+type MsgContestDisputeResponse struct {
+	// Filing fee matched to contest the dispute.
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
 }
 
-func (m *MsgRejectApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgContestDisputeResponse) Reset()         { *m = MsgContestDisputeResponse{} }
+func (m *MsgContestDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgContestDisputeResponse) ProtoMessage()    {}
+func (*MsgContestDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e8ad05870c9a3, []int{85}
 }
-
-func (m *MsgDeliverContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgContestDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgContestDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgContestDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeliverContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgContestDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgContestDisputeResponse.Merge(m, src)
+}
+func (m *MsgContestDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgContestDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgContestDisputeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeliverContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeliveryNote) > 0 {
-		i -= len(m.DeliveryNote)
-		copy(dAtA[i:], m.DeliveryNote)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DeliveryNote)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgContestDisputeResponse proto.InternalMessageInfo
+
+func (m *MsgContestDisputeResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgDeliverContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "skillchain.marketplace.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "skillchain.marketplace.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateProfile)(nil), "skillchain.marketplace.v1.MsgCreateProfile")
+	proto.RegisterType((*MsgCreateProfileResponse)(nil), "skillchain.marketplace.v1.MsgCreateProfileResponse")
+	proto.RegisterType((*MsgUpdateProfile)(nil), "skillchain.marketplace.v1.MsgUpdateProfile")
+	proto.RegisterType((*MsgUpdateProfileResponse)(nil), "skillchain.marketplace.v1.MsgUpdateProfileResponse")
+	proto.RegisterType((*MsgCreateGig)(nil), "skillchain.marketplace.v1.MsgCreateGig")
+	proto.RegisterType((*MsgCreateGigResponse)(nil), "skillchain.marketplace.v1.MsgCreateGigResponse")
+	proto.RegisterType((*MsgUpdateGigStatus)(nil), "skillchain.marketplace.v1.MsgUpdateGigStatus")
+	proto.RegisterType((*MsgUpdateGigStatusResponse)(nil), "skillchain.marketplace.v1.MsgUpdateGigStatusResponse")
+	proto.RegisterType((*MsgCreateApplication)(nil), "skillchain.marketplace.v1.MsgCreateApplication")
+	proto.RegisterType((*MsgCreateApplicationResponse)(nil), "skillchain.marketplace.v1.MsgCreateApplicationResponse")
+	proto.RegisterType((*MsgUpdateApplication)(nil), "skillchain.marketplace.v1.MsgUpdateApplication")
+	proto.RegisterType((*MsgUpdateApplicationResponse)(nil), "skillchain.marketplace.v1.MsgUpdateApplicationResponse")
+	proto.RegisterType((*MsgDeleteApplication)(nil), "skillchain.marketplace.v1.MsgDeleteApplication")
+	proto.RegisterType((*MsgDeleteApplicationResponse)(nil), "skillchain.marketplace.v1.MsgDeleteApplicationResponse")
+	proto.RegisterType((*MsgApplyToGig)(nil), "skillchain.marketplace.v1.MsgApplyToGig")
+	proto.RegisterType((*MsgApplyToGigResponse)(nil), "skillchain.marketplace.v1.MsgApplyToGigResponse")
+	proto.RegisterType((*MsgWithdrawApplication)(nil), "skillchain.marketplace.v1.MsgWithdrawApplication")
+	proto.RegisterType((*MsgWithdrawApplicationResponse)(nil), "skillchain.marketplace.v1.MsgWithdrawApplicationResponse")
+	proto.RegisterType((*MsgAcceptApplication)(nil), "skillchain.marketplace.v1.MsgAcceptApplication")
+	proto.RegisterType((*MsgAcceptApplicationResponse)(nil), "skillchain.marketplace.v1.MsgAcceptApplicationResponse")
+	proto.RegisterType((*MsgRejectApplication)(nil), "skillchain.marketplace.v1.MsgRejectApplication")
+	proto.RegisterType((*MsgRejectApplicationResponse)(nil), "skillchain.marketplace.v1.MsgRejectApplicationResponse")
+	proto.RegisterType((*MsgDeliverContract)(nil), "skillchain.marketplace.v1.MsgDeliverContract")
+	proto.RegisterType((*MsgDeliverContractResponse)(nil), "skillchain.marketplace.v1.MsgDeliverContractResponse")
+	proto.RegisterType((*MsgCompleteContract)(nil), "skillchain.marketplace.v1.MsgCompleteContract")
+	proto.RegisterType((*MsgCompleteContractResponse)(nil), "skillchain.marketplace.v1.MsgCompleteContractResponse")
+	proto.RegisterType((*MsgDisputeContract)(nil), "skillchain.marketplace.v1.MsgDisputeContract")
+	proto.RegisterType((*MsgDisputeContractResponse)(nil), "skillchain.marketplace.v1.MsgDisputeContractResponse")
+	proto.RegisterType((*MsgOpenDispute)(nil), "skillchain.marketplace.v1.MsgOpenDispute")
+	proto.RegisterType((*MsgOpenDisputeResponse)(nil), "skillchain.marketplace.v1.MsgOpenDisputeResponse")
+	proto.RegisterType((*MsgSubmitEvidence)(nil), "skillchain.marketplace.v1.MsgSubmitEvidence")
+	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "skillchain.marketplace.v1.MsgSubmitEvidenceResponse")
+	proto.RegisterType((*MsgVoteDispute)(nil), "skillchain.marketplace.v1.MsgVoteDispute")
+	proto.RegisterType((*MsgVoteDisputeResponse)(nil), "skillchain.marketplace.v1.MsgVoteDisputeResponse")
+	proto.RegisterType((*MsgResolveDispute)(nil), "skillchain.marketplace.v1.MsgResolveDispute")
+	proto.RegisterType((*MsgResolveDisputeResponse)(nil), "skillchain.marketplace.v1.MsgResolveDisputeResponse")
+	proto.RegisterType((*MsgDeliverMilestone)(nil), "skillchain.marketplace.v1.MsgDeliverMilestone")
+	proto.RegisterType((*MsgDeliverMilestoneResponse)(nil), "skillchain.marketplace.v1.MsgDeliverMilestoneResponse")
+	proto.RegisterType((*MsgApproveMilestone)(nil), "skillchain.marketplace.v1.MsgApproveMilestone")
+	proto.RegisterType((*MsgApproveMilestoneResponse)(nil), "skillchain.marketplace.v1.MsgApproveMilestoneResponse")
+	proto.RegisterType((*MsgCancelOverdueContract)(nil), "skillchain.marketplace.v1.MsgCancelOverdueContract")
+	proto.RegisterType((*MsgCancelOverdueContractResponse)(nil), "skillchain.marketplace.v1.MsgCancelOverdueContractResponse")
+	proto.RegisterType((*MsgProposeCancellation)(nil), "skillchain.marketplace.v1.MsgProposeCancellation")
+	proto.RegisterType((*MsgProposeCancellationResponse)(nil), "skillchain.marketplace.v1.MsgProposeCancellationResponse")
+	proto.RegisterType((*MsgAcceptCancellation)(nil), "skillchain.marketplace.v1.MsgAcceptCancellation")
+	proto.RegisterType((*MsgAcceptCancellationResponse)(nil), "skillchain.marketplace.v1.MsgAcceptCancellationResponse")
+	proto.RegisterType((*MsgRejectCancellation)(nil), "skillchain.marketplace.v1.MsgRejectCancellation")
+	proto.RegisterType((*MsgRejectCancellationResponse)(nil), "skillchain.marketplace.v1.MsgRejectCancellationResponse")
+	proto.RegisterType((*MsgTipFreelancer)(nil), "skillchain.marketplace.v1.MsgTipFreelancer")
+	proto.RegisterType((*MsgTipFreelancerResponse)(nil), "skillchain.marketplace.v1.MsgTipFreelancerResponse")
+	proto.RegisterType((*MsgLogTime)(nil), "skillchain.marketplace.v1.MsgLogTime")
+	proto.RegisterType((*MsgLogTimeResponse)(nil), "skillchain.marketplace.v1.MsgLogTimeResponse")
+	proto.RegisterType((*MsgContestTimeLog)(nil), "skillchain.marketplace.v1.MsgContestTimeLog")
+	proto.RegisterType((*MsgContestTimeLogResponse)(nil), "skillchain.marketplace.v1.MsgContestTimeLogResponse")
+	proto.RegisterType((*MsgFundHourlyContract)(nil), "skillchain.marketplace.v1.MsgFundHourlyContract")
+	proto.RegisterType((*MsgFundHourlyContractResponse)(nil), "skillchain.marketplace.v1.MsgFundHourlyContractResponse")
+	proto.RegisterType((*MsgClaimStream)(nil), "skillchain.marketplace.v1.MsgClaimStream")
+	proto.RegisterType((*MsgClaimStreamResponse)(nil), "skillchain.marketplace.v1.MsgClaimStreamResponse")
+	proto.RegisterType((*MsgStopStream)(nil), "skillchain.marketplace.v1.MsgStopStream")
+	proto.RegisterType((*MsgStopStreamResponse)(nil), "skillchain.marketplace.v1.MsgStopStreamResponse")
+	proto.RegisterType((*MsgFlagApplicationSpam)(nil), "skillchain.marketplace.v1.MsgFlagApplicationSpam")
+	proto.RegisterType((*MsgFlagApplicationSpamResponse)(nil), "skillchain.marketplace.v1.MsgFlagApplicationSpamResponse")
+	proto.RegisterType((*MsgProposeAmendment)(nil), "skillchain.marketplace.v1.MsgProposeAmendment")
+	proto.RegisterType((*MsgProposeAmendmentResponse)(nil), "skillchain.marketplace.v1.MsgProposeAmendmentResponse")
+	proto.RegisterType((*MsgAcceptAmendment)(nil), "skillchain.marketplace.v1.MsgAcceptAmendment")
+	proto.RegisterType((*MsgAcceptAmendmentResponse)(nil), "skillchain.marketplace.v1.MsgAcceptAmendmentResponse")
+	proto.RegisterType((*MsgRequestDeadlineExtension)(nil), "skillchain.marketplace.v1.MsgRequestDeadlineExtension")
+	proto.RegisterType((*MsgRequestDeadlineExtensionResponse)(nil), "skillchain.marketplace.v1.MsgRequestDeadlineExtensionResponse")
+	proto.RegisterType((*MsgApproveDeadlineExtension)(nil), "skillchain.marketplace.v1.MsgApproveDeadlineExtension")
+	proto.RegisterType((*MsgApproveDeadlineExtensionResponse)(nil), "skillchain.marketplace.v1.MsgApproveDeadlineExtensionResponse")
+	proto.RegisterType((*MsgDeclineDeadlineExtension)(nil), "skillchain.marketplace.v1.MsgDeclineDeadlineExtension")
+	proto.RegisterType((*MsgDeclineDeadlineExtensionResponse)(nil), "skillchain.marketplace.v1.MsgDeclineDeadlineExtensionResponse")
+	proto.RegisterType((*MsgSetEscrowStaking)(nil), "skillchain.marketplace.v1.MsgSetEscrowStaking")
+	proto.RegisterType((*MsgSetEscrowStakingResponse)(nil), "skillchain.marketplace.v1.MsgSetEscrowStakingResponse")
+	proto.RegisterType((*MsgRegisterArbiter)(nil), "skillchain.marketplace.v1.MsgRegisterArbiter")
+	proto.RegisterType((*MsgRegisterArbiterResponse)(nil), "skillchain.marketplace.v1.MsgRegisterArbiterResponse")
+	proto.RegisterType((*MsgUnbondArbiter)(nil), "skillchain.marketplace.v1.MsgUnbondArbiter")
+	proto.RegisterType((*MsgUnbondArbiterResponse)(nil), "skillchain.marketplace.v1.MsgUnbondArbiterResponse")
+	proto.RegisterType((*MsgRevealDisputeVote)(nil), "skillchain.marketplace.v1.MsgRevealDisputeVote")
+	proto.RegisterType((*MsgRevealDisputeVoteResponse)(nil), "skillchain.marketplace.v1.MsgRevealDisputeVoteResponse")
+	proto.RegisterType((*MsgAppealDispute)(nil), "skillchain.marketplace.v1.MsgAppealDispute")
+	proto.RegisterType((*MsgAppealDisputeResponse)(nil), "skillchain.marketplace.v1.MsgAppealDisputeResponse")
+	proto.RegisterType((*MsgContestDispute)(nil), "skillchain.marketplace.v1.MsgContestDispute")
+	proto.RegisterType((*MsgContestDisputeResponse)(nil), "skillchain.marketplace.v1.MsgContestDisputeResponse")
 }
 
-func (m *MsgDeliverContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("skillchain/marketplace/v1/tx.proto", fileDescriptor_9b0e8ad05870c9a3)
 }
 
-func (m *MsgDeliverContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_9b0e8ad05870c9a3 = []byte{
+	// 3041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcd, 0x6f, 0x24, 0xc5,
+	0xd9, 0xdf, 0x9e, 0x0f, 0xdb, 0xf3, 0xd8, 0xeb, 0xb5, 0x9b, 0x5d, 0x98, 0x1d, 0xd8, 0x59, 0xef,
+	0xf0, 0x02, 0xc6, 0x8b, 0xc7, 0xeb, 0xe5, 0x5d, 0xe0, 0x05, 0x09, 0xe4, 0xfd, 0x36, 0x5a, 0xc3,
+	0x6a, 0xbc, 0x2f, 0x89, 0x72, 0x19, 0xb5, 0xa7, 0xcb, 0x3d, 0x15, 0xcf, 0x74, 0x37, 0xdd, 0x35,
+	0xf6, 0x1a, 0x09, 0x85, 0x10, 0xe5, 0x90, 0x10, 0x14, 0xc4, 0x39, 0x09, 0xca, 0x29, 0x51, 0x4e,
+	0x2b, 0xc1, 0x2d, 0xb7, 0x1c, 0x22, 0xa4, 0x44, 0x0a, 0xe2, 0x14, 0x45, 0x09, 0x41, 0x70, 0xd8,
+	0x4b, 0x72, 0xca, 0x3f, 0x10, 0xd5, 0xc7, 0xd4, 0xf4, 0xe7, 0x54, 0xcf, 0xec, 0xce, 0xc2, 0x05,
+	0xdc, 0x35, 0x4f, 0xd5, 0xf3, 0x7b, 0x3e, 0xea, 0xa9, 0xa7, 0x9e, 0xa7, 0x16, 0x6a, 0xfe, 0x1e,
+	0xee, 0x74, 0x5a, 0x6d, 0x03, 0xdb, 0x6b, 0x5d, 0xc3, 0xdb, 0x43, 0xc4, 0xed, 0x18, 0x2d, 0xb4,
+	0xb6, 0xbf, 0xbe, 0x46, 0x6e, 0xd7, 0x5d, 0xcf, 0x21, 0x8e, 0x7e, 0x72, 0x40, 0x53, 0x0f, 0xd0,
+	0xd4, 0xf7, 0xd7, 0x2b, 0x8b, 0x46, 0x17, 0xdb, 0xce, 0x1a, 0xfb, 0x2f, 0xa7, 0xae, 0x54, 0x5b,
+	0x8e, 0xdf, 0x75, 0xfc, 0xb5, 0x1d, 0xc3, 0xa7, 0xcb, 0xec, 0x20, 0x62, 0xac, 0xaf, 0xb5, 0x1c,
+	0x6c, 0x8b, 0xdf, 0x1f, 0x11, 0xbf, 0x77, 0x7d, 0x8b, 0x72, 0xe9, 0xfa, 0x96, 0xf8, 0xe1, 0x24,
+	0xff, 0xa1, 0xc9, 0xbe, 0xd6, 0xf8, 0x87, 0xf8, 0xe9, 0xb8, 0xe5, 0x58, 0x0e, 0x1f, 0xa7, 0x7f,
+	0x89, 0xd1, 0xe5, 0x74, 0xec, 0x2d, 0xc7, 0x26, 0x9e, 0xd1, 0x22, 0x82, 0xf2, 0xc9, 0x74, 0x4a,
+	0xd7, 0xf0, 0x8c, 0xae, 0xe0, 0x53, 0xfb, 0xb3, 0x06, 0xc7, 0xb6, 0x7c, 0xeb, 0xff, 0x5d, 0xd3,
+	0x20, 0xe8, 0x26, 0xfb, 0x45, 0x7f, 0x0e, 0x4a, 0x46, 0x8f, 0xb4, 0x1d, 0x0f, 0x93, 0xc3, 0xb2,
+	0xb6, 0xa4, 0x2d, 0x97, 0x2e, 0x96, 0x3f, 0xff, 0x64, 0xf5, 0xb8, 0x00, 0xb8, 0x61, 0x9a, 0x1e,
+	0xf2, 0xfd, 0x6d, 0xe2, 0x61, 0xdb, 0x6a, 0x0c, 0x48, 0xf5, 0xcb, 0x30, 0xc5, 0xd7, 0x2e, 0xe7,
+	0x96, 0xb4, 0xe5, 0xd9, 0xf3, 0x67, 0xea, 0xa9, 0x6a, 0xac, 0x73, 0x56, 0x17, 0x4b, 0x9f, 0x7e,
+	0x71, 0xfa, 0xc8, 0x6f, 0xef, 0xde, 0x59, 0xd1, 0x1a, 0x62, 0xee, 0x8b, 0x2f, 0xbd, 0x7b, 0xf7,
+	0xce, 0xca, 0x60, 0xd5, 0x9f, 0xde, 0xbd, 0xb3, 0x12, 0x14, 0xfb, 0x76, 0x48, 0x9c, 0x08, 0xf4,
+	0xda, 0x49, 0x78, 0x24, 0x32, 0xd4, 0x40, 0xbe, 0xeb, 0xd8, 0x3e, 0xaa, 0xfd, 0x45, 0x83, 0x85,
+	0x2d, 0xdf, 0xba, 0xe4, 0x21, 0xfa, 0x9b, 0xe7, 0xec, 0xe2, 0x0e, 0xd2, 0xcf, 0xc3, 0x74, 0x8b,
+	0x0e, 0x38, 0x9e, 0x52, 0xd0, 0x3e, 0xa1, 0xae, 0x43, 0xc1, 0x36, 0xba, 0x88, 0x09, 0x59, 0x6a,
+	0xb0, 0xbf, 0xf5, 0x05, 0xc8, 0xef, 0x60, 0xa7, 0x9c, 0x67, 0x43, 0xf4, 0x4f, 0xfd, 0x61, 0x98,
+	0x62, 0xa8, 0xfd, 0x72, 0x61, 0x29, 0xbf, 0x5c, 0x6a, 0x88, 0x2f, 0xfd, 0x34, 0xcc, 0xb6, 0x9d,
+	0x9e, 0xd7, 0x39, 0x6c, 0x7a, 0x06, 0x41, 0xe5, 0xe2, 0x92, 0xb6, 0x5c, 0x68, 0x00, 0x1f, 0x6a,
+	0x18, 0x04, 0xe9, 0x15, 0x98, 0xf1, 0xd0, 0x2e, 0xf2, 0x3c, 0xe4, 0x95, 0xa7, 0xd8, 0x7a, 0xf2,
+	0xfb, 0xc5, 0x39, 0xaa, 0x9b, 0x3e, 0x90, 0xda, 0x0a, 0x94, 0xa3, 0x02, 0xf5, 0xa5, 0xd5, 0xe7,
+	0x21, 0x87, 0x4d, 0x26, 0x53, 0xa1, 0x91, 0xc3, 0x66, 0xed, 0x63, 0x2e, 0xbd, 0xd0, 0xcc, 0xb7,
+	0x56, 0xfa, 0x88, 0x84, 0x15, 0x26, 0x61, 0x08, 0xb4, 0xb4, 0xe7, 0xaf, 0x72, 0x30, 0x27, 0xc5,
+	0xbf, 0x86, 0xad, 0xb1, 0xa4, 0x39, 0x0e, 0x45, 0x82, 0x49, 0xa7, 0x2f, 0x0e, 0xff, 0xd0, 0x97,
+	0x60, 0xd6, 0x44, 0x7e, 0xcb, 0xc3, 0x2e, 0xc1, 0x8e, 0x2d, 0xe4, 0x0a, 0x0e, 0x51, 0x23, 0xb5,
+	0x0c, 0x82, 0x2c, 0xc7, 0x3b, 0x64, 0x42, 0x94, 0x1a, 0xf2, 0x5b, 0x7f, 0x1c, 0x8e, 0x9a, 0xa8,
+	0x83, 0xf7, 0x91, 0x77, 0xd8, 0x34, 0x8d, 0x43, 0x9f, 0x59, 0xb1, 0xd0, 0x98, 0xeb, 0x0f, 0x5e,
+	0x36, 0x0e, 0x7d, 0xfd, 0x02, 0x14, 0x5d, 0x0f, 0xb7, 0x50, 0x79, 0x9a, 0x6d, 0x95, 0x93, 0x75,
+	0x81, 0x93, 0xc6, 0x90, 0xba, 0x88, 0x21, 0xf5, 0x4b, 0x0e, 0xb6, 0x2f, 0x16, 0xe8, 0x16, 0x69,
+	0x70, 0x6a, 0xaa, 0xd7, 0xdd, 0x9e, 0x6d, 0x22, 0xb3, 0x3c, 0xb3, 0xa4, 0x2d, 0xcf, 0x34, 0xc4,
+	0x57, 0x58, 0x6d, 0xaf, 0x16, 0x66, 0x0a, 0x0b, 0xc5, 0xda, 0x93, 0x70, 0x3c, 0xa8, 0x9f, 0x54,
+	0xd7, 0xf8, 0xb1, 0x06, 0xba, 0xd4, 0xf2, 0x35, 0x6c, 0x6d, 0x13, 0x83, 0xf4, 0xfc, 0xb1, 0xd4,
+	0x79, 0x02, 0xa6, 0x2c, 0x6c, 0x35, 0xb1, 0xc9, 0xf4, 0x59, 0x68, 0x14, 0x2d, 0x6c, 0x6d, 0x9a,
+	0xcc, 0x1b, 0xd8, 0xa2, 0x42, 0x95, 0xe2, 0x2b, 0x62, 0xec, 0xc7, 0xa0, 0x12, 0x87, 0x21, 0xcd,
+	0xfd, 0xf7, 0x5c, 0x40, 0x9c, 0x0d, 0xd7, 0xed, 0xe0, 0x96, 0xc1, 0x4c, 0x71, 0x1f, 0x71, 0x56,
+	0x01, 0x76, 0x3d, 0x84, 0x3a, 0x86, 0xdd, 0x42, 0x9e, 0xc0, 0x1a, 0x18, 0xd1, 0xcf, 0xc0, 0x5c,
+	0xcb, 0xd9, 0x47, 0x5e, 0xb3, 0x83, 0x08, 0x41, 0x5e, 0xb9, 0xc0, 0x1d, 0x83, 0x8d, 0xdd, 0x60,
+	0x43, 0xd4, 0xf8, 0xae, 0xe7, 0xb8, 0x8e, 0x8f, 0xcc, 0x90, 0xf1, 0xfb, 0x83, 0xcc, 0xf8, 0x03,
+	0x7d, 0x4c, 0x07, 0xf5, 0xa1, 0x9f, 0x02, 0x60, 0x08, 0x91, 0xd9, 0x34, 0x08, 0xb3, 0x70, 0xbe,
+	0x51, 0x12, 0x23, 0x1b, 0x44, 0xbf, 0x0a, 0xf3, 0x72, 0x6d, 0xee, 0x3c, 0xa5, 0x6c, 0xce, 0x23,
+	0x21, 0xdd, 0xa4, 0xb3, 0x62, 0xce, 0x52, 0x5c, 0x98, 0xaa, 0xd5, 0xe1, 0xb1, 0x24, 0xed, 0xa6,
+	0x3a, 0xcd, 0xbf, 0xb8, 0x39, 0xb8, 0xb5, 0xee, 0xd5, 0x1c, 0x7c, 0xf1, 0x5c, 0x7f, 0xf1, 0x80,
+	0x79, 0xf2, 0xe9, 0xe6, 0x29, 0x28, 0xcd, 0x53, 0xcc, 0x60, 0x9e, 0xe9, 0xa1, 0xe6, 0x99, 0x19,
+	0x62, 0x9e, 0x92, 0xda, 0x3c, 0x70, 0x5f, 0xcc, 0x33, 0xb5, 0x30, 0x5d, 0xab, 0x32, 0xf3, 0xc4,
+	0xb4, 0x2d, 0x77, 0x47, 0x9b, 0x59, 0xe3, 0x32, 0xea, 0xa0, 0xfb, 0x6e, 0x8d, 0xc8, 0x2e, 0xe5,
+	0x48, 0x62, 0x9c, 0x24, 0x92, 0x2f, 0xf3, 0x70, 0x74, 0xcb, 0xb7, 0xe8, 0x4f, 0x87, 0xb7, 0x9c,
+	0x71, 0xe3, 0x72, 0xca, 0x06, 0x8d, 0x5a, 0x38, 0x9f, 0xc1, 0xc2, 0xc5, 0x04, 0x0b, 0xbf, 0x0a,
+	0xd0, 0xc5, 0x1d, 0xe4, 0x13, 0xc7, 0x46, 0x74, 0x8b, 0xe6, 0x97, 0x67, 0xcf, 0xff, 0xcf, 0x90,
+	0x6c, 0x65, 0xab, 0x4f, 0x2c, 0x2c, 0x16, 0x98, 0x9d, 0x60, 0xf6, 0xe9, 0x71, 0xcc, 0xae, 0x2f,
+	0xc3, 0xc2, 0x01, 0x42, 0x7b, 0x9d, 0xc3, 0x26, 0x3d, 0x0e, 0xfd, 0x66, 0xcb, 0x70, 0x99, 0xff,
+	0x15, 0x1a, 0xf3, 0x7c, 0xfc, 0x3a, 0x1d, 0xbe, 0x64, 0xb8, 0xfa, 0x8d, 0xf0, 0x21, 0x5a, 0x62,
+	0x4a, 0x3d, 0x4b, 0xd7, 0xfc, 0xdb, 0x17, 0xa7, 0x4f, 0x70, 0xae, 0xbe, 0xb9, 0x57, 0xc7, 0xce,
+	0x5a, 0xd7, 0x20, 0xed, 0xfa, 0xa6, 0x4d, 0x3e, 0xff, 0x64, 0x15, 0x04, 0x9c, 0x4d, 0x9b, 0x84,
+	0xf2, 0x8d, 0xc7, 0xa0, 0xe4, 0x13, 0x0f, 0xd1, 0x84, 0xd6, 0x62, 0x1e, 0x3b, 0xd3, 0x18, 0x0c,
+	0x24, 0x1e, 0x2c, 0x2f, 0xc3, 0x89, 0x90, 0x85, 0x65, 0x90, 0x78, 0x02, 0xe6, 0x8d, 0x81, 0x4b,
+	0x34, 0x65, 0xc0, 0x38, 0x1a, 0x18, 0xdd, 0x34, 0x6b, 0x3f, 0xd4, 0xe0, 0xe1, 0x2d, 0xdf, 0xfa,
+	0x0e, 0x26, 0x6d, 0xd3, 0x33, 0x0e, 0xee, 0xd5, 0x5f, 0xe3, 0x5c, 0x73, 0x09, 0x5c, 0x23, 0x6e,
+	0xbc, 0x04, 0xd5, 0x64, 0x08, 0xd2, 0x91, 0x7f, 0xc0, 0xb6, 0xd4, 0x46, 0xab, 0x85, 0x5c, 0xf2,
+	0x8d, 0x40, 0x7c, 0x85, 0xed, 0xb4, 0x18, 0x00, 0xa9, 0xed, 0xd3, 0x30, 0xdb, 0x4f, 0xfa, 0x07,
+	0xaa, 0x86, 0xfe, 0xd0, 0xa6, 0x29, 0x24, 0x68, 0xa0, 0xef, 0xa3, 0xd6, 0x37, 0x23, 0x01, 0x8f,
+	0x15, 0x31, 0x00, 0x52, 0xc5, 0xbf, 0xe0, 0x99, 0xc7, 0x65, 0x9e, 0x18, 0x5d, 0x12, 0xc8, 0xc7,
+	0xc2, 0x17, 0x51, 0x46, 0x2e, 0xaa, 0x8c, 0x50, 0x56, 0x66, 0x3b, 0x04, 0x89, 0xd8, 0x21, 0xb3,
+	0xb2, 0xd7, 0x9c, 0x58, 0xf6, 0xc9, 0x13, 0x92, 0x08, 0x3a, 0x09, 0xfe, 0x36, 0x3c, 0x44, 0x4f,
+	0x4c, 0xa7, 0xeb, 0xd2, 0x50, 0x38, 0x51, 0xf0, 0x11, 0x5c, 0xa7, 0xe0, 0xd1, 0x04, 0xce, 0x12,
+	0xd8, 0xcf, 0x85, 0x56, 0xb1, 0xef, 0xf6, 0x26, 0x0c, 0x8c, 0x1e, 0x95, 0x1e, 0x32, 0x7c, 0x99,
+	0x24, 0x8b, 0xaf, 0x64, 0x45, 0x86, 0x01, 0x49, 0xbc, 0xbf, 0xd1, 0x60, 0x7e, 0xcb, 0xb7, 0x5e,
+	0x77, 0x91, 0x2d, 0x48, 0x1e, 0x28, 0x56, 0x9a, 0xcb, 0xa3, 0x7d, 0x6c, 0x22, 0xbb, 0x85, 0x44,
+	0x52, 0x21, 0xbf, 0x23, 0x72, 0x3c, 0xcf, 0xe2, 0x56, 0x00, 0xa8, 0xdc, 0x8b, 0xa7, 0x00, 0x4c,
+	0x3e, 0x34, 0xd8, 0x8a, 0x25, 0x31, 0xb2, 0x69, 0xd6, 0x3e, 0xd0, 0x60, 0x71, 0xcb, 0xb7, 0xb6,
+	0x7b, 0x3b, 0x5d, 0x4c, 0xae, 0x88, 0xc5, 0xc7, 0x92, 0x32, 0xcc, 0x28, 0x17, 0x61, 0x14, 0x92,
+	0x25, 0x3f, 0x54, 0x96, 0x47, 0xe1, 0x64, 0x0c, 0x91, 0x34, 0xc9, 0x2f, 0xb9, 0x49, 0xde, 0x70,
+	0x08, 0xba, 0x17, 0x93, 0x28, 0xc0, 0x56, 0x01, 0x5a, 0x4e, 0xb7, 0x8b, 0x49, 0x17, 0xd9, 0xa4,
+	0x9f, 0xcf, 0x0d, 0x46, 0x62, 0x67, 0x4f, 0x7e, 0xa1, 0xd0, 0x28, 0xec, 0x3b, 0x04, 0xd5, 0xca,
+	0xcc, 0x10, 0x01, 0x78, 0x12, 0xf9, 0xcf, 0xb8, 0xa6, 0x1b, 0xc8, 0x77, 0x3a, 0xfb, 0x93, 0x04,
+	0xff, 0x30, 0x4c, 0x1d, 0x60, 0xdb, 0x96, 0x49, 0x88, 0xf8, 0x4a, 0xd4, 0x72, 0x18, 0x8d, 0xc4,
+	0xfa, 0x47, 0x8d, 0x85, 0x10, 0x11, 0x60, 0x64, 0x8e, 0x31, 0x19, 0xef, 0x7f, 0x0a, 0x8e, 0xc9,
+	0xa4, 0xa5, 0x89, 0x6d, 0x13, 0xdd, 0x16, 0xc9, 0xf5, 0xbc, 0x1c, 0xde, 0xa4, 0xa3, 0xf1, 0x40,
+	0x59, 0x50, 0x06, 0x4a, 0x1e, 0x90, 0xa2, 0x72, 0x48, 0x39, 0x7f, 0xcd, 0xe5, 0xdc, 0x70, 0x5d,
+	0xcf, 0xd9, 0x47, 0xdf, 0x12, 0x39, 0x13, 0x45, 0x88, 0x42, 0x0c, 0x9e, 0x54, 0xac, 0xd6, 0x42,
+	0x2f, 0x1a, 0x9d, 0xd7, 0xf7, 0x91, 0x67, 0xf6, 0x26, 0x1c, 0x59, 0x4f, 0x01, 0x78, 0xc8, 0x71,
+	0x91, 0xdd, 0xb4, 0xb0, 0xc5, 0x44, 0x98, 0x69, 0x94, 0xf8, 0xc8, 0x35, 0x1c, 0xc9, 0xcb, 0xa8,
+	0xd7, 0x2f, 0xa5, 0xc1, 0x93, 0x31, 0xaa, 0x4d, 0xe3, 0xdf, 0x6e, 0xcf, 0xa6, 0xf1, 0x29, 0x3f,
+	0x3c, 0x41, 0xbd, 0x40, 0x93, 0xc9, 0xdf, 0xfd, 0xf3, 0xf4, 0xb2, 0x85, 0x49, 0xbb, 0xb7, 0x53,
+	0x6f, 0x39, 0x5d, 0x51, 0x9e, 0x14, 0xff, 0x5b, 0xf5, 0xcd, 0xbd, 0x35, 0x72, 0xe8, 0x22, 0x9f,
+	0x4d, 0xf0, 0x45, 0x09, 0x8f, 0xaf, 0x5f, 0xfb, 0x37, 0x4f, 0xf0, 0x6e, 0xf2, 0xfc, 0x96, 0xa3,
+	0xea, 0x8c, 0x9f, 0x7b, 0x28, 0x75, 0xf5, 0x5d, 0x58, 0x1c, 0x5c, 0x03, 0x9b, 0xae, 0x71, 0xe8,
+	0xf4, 0x08, 0xdf, 0x96, 0xa3, 0xa5, 0xc5, 0x0b, 0x83, 0x55, 0x6e, 0xb2, 0x45, 0x02, 0x67, 0x46,
+	0x61, 0xc8, 0xf9, 0xf6, 0x0a, 0x4b, 0x26, 0x13, 0xc4, 0x0d, 0x9e, 0x0f, 0xe8, 0xb6, 0x8b, 0x3d,
+	0xe4, 0xd3, 0xab, 0xa3, 0xc6, 0xaf, 0x8e, 0x62, 0x64, 0x83, 0xd4, 0xde, 0xe2, 0x19, 0x35, 0x4b,
+	0xf5, 0x26, 0xae, 0xae, 0x08, 0xf8, 0xff, 0x68, 0x70, 0x2a, 0x91, 0x79, 0xd0, 0x71, 0x84, 0x4e,
+	0x27, 0xe6, 0x38, 0x7c, 0xfd, 0x80, 0x8b, 0xe6, 0x26, 0xec, 0xa2, 0x5c, 0xe3, 0x3c, 0x35, 0x7d,
+	0xd0, 0x1a, 0x3f, 0xcd, 0x14, 0x1e, 0xe7, 0x2d, 0xa3, 0xcd, 0x1f, 0x78, 0xb1, 0xf6, 0x16, 0x76,
+	0xaf, 0x0e, 0xaa, 0x1b, 0x13, 0xd9, 0x39, 0xcf, 0xc3, 0x94, 0xd1, 0x75, 0x7a, 0x36, 0xdf, 0x2e,
+	0x19, 0x2e, 0xad, 0x82, 0x9c, 0x95, 0x81, 0x07, 0x87, 0x03, 0xfb, 0x3b, 0x22, 0xe5, 0x3a, 0x8b,
+	0x98, 0x21, 0x19, 0xa4, 0x47, 0x9d, 0x80, 0x29, 0x82, 0xdd, 0x41, 0xaa, 0x54, 0x24, 0xd8, 0xdd,
+	0x34, 0x6b, 0x77, 0x34, 0x80, 0x2d, 0xdf, 0xba, 0xe1, 0x58, 0xb7, 0x70, 0x77, 0x42, 0xe7, 0xc3,
+	0x71, 0x28, 0xb2, 0xfb, 0x75, 0xbf, 0xb4, 0xc4, 0x3e, 0xf4, 0xa7, 0x61, 0x21, 0x50, 0xde, 0x6d,
+	0xb6, 0x0d, 0xbf, 0x2d, 0x44, 0x3b, 0x16, 0x18, 0xbf, 0x6e, 0xf8, 0xed, 0x88, 0x94, 0x4d, 0x96,
+	0x6b, 0x0b, 0xc4, 0x52, 0xbe, 0x2a, 0xcc, 0x12, 0xdc, 0x45, 0xcd, 0x8e, 0x63, 0x05, 0xf2, 0x41,
+	0x3a, 0x74, 0xc3, 0xb1, 0x36, 0x4d, 0xca, 0x8e, 0x42, 0x42, 0x3e, 0x69, 0x9a, 0xc8, 0x30, 0x3b,
+	0xd8, 0xe6, 0x15, 0xe8, 0x7c, 0xe3, 0x98, 0x18, 0xbf, 0x2c, 0x86, 0x6b, 0xef, 0xf3, 0x84, 0xe6,
+	0x12, 0x1f, 0xbe, 0xc5, 0x97, 0x18, 0x4b, 0x35, 0x11, 0x50, 0xb9, 0x28, 0xa8, 0x6c, 0xb9, 0x3c,
+	0xcf, 0x68, 0xc2, 0x70, 0xa4, 0xe3, 0x7e, 0xac, 0xb1, 0x6d, 0x75, 0xb5, 0x67, 0x9b, 0xd7, 0x59,
+	0x85, 0x61, 0xb2, 0x67, 0xe4, 0xb8, 0xde, 0x9b, 0xb8, 0x1f, 0xe3, 0xa0, 0xa5, 0x58, 0x3e, 0xcb,
+	0x86, 0x2f, 0x75, 0x0c, 0xdc, 0xdd, 0x66, 0x95, 0x91, 0x07, 0x11, 0x25, 0xde, 0x66, 0x67, 0x68,
+	0x80, 0xa9, 0xf4, 0xae, 0x96, 0x14, 0x5b, 0x19, 0x8f, 0xcf, 0x8d, 0x1a, 0x25, 0xfb, 0x2a, 0xaa,
+	0x79, 0xac, 0x8c, 0xb7, 0x4d, 0x1c, 0xf7, 0xc1, 0x89, 0xfc, 0x0f, 0xee, 0x3e, 0x03, 0xa6, 0x52,
+	0xe4, 0x26, 0x14, 0x5c, 0x03, 0x9b, 0x93, 0x10, 0x98, 0x2d, 0x4c, 0x75, 0x9a, 0xf5, 0xe4, 0x19,
+	0x43, 0xa7, 0xe2, 0xd0, 0x11, 0x85, 0xaf, 0xab, 0x1d, 0xc3, 0x0a, 0x94, 0x43, 0xb6, 0xdd, 0x31,
+	0xb5, 0x3b, 0x56, 0x4d, 0xe6, 0x3d, 0x8d, 0x25, 0x2b, 0x09, 0x18, 0xa4, 0xb2, 0x31, 0x94, 0x76,
+	0x1d, 0x6f, 0x17, 0x61, 0x82, 0x26, 0xa2, 0xf1, 0xc1, 0xea, 0xb5, 0x8f, 0x72, 0xec, 0x6a, 0x20,
+	0x52, 0xa7, 0x8d, 0x2e, 0xb2, 0x4d, 0x7a, 0xf5, 0x9b, 0x4c, 0xb8, 0x90, 0x3d, 0xb7, 0xfc, 0x48,
+	0x3d, 0xb7, 0xb3, 0xb0, 0x38, 0xe8, 0xe7, 0xf5, 0xa3, 0x75, 0x81, 0x45, 0xeb, 0x05, 0xd9, 0xd3,
+	0x13, 0xe3, 0x89, 0x07, 0x49, 0x31, 0xf1, 0x20, 0x09, 0xc4, 0xdb, 0xa9, 0x21, 0xf1, 0x96, 0x5f,
+	0x4c, 0xa2, 0x0a, 0x92, 0xa1, 0xe9, 0x80, 0x9d, 0x3f, 0xa2, 0x48, 0x38, 0x51, 0xf5, 0x25, 0xd6,
+	0x74, 0x22, 0x8c, 0x25, 0xac, 0xdf, 0x6b, 0x0c, 0x76, 0x03, 0xbd, 0xd9, 0x0b, 0x1c, 0x66, 0x57,
+	0x6e, 0x13, 0x64, 0xfb, 0x13, 0xbb, 0x06, 0x9c, 0x81, 0x39, 0x1b, 0x1d, 0x0c, 0x6c, 0x94, 0x67,
+	0x36, 0x9a, 0xb5, 0xd1, 0x81, 0x34, 0x4f, 0xb6, 0x7c, 0xfe, 0x3a, 0x3c, 0x3e, 0x04, 0xbc, 0xdc,
+	0x27, 0x67, 0x60, 0x0e, 0xf5, 0x07, 0x07, 0xc7, 0xfc, 0xac, 0x1c, 0xdb, 0x34, 0x6b, 0xef, 0x6a,
+	0xc1, 0x7b, 0xe5, 0xfd, 0xd1, 0x43, 0x94, 0x6d, 0x2e, 0xc6, 0x36, 0x22, 0xce, 0x13, 0x4c, 0x9c,
+	0x34, 0x0c, 0xd2, 0x66, 0x02, 0xeb, 0x65, 0xd4, 0xa2, 0xbf, 0x7f, 0xa3, 0x58, 0xd3, 0x30, 0x48,
+	0xac, 0x1f, 0xf2, 0x92, 0xc2, 0x36, 0x22, 0x57, 0xfc, 0x96, 0xe7, 0x1c, 0x6c, 0x13, 0x63, 0x0f,
+	0xdb, 0xd6, 0x64, 0xfc, 0xaa, 0x0c, 0xd3, 0xc8, 0x36, 0x76, 0x3a, 0xc8, 0x14, 0xf7, 0xf0, 0xfe,
+	0x67, 0xe2, 0x56, 0x8d, 0x62, 0x92, 0x98, 0xdf, 0xe7, 0x75, 0xd9, 0x06, 0xb2, 0xb0, 0x4f, 0x90,
+	0xb7, 0xe1, 0xed, 0x60, 0x32, 0x66, 0x5e, 0x7f, 0x01, 0x8a, 0x3e, 0x31, 0xf6, 0x90, 0x78, 0x68,
+	0xa3, 0x8e, 0x64, 0x8c, 0x3a, 0x71, 0x07, 0x47, 0xe0, 0x48, 0xb4, 0xb7, 0xf8, 0x7b, 0x11, 0x7b,
+	0xc7, 0xb1, 0xcd, 0x7b, 0x80, 0x1a, 0xe1, 0x79, 0x95, 0x3f, 0xe8, 0x08, 0xae, 0x2a, 0xb7, 0xd3,
+	0x0a, 0x2c, 0xf6, 0xd8, 0x0f, 0xd8, 0xb6, 0x9a, 0xc8, 0x36, 0x03, 0x57, 0xe5, 0x63, 0xf2, 0x87,
+	0x2b, 0xb6, 0x49, 0x2f, 0xcc, 0x7f, 0xd2, 0x44, 0x6f, 0x63, 0x1f, 0x19, 0x1d, 0x51, 0x57, 0x7b,
+	0xc3, 0x99, 0x4c, 0xa5, 0x4f, 0x87, 0x82, 0x6f, 0x74, 0xfa, 0x05, 0x4a, 0xf6, 0xb7, 0x7e, 0x1e,
+	0x4e, 0xc4, 0x2a, 0x0e, 0xcd, 0x1d, 0xb7, 0xdf, 0x6d, 0x7c, 0x28, 0x5a, 0x48, 0xb8, 0xe8, 0xfa,
+	0x43, 0xca, 0x99, 0xfd, 0x3e, 0x49, 0x44, 0x18, 0x69, 0x8b, 0xf7, 0xf8, 0x7d, 0x70, 0xc3, 0x75,
+	0x07, 0x04, 0x13, 0xaa, 0x69, 0x66, 0xb8, 0x01, 0xec, 0x31, 0x1b, 0x86, 0xc0, 0x48, 0x1b, 0x3e,
+	0x0b, 0x05, 0x6a, 0x28, 0x86, 0x28, 0x83, 0x5f, 0x32, 0x62, 0xbd, 0x02, 0x33, 0x91, 0x5b, 0x90,
+	0xfc, 0xae, 0x91, 0xe0, 0xed, 0x67, 0x72, 0xa2, 0x47, 0x44, 0x7c, 0x2d, 0x78, 0xc9, 0x89, 0xca,
+	0xb8, 0x0e, 0xf9, 0x5d, 0x84, 0xb2, 0x8a, 0x48, 0x69, 0xcf, 0x7f, 0xf4, 0x34, 0xe4, 0xb7, 0x7c,
+	0x4b, 0xb7, 0x61, 0x2e, 0xf4, 0xd2, 0x6e, 0x65, 0x58, 0xcf, 0x39, 0xfc, 0x8e, 0xad, 0x72, 0x3e,
+	0x3b, 0xad, 0x84, 0xfa, 0x26, 0x1c, 0x0d, 0xbf, 0x77, 0x3b, 0x3b, 0x7c, 0x91, 0x10, 0x71, 0xe5,
+	0xd9, 0x11, 0x88, 0x83, 0x2c, 0xc3, 0x8f, 0xcc, 0xce, 0x66, 0xc2, 0x9d, 0x8d, 0x65, 0xe2, 0x4b,
+	0x30, 0x1d, 0x41, 0x69, 0xf0, 0x0a, 0xec, 0xa9, 0x2c, 0xa0, 0xaf, 0x61, 0xab, 0xb2, 0x96, 0x91,
+	0x50, 0xb2, 0x39, 0x80, 0x63, 0xd1, 0x37, 0x52, 0xab, 0x59, 0xe0, 0x4a, 0xf2, 0xca, 0x85, 0x91,
+	0xc8, 0x25, 0xe3, 0xb7, 0x61, 0x31, 0xfe, 0xec, 0x29, 0x13, 0xfc, 0xc0, 0x84, 0xca, 0xf3, 0x23,
+	0x4e, 0x08, 0xb2, 0x8f, 0x3f, 0xf3, 0x59, 0xcb, 0x22, 0xca, 0x08, 0xec, 0x53, 0x9f, 0xb6, 0x50,
+	0xf6, 0xf1, 0x77, 0x2d, 0x0a, 0xf6, 0xb1, 0x09, 0x2a, 0xf6, 0xa9, 0xef, 0x59, 0xf4, 0x36, 0x40,
+	0xe0, 0x2d, 0xcb, 0xf2, 0xf0, 0x65, 0x06, 0x94, 0x95, 0x73, 0x59, 0x29, 0x25, 0xa7, 0x1f, 0x69,
+	0xf0, 0x50, 0xd2, 0x9b, 0x88, 0xf5, 0xe1, 0x2b, 0x25, 0x4c, 0xa9, 0xfc, 0xdf, 0xc8, 0x53, 0x82,
+	0xea, 0x8e, 0xbf, 0x79, 0x50, 0xa8, 0x3b, 0x36, 0x41, 0xa5, 0xee, 0xf4, 0x47, 0x0d, 0x6f, 0xc3,
+	0x62, 0xfc, 0xc1, 0x82, 0x82, 0x7d, 0x6c, 0x82, 0x8a, 0x7d, 0xea, 0x8b, 0x04, 0xba, 0xc7, 0xa3,
+	0xaf, 0x11, 0x56, 0x95, 0x9e, 0x13, 0x24, 0x57, 0xed, 0xf1, 0x94, 0xd7, 0x04, 0xfa, 0x5b, 0xb0,
+	0x10, 0x7b, 0x4a, 0x50, 0x57, 0xec, 0xd8, 0x08, 0x7d, 0xe5, 0xb9, 0xd1, 0xe8, 0x43, 0x42, 0x47,
+	0x1e, 0x0b, 0xa8, 0x84, 0x0e, 0x93, 0x2b, 0x85, 0x4e, 0xee, 0xfc, 0xeb, 0x7b, 0x30, 0x1b, 0xec,
+	0xfa, 0x3f, 0x3d, 0x7c, 0x95, 0x00, 0x69, 0x65, 0x3d, 0x33, 0xa9, 0x64, 0x46, 0x60, 0x3e, 0xd2,
+	0x7f, 0x7f, 0x66, 0xf8, 0x22, 0x61, 0xea, 0xca, 0xff, 0x8e, 0x42, 0x1d, 0x14, 0x31, 0xd8, 0x45,
+	0x57, 0x88, 0x18, 0x20, 0x55, 0x89, 0x98, 0xd0, 0xfc, 0xa6, 0x22, 0x46, 0x1a, 0xdf, 0xcf, 0xa8,
+	0x36, 0x42, 0x90, 0x5a, 0x25, 0x62, 0x72, 0x1b, 0x9b, 0xba, 0x6e, 0xac, 0x85, 0x5d, 0xcf, 0xb4,
+	0x0b, 0x24, 0xbd, 0xca, 0x75, 0xd3, 0x5a, 0xcb, 0x94, 0x77, 0xac, 0xad, 0x5c, 0x57, 0x46, 0xde,
+	0x10, 0xbd, 0x8a, 0x77, 0x5a, 0x4f, 0x58, 0xff, 0x89, 0x06, 0x27, 0x92, 0x1b, 0xc2, 0xaa, 0xc4,
+	0x29, 0x69, 0x52, 0xe5, 0xa5, 0x31, 0x26, 0x85, 0xce, 0x8e, 0xa4, 0x76, 0xab, 0xc2, 0x89, 0x12,
+	0xa6, 0xa8, 0xce, 0x8e, 0x61, 0x5d, 0xce, 0x77, 0x34, 0xd0, 0x13, 0x9a, 0x98, 0xe7, 0xb2, 0x1c,
+	0x06, 0x21, 0x0c, 0x2f, 0x8c, 0x3a, 0x23, 0x04, 0x21, 0xa1, 0xab, 0x77, 0x2e, 0xcb, 0x81, 0x30,
+	0x0a, 0x84, 0xf4, 0xee, 0x1d, 0xcd, 0x80, 0xc3, 0x9d, 0x3b, 0x45, 0x06, 0x1c, 0x22, 0x56, 0x65,
+	0xc0, 0xc9, 0xfd, 0xb4, 0x26, 0x4c, 0xf7, 0x9b, 0x66, 0x4f, 0x0c, 0x9f, 0x2f, 0xc8, 0x2a, 0xab,
+	0x99, 0xc8, 0x82, 0x91, 0x25, 0xd2, 0x81, 0x7a, 0x46, 0x75, 0xd8, 0x04, 0xa9, 0x55, 0x91, 0x25,
+	0xb9, 0x9d, 0xc4, 0x8c, 0x99, 0xd0, 0x4b, 0x52, 0x18, 0x33, 0x3e, 0x43, 0x65, 0xcc, 0xf4, 0xd6,
+	0x0f, 0x8d, 0xdf, 0xc1, 0xbe, 0x8f, 0x22, 0x7e, 0x07, 0x48, 0x55, 0xf1, 0x3b, 0xa9, 0xb1, 0xd3,
+	0x06, 0x08, 0x34, 0x5c, 0x14, 0xb9, 0xe6, 0x80, 0x52, 0x95, 0x6b, 0x26, 0xf4, 0x53, 0x68, 0xbc,
+	0x48, 0x6a, 0x43, 0x28, 0x40, 0x27, 0x4c, 0x51, 0xc5, 0x8b, 0x61, 0x8d, 0x86, 0xb7, 0x60, 0x21,
+	0x56, 0xf9, 0xaf, 0x67, 0x0a, 0x3f, 0x92, 0x5e, 0x15, 0xbd, 0xd3, 0x0a, 0xe7, 0x34, 0xe9, 0x89,
+	0x56, 0xcd, 0x57, 0x33, 0x25, 0xad, 0x92, 0xf3, 0x85, 0x91, 0xc8, 0x25, 0xe3, 0x0f, 0x35, 0x28,
+	0xa7, 0xd6, 0xc5, 0x9f, 0x53, 0x45, 0x9d, 0xe4, 0x79, 0x95, 0x97, 0xc7, 0x9b, 0x17, 0x02, 0x95,
+	0x5a, 0xa4, 0xce, 0x76, 0x40, 0x8e, 0x0c, 0x4a, 0x55, 0x90, 0x66, 0xa0, 0x52, 0xab, 0xd1, 0xca,
+	0x8c, 0x21, 0x79, 0x9e, 0x0a, 0x94, 0xaa, 0xf2, 0x4c, 0x7d, 0x36, 0x56, 0x75, 0x56, 0xf8, 0x6c,
+	0x94, 0x5e, 0xe5, 0xb3, 0x69, 0x15, 0x64, 0xea, 0xb3, 0xd1, 0xea, 0xf1, 0xaa, 0xca, 0xf0, 0x21,
+	0x72, 0x95, 0xcf, 0xa6, 0x14, 0x83, 0x59, 0x51, 0x27, 0x54, 0x09, 0x56, 0x15, 0x75, 0x82, 0xc4,
+	0xca, 0xa2, 0x4e, 0x62, 0x35, 0x98, 0x5d, 0x04, 0xa3, 0xd5, 0x5d, 0xe5, 0x45, 0x30, 0x32, 0x41,
+	0x7d, 0x11, 0x4c, 0x29, 0xb9, 0x52, 0x89, 0xc3, 0xe5, 0xd6, 0xb3, 0x4a, 0x67, 0x1e, 0x10, 0xab,
+	0x24, 0x4e, 0xae, 0x9d, 0x0e, 0xce, 0xd8, 0x8c, 0xd9, 0x7b, 0x98, 0x3a, 0xe3, 0x19, 0x1b, 0xe1,
+	0x5a, 0x29, 0xbe, 0x73, 0xf7, 0xce, 0x8a, 0x76, 0xf1, 0x85, 0x4f, 0xbf, 0xaa, 0x6a, 0x9f, 0x7d,
+	0x55, 0xd5, 0xbe, 0xfc, 0xaa, 0xaa, 0x7d, 0xf0, 0x75, 0xf5, 0xc8, 0x67, 0x5f, 0x57, 0x8f, 0xfc,
+	0xf5, 0xeb, 0xea, 0x91, 0xef, 0x55, 0x53, 0xff, 0xf1, 0x2d, 0xeb, 0xe9, 0xee, 0x4c, 0xb1, 0x7f,
+	0x48, 0xfc, 0xec, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x04, 0xc4, 0xfb, 0x31, 0x58, 0x3d, 0x00,
+	0x00,
 }
 
-func (m *MsgCompleteContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateProfile defines the CreateProfile RPC.
+	CreateProfile(ctx context.Context, in *MsgCreateProfile, opts ...grpc.CallOption) (*MsgCreateProfileResponse, error)
+	// UpdateProfile defines the UpdateProfile RPC.
+	UpdateProfile(ctx context.Context, in *MsgUpdateProfile, opts ...grpc.CallOption) (*MsgUpdateProfileResponse, error)
+	// CreateGig defines the CreateGig RPC.
+	CreateGig(ctx context.Context, in *MsgCreateGig, opts ...grpc.CallOption) (*MsgCreateGigResponse, error)
+	// UpdateGigStatus defines the UpdateGigStatus RPC.
+	UpdateGigStatus(ctx context.Context, in *MsgUpdateGigStatus, opts ...grpc.CallOption) (*MsgUpdateGigStatusResponse, error)
+	// CreateApplication defines the CreateApplication RPC.
+	CreateApplication(ctx context.Context, in *MsgCreateApplication, opts ...grpc.CallOption) (*MsgCreateApplicationResponse, error)
+	// UpdateApplication defines the UpdateApplication RPC.
+	UpdateApplication(ctx context.Context, in *MsgUpdateApplication, opts ...grpc.CallOption) (*MsgUpdateApplicationResponse, error)
+	// DeleteApplication defines the DeleteApplication RPC.
+	DeleteApplication(ctx context.Context, in *MsgDeleteApplication, opts ...grpc.CallOption) (*MsgDeleteApplicationResponse, error)
+	// ApplyToGig defines the ApplyToGig RPC.
+	ApplyToGig(ctx context.Context, in *MsgApplyToGig, opts ...grpc.CallOption) (*MsgApplyToGigResponse, error)
+	// WithdrawApplication defines the WithdrawApplication RPC.
+	WithdrawApplication(ctx context.Context, in *MsgWithdrawApplication, opts ...grpc.CallOption) (*MsgWithdrawApplicationResponse, error)
+	// AcceptApplication defines the AcceptApplication RPC.
+	AcceptApplication(ctx context.Context, in *MsgAcceptApplication, opts ...grpc.CallOption) (*MsgAcceptApplicationResponse, error)
+	// RejectApplication defines the RejectApplication RPC.
+	RejectApplication(ctx context.Context, in *MsgRejectApplication, opts ...grpc.CallOption) (*MsgRejectApplicationResponse, error)
+	// DeliverContract defines the DeliverContract RPC.
+	DeliverContract(ctx context.Context, in *MsgDeliverContract, opts ...grpc.CallOption) (*MsgDeliverContractResponse, error)
+	// CompleteContract defines the CompleteContract RPC.
+	CompleteContract(ctx context.Context, in *MsgCompleteContract, opts ...grpc.CallOption) (*MsgCompleteContractResponse, error)
+	// DisputeContract defines the DisputeContract RPC.
+	DisputeContract(ctx context.Context, in *MsgDisputeContract, opts ...grpc.CallOption) (*MsgDisputeContractResponse, error)
+	// OpenDispute defines the OpenDispute RPC.
+	OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error)
+	// SubmitEvidence defines the SubmitEvidence RPC.
+	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// VoteDispute defines the VoteDispute RPC.
+	VoteDispute(ctx context.Context, in *MsgVoteDispute, opts ...grpc.CallOption) (*MsgVoteDisputeResponse, error)
+	// ResolveDispute defines the ResolveDispute RPC.
+	ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error)
+	// DeliverMilestone defines the DeliverMilestone RPC.
+	DeliverMilestone(ctx context.Context, in *MsgDeliverMilestone, opts ...grpc.CallOption) (*MsgDeliverMilestoneResponse, error)
+	// ApproveMilestone defines the ApproveMilestone RPC.
+	ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error)
+	// CancelOverdueContract defines the CancelOverdueContract RPC.
+	CancelOverdueContract(ctx context.Context, in *MsgCancelOverdueContract, opts ...grpc.CallOption) (*MsgCancelOverdueContractResponse, error)
+	// ProposeCancellation defines the ProposeCancellation RPC.
+	ProposeCancellation(ctx context.Context, in *MsgProposeCancellation, opts ...grpc.CallOption) (*MsgProposeCancellationResponse, error)
+	// AcceptCancellation defines the AcceptCancellation RPC.
+	AcceptCancellation(ctx context.Context, in *MsgAcceptCancellation, opts ...grpc.CallOption) (*MsgAcceptCancellationResponse, error)
+	// RejectCancellation defines the RejectCancellation RPC.
+	RejectCancellation(ctx context.Context, in *MsgRejectCancellation, opts ...grpc.CallOption) (*MsgRejectCancellationResponse, error)
+	// TipFreelancer defines the TipFreelancer RPC.
+	TipFreelancer(ctx context.Context, in *MsgTipFreelancer, opts ...grpc.CallOption) (*MsgTipFreelancerResponse, error)
+	// LogTime defines the LogTime RPC.
+	LogTime(ctx context.Context, in *MsgLogTime, opts ...grpc.CallOption) (*MsgLogTimeResponse, error)
+	// ContestTimeLog defines the ContestTimeLog RPC.
+	ContestTimeLog(ctx context.Context, in *MsgContestTimeLog, opts ...grpc.CallOption) (*MsgContestTimeLogResponse, error)
+	// FundHourlyContract defines the FundHourlyContract RPC.
+	FundHourlyContract(ctx context.Context, in *MsgFundHourlyContract, opts ...grpc.CallOption) (*MsgFundHourlyContractResponse, error)
+	// ClaimStream defines the ClaimStream RPC.
+	ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error)
+	// StopStream defines the StopStream RPC.
+	StopStream(ctx context.Context, in *MsgStopStream, opts ...grpc.CallOption) (*MsgStopStreamResponse, error)
+	// FlagApplicationSpam defines the FlagApplicationSpam RPC.
+	FlagApplicationSpam(ctx context.Context, in *MsgFlagApplicationSpam, opts ...grpc.CallOption) (*MsgFlagApplicationSpamResponse, error)
+	// ProposeAmendment defines the ProposeAmendment RPC.
+	ProposeAmendment(ctx context.Context, in *MsgProposeAmendment, opts ...grpc.CallOption) (*MsgProposeAmendmentResponse, error)
+	// AcceptAmendment defines the AcceptAmendment RPC.
+	AcceptAmendment(ctx context.Context, in *MsgAcceptAmendment, opts ...grpc.CallOption) (*MsgAcceptAmendmentResponse, error)
+	// RequestDeadlineExtension defines the RequestDeadlineExtension RPC.
+	RequestDeadlineExtension(ctx context.Context, in *MsgRequestDeadlineExtension, opts ...grpc.CallOption) (*MsgRequestDeadlineExtensionResponse, error)
+	// ApproveDeadlineExtension defines the ApproveDeadlineExtension RPC.
+	ApproveDeadlineExtension(ctx context.Context, in *MsgApproveDeadlineExtension, opts ...grpc.CallOption) (*MsgApproveDeadlineExtensionResponse, error)
+	// DeclineDeadlineExtension defines the DeclineDeadlineExtension RPC.
+	DeclineDeadlineExtension(ctx context.Context, in *MsgDeclineDeadlineExtension, opts ...grpc.CallOption) (*MsgDeclineDeadlineExtensionResponse, error)
+	// SetEscrowStaking defines the SetEscrowStaking RPC.
+	SetEscrowStaking(ctx context.Context, in *MsgSetEscrowStaking, opts ...grpc.CallOption) (*MsgSetEscrowStakingResponse, error)
+	// RegisterArbiter defines the RegisterArbiter RPC.
+	RegisterArbiter(ctx context.Context, in *MsgRegisterArbiter, opts ...grpc.CallOption) (*MsgRegisterArbiterResponse, error)
+	// UnbondArbiter defines the UnbondArbiter RPC.
+	UnbondArbiter(ctx context.Context, in *MsgUnbondArbiter, opts ...grpc.CallOption) (*MsgUnbondArbiterResponse, error)
+	// RevealDisputeVote defines the RevealDisputeVote RPC.
+	RevealDisputeVote(ctx context.Context, in *MsgRevealDisputeVote, opts ...grpc.CallOption) (*MsgRevealDisputeVoteResponse, error)
+	// AppealDispute defines the AppealDispute RPC.
+	AppealDispute(ctx context.Context, in *MsgAppealDispute, opts ...grpc.CallOption) (*MsgAppealDisputeResponse, error)
+	// ContestDispute defines the ContestDispute RPC.
+	ContestDispute(ctx context.Context, in *MsgContestDispute, opts ...grpc.CallOption) (*MsgContestDisputeResponse, error)
 }
 
-func (m *MsgCompleteContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgCompleteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgCompleteContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCompleteContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCompleteContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateProfile(ctx context.Context, in *MsgCreateProfile, opts ...grpc.CallOption) (*MsgCreateProfileResponse, error) {
+	out := new(MsgCreateProfileResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDisputeContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateProfile(ctx context.Context, in *MsgUpdateProfile, opts ...grpc.CallOption) (*MsgUpdateProfileResponse, error) {
+	out := new(MsgUpdateProfileResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDisputeContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateGig(ctx context.Context, in *MsgCreateGig, opts ...grpc.CallOption) (*MsgCreateGigResponse, error) {
+	out := new(MsgCreateGigResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateGig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDisputeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateGigStatus(ctx context.Context, in *MsgUpdateGigStatus, opts ...grpc.CallOption) (*MsgUpdateGigStatusResponse, error) {
+	out := new(MsgUpdateGigStatusResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateGigStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDisputeContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateApplication(ctx context.Context, in *MsgCreateApplication, opts ...grpc.CallOption) (*MsgCreateApplicationResponse, error) {
+	out := new(MsgCreateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CreateApplication", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDisputeContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDisputeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateApplication(ctx context.Context, in *MsgUpdateApplication, opts ...grpc.CallOption) (*MsgUpdateApplicationResponse, error) {
+	out := new(MsgUpdateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UpdateApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgOpenDispute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteApplication(ctx context.Context, in *MsgDeleteApplication, opts ...grpc.CallOption) (*MsgDeleteApplicationResponse, error) {
+	out := new(MsgDeleteApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeleteApplication", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgOpenDispute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ApplyToGig(ctx context.Context, in *MsgApplyToGig, opts ...grpc.CallOption) (*MsgApplyToGigResponse, error) {
+	out := new(MsgApplyToGigResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ApplyToGig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgOpenDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Evidence) > 0 {
-		i -= len(m.Evidence)
-		copy(dAtA[i:], m.Evidence)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) WithdrawApplication(ctx context.Context, in *MsgWithdrawApplication, opts ...grpc.CallOption) (*MsgWithdrawApplicationResponse, error) {
+	out := new(MsgWithdrawApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/WithdrawApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgOpenDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptApplication(ctx context.Context, in *MsgAcceptApplication, opts ...grpc.CallOption) (*MsgAcceptApplicationResponse, error) {
+	out := new(MsgAcceptApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/AcceptApplication", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgOpenDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectApplication(ctx context.Context, in *MsgRejectApplication, opts ...grpc.CallOption) (*MsgRejectApplicationResponse, error) {
+	out := new(MsgRejectApplicationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/RejectApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgOpenDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DisputeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) DeliverContract(ctx context.Context, in *MsgDeliverContract, opts ...grpc.CallOption) (*MsgDeliverContractResponse, error) {
+	out := new(MsgDeliverContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeliverContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CompleteContract(ctx context.Context, in *MsgCompleteContract, opts ...grpc.CallOption) (*MsgCompleteContractResponse, error) {
+	out := new(MsgCompleteContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CompleteContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DisputeContract(ctx context.Context, in *MsgDisputeContract, opts ...grpc.CallOption) (*MsgDisputeContractResponse, error) {
+	out := new(MsgDisputeContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DisputeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Evidence) > 0 {
-		i -= len(m.Evidence)
-		copy(dAtA[i:], m.Evidence)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DisputeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) OpenDispute(ctx context.Context, in *MsgOpenDispute, opts ...grpc.CallOption) (*MsgOpenDisputeResponse, error) {
+	out := new(MsgOpenDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/OpenDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitEvidenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error) {
+	out := new(MsgSubmitEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/SubmitEvidence", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteDispute(ctx context.Context, in *MsgVoteDispute, opts ...grpc.CallOption) (*MsgVoteDisputeResponse, error) {
+	out := new(MsgVoteDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/VoteDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ResolveDispute(ctx context.Context, in *MsgResolveDispute, opts ...grpc.CallOption) (*MsgResolveDisputeResponse, error) {
+	out := new(MsgResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ResolveDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgVoteDispute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeliverMilestone(ctx context.Context, in *MsgDeliverMilestone, opts ...grpc.CallOption) (*MsgDeliverMilestoneResponse, error) {
+	out := new(MsgDeliverMilestoneResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeliverMilestone", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgVoteDispute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error) {
+	out := new(MsgApproveMilestoneResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ApproveMilestone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgVoteDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vote) > 0 {
-		i -= len(m.Vote)
-		copy(dAtA[i:], m.Vote)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Vote)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DisputeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) CancelOverdueContract(ctx context.Context, in *MsgCancelOverdueContract, opts ...grpc.CallOption) (*MsgCancelOverdueContractResponse, error) {
+	out := new(MsgCancelOverdueContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/CancelOverdueContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ProposeCancellation(ctx context.Context, in *MsgProposeCancellation, opts ...grpc.CallOption) (*MsgProposeCancellationResponse, error) {
+	out := new(MsgProposeCancellationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ProposeCancellation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgVoteDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptCancellation(ctx context.Context, in *MsgAcceptCancellation, opts ...grpc.CallOption) (*MsgAcceptCancellationResponse, error) {
+	out := new(MsgAcceptCancellationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/AcceptCancellation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgVoteDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectCancellation(ctx context.Context, in *MsgRejectCancellation, opts ...grpc.CallOption) (*MsgRejectCancellationResponse, error) {
+	out := new(MsgRejectCancellationResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/RejectCancellation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgVoteDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) TipFreelancer(ctx context.Context, in *MsgTipFreelancer, opts ...grpc.CallOption) (*MsgTipFreelancerResponse, error) {
+	out := new(MsgTipFreelancerResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/TipFreelancer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) LogTime(ctx context.Context, in *MsgLogTime, opts ...grpc.CallOption) (*MsgLogTimeResponse, error) {
+	out := new(MsgLogTimeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/LogTime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ContestTimeLog(ctx context.Context, in *MsgContestTimeLog, opts ...grpc.CallOption) (*MsgContestTimeLogResponse, error) {
+	out := new(MsgContestTimeLogResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ContestTimeLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Winner) > 0 {
-		i -= len(m.Winner)
-		copy(dAtA[i:], m.Winner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Winner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DisputeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) FundHourlyContract(ctx context.Context, in *MsgFundHourlyContract, opts ...grpc.CallOption) (*MsgFundHourlyContractResponse, error) {
+	out := new(MsgFundHourlyContractResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/FundHourlyContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error) {
+	out := new(MsgClaimStreamResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ClaimStream", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StopStream(ctx context.Context, in *MsgStopStream, opts ...grpc.CallOption) (*MsgStopStreamResponse, error) {
+	out := new(MsgStopStreamResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/StopStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) FlagApplicationSpam(ctx context.Context, in *MsgFlagApplicationSpam, opts ...grpc.CallOption) (*MsgFlagApplicationSpamResponse, error) {
+	out := new(MsgFlagApplicationSpamResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/FlagApplicationSpam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) ProposeAmendment(ctx context.Context, in *MsgProposeAmendment, opts ...grpc.CallOption) (*MsgProposeAmendmentResponse, error) {
+	out := new(MsgProposeAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ProposeAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) AcceptAmendment(ctx context.Context, in *MsgAcceptAmendment, opts ...grpc.CallOption) (*MsgAcceptAmendmentResponse, error) {
+	out := new(MsgAcceptAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/AcceptAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RequestDeadlineExtension(ctx context.Context, in *MsgRequestDeadlineExtension, opts ...grpc.CallOption) (*MsgRequestDeadlineExtensionResponse, error) {
+	out := new(MsgRequestDeadlineExtensionResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/RequestDeadlineExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateProfile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Bio)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Skills) > 0 {
-		for _, s := range m.Skills {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.HourlyRate != 0 {
-		n += 1 + sovTx(uint64(m.HourlyRate))
+func (c *msgClient) ApproveDeadlineExtension(ctx context.Context, in *MsgApproveDeadlineExtension, opts ...grpc.CallOption) (*MsgApproveDeadlineExtensionResponse, error) {
+	out := new(MsgApproveDeadlineExtensionResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ApproveDeadlineExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateProfileResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (c *msgClient) DeclineDeadlineExtension(ctx context.Context, in *MsgDeclineDeadlineExtension, opts ...grpc.CallOption) (*MsgDeclineDeadlineExtensionResponse, error) {
+	out := new(MsgDeclineDeadlineExtensionResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/DeclineDeadlineExtension", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateProfile) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Bio)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Skills) > 0 {
-		for _, s := range m.Skills {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.HourlyRate != 0 {
-		n += 1 + sovTx(uint64(m.HourlyRate))
+func (c *msgClient) SetEscrowStaking(ctx context.Context, in *MsgSetEscrowStaking, opts ...grpc.CallOption) (*MsgSetEscrowStakingResponse, error) {
+	out := new(MsgSetEscrowStakingResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/SetEscrowStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateProfileResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterArbiter(ctx context.Context, in *MsgRegisterArbiter, opts ...grpc.CallOption) (*MsgRegisterArbiterResponse, error) {
+	out := new(MsgRegisterArbiterResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/RegisterArbiter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateGig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
-	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DeliveryDays != 0 {
-		n += 1 + sovTx(uint64(m.DeliveryDays))
+func (c *msgClient) UnbondArbiter(ctx context.Context, in *MsgUnbondArbiter, opts ...grpc.CallOption) (*MsgUnbondArbiterResponse, error) {
+	out := new(MsgUnbondArbiterResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/UnbondArbiter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateGigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (c *msgClient) RevealDisputeVote(ctx context.Context, in *MsgRevealDisputeVote, opts ...grpc.CallOption) (*MsgRevealDisputeVoteResponse, error) {
+	out := new(MsgRevealDisputeVoteResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/RevealDisputeVote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateGigStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GigId != 0 {
-		n += 1 + sovTx(uint64(m.GigId))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) AppealDispute(ctx context.Context, in *MsgAppealDispute, opts ...grpc.CallOption) (*MsgAppealDisputeResponse, error) {
+	out := new(MsgAppealDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/AppealDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateGigStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ContestDispute(ctx context.Context, in *MsgContestDispute, opts ...grpc.CallOption) (*MsgContestDisputeResponse, error) {
+	out := new(MsgContestDisputeResponse)
+	err := c.cc.Invoke(ctx, "/skillchain.marketplace.v1.Msg/ContestDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateApplication) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GigId != 0 {
-		n += 1 + sovTx(uint64(m.GigId))
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateProfile defines the CreateProfile RPC.
+	CreateProfile(context.Context, *MsgCreateProfile) (*MsgCreateProfileResponse, error)
+	// UpdateProfile defines the UpdateProfile RPC.
+	UpdateProfile(context.Context, *MsgUpdateProfile) (*MsgUpdateProfileResponse, error)
+	// CreateGig defines the CreateGig RPC.
+	CreateGig(context.Context, *MsgCreateGig) (*MsgCreateGigResponse, error)
+	// UpdateGigStatus defines the UpdateGigStatus RPC.
+	UpdateGigStatus(context.Context, *MsgUpdateGigStatus) (*MsgUpdateGigStatusResponse, error)
+	// CreateApplication defines the CreateApplication RPC.
+	CreateApplication(context.Context, *MsgCreateApplication) (*MsgCreateApplicationResponse, error)
+	// UpdateApplication defines the UpdateApplication RPC.
+	UpdateApplication(context.Context, *MsgUpdateApplication) (*MsgUpdateApplicationResponse, error)
+	// DeleteApplication defines the DeleteApplication RPC.
+	DeleteApplication(context.Context, *MsgDeleteApplication) (*MsgDeleteApplicationResponse, error)
+	// ApplyToGig defines the ApplyToGig RPC.
+	ApplyToGig(context.Context, *MsgApplyToGig) (*MsgApplyToGigResponse, error)
+	// WithdrawApplication defines the WithdrawApplication RPC.
+	WithdrawApplication(context.Context, *MsgWithdrawApplication) (*MsgWithdrawApplicationResponse, error)
+	// AcceptApplication defines the AcceptApplication RPC.
+	AcceptApplication(context.Context, *MsgAcceptApplication) (*MsgAcceptApplicationResponse, error)
+	// RejectApplication defines the RejectApplication RPC.
+	RejectApplication(context.Context, *MsgRejectApplication) (*MsgRejectApplicationResponse, error)
+	// DeliverContract defines the DeliverContract RPC.
+	DeliverContract(context.Context, *MsgDeliverContract) (*MsgDeliverContractResponse, error)
+	// CompleteContract defines the CompleteContract RPC.
+	CompleteContract(context.Context, *MsgCompleteContract) (*MsgCompleteContractResponse, error)
+	// DisputeContract defines the DisputeContract RPC.
+	DisputeContract(context.Context, *MsgDisputeContract) (*MsgDisputeContractResponse, error)
+	// OpenDispute defines the OpenDispute RPC.
+	OpenDispute(context.Context, *MsgOpenDispute) (*MsgOpenDisputeResponse, error)
+	// SubmitEvidence defines the SubmitEvidence RPC.
+	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// VoteDispute defines the VoteDispute RPC.
+	VoteDispute(context.Context, *MsgVoteDispute) (*MsgVoteDisputeResponse, error)
+	// ResolveDispute defines the ResolveDispute RPC.
+	ResolveDispute(context.Context, *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	// DeliverMilestone defines the DeliverMilestone RPC.
+	DeliverMilestone(context.Context, *MsgDeliverMilestone) (*MsgDeliverMilestoneResponse, error)
+	// ApproveMilestone defines the ApproveMilestone RPC.
+	ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error)
+	// CancelOverdueContract defines the CancelOverdueContract RPC.
+	CancelOverdueContract(context.Context, *MsgCancelOverdueContract) (*MsgCancelOverdueContractResponse, error)
+	// ProposeCancellation defines the ProposeCancellation RPC.
+	ProposeCancellation(context.Context, *MsgProposeCancellation) (*MsgProposeCancellationResponse, error)
+	// AcceptCancellation defines the AcceptCancellation RPC.
+	AcceptCancellation(context.Context, *MsgAcceptCancellation) (*MsgAcceptCancellationResponse, error)
+	// RejectCancellation defines the RejectCancellation RPC.
+	RejectCancellation(context.Context, *MsgRejectCancellation) (*MsgRejectCancellationResponse, error)
+	// TipFreelancer defines the TipFreelancer RPC.
+	TipFreelancer(context.Context, *MsgTipFreelancer) (*MsgTipFreelancerResponse, error)
+	// LogTime defines the LogTime RPC.
+	LogTime(context.Context, *MsgLogTime) (*MsgLogTimeResponse, error)
+	// ContestTimeLog defines the ContestTimeLog RPC.
+	ContestTimeLog(context.Context, *MsgContestTimeLog) (*MsgContestTimeLogResponse, error)
+	// FundHourlyContract defines the FundHourlyContract RPC.
+	FundHourlyContract(context.Context, *MsgFundHourlyContract) (*MsgFundHourlyContractResponse, error)
+	// ClaimStream defines the ClaimStream RPC.
+	ClaimStream(context.Context, *MsgClaimStream) (*MsgClaimStreamResponse, error)
+	// StopStream defines the StopStream RPC.
+	StopStream(context.Context, *MsgStopStream) (*MsgStopStreamResponse, error)
+	// FlagApplicationSpam defines the FlagApplicationSpam RPC.
+	FlagApplicationSpam(context.Context, *MsgFlagApplicationSpam) (*MsgFlagApplicationSpamResponse, error)
+	// ProposeAmendment defines the ProposeAmendment RPC.
+	ProposeAmendment(context.Context, *MsgProposeAmendment) (*MsgProposeAmendmentResponse, error)
+	// AcceptAmendment defines the AcceptAmendment RPC.
+	AcceptAmendment(context.Context, *MsgAcceptAmendment) (*MsgAcceptAmendmentResponse, error)
+	// RequestDeadlineExtension defines the RequestDeadlineExtension RPC.
+	RequestDeadlineExtension(context.Context, *MsgRequestDeadlineExtension) (*MsgRequestDeadlineExtensionResponse, error)
+	// ApproveDeadlineExtension defines the ApproveDeadlineExtension RPC.
+	ApproveDeadlineExtension(context.Context, *MsgApproveDeadlineExtension) (*MsgApproveDeadlineExtensionResponse, error)
+	// DeclineDeadlineExtension defines the DeclineDeadlineExtension RPC.
+	DeclineDeadlineExtension(context.Context, *MsgDeclineDeadlineExtension) (*MsgDeclineDeadlineExtensionResponse, error)
+	// SetEscrowStaking defines the SetEscrowStaking RPC.
+	SetEscrowStaking(context.Context, *MsgSetEscrowStaking) (*MsgSetEscrowStakingResponse, error)
+	// RegisterArbiter defines the RegisterArbiter RPC.
+	RegisterArbiter(context.Context, *MsgRegisterArbiter) (*MsgRegisterArbiterResponse, error)
+	// UnbondArbiter defines the UnbondArbiter RPC.
+	UnbondArbiter(context.Context, *MsgUnbondArbiter) (*MsgUnbondArbiterResponse, error)
+	// RevealDisputeVote defines the RevealDisputeVote RPC.
+	RevealDisputeVote(context.Context, *MsgRevealDisputeVote) (*MsgRevealDisputeVoteResponse, error)
+	// AppealDispute defines the AppealDispute RPC.
+	AppealDispute(context.Context, *MsgAppealDispute) (*MsgAppealDisputeResponse, error)
+	// ContestDispute defines the ContestDispute RPC.
+	ContestDispute(context.Context, *MsgContestDispute) (*MsgContestDisputeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateProfile(ctx context.Context, req *MsgCreateProfile) (*MsgCreateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProfile(ctx context.Context, req *MsgUpdateProfile) (*MsgUpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (*UnimplementedMsgServer) CreateGig(ctx context.Context, req *MsgCreateGig) (*MsgCreateGigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGig not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGigStatus(ctx context.Context, req *MsgUpdateGigStatus) (*MsgUpdateGigStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGigStatus not implemented")
+}
+func (*UnimplementedMsgServer) CreateApplication(ctx context.Context, req *MsgCreateApplication) (*MsgCreateApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApplication not implemented")
+}
+func (*UnimplementedMsgServer) UpdateApplication(ctx context.Context, req *MsgUpdateApplication) (*MsgUpdateApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplication not implemented")
+}
+func (*UnimplementedMsgServer) DeleteApplication(ctx context.Context, req *MsgDeleteApplication) (*MsgDeleteApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplication not implemented")
+}
+func (*UnimplementedMsgServer) ApplyToGig(ctx context.Context, req *MsgApplyToGig) (*MsgApplyToGigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyToGig not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawApplication(ctx context.Context, req *MsgWithdrawApplication) (*MsgWithdrawApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawApplication not implemented")
+}
+func (*UnimplementedMsgServer) AcceptApplication(ctx context.Context, req *MsgAcceptApplication) (*MsgAcceptApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptApplication not implemented")
+}
+func (*UnimplementedMsgServer) RejectApplication(ctx context.Context, req *MsgRejectApplication) (*MsgRejectApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectApplication not implemented")
+}
+func (*UnimplementedMsgServer) DeliverContract(ctx context.Context, req *MsgDeliverContract) (*MsgDeliverContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverContract not implemented")
+}
+func (*UnimplementedMsgServer) CompleteContract(ctx context.Context, req *MsgCompleteContract) (*MsgCompleteContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteContract not implemented")
+}
+func (*UnimplementedMsgServer) DisputeContract(ctx context.Context, req *MsgDisputeContract) (*MsgDisputeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeContract not implemented")
+}
+func (*UnimplementedMsgServer) OpenDispute(ctx context.Context, req *MsgOpenDispute) (*MsgOpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
+}
+func (*UnimplementedMsgServer) VoteDispute(ctx context.Context, req *MsgVoteDispute) (*MsgVoteDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDispute not implemented")
+}
+func (*UnimplementedMsgServer) ResolveDispute(ctx context.Context, req *MsgResolveDispute) (*MsgResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (*UnimplementedMsgServer) DeliverMilestone(ctx context.Context, req *MsgDeliverMilestone) (*MsgDeliverMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverMilestone not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMilestone(ctx context.Context, req *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMilestone not implemented")
+}
+func (*UnimplementedMsgServer) CancelOverdueContract(ctx context.Context, req *MsgCancelOverdueContract) (*MsgCancelOverdueContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOverdueContract not implemented")
+}
+func (*UnimplementedMsgServer) ProposeCancellation(ctx context.Context, req *MsgProposeCancellation) (*MsgProposeCancellationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeCancellation not implemented")
+}
+func (*UnimplementedMsgServer) AcceptCancellation(ctx context.Context, req *MsgAcceptCancellation) (*MsgAcceptCancellationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCancellation not implemented")
+}
+func (*UnimplementedMsgServer) RejectCancellation(ctx context.Context, req *MsgRejectCancellation) (*MsgRejectCancellationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCancellation not implemented")
+}
+func (*UnimplementedMsgServer) TipFreelancer(ctx context.Context, req *MsgTipFreelancer) (*MsgTipFreelancerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TipFreelancer not implemented")
+}
+func (*UnimplementedMsgServer) LogTime(ctx context.Context, req *MsgLogTime) (*MsgLogTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogTime not implemented")
+}
+func (*UnimplementedMsgServer) ContestTimeLog(ctx context.Context, req *MsgContestTimeLog) (*MsgContestTimeLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContestTimeLog not implemented")
+}
+func (*UnimplementedMsgServer) FundHourlyContract(ctx context.Context, req *MsgFundHourlyContract) (*MsgFundHourlyContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundHourlyContract not implemented")
+}
+func (*UnimplementedMsgServer) ClaimStream(ctx context.Context, req *MsgClaimStream) (*MsgClaimStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimStream not implemented")
+}
+func (*UnimplementedMsgServer) StopStream(ctx context.Context, req *MsgStopStream) (*MsgStopStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopStream not implemented")
+}
+func (*UnimplementedMsgServer) FlagApplicationSpam(ctx context.Context, req *MsgFlagApplicationSpam) (*MsgFlagApplicationSpamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagApplicationSpam not implemented")
+}
+func (*UnimplementedMsgServer) ProposeAmendment(ctx context.Context, req *MsgProposeAmendment) (*MsgProposeAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAmendment not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAmendment(ctx context.Context, req *MsgAcceptAmendment) (*MsgAcceptAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAmendment not implemented")
+}
+func (*UnimplementedMsgServer) RequestDeadlineExtension(ctx context.Context, req *MsgRequestDeadlineExtension) (*MsgRequestDeadlineExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDeadlineExtension not implemented")
+}
+func (*UnimplementedMsgServer) ApproveDeadlineExtension(ctx context.Context, req *MsgApproveDeadlineExtension) (*MsgApproveDeadlineExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDeadlineExtension not implemented")
+}
+func (*UnimplementedMsgServer) DeclineDeadlineExtension(ctx context.Context, req *MsgDeclineDeadlineExtension) (*MsgDeclineDeadlineExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineDeadlineExtension not implemented")
+}
+func (*UnimplementedMsgServer) SetEscrowStaking(ctx context.Context, req *MsgSetEscrowStaking) (*MsgSetEscrowStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEscrowStaking not implemented")
+}
+func (*UnimplementedMsgServer) RegisterArbiter(ctx context.Context, req *MsgRegisterArbiter) (*MsgRegisterArbiterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterArbiter not implemented")
+}
+func (*UnimplementedMsgServer) UnbondArbiter(ctx context.Context, req *MsgUnbondArbiter) (*MsgUnbondArbiterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondArbiter not implemented")
+}
+func (*UnimplementedMsgServer) RevealDisputeVote(ctx context.Context, req *MsgRevealDisputeVote) (*MsgRevealDisputeVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealDisputeVote not implemented")
+}
+func (*UnimplementedMsgServer) AppealDispute(ctx context.Context, req *MsgAppealDispute) (*MsgAppealDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealDispute not implemented")
+}
+func (*UnimplementedMsgServer) ContestDispute(ctx context.Context, req *MsgContestDispute) (*MsgContestDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContestDispute not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Freelancer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.CoverLetter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateParams",
 	}
-	if m.ProposedPrice != 0 {
-		n += 1 + sovTx(uint64(m.ProposedPrice))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if m.ProposedDays != 0 {
-		n += 1 + sovTx(uint64(m.ProposedDays))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateProfile)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateProfile(ctx, in)
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTx(uint64(m.CreatedAt))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/CreateProfile",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateProfile(ctx, req.(*MsgCreateProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProfile)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProfile(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgUpdateApplication) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateProfile",
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProfile(ctx, req.(*MsgUpdateProfile))
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GigId != 0 {
-		n += 1 + sovTx(uint64(m.GigId))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGig(ctx, in)
 	}
-	l = len(m.Freelancer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/CreateGig",
 	}
-	l = len(m.CoverLetter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGig(ctx, req.(*MsgCreateGig))
 	}
-	if m.ProposedPrice != 0 {
-		n += 1 + sovTx(uint64(m.ProposedPrice))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGigStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGigStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProposedDays != 0 {
-		n += 1 + sovTx(uint64(m.ProposedDays))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGigStatus(ctx, in)
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateGigStatus",
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTx(uint64(m.CreatedAt))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGigStatus(ctx, req.(*MsgUpdateGigStatus))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteApplication) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateApplication)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateApplication(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/CreateApplication",
 	}
-	return n
-}
-
-func (m *MsgDeleteApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateApplication(ctx, req.(*MsgCreateApplication))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GigId != 0 {
-		n += 1 + sovTx(uint64(m.GigId))
-	}
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+func _Msg_UpdateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateApplication)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateApplication(ctx, in)
 	}
-	l = len(m.Freelancer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/UpdateApplication",
 	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateApplication(ctx, req.(*MsgUpdateApplication))
 	}
-	if m.DeliveryDeadline != 0 {
-		n += 1 + sovTx(uint64(m.DeliveryDeadline))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteApplication)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteApplication(ctx, in)
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTx(uint64(m.CreatedAt))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/DeleteApplication",
 	}
-	if m.CompletedAt != 0 {
-		n += 1 + sovTx(uint64(m.CompletedAt))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteApplication(ctx, req.(*MsgDeleteApplication))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ApplyToGig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApplyToGig)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).ApplyToGig(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ApplyToGig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApplyToGig(ctx, req.(*MsgApplyToGig))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawApplication)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawApplication(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/WithdrawApplication",
 	}
-	if m.GigId != 0 {
-		n += 1 + sovTx(uint64(m.GigId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawApplication(ctx, req.(*MsgWithdrawApplication))
 	}
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptApplication)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptApplication(ctx, in)
 	}
-	l = len(m.Freelancer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/AcceptApplication",
 	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptApplication(ctx, req.(*MsgAcceptApplication))
 	}
-	if m.DeliveryDeadline != 0 {
-		n += 1 + sovTx(uint64(m.DeliveryDeadline))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectApplication)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RejectApplication(ctx, in)
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovTx(uint64(m.CreatedAt))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/RejectApplication",
 	}
-	if m.CompletedAt != 0 {
-		n += 1 + sovTx(uint64(m.CompletedAt))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectApplication(ctx, req.(*MsgRejectApplication))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeliverContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeliverContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteContract) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).DeliverContract(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/DeliverContract",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeliverContract(ctx, req.(*MsgDeliverContract))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CompleteContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompleteContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgApplyToGig) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CompleteContract(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/CompleteContract",
 	}
-	if m.GigId != 0 {
-		n += 1 + sovTx(uint64(m.GigId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CompleteContract(ctx, req.(*MsgCompleteContract))
 	}
-	l = len(m.CoverLetter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProposedPrice != 0 {
-		n += 1 + sovTx(uint64(m.ProposedPrice))
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeContract(ctx, in)
 	}
-	if m.ProposedDays != 0 {
-		n += 1 + sovTx(uint64(m.ProposedDays))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/DisputeContract",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeContract(ctx, req.(*MsgDisputeContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgApplyToGigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenDispute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+	if interceptor == nil {
+		return srv.(MsgServer).OpenDispute(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/OpenDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenDispute(ctx, req.(*MsgOpenDispute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawApplication) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEvidence(ctx, in)
 	}
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/SubmitEvidence",
 	}
-	return n
-}
-
-func (m *MsgWithdrawApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEvidence(ctx, req.(*MsgSubmitEvidence))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptApplication) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_VoteDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteDispute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).VoteDispute(ctx, in)
 	}
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/VoteDispute",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteDispute(ctx, req.(*MsgVoteDispute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDispute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDispute(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ResolveDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDispute(ctx, req.(*MsgResolveDispute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectApplication) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeliverMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeliverMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeliverMilestone(ctx, in)
 	}
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/DeliverMilestone",
 	}
-	return n
-}
-
-func (m *MsgRejectApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeliverMilestone(ctx, req.(*MsgDeliverMilestone))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeliverContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ApproveMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMilestone(ctx, in)
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ApproveMilestone",
 	}
-	l = len(m.DeliveryNote)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMilestone(ctx, req.(*MsgApproveMilestone))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeliverContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelOverdueContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOverdueContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOverdueContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/CancelOverdueContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOverdueContract(ctx, req.(*MsgCancelOverdueContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ProposeCancellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeCancellation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeCancellation(ctx, in)
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ProposeCancellation",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeCancellation(ctx, req.(*MsgProposeCancellation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCompleteContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptCancellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptCancellation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptCancellation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/AcceptCancellation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptCancellation(ctx, req.(*MsgAcceptCancellation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDisputeContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RejectCancellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectCancellation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RejectCancellation(ctx, in)
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/RejectCancellation",
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectCancellation(ctx, req.(*MsgRejectCancellation))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDisputeContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TipFreelancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTipFreelancer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).TipFreelancer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/TipFreelancer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TipFreelancer(ctx, req.(*MsgTipFreelancer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOpenDispute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_LogTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLogTime)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	if interceptor == nil {
+		return srv.(MsgServer).LogTime(ctx, in)
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/LogTime",
 	}
-	l = len(m.Evidence)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LogTime(ctx, req.(*MsgLogTime))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgOpenDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ContestTimeLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgContestTimeLog)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DisputeId != 0 {
-		n += 1 + sovTx(uint64(m.DisputeId))
+	if interceptor == nil {
+		return srv.(MsgServer).ContestTimeLog(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ContestTimeLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ContestTimeLog(ctx, req.(*MsgContestTimeLog))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitEvidence) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FundHourlyContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundHourlyContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).FundHourlyContract(ctx, in)
 	}
-	if m.DisputeId != 0 {
-		n += 1 + sovTx(uint64(m.DisputeId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/FundHourlyContract",
 	}
-	l = len(m.Evidence)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundHourlyContract(ctx, req.(*MsgFundHourlyContract))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimStream)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ClaimStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimStream(ctx, req.(*MsgClaimStream))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteDispute) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_StopStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStopStream)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).StopStream(ctx, in)
 	}
-	if m.DisputeId != 0 {
-		n += 1 + sovTx(uint64(m.DisputeId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/StopStream",
 	}
-	l = len(m.Vote)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StopStream(ctx, req.(*MsgStopStream))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgVoteDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FlagApplicationSpam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlagApplicationSpam)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).FlagApplicationSpam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/FlagApplicationSpam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlagApplicationSpam(ctx, req.(*MsgFlagApplicationSpam))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgResolveDispute) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ProposeAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAmendment(ctx, in)
 	}
-	if m.DisputeId != 0 {
-		n += 1 + sovTx(uint64(m.DisputeId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ProposeAmendment",
 	}
-	l = len(m.Winner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAmendment(ctx, req.(*MsgProposeAmendment))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgResolveDisputeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/AcceptAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAmendment(ctx, req.(*MsgAcceptAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_RequestDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestDeadlineExtension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestDeadlineExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/RequestDeadlineExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestDeadlineExtension(ctx, req.(*MsgRequestDeadlineExtension))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_ApproveDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveDeadlineExtension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveDeadlineExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ApproveDeadlineExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveDeadlineExtension(ctx, req.(*MsgApproveDeadlineExtension))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeclineDeadlineExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeclineDeadlineExtension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeclineDeadlineExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/DeclineDeadlineExtension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeclineDeadlineExtension(ctx, req.(*MsgDeclineDeadlineExtension))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEscrowStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEscrowStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEscrowStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/SetEscrowStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEscrowStaking(ctx, req.(*MsgSetEscrowStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterArbiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterArbiter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterArbiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/RegisterArbiter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterArbiter(ctx, req.(*MsgRegisterArbiter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondArbiter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondArbiter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondArbiter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/UnbondArbiter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondArbiter(ctx, req.(*MsgUnbondArbiter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealDisputeVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealDisputeVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealDisputeVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/RevealDisputeVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealDisputeVote(ctx, req.(*MsgRevealDisputeVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppealDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/AppealDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealDispute(ctx, req.(*MsgAppealDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ContestDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgContestDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ContestDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/skillchain.marketplace.v1.Msg/ContestDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ContestDispute(ctx, req.(*MsgContestDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "skillchain.marketplace.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateProfile",
+			Handler:    _Msg_CreateProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Msg_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "CreateGig",
+			Handler:    _Msg_CreateGig_Handler,
+		},
+		{
+			MethodName: "UpdateGigStatus",
+			Handler:    _Msg_UpdateGigStatus_Handler,
+		},
+		{
+			MethodName: "CreateApplication",
+			Handler:    _Msg_CreateApplication_Handler,
+		},
+		{
+			MethodName: "UpdateApplication",
+			Handler:    _Msg_UpdateApplication_Handler,
+		},
+		{
+			MethodName: "DeleteApplication",
+			Handler:    _Msg_DeleteApplication_Handler,
+		},
+		{
+			MethodName: "ApplyToGig",
+			Handler:    _Msg_ApplyToGig_Handler,
+		},
+		{
+			MethodName: "WithdrawApplication",
+			Handler:    _Msg_WithdrawApplication_Handler,
+		},
+		{
+			MethodName: "AcceptApplication",
+			Handler:    _Msg_AcceptApplication_Handler,
+		},
+		{
+			MethodName: "RejectApplication",
+			Handler:    _Msg_RejectApplication_Handler,
+		},
+		{
+			MethodName: "DeliverContract",
+			Handler:    _Msg_DeliverContract_Handler,
+		},
+		{
+			MethodName: "CompleteContract",
+			Handler:    _Msg_CompleteContract_Handler,
+		},
+		{
+			MethodName: "DisputeContract",
+			Handler:    _Msg_DisputeContract_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _Msg_OpenDispute_Handler,
+		},
+		{
+			MethodName: "SubmitEvidence",
+			Handler:    _Msg_SubmitEvidence_Handler,
+		},
+		{
+			MethodName: "VoteDispute",
+			Handler:    _Msg_VoteDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _Msg_ResolveDispute_Handler,
+		},
+		{
+			MethodName: "DeliverMilestone",
+			Handler:    _Msg_DeliverMilestone_Handler,
+		},
+		{
+			MethodName: "ApproveMilestone",
+			Handler:    _Msg_ApproveMilestone_Handler,
+		},
+		{
+			MethodName: "CancelOverdueContract",
+			Handler:    _Msg_CancelOverdueContract_Handler,
+		},
+		{
+			MethodName: "ProposeCancellation",
+			Handler:    _Msg_ProposeCancellation_Handler,
+		},
+		{
+			MethodName: "AcceptCancellation",
+			Handler:    _Msg_AcceptCancellation_Handler,
+		},
+		{
+			MethodName: "RejectCancellation",
+			Handler:    _Msg_RejectCancellation_Handler,
+		},
+		{
+			MethodName: "TipFreelancer",
+			Handler:    _Msg_TipFreelancer_Handler,
+		},
+		{
+			MethodName: "LogTime",
+			Handler:    _Msg_LogTime_Handler,
+		},
+		{
+			MethodName: "ContestTimeLog",
+			Handler:    _Msg_ContestTimeLog_Handler,
+		},
+		{
+			MethodName: "FundHourlyContract",
+			Handler:    _Msg_FundHourlyContract_Handler,
+		},
+		{
+			MethodName: "ClaimStream",
+			Handler:    _Msg_ClaimStream_Handler,
+		},
+		{
+			MethodName: "StopStream",
+			Handler:    _Msg_StopStream_Handler,
+		},
+		{
+			MethodName: "FlagApplicationSpam",
+			Handler:    _Msg_FlagApplicationSpam_Handler,
+		},
+		{
+			MethodName: "ProposeAmendment",
+			Handler:    _Msg_ProposeAmendment_Handler,
+		},
+		{
+			MethodName: "AcceptAmendment",
+			Handler:    _Msg_AcceptAmendment_Handler,
+		},
+		{
+			MethodName: "RequestDeadlineExtension",
+			Handler:    _Msg_RequestDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "ApproveDeadlineExtension",
+			Handler:    _Msg_ApproveDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "DeclineDeadlineExtension",
+			Handler:    _Msg_DeclineDeadlineExtension_Handler,
+		},
+		{
+			MethodName: "SetEscrowStaking",
+			Handler:    _Msg_SetEscrowStaking_Handler,
+		},
+		{
+			MethodName: "RegisterArbiter",
+			Handler:    _Msg_RegisterArbiter_Handler,
+		},
+		{
+			MethodName: "UnbondArbiter",
+			Handler:    _Msg_UnbondArbiter_Handler,
+		},
+		{
+			MethodName: "RevealDisputeVote",
+			Handler:    _Msg_RevealDisputeVote_Handler,
+		},
+		{
+			MethodName: "AppealDispute",
+			Handler:    _Msg_AppealDispute_Handler,
+		},
+		{
+			MethodName: "ContestDispute",
+			Handler:    _Msg_ContestDispute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "skillchain/marketplace/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HourlyRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HourlyRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Skills) > 0 {
+		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skills[iNdEx])
+			copy(dAtA[i:], m.Skills[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Skills[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Bio) > 0 {
+		i -= len(m.Bio)
+		copy(dAtA[i:], m.Bio)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bio)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HourlyRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HourlyRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Skills) > 0 {
+		for iNdEx := len(m.Skills) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Skills[iNdEx])
+			copy(dAtA[i:], m.Skills[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Skills[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Bio) > 0 {
+		i -= len(m.Bio)
+		copy(dAtA[i:], m.Bio)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bio)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Funded {
+		i--
+		if m.Funded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.DeliveryDays != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeliveryDays))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGigStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGigStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGigStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GigId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGigStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGigStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGigStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.CreatedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ProposedDays != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposedDays))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CoverLetter) > 0 {
+		i -= len(m.CoverLetter)
+		copy(dAtA[i:], m.CoverLetter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoverLetter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GigId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.CreatedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ProposedDays != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposedDays))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CoverLetter) > 0 {
+		i -= len(m.CoverLetter)
+		copy(dAtA[i:], m.CoverLetter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoverLetter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Freelancer) > 0 {
+		i -= len(m.Freelancer)
+		copy(dAtA[i:], m.Freelancer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Freelancer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GigId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApplyToGig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApplyToGig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApplyToGig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Streaming {
+		i--
+		if m.Streaming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.HourlyRate.Size()
+		i -= size
+		if _, err := m.HourlyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.WeeklyHoursCap != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WeeklyHoursCap))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.ProposedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ProposedDays != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposedDays))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CoverLetter) > 0 {
+		i -= len(m.CoverLetter)
+		copy(dAtA[i:], m.CoverLetter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoverLetter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GigId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GigId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApplyToGigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApplyToGigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApplyToGigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeliveryNote) > 0 {
+		i -= len(m.DeliveryNote)
+		copy(dAtA[i:], m.DeliveryNote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeliveryNote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompleteContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompleteContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompleteContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeliveryNote) > 0 {
+		i -= len(m.DeliveryNote)
+		copy(dAtA[i:], m.DeliveryNote)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeliveryNote)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MilestoneIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MilestoneIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeliverMilestoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeliverMilestoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeliverMilestoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MilestoneIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MilestoneIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMilestoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMilestoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMilestoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOverdueContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOverdueContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOverdueContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReopenGig {
+		i--
+		if m.ReopenGig {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOverdueContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOverdueContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOverdueContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeCancellation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeCancellation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeCancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FreelancerPayout.Size()
+		i -= size
+		if _, err := m.FreelancerPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeCancellationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeCancellationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeCancellationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptCancellation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptCancellation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptCancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptCancellationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptCancellationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptCancellationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Payout) > 0 {
+		for iNdEx := len(m.Payout) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectCancellation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectCancellation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectCancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectCancellationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectCancellationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectCancellationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTipFreelancer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTipFreelancer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTipFreelancer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTipFreelancerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTipFreelancerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTipFreelancerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TipId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TipId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLogTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLogTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLogTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DescriptionHash) > 0 {
+		i -= len(m.DescriptionHash)
+		copy(dAtA[i:], m.DescriptionHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DescriptionHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Hours != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Hours))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLogTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLogTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLogTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContestDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContestDeadline))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TimeLogId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeLogId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgContestTimeLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgContestTimeLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgContestTimeLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TimeLogId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeLogId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgContestTimeLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgContestTimeLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgContestTimeLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundHourlyContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundHourlyContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundHourlyContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundHourlyContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundHourlyContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundHourlyContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStopStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStopStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStopStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStopStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStopStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStopStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlagApplicationSpam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlagApplicationSpam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlagApplicationSpam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlagApplicationSpamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlagApplicationSpamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlagApplicationSpamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Forfeited) > 0 {
+		for iNdEx := len(m.Forfeited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forfeited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DescriptionHash) > 0 {
+		i -= len(m.DescriptionHash)
+		copy(dAtA[i:], m.DescriptionHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DescriptionHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DeliveryDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeliveryDeadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestDeadlineExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestDeadlineExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestDeadlineExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewDeadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewDeadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestDeadlineExtensionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestDeadlineExtensionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestDeadlineExtensionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtensionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtensionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveDeadlineExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveDeadlineExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveDeadlineExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtensionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtensionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveDeadlineExtensionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveDeadlineExtensionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveDeadlineExtensionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclineDeadlineExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclineDeadlineExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclineDeadlineExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtensionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtensionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeclineDeadlineExtensionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeclineDeadlineExtensionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeclineDeadlineExtensionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEscrowStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEscrowStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEscrowStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEscrowStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEscrowStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEscrowStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterArbiter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterArbiter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterArbiter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterArbiterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterArbiterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterArbiterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondArbiter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondArbiter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondArbiter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondArbiterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondArbiterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondArbiterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndsAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingEndsAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealDisputeVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealDisputeVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealDisputeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreelancerPayoutBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FreelancerPayoutBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealDisputeVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealDisputeVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealDisputeVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgContestDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgContestDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgContestDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgContestDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgContestDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgContestDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bio)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Skills) > 0 {
+		for _, s := range m.Skills {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.HourlyRate != 0 {
+		n += 1 + sovTx(uint64(m.HourlyRate))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bio)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Skills) > 0 {
+		for _, s := range m.Skills {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.HourlyRate != 0 {
+		n += 1 + sovTx(uint64(m.HourlyRate))
+	}
+	return n
+}
+
+func (m *MsgUpdateProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateGig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeliveryDays != 0 {
+		n += 1 + sovTx(uint64(m.DeliveryDays))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Funded {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateGigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateGigStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GigId != 0 {
+		n += 1 + sovTx(uint64(m.GigId))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGigStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GigId != 0 {
+		n += 1 + sovTx(uint64(m.GigId))
+	}
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CoverLetter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposedDays != 0 {
+		n += 1 + sovTx(uint64(m.ProposedDays))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTx(uint64(m.CreatedAt))
+	}
+	l = m.ProposedPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.GigId != 0 {
+		n += 1 + sovTx(uint64(m.GigId))
+	}
+	l = len(m.Freelancer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CoverLetter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposedDays != 0 {
+		n += 1 + sovTx(uint64(m.ProposedDays))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTx(uint64(m.CreatedAt))
+	}
+	l = m.ProposedPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApplyToGig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GigId != 0 {
+		n += 1 + sovTx(uint64(m.GigId))
+	}
+	l = len(m.CoverLetter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposedDays != 0 {
+		n += 1 + sovTx(uint64(m.ProposedDays))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProposedPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.WeeklyHoursCap != 0 {
+		n += 1 + sovTx(uint64(m.WeeklyHoursCap))
+	}
+	l = m.HourlyRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Streaming {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApplyToGigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgAcceptApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgRejectApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgRejectApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeliverContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.DeliveryNote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeliverContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCompleteContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgCompleteContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisputeContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	return n
+}
+
+func (m *MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeliverMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.MilestoneIndex != 0 {
+		n += 1 + sovTx(uint64(m.MilestoneIndex))
+	}
+	l = len(m.DeliveryNote)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeliverMilestoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.MilestoneIndex != 0 {
+		n += 1 + sovTx(uint64(m.MilestoneIndex))
+	}
+	return n
+}
+
+func (m *MsgApproveMilestoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOverdueContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.ReopenGig {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelOverdueContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeCancellation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.FreelancerPayout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeCancellationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgAcceptCancellation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgAcceptCancellationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payout) > 0 {
+		for _, e := range m.Payout {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRejectCancellation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgRejectCancellationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTipFreelancer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTipFreelancerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TipId != 0 {
+		n += 1 + sovTx(uint64(m.TipId))
+	}
+	return n
+}
+
+func (m *MsgLogTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Hours != 0 {
+		n += 1 + sovTx(uint64(m.Hours))
+	}
+	l = len(m.DescriptionHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLogTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeLogId != 0 {
+		n += 1 + sovTx(uint64(m.TimeLogId))
+	}
+	if m.ContestDeadline != 0 {
+		n += 1 + sovTx(uint64(m.ContestDeadline))
+	}
+	return n
+}
+
+func (m *MsgContestTimeLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeLogId != 0 {
+		n += 1 + sovTx(uint64(m.TimeLogId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgContestTimeLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundHourlyContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundHourlyContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgClaimStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStopStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgStopStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlagApplicationSpam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgFlagApplicationSpamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Forfeited) > 0 {
+		for _, e := range m.Forfeited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeliveryDeadline != 0 {
+		n += 1 + sovTx(uint64(m.DeliveryDeadline))
+	}
+	l = len(m.DescriptionHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgAcceptAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestDeadlineExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.NewDeadline != 0 {
+		n += 1 + sovTx(uint64(m.NewDeadline))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestDeadlineExtensionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExtensionId != 0 {
+		n += 1 + sovTx(uint64(m.ExtensionId))
+	}
+	return n
+}
+
+func (m *MsgApproveDeadlineExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExtensionId != 0 {
+		n += 1 + sovTx(uint64(m.ExtensionId))
+	}
+	return n
+}
+
+func (m *MsgApproveDeadlineExtensionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeclineDeadlineExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExtensionId != 0 {
+		n += 1 + sovTx(uint64(m.ExtensionId))
+	}
+	return n
+}
+
+func (m *MsgDeclineDeadlineExtensionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEscrowStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetEscrowStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterArbiter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterArbiterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondArbiter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondArbiterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingEndsAt != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingEndsAt))
+	}
+	return n
+}
+
+func (m *MsgRevealDisputeVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FreelancerPayoutBps != 0 {
+		n += 1 + sovTx(uint64(m.FreelancerPayoutBps))
+	}
+	return n
+}
+
+func (m *MsgRevealDisputeVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAppealDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *MsgContestDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputeId != 0 {
+		n += 1 + sovTx(uint64(m.DisputeId))
+	}
+	return n
+}
+
+func (m *MsgContestDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
+			}
+			m.HourlyRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HourlyRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
+			}
+			m.HourlyRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HourlyRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDays", wireType)
+			}
+			m.DeliveryDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Funded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGigStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			}
+			m.GigId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GigId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGigStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGigStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGigStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			}
+			m.GigId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GigId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoverLetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedDays", wireType)
+			}
+			m.ProposedDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			}
+			m.GigId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GigId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoverLetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedDays", wireType)
+			}
+			m.ProposedDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApplyToGig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApplyToGig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+			}
+			m.GigId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GigId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoverLetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedDays", wireType)
+			}
+			m.ProposedDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeeklyHoursCap", wireType)
+			}
+			m.WeeklyHoursCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeeklyHoursCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HourlyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Streaming = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApplyToGigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApplyToGigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApplyToGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+			}
+			m.ApplicationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+			}
+			m.ApplicationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+			}
+			m.ApplicationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+			}
+			m.ApplicationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeliverContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeliverContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeliverContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompleteContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompleteContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompleteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisputeContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisputeContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisputeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5951,15 +14723,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5987,13 +14759,32 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+			}
+			m.DisputeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6003,24 +14794,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6043,7 +14833,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6066,10 +14856,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6093,7 +14883,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6116,10 +14906,10 @@ func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateProfile: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6155,42 +14945,10 @@ func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
 			}
-			var stringLen uint64
+			m.DisputeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6200,27 +14958,14 @@ func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DisputeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6248,27 +14993,8 @@ func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
+			m.Commitment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
-			}
-			m.HourlyRate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HourlyRate |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6290,7 +15016,7 @@ func (m *MsgCreateProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateProfileResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6313,31 +15039,12 @@ func (m *MsgCreateProfileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateProfileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6359,7 +15066,7 @@ func (m *MsgCreateProfileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6382,10 +15089,10 @@ func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProfile: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6421,10 +15128,10 @@ func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
 			}
-			var stringLen uint64
+			m.DisputeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6434,93 +15141,16 @@ func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DisputeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Skills", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Skills = append(m.Skills, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HourlyRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
-			m.HourlyRate = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6530,11 +15160,24 @@ func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HourlyRate |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6556,7 +15199,7 @@ func (m *MsgUpdateProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateProfileResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6579,10 +15222,10 @@ func (m *MsgUpdateProfileResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProfileResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6606,7 +15249,7 @@ func (m *MsgUpdateProfileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
+func (m *MsgDeliverMilestone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6629,10 +15272,10 @@ func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeliverMilestone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeliverMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6668,10 +15311,10 @@ func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var stringLen uint64
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6681,61 +15324,16 @@ func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneIndex", wireType)
 			}
-			m.Price = 0
+			m.MilestoneIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6745,14 +15343,14 @@ func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.MilestoneIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryNote", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6780,27 +15378,8 @@ func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
+			m.DeliveryNote = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDays", wireType)
-			}
-			m.DeliveryDays = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeliveryDays |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6822,7 +15401,7 @@ func (m *MsgCreateGig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeliverMilestoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6845,31 +15424,12 @@ func (m *MsgCreateGigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeliverMilestoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeliverMilestoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6891,7 +15451,7 @@ func (m *MsgCreateGigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveMilestone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6914,10 +15474,10 @@ func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGigStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveMilestone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGigStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6954,9 +15514,9 @@ func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.GigId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6966,16 +15526,16 @@ func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneIndex", wireType)
 			}
-			var stringLen uint64
+			m.MilestoneIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6985,24 +15545,11 @@ func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MilestoneIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7024,7 +15571,7 @@ func (m *MsgUpdateGigStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGigStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveMilestoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7047,10 +15594,10 @@ func (m *MsgUpdateGigStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGigStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveMilestoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGigStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveMilestoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7074,7 +15621,7 @@ func (m *MsgUpdateGigStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelOverdueContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7097,10 +15644,10 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelOverdueContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelOverdueContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7137,9 +15684,9 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.GigId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7149,16 +15696,86 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReopenGig", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReopenGig = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOverdueContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOverdueContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOverdueContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7168,27 +15785,79 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeCancellation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freelancer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeCancellation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeCancellation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7216,32 +15885,13 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoverLetter = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
-			}
-			m.ProposedPrice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposedPrice |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.ProposedDays = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7251,14 +15901,14 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposedDays |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerPayout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7286,13 +15936,15 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if err := m.FreelancerPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.CreatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7302,11 +15954,24 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7328,7 +15993,7 @@ func (m *MsgCreateApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeCancellationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7351,17 +16016,17 @@ func (m *MsgCreateApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeCancellationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeCancellationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.Id = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7371,7 +16036,7 @@ func (m *MsgCreateApplicationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7397,7 +16062,7 @@ func (m *MsgCreateApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptCancellation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7420,10 +16085,10 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptCancellation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptCancellation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7460,9 +16125,9 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7472,35 +16137,66 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GigId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptCancellationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptCancellationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptCancellationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7510,29 +16206,31 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			m.Payout = append(m.Payout, types.Coin{})
+			if err := m.Payout[len(m.Payout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7542,65 +16240,79 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoverLetter = string(dAtA[iNdEx:postIndex])
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ProposedPrice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposedPrice |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedDays", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ProposedDays = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposedDays |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectCancellation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectCancellation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectCancellation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7628,13 +16340,13 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.CreatedAt = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7644,7 +16356,7 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7670,7 +16382,7 @@ func (m *MsgUpdateApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectCancellationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7693,10 +16405,10 @@ func (m *MsgUpdateApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectCancellationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectCancellationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7720,7 +16432,7 @@ func (m *MsgUpdateApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgTipFreelancer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7743,10 +16455,10 @@ func (m *MsgDeleteApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTipFreelancer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTipFreelancer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7783,9 +16495,9 @@ func (m *MsgDeleteApplication) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7795,11 +16507,76 @@ func (m *MsgDeleteApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7821,7 +16598,7 @@ func (m *MsgDeleteApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTipFreelancerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7844,12 +16621,31 @@ func (m *MsgDeleteApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTipFreelancerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTipFreelancerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipId", wireType)
+			}
+			m.TipId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TipId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7871,7 +16667,7 @@ func (m *MsgDeleteApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgLogTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7894,10 +16690,10 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLogTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLogTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7934,9 +16730,9 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.GigId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7946,16 +16742,16 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hours", wireType)
 			}
-			m.ApplicationId = 0
+			m.Hours = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7965,14 +16761,14 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplicationId |= uint64(b&0x7F) << shift
+				m.Hours |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8000,45 +16796,63 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
+			m.DescriptionHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLogTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freelancer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLogTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLogTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLogId", wireType)
 			}
-			m.Price = 0
+			m.TimeLogId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8048,16 +16862,16 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.TimeLogId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContestDeadline", wireType)
 			}
-			m.DeliveryDeadline = 0
+			m.ContestDeadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8067,14 +16881,64 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeliveryDeadline |= int64(b&0x7F) << shift
+				m.ContestDeadline |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgContestTimeLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgContestTimeLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgContestTimeLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8102,13 +16966,13 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLogId", wireType)
 			}
-			m.CreatedAt = 0
+			m.TimeLogId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8118,16 +16982,16 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.TimeLogId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.CompletedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8137,11 +17001,24 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8163,7 +17040,7 @@ func (m *MsgCreateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgContestTimeLogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8186,31 +17063,12 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgContestTimeLogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgContestTimeLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8232,7 +17090,7 @@ func (m *MsgCreateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgFundHourlyContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8255,10 +17113,10 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundHourlyContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundHourlyContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8295,9 +17153,9 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8307,54 +17165,16 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
-			}
-			m.GigId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
-			}
-			m.ApplicationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplicationId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8364,27 +17184,128 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundHourlyContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundHourlyContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundHourlyContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freelancer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8412,13 +17333,13 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freelancer = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Price = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8428,16 +17349,66 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.DeliveryDeadline = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8447,14 +17418,79 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeliveryDeadline |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStopStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStopStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStopStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8482,32 +17518,13 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.CompletedAt = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8517,7 +17534,7 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletedAt |= int64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8543,7 +17560,7 @@ func (m *MsgUpdateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStopStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8566,12 +17583,80 @@ func (m *MsgUpdateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStopStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStopStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8593,7 +17678,7 @@ func (m *MsgUpdateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteContract) Unmarshal(dAtA []byte) error {
+func (m *MsgFlagApplicationSpam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8616,10 +17701,10 @@ func (m *MsgDeleteContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlagApplicationSpam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlagApplicationSpam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8656,9 +17741,9 @@ func (m *MsgDeleteContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
 			}
-			m.Id = 0
+			m.ApplicationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8668,7 +17753,7 @@ func (m *MsgDeleteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ApplicationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8694,7 +17779,7 @@ func (m *MsgDeleteContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlagApplicationSpamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8717,12 +17802,46 @@ func (m *MsgDeleteContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlagApplicationSpamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlagApplicationSpamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forfeited = append(m.Forfeited, types.Coin{})
+			if err := m.Forfeited[len(m.Forfeited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8744,7 +17863,7 @@ func (m *MsgDeleteContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAmendment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8767,17 +17886,68 @@ func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApplyToGig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAmendment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApplyToGig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8787,29 +17957,30 @@ func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GigId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
 			}
-			m.GigId = 0
+			m.DeliveryDeadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8819,14 +17990,14 @@ func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GigId |= uint64(b&0x7F) << shift
+				m.DeliveryDeadline |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoverLetter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8854,13 +18025,13 @@ func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoverLetter = string(dAtA[iNdEx:postIndex])
+			m.DescriptionHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedPrice", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.ProposedPrice = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8870,30 +18041,24 @@ func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposedPrice |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedDays", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ProposedDays = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposedDays |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8915,7 +18080,7 @@ func (m *MsgApplyToGig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApplyToGigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAmendmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8938,31 +18103,12 @@ func (m *MsgApplyToGigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApplyToGigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAmendmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApplyToGigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
-			}
-			m.ApplicationId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplicationId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8984,7 +18130,7 @@ func (m *MsgApplyToGigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAmendment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9007,10 +18153,10 @@ func (m *MsgWithdrawApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAmendment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9047,9 +18193,9 @@ func (m *MsgWithdrawApplication) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.ApplicationId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9059,7 +18205,7 @@ func (m *MsgWithdrawApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplicationId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9085,7 +18231,7 @@ func (m *MsgWithdrawApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAmendmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9108,10 +18254,10 @@ func (m *MsgWithdrawApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAmendmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9135,7 +18281,7 @@ func (m *MsgWithdrawApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestDeadlineExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9158,10 +18304,10 @@ func (m *MsgAcceptApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestDeadlineExtension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestDeadlineExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9198,9 +18344,9 @@ func (m *MsgAcceptApplication) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.ApplicationId = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9210,11 +18356,62 @@ func (m *MsgAcceptApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplicationId |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDeadline", wireType)
+			}
+			m.NewDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9236,7 +18433,7 @@ func (m *MsgAcceptApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestDeadlineExtensionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9259,17 +18456,17 @@ func (m *MsgAcceptApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestDeadlineExtensionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestDeadlineExtensionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionId", wireType)
 			}
-			m.ContractId = 0
+			m.ExtensionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9279,7 +18476,7 @@ func (m *MsgAcceptApplicationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				m.ExtensionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9305,7 +18502,7 @@ func (m *MsgAcceptApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveDeadlineExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9328,10 +18525,10 @@ func (m *MsgRejectApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveDeadlineExtension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveDeadlineExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9368,9 +18565,9 @@ func (m *MsgRejectApplication) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionId", wireType)
 			}
-			m.ApplicationId = 0
+			m.ExtensionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9380,7 +18577,7 @@ func (m *MsgRejectApplication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApplicationId |= uint64(b&0x7F) << shift
+				m.ExtensionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9406,7 +18603,7 @@ func (m *MsgRejectApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRejectApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveDeadlineExtensionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9429,10 +18626,10 @@ func (m *MsgRejectApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRejectApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveDeadlineExtensionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRejectApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveDeadlineExtensionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9456,7 +18653,7 @@ func (m *MsgRejectApplicationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeliverContract) Unmarshal(dAtA []byte) error {
+func (m *MsgDeclineDeadlineExtension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9479,10 +18676,10 @@ func (m *MsgDeliverContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeclineDeadlineExtension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeclineDeadlineExtension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9519,28 +18716,9 @@ func (m *MsgDeliverContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			m.ContractId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryNote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionId", wireType)
 			}
-			var stringLen uint64
+			m.ExtensionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9550,24 +18728,11 @@ func (m *MsgDeliverContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExtensionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeliveryNote = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9589,7 +18754,7 @@ func (m *MsgDeliverContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeliverContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeclineDeadlineExtensionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9612,10 +18777,10 @@ func (m *MsgDeliverContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeliverContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeclineDeadlineExtensionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeliverContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeclineDeadlineExtensionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9639,7 +18804,7 @@ func (m *MsgDeliverContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEscrowStaking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9662,10 +18827,10 @@ func (m *MsgCompleteContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEscrowStaking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEscrowStaking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9719,6 +18884,26 @@ func (m *MsgCompleteContract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9740,7 +18925,7 @@ func (m *MsgCompleteContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCompleteContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEscrowStakingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9763,10 +18948,10 @@ func (m *MsgCompleteContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCompleteContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEscrowStakingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCompleteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEscrowStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9790,7 +18975,7 @@ func (m *MsgCompleteContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisputeContract) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterArbiter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9813,10 +18998,10 @@ func (m *MsgDisputeContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisputeContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterArbiter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisputeContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterArbiter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9852,29 +19037,10 @@ func (m *MsgDisputeContract) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			m.ContractId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9884,23 +19050,24 @@ func (m *MsgDisputeContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9923,7 +19090,7 @@ func (m *MsgDisputeContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisputeContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterArbiterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9946,10 +19113,10 @@ func (m *MsgDisputeContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisputeContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterArbiterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisputeContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterArbiterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9973,7 +19140,7 @@ func (m *MsgDisputeContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondArbiter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9996,10 +19163,10 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenDispute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondArbiter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondArbiter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10034,89 +19201,6 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			m.ContractId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Evidence = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10138,7 +19222,7 @@ func (m *MsgOpenDispute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondArbiterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10161,17 +19245,17 @@ func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenDisputeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondArbiterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondArbiterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisputeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndsAt", wireType)
 			}
-			m.DisputeId = 0
+			m.UnbondingEndsAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10181,7 +19265,7 @@ func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DisputeId |= uint64(b&0x7F) << shift
+				m.UnbondingEndsAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10207,7 +19291,7 @@ func (m *MsgOpenDisputeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealDisputeVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10230,10 +19314,10 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealDisputeVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealDisputeVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10287,9 +19371,9 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10317,8 +19401,27 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidence = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreelancerPayoutBps", wireType)
+			}
+			m.FreelancerPayoutBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreelancerPayoutBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10340,7 +19443,7 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealDisputeVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10363,10 +19466,10 @@ func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealDisputeVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealDisputeVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10390,7 +19493,7 @@ func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10413,10 +19516,10 @@ func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteDispute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10472,7 +19575,7 @@ func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10500,7 +19603,7 @@ func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vote = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10523,7 +19626,7 @@ func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteDisputeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10546,12 +19649,64 @@ func (m *MsgVoteDisputeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteDisputeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10573,7 +19728,7 @@ func (m *MsgVoteDisputeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
+func (m *MsgContestDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10596,10 +19751,10 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolveDispute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgContestDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolveDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgContestDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10653,38 +19808,6 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10706,7 +19829,7 @@ func (m *MsgResolveDispute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgContestDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10729,12 +19852,45 @@ func (m *MsgResolveDisputeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolveDisputeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgContestDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolveDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgContestDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])